@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 
@@ -19,6 +20,7 @@ import (
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -50,7 +52,9 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/supplyx"
 	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
+	"github.com/coinexchain/dex/app/indexer"
 	"github.com/coinexchain/dex/app/plugin"
+	"github.com/coinexchain/dex/app/vesting"
 	tserver "github.com/coinexchain/trade-server/server"
 )
 
@@ -99,6 +103,7 @@ func init() {
 		comment.AppModuleBasic{},
 		incentive.AppModuleBasic{},
 		autoswap.AppModuleBasic{},
+		vesting.AppModuleBasic{},
 
 		//modules wraps those of cosmos
 		authx.AppModuleBasic{}, //before `bank` to override `/bank/balances/{address}`
@@ -140,6 +145,8 @@ type CetChainApp struct {
 	txDecoder sdk.TxDecoder // unmarshal []byte into sdk.Tx
 	txCount   int64
 	height    int64
+	// shares the tx decoded in CheckTx and DeliverTx with BaseApp
+	txDecodeCache *txDecodeCache
 
 	invCheckPeriod uint
 
@@ -194,6 +201,19 @@ type CetChainApp struct {
 	currBlockTime          int64
 	account2UnconfirmedTx  *Account2UnconfirmedTx
 
+	// writes tx_json of the notify_tx messages as amino JSON
+	notifyAminoTxJSON bool
+	// the DB to read the commit hashes of the stores from, nil if they are not logged
+	storeHashesDB dbm.DB
+	// checks the invariants after the blocks are committed, nil if it is disabled
+	invariantMonitor *invariantMonitor
+	// indexes the txs by address, nil if it is disabled
+	txIndex *indexer.TxIndex
+	// keeps the history of the balances, nil if it is disabled
+	balanceIndex *indexer.BalanceIndex
+	// the context of the block being delivered, to read the balances after each tx
+	deliverCtx sdk.Context
+
 	// the module manager
 	mm *module.Manager
 
@@ -207,13 +227,44 @@ func NewCetChainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 
 	cdc := MakeCodec()
 
-	txDecoder := auth.DefaultTxDecoder(cdc)
-	bApp := bam.NewBaseApp(appName, logger, db, txDecoder, baseAppOptions...)
+	var cms sdk.CommitMultiStore
+	if viper.GetInt64(FlagInvariantMonitorPeriod) > 0 {
+		// the invariant monitor loads the committed versions of the stores from it
+		cms = store.NewCommitMultiStore(db)
+		baseAppOptions = append(baseAppOptions, func(bApp *bam.BaseApp) { bApp.SetCMS(cms) })
+	}
+
+	txDecoder, err := newTxDecoder(viper.GetString(FlagTxDecoder), cdc, logger)
+	if err != nil {
+		cmn.Exit(err.Error())
+	}
+	txDecodeCache := newTxDecodeCache(txDecoder)
+	bApp := bam.NewBaseApp(appName, logger, db, txDecodeCache.Decode, baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 	bam.SetHaltHeight(viper.GetUint64(server.FlagHaltHeight))(bApp)
 
-	app := newCetChainApp(bApp, cdc, invCheckPeriod, txDecoder)
+	app := newCetChainApp(bApp, cdc, invCheckPeriod, txDecodeCache)
+	app.notifyAminoTxJSON = viper.GetBool(FlagNotifyAminoTxJSON)
+	if viper.GetBool(FlagLogStoreHashes) {
+		app.storeHashesDB = db
+	}
+	if cms != nil {
+		app.invariantMonitor = newInvariantMonitor(viper.GetInt64(FlagInvariantMonitorPeriod),
+			viper.GetString(FlagInvariantMonitorReport), cms)
+	}
+	if viper.GetBool(FlagIndexAddressTxs) || viper.GetBool(FlagIndexBalanceHistory) {
+		indexerDB, err := openIndexerDB()
+		if err != nil {
+			cmn.Exit(err.Error())
+		}
+		if viper.GetBool(FlagIndexAddressTxs) {
+			app.txIndex = indexer.NewTxIndex(indexerDB)
+		}
+		if viper.GetBool(FlagIndexBalanceHistory) {
+			app.balanceIndex = indexer.NewBalanceIndex(indexerDB)
+		}
+	}
 	app.initPubMsgBuf()
 	app.initMsgQue()
 	app.initKeepers(invCheckPeriod)
@@ -239,7 +290,6 @@ func NewCetChainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 
 	unconfirmedTxLimitTime, ok := os.LookupEnv("COINEX_UNCONFIRMED_TX_LIMIT_TIME")
 	var limitTime int64
-	var err error
 	if ok {
 		limitTime, err = strconv.ParseInt(unconfirmedTxLimitTime, 10, 64)
 		if err != nil {
@@ -257,10 +307,11 @@ func NewCetChainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 	return app
 }
 
-func newCetChainApp(bApp *bam.BaseApp, cdc *codec.Codec, invCheckPeriod uint, txDecoder sdk.TxDecoder) *CetChainApp {
+func newCetChainApp(bApp *bam.BaseApp, cdc *codec.Codec, invCheckPeriod uint, txDecodeCache *txDecodeCache) *CetChainApp {
 	return &CetChainApp{
 		BaseApp:        bApp,
-		txDecoder:      txDecoder,
+		txDecoder:      txDecodeCache.Decode,
+		txDecodeCache:  txDecodeCache,
 		cdc:            cdc,
 		invCheckPeriod: invCheckPeriod,
 		keyMain:        sdk.NewKVStoreKey(bam.MainStoreKey),
@@ -503,15 +554,17 @@ func (app *CetChainApp) initModules() {
 
 	app.mm.SetOrderExportGenesis(initGenesisOrder...)
 
-	app.crisisKeeper.RegisterRoute(authx.ModuleName, "pre-total-supply", authx.PreTotalSupplyInvariant(app.accountXKeeper))
+	app.registerInvariants()
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 
 	app.registerRoutesWithOrder(modules)
+	app.QueryRouter().AddRoute(indexer.QuerierRoute, indexer.NewQuerier(app.cdc, app.txIndex, app.balanceIndex))
 }
 
 func (app *CetChainApp) createAppModules() []module.AppModule {
 	return []module.AppModule{
 		genaccounts.NewAppModule(app.accountKeeper),
+		vesting.NewAppModule(app.accountKeeper),
 		auth.NewAppModule(app.accountKeeper),
 		authx.NewAppModule(app.accountXKeeper, app.accountKeeper, app.tokenKeeper),
 		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
@@ -537,6 +590,7 @@ func (app *CetChainApp) createAppModules() []module.AppModule {
 func getAppModuleInitOrder() []string {
 	return []string{
 		genaccounts.ModuleName,
+		vesting.ModuleName, //right after genaccounts to turn vesting accounts into periodic ones
 		distr.ModuleName,
 		staking.ModuleName,
 		auth.ModuleName,
@@ -586,17 +640,33 @@ func (app *CetChainApp) mountStores() {
 func (app *CetChainApp) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	app.height = ctx.BlockHeight()
 	app.resetPubMsgBuf()
+	if app.invariantMonitor != nil {
+		app.invariantMonitor.header = req.Header
+	}
+	if app.txIndex != nil {
+		app.txIndex.BeginBlock(req.Header.Height)
+	}
+	if app.balanceIndex != nil {
+		app.deliverCtx = ctx
+		app.balanceIndex.BeginBlock(req.Header.Height)
+		app.snapshotBalances(ctx)
+	}
 	if app.msgQueProducer.IsOpenToggle() {
 		app.txCount = req.Header.TotalTxs - req.Header.NumTxs
 		app.pushNewHeightInfo(ctx)
 	}
-	if ctx.BlockHeight() == Dex3StartHeight {
+	// a genesis exported after DEX3 carries the height adjustment of its old chain,
+	// so its first block is not the DEX3 start and must not rerun the migration
+	if ctx.BlockHeight()+app.incentiveKeeper.GetState(ctx).HeightAdjustment == Dex3StartHeight {
 		app.cancelAllBancors(ctx)
 		app.cancelAllMarketOrders(ctx)
 		app.autoSwapKeeper.SetParams(ctx, autoswap.DefaultParams())
 		app.incentiveKeeper.ClearIncentiveState(ctx)
 	}
 	ret := app.mm.BeginBlock(ctx, req)
+	if app.balanceIndex != nil {
+		app.recordBlockBalances(ctx, indexer.SourceBeginBlock, ret.Events)
+	}
 	if app.msgQueProducer.IsOpenToggle() {
 		ret.Events = collectKafkaEvents(ret.Events, app)
 		app.notifyBeginBlock(ret.Events)
@@ -633,6 +703,9 @@ func (app *CetChainApp) cancelAllMarketOrders(ctx sdk.Context) {
 // nolint: unparam
 func (app *CetChainApp) endBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	ret := app.mm.EndBlock(ctx, req)
+	if app.balanceIndex != nil {
+		app.recordBlockBalances(ctx, indexer.SourceEndBlock, ret.Events)
+	}
 	if app.msgQueProducer.IsOpenToggle() {
 		ret.Events = collectKafkaEvents(ret.Events, app)
 		app.notifyEndBlock(ret.Events)
@@ -645,10 +718,26 @@ func (app *CetChainApp) initChainer(ctx sdk.Context, req abci.RequestInitChain)
 	var genesisState map[string]json.RawMessage
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
 
+	// the module states of a split genesis are kept in the files of its manifest,
+	// next to the genesis file
+	if manifest, ok := genesisState[GenesisManifestKey]; ok {
+		genesisFile, err := getGenesisFile()
+		if err != nil {
+			panic(err)
+		}
+		if genesisState, err = LoadGenesisManifest(app.cdc, manifest, filepath.Dir(genesisFile)); err != nil {
+			panic(err)
+		}
+	}
+
 	// use default AutoSwap genesis state
 	if genesisState[autoswap.ModuleName] == nil {
 		genesisState[autoswap.ModuleName] = autoswap.AppModuleBasic{}.DefaultGenesis()
 	}
+	// genesis files created before periodic vesting accounts have no vesting state
+	if genesisState[vesting.ModuleName] == nil {
+		genesisState[vesting.ModuleName] = vesting.AppModuleBasic{}.DefaultGenesis()
+	}
 
 	if err := ModuleBasics.ValidateGenesis(genesisState); err != nil {
 		panic(err)
@@ -692,6 +781,7 @@ func (app *CetChainApp) appendPubMsgKV(key string, val []byte) {
 /* "override" ABCI methods */
 
 func (app *CetChainApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	defer app.txDecodeCache.Reset()
 	if p := app.GetPlugin(); p != nil {
 		if err := p.PreCheckTx(req, app.txDecoder, app.Logger()); err != nil {
 			return dex.ResponseFrom(err)
@@ -745,6 +835,7 @@ func (app *CetChainApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 }
 
 func (app *CetChainApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	defer app.txDecodeCache.Reset()
 	formatOK := true
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
@@ -758,6 +849,14 @@ func (app *CetChainApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDelive
 
 	ret := app.BaseApp.DeliverTx(req)
 
+	if app.txIndex != nil {
+		// the txs which can not be decoded are added with no parties
+		app.indexTx(req, stdTx, ret)
+	}
+	if app.balanceIndex != nil {
+		app.recordTxBalances(req, stdTx, ret)
+	}
+
 	if app.msgQueProducer.IsOpenToggle() {
 		if formatOK {
 			app.notifyTx(req, stdTx, ret)
@@ -786,5 +885,18 @@ func (app *CetChainApp) Commit() abci.ResponseCommit {
 	if app.enableUnconfirmedLimit {
 		app.account2UnconfirmedTx.CommitRemove(app.currBlockTime)
 	}
-	return app.BaseApp.Commit()
+	if app.txIndex != nil {
+		app.txIndex.Commit()
+	}
+	if app.balanceIndex != nil {
+		app.balanceIndex.Commit()
+	}
+	res := app.BaseApp.Commit()
+	if app.storeHashesDB != nil {
+		app.logStoreHashes()
+	}
+	if app.invariantMonitor != nil {
+		app.invariantMonitor.checkCommitted(app.Logger().With("module", "invariants"), app.crisisKeeper.Routes())
+	}
+	return res
 }
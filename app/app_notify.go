@@ -1,6 +1,7 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
 	"reflect"
 	"time"
@@ -17,8 +18,13 @@ import (
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	dex "github.com/coinexchain/cet-sdk/types"
+	codon "github.com/coinexchain/dex/codec"
 )
 
+// FlagNotifyAminoTxJSON makes tx_json of the notify_tx messages the amino JSON of the
+// tx, written by the encoders generated by codon, instead of the output of encoding/json
+const FlagNotifyAminoTxJSON = "notify-amino-tx-json"
+
 type TxExtraInfo struct {
 	Code      uint32       `json:"code,omitempty"`
 	Data      []byte       `json:"data,omitempty"`
@@ -82,6 +88,31 @@ func getTransferRecord(dualEvent []abci.Event) TransferRecord {
 	return res
 }
 
+// getMsgTransfers returns the transfers made by each msg of a tx from its events,
+// the events of a msg end with the message event of its action. A transfer event
+// not followed by its sender, as the one bankx emits after a send, is skipped.
+func getMsgTransfers(events []abci.Event) [][]TransferRecord {
+	transfers := [][]TransferRecord{nil}
+	for i := 0; i < len(events); i++ {
+		if events[i].Type == "transfer" && i+1 < len(events) && isSenderEvent(events[i+1]) {
+			last := len(transfers) - 1
+			transfers[last] = append(transfers[last], getTransferRecord(events[i:i+2]))
+			i++
+		} else if events[i].Type == sdk.EventTypeMessage && len(events[i].Attributes) == 1 &&
+			string(events[i].Attributes[0].Key) == sdk.AttributeKeyAction {
+			transfers = append(transfers, nil)
+		}
+	}
+	return transfers[:len(transfers)-1]
+}
+
+// isSenderEvent tells whether the event is the message event with the sender,
+// which follows the transfer event of a bank send
+func isSenderEvent(event abci.Event) bool {
+	return event.Type == sdk.EventTypeMessage && len(event.Attributes) == 1 &&
+		string(event.Attributes[0].Key) == sdk.AttributeKeySender
+}
+
 func getType(myvar interface{}) string {
 	t := reflect.TypeOf(myvar)
 	if t.Kind() == reflect.Ptr {
@@ -125,7 +156,7 @@ func (app *CetChainApp) notifyTx(req abci.RequestDeliverTx, stdTx auth.StdTx, re
 		msgTypes[i] = getType(msg)
 	}
 
-	bytes, errJSON := json.Marshal(&stdTx)
+	txJSON, errJSON := app.txJSON(stdTx)
 	if errJSON != nil {
 		return
 	}
@@ -134,7 +165,7 @@ func (app *CetChainApp) notifyTx(req abci.RequestDeliverTx, stdTx auth.StdTx, re
 		Signers:      stdTx.GetSigners(),
 		Transfers:    transfers,
 		SerialNumber: app.txCount,
-		TxJSON:       string(bytes),
+		TxJSON:       string(txJSON),
 		MsgTypes:     msgTypes,
 		Height:       app.height,
 		Hash:         tmtypes.Tx(req.Tx).Hash(),
@@ -151,18 +182,18 @@ func (app *CetChainApp) notifyTx(req abci.RequestDeliverTx, stdTx auth.StdTx, re
 			Events:    ret.Events,
 			Codespace: ret.Codespace,
 		}
-		bytes, errJSON = json.Marshal(txExtraInfo)
+		extraInfo, errJSON := json.Marshal(txExtraInfo)
 		if errJSON == nil {
-			n4s.ExtraInfo = string(bytes)
+			n4s.ExtraInfo = string(extraInfo)
 		}
 	}
 
-	bytes, errJSON = json.Marshal(n4s)
+	n4sJSON, errJSON := json.Marshal(n4s)
 	if errJSON != nil {
 		return
 	}
 
-	app.appendPubMsgKV("notify_tx", bytes)
+	app.appendPubMsgKV("notify_tx", n4sJSON)
 	for _, val := range unbondingMsgList {
 		app.appendPubMsgKV("begin_unbonding", val)
 	}
@@ -171,6 +202,20 @@ func (app *CetChainApp) notifyTx(req abci.RequestDeliverTx, stdTx auth.StdTx, re
 	}
 }
 
+// txJSON returns tx_json of stdTx. It is the output of encoding/json, like the rest
+// of the notification, unless the amino JSON is asked for, which is written by the
+// encoders generated by codon, or by amino if the tx has types codon does not support.
+func (app *CetChainApp) txJSON(stdTx auth.StdTx) ([]byte, error) {
+	if !app.notifyAminoTxJSON {
+		return json.Marshal(&stdTx)
+	}
+	var buf bytes.Buffer
+	if err := codon.EncodeJSONAny(&buf, stdTx); err == nil {
+		return buf.Bytes(), nil
+	}
+	return app.cdc.MarshalJSON(stdTx)
+}
+
 type NotificationBeginRedelegation struct {
 	Delegator      string `json:"delegator"`
 	ValidatorSrc   string `json:"src"`
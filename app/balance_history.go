@@ -0,0 +1,166 @@
+package app
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/incentive"
+	"github.com/coinexchain/cet-sdk/msgqueue"
+	"github.com/coinexchain/dex/app/indexer"
+)
+
+// FlagIndexBalanceHistory makes the node keep the history of the balances of
+// all the addresses, in a local DB which is queried through the LCD
+const FlagIndexBalanceHistory = "index-balance-history"
+
+// snapshotBalances records the balances of all the accounts before the block,
+// if the balance history has not started yet
+func (app *CetChainApp) snapshotBalances(ctx sdk.Context) {
+	if app.balanceIndex.Started() {
+		return
+	}
+	var updates []indexer.BalanceUpdate
+	app.accountKeeper.IterateAccounts(ctx, func(acc authexported.Account) bool {
+		updates = append(updates, indexer.BalanceUpdate{
+			Address:  acc.GetAddress(),
+			Balances: app.totalBalances(ctx, acc.GetAddress()),
+		})
+		return false
+	})
+	app.balanceIndex.Snapshot(updates)
+}
+
+// recordBlockBalances records the balances after the BeginBlock or the EndBlock
+// of the addresses which its events refer to
+func (app *CetChainApp) recordBlockBalances(ctx sdk.Context, source string, events []abci.Event) {
+	app.balanceIndex.AddBlockUpdates(source, app.balanceUpdates(ctx, eventAddresses(events)))
+}
+
+// recordTxBalances records the balances after a delivered tx of the addresses
+// which its events refer to and of its signers. The fee is paid by the first
+// signer even if the tx fails.
+func (app *CetChainApp) recordTxBalances(req abci.RequestDeliverTx, stdTx auth.StdTx, ret abci.ResponseDeliverTx) {
+	addrs := eventAddresses(ret.Events)
+	for _, signer := range stdTx.GetSigners() {
+		addrs.add(signer, "")
+	}
+	if signers := stdTx.GetSigners(); len(signers) != 0 && !stdTx.Fee.Amount.IsZero() {
+		addrs.add(signers[0], indexer.EventFee)
+	}
+	app.balanceIndex.AddTx(tmtypes.Tx(req.Tx).Hash(), app.balanceUpdates(app.deliverCtx, addrs))
+}
+
+// balanceUpdates returns the balances of addrs and of the accounts which are
+// changed without events, as the module accounts and the incentive pool
+func (app *CetChainApp) balanceUpdates(ctx sdk.Context, addrs *addressEvents) []indexer.BalanceUpdate {
+	for name := range MaccPerms {
+		addrs.add(supply.NewModuleAddress(name), "")
+	}
+	addrs.add(incentive.PoolAddr, "")
+
+	updates := make([]indexer.BalanceUpdate, 0, len(addrs.order))
+	for _, addr := range addrs.order {
+		updates = append(updates, indexer.BalanceUpdate{
+			Address:  sdk.AccAddress(addr),
+			Balances: app.totalBalances(ctx, sdk.AccAddress(addr)),
+			Events:   addrs.events[addr],
+		})
+	}
+	return updates
+}
+
+// totalBalances returns the spendable, frozen and locked coins of addr together
+func (app *CetChainApp) totalBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	coins := sdk.NewCoins()
+	if acc := app.accountKeeper.GetAccount(ctx, addr); acc != nil {
+		coins = coins.Add(acc.GetCoins())
+	}
+	if accx, ok := app.accountXKeeper.GetAccountX(ctx, addr); ok {
+		coins = coins.Add(accx.GetAllCoins())
+	}
+	return coins
+}
+
+// addressEvents are the addresses which the events refer to, in the order they
+// are found, with the event attributes which refer to each of them
+type addressEvents struct {
+	order  []string
+	events map[string][]string
+}
+
+func (ae *addressEvents) add(addr sdk.AccAddress, event string) {
+	key := string(addr)
+	events, ok := ae.events[key]
+	if !ok {
+		ae.order = append(ae.order, key)
+	}
+	if event != "" {
+		for _, e := range events {
+			if e == event {
+				return
+			}
+		}
+		events = append(events, event)
+	}
+	ae.events[key] = events
+}
+
+// eventAddresses returns the account addresses which the attributes of events
+// refer to, with the attributes as "type.key"
+func eventAddresses(events []abci.Event) *addressEvents {
+	addrs := &addressEvents{events: make(map[string][]string)}
+	for _, event := range events {
+		if event.Type == msgqueue.EventTypeMsgQueue {
+			addrs.addMsgQueueAddresses(event)
+			continue
+		}
+		for _, attr := range event.Attributes {
+			addr, err := sdk.AccAddressFromBech32(string(attr.Value))
+			if err != nil || len(addr) == 0 {
+				continue
+			}
+			addrs.add(addr, event.Type+"."+string(attr.Key))
+		}
+	}
+	return addrs
+}
+
+// addMsgQueueAddresses adds the addresses in the messages to the brokers which
+// the modules put in the events. The fills and the cancels of the orders, in a
+// tx of another address or in the EndBlock, change the balances of the senders
+// of the orders with no other events, so the senders are found by the order IDs.
+func (ae *addressEvents) addMsgQueueAddresses(event abci.Event) {
+	for _, attr := range event.Attributes {
+		var msg map[string]interface{}
+		if json.Unmarshal(attr.Value, &msg) != nil {
+			continue
+		}
+		fields := make([]string, 0, len(msg))
+		for field := range msg {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			value, ok := msg[field].(string)
+			if !ok {
+				continue
+			}
+			// an order ID is the sender and the sequence joined with "-"
+			if i := strings.LastIndex(value, "-"); i >= 0 && strings.HasSuffix(field, "order_id") {
+				value = value[:i]
+			}
+			if addr, err := sdk.AccAddressFromBech32(value); err == nil && len(addr) != 0 {
+				ae.add(addr, event.Type+"."+string(attr.Key))
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/cet-sdk/msgqueue"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+
+	"github.com/coinexchain/dex/app/indexer"
+)
+
+func TestEventAddresses(t *testing.T) {
+	_, _, addr1 := testutil.KeyPubAddr()
+	_, _, addr2 := testutil.KeyPubAddr()
+	events := sdk.Events{
+		sdk.NewEvent("transfer", sdk.NewAttribute("recipient", addr2.String()), sdk.NewAttribute("amount", "1cet")),
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeySender, addr1.String())),
+		sdk.NewEvent("transfer", sdk.NewAttribute("recipient", addr2.String()), sdk.NewAttribute("amount", "2cet")),
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, "send")),
+	}.ToABCIEvents()
+
+	addrs := eventAddresses(events)
+	require.Equal(t, []string{string(addr2), string(addr1)}, addrs.order)
+	require.Equal(t, []string{"transfer.recipient"}, addrs.events[string(addr2)])
+	addrs.add(addr1, indexer.EventFee)
+	require.Equal(t, []string{"message.sender", indexer.EventFee}, addrs.events[string(addr1)])
+
+	// the sender of a filled order is found by its ID
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	msgqueue.FillMsgs(ctx, "fill_order_info", market.FillOrderInfo{OrderID: addr1.String() + "-513"})
+	msgqueue.FillMsgs(ctx, "del_order_info", map[string]string{
+		"order_id": "bad-1", "rebate_referee_addr": addr2.String()})
+	addrs = eventAddresses(ctx.EventManager().ABCIEvents())
+	require.Equal(t, []string{string(addr1), string(addr2)}, addrs.order)
+	require.Equal(t, []string{"kafka.fill_order_info"}, addrs.events[string(addr1)])
+	require.Equal(t, []string{"kafka.del_order_info"}, addrs.events[string(addr2)])
+}
+
+func TestIndexBalanceHistory(t *testing.T) {
+	_, _, toAddr := testutil.KeyPubAddr()
+	key, _, fromAddr := testutil.KeyPubAddr()
+	acc0 := auth.BaseAccount{Address: fromAddr, Coins: dex.NewCetCoins(30000000000)}
+	home, err := ioutil.TempDir("", "balance-history")
+	require.Nil(t, err)
+	defer os.RemoveAll(home)
+	viper.Set(cli.HomeFlag, home)
+	viper.Set(FlagIndexBalanceHistory, true)
+	defer viper.Set(cli.HomeFlag, "")
+	defer viper.Set(FlagIndexBalanceHistory, false)
+	app := initAppWithBaseAccounts(acc0)
+	require.NotNil(t, app.balanceIndex)
+	require.Nil(t, app.txIndex)
+
+	header := abci.Header{Height: 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	msg := bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(1000000000), 0)
+	tx := newStdTxBuilder().Msgs(msg).GasAndFee(1000000, 100).AccNumSeqKey(0, 0, key).Build()
+	require.Equal(t, sdk.CodeOK, app.Deliver(tx).Code)
+	// fails for the insufficient coins, only the fee is paid
+	msg = bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(100000000000), 0)
+	tx = newStdTxBuilder().Msgs(msg).GasAndFee(1000000, 100).AccNumSeqKey(0, 1, key).Build()
+	require.NotEqual(t, sdk.CodeOK, app.Deliver(tx).Code)
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	query := func(addr sdk.AccAddress) indexer.BalanceHistory {
+		res := app.Query(abci.RequestQuery{
+			Path: "custom/" + indexer.QuerierRoute + "/" + indexer.QueryBalanceHistory,
+			Data: app.cdc.MustMarshalJSON(indexer.NewQueryBalanceHistoryParams(addr, 1, 10)),
+		})
+		require.True(t, res.IsOK(), res.Log)
+		var history indexer.BalanceHistory
+		app.cdc.MustUnmarshalJSON(res.Value, &history)
+		return history
+	}
+	history := query(fromAddr)
+	require.Equal(t, int64(3), history.Total)
+	require.Equal(t, uint32(1), history.Changes[0].TxIndex)
+	require.Equal(t, []string{indexer.EventFee}, history.Changes[0].Events)
+	require.Equal(t, []indexer.CoinDelta{{Denom: dex.CET, Delta: sdk.NewInt(-100)}}, history.Changes[0].Deltas)
+	require.Equal(t, "28999999800cet", history.Changes[0].Balances.String())
+	require.Equal(t, uint32(0), history.Changes[1].TxIndex)
+	require.Equal(t, []string{"message.sender", "transfer.sender", indexer.EventFee}, history.Changes[1].Events)
+	require.Equal(t, []indexer.CoinDelta{{Denom: dex.CET, Delta: sdk.NewInt(-1000000100)}}, history.Changes[1].Deltas)
+	require.Equal(t, indexer.SourceSnapshot, history.Changes[2].Source)
+	require.Equal(t, int64(0), history.Changes[2].Height)
+
+	history = query(toAddr)
+	require.Equal(t, int64(1), history.Total)
+	require.Equal(t, indexer.SourceTx, history.Changes[0].Source)
+	require.Equal(t, []string{"transfer.recipient"}, history.Changes[0].Events)
+
+	res := app.Query(abci.RequestQuery{
+		Path: "custom/" + indexer.QuerierRoute + "/" + indexer.QueryBalanceAt,
+		Data: app.cdc.MustMarshalJSON(indexer.NewQueryBalanceAtParams(fromAddr, 0)),
+	})
+	require.True(t, res.IsOK(), res.Log)
+	var at indexer.BalanceAt
+	app.cdc.MustUnmarshalJSON(res.Value, &at)
+	require.Equal(t, "30000000000cet", at.Balances.String())
+}
+
+func TestIndexBalanceHistoryOrderFill(t *testing.T) {
+	_, _, makerAddr := testutil.KeyPubAddr()
+	key, _, takerAddr := testutil.KeyPubAddr()
+	maker := auth.BaseAccount{Address: makerAddr, Coins: sdk.NewCoins(sdk.NewInt64Coin("abc", 1000), dex.NewCetCoin(1000000))}
+	taker := auth.BaseAccount{Address: takerAddr, Coins: dex.NewCetCoins(30000000000), AccountNumber: 1}
+	home, err := ioutil.TempDir("", "balance-history")
+	require.Nil(t, err)
+	defer os.RemoveAll(home)
+	viper.Set(cli.HomeFlag, home)
+	viper.Set(FlagIndexBalanceHistory, true)
+	defer viper.Set(cli.HomeFlag, "")
+	defer viper.Set(FlagIndexBalanceHistory, false)
+	app := initApp(func(genState *GenesisState) {
+		addGenesisAccounts(genState, maker, taker)
+		genState.AuthData = GetDefaultAuthGenesisState()
+		genState.AssetData.Tokens = append(genState.AssetData.Tokens, &asset.BaseToken{
+			Name: "ABC", Symbol: "abc", Owner: makerAddr, Identity: asset.TestIdentityString,
+			TotalSupply: sdk.NewInt(1000), SendLock: sdk.ZeroInt(), TotalBurn: sdk.ZeroInt(), TotalMint: sdk.ZeroInt(),
+		})
+	})
+
+	header := abci.Header{Height: 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.NewContext(false, header)
+	app.autoSwapKeeper.SetPoolInfo(ctx, "abc/cet", &autoswap.PoolInfo{
+		Symbol:                "abc/cet",
+		StockAmmReserve:       sdk.ZeroInt(),
+		MoneyAmmReserve:       sdk.ZeroInt(),
+		StockOrderBookReserve: sdk.ZeroInt(),
+		MoneyOrderBookReserve: sdk.ZeroInt(),
+		TotalSupply:           sdk.ZeroInt(),
+		PricePrecision:        8,
+		LastExecutedPrice:     sdk.ZeroDec(),
+	})
+	sell := autoswap.MsgCreateOrder{Sender: makerAddr, TradingPair: "abc/cet", Price: 1, Quantity: 1000, Side: market.SELL}
+	require.Nil(t, app.autoSwapKeeper.AddLimitOrder(ctx, sell.GetOrder()))
+
+	// the order of the maker is filled by the tx of the taker
+	buy := market.MsgCreateOrder{Sender: takerAddr, TradingPair: "abc/cet", OrderType: market.LimitOrder,
+		Price: 1, Quantity: 1000, Side: market.BUY, TimeInForce: market.GTE}
+	tx := newStdTxBuilder().Msgs(buy).GasAndFee(1000000, 100).AccNumSeqKey(1, 0, key).Build()
+	res := app.Deliver(tx)
+	require.Equal(t, sdk.CodeOK, res.Code, res.Log)
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	res2 := app.Query(abci.RequestQuery{
+		Path: "custom/" + indexer.QuerierRoute + "/" + indexer.QueryBalanceHistory,
+		Data: app.cdc.MustMarshalJSON(indexer.NewQueryBalanceHistoryParams(makerAddr, 1, 10)),
+	})
+	require.True(t, res2.IsOK(), res2.Log)
+	var history indexer.BalanceHistory
+	app.cdc.MustUnmarshalJSON(res2.Value, &history)
+	require.Equal(t, indexer.SourceTx, history.Changes[0].Source)
+	require.Equal(t, []indexer.CoinDelta{
+		{Denom: "abc", Delta: sdk.NewInt(-1000)},
+		{Denom: dex.CET, Delta: sdk.NewInt(995)},
+	}, history.Changes[0].Deltas)
+	require.Equal(t, "1000995cet", history.Changes[0].Balances.String())
+}
@@ -2,7 +2,10 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"log"
+	"strings"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -12,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
 	"github.com/coinexchain/cet-sdk/modules/incentive"
 )
 
@@ -33,10 +37,7 @@ func (app *CetChainApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhit
 
 	genState := app.mm.ExportGenesis(ctx)
 	if forZeroHeight {
-		var ig incentive.GenesisState
-		incentive.ModuleCdc.MustUnmarshalJSON(genState[incentive.ModuleName], &ig)
-		ig.State.HeightAdjustment = ig.State.HeightAdjustment + ctx.BlockHeader().Height
-		genState[incentive.ModuleName] = incentive.ModuleCdc.MustMarshalJSON(ig)
+		rebaseGenesisHeights(genState, ctx.BlockHeight())
 	}
 
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
@@ -47,6 +48,48 @@ func (app *CetChainApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhit
 	return appState, validators, nil
 }
 
+// LoadJailWhiteList reads the operator addresses of the validators which are
+// not jailed by a zero height export, one address per line. Empty lines and
+// lines starting with '#' are skipped.
+func LoadJailWhiteList(file string) ([]string, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var jailWhiteList []string
+	for i, line := range strings.Split(string(bz), "\n") {
+		addr := strings.TrimSpace(line)
+		if len(addr) == 0 || strings.HasPrefix(addr, "#") {
+			continue
+		}
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			return nil, fmt.Errorf("invalid validator address at line %d of %s: %s", i+1, file, err.Error())
+		}
+		jailWhiteList = append(jailWhiteList, addr)
+	}
+	if len(jailWhiteList) == 0 {
+		return nil, fmt.Errorf("no validator address found in %s", file)
+	}
+	return jailWhiteList, nil
+}
+
+// rebaseGenesisHeights adjusts the block heights kept in the exported state of
+// cet modules, so that they are still right when the chain restarts at height 0.
+// Only incentive keeps heights: market has been replaced by autoswap since DEX3,
+// autoswap orders are canceled by prepForZeroHeightGenesis and comment only
+// exports the counts of comments. Modules not in genState are skipped.
+func rebaseGenesisHeights(genState map[string]json.RawMessage, height int64) {
+	bz, ok := genState[incentive.ModuleName]
+	if !ok {
+		return
+	}
+	var ig incentive.GenesisState
+	incentive.ModuleCdc.MustUnmarshalJSON(bz, &ig)
+	ig.State.HeightAdjustment = ig.State.HeightAdjustment + height
+	genState[incentive.ModuleName] = incentive.ModuleCdc.MustMarshalJSON(ig)
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //      in favour of export at a block height
@@ -71,6 +114,21 @@ func (app *CetChainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList
 	/* Just to be safe, assert the invariants on current state. */
 	app.crisisKeeper.AssertInvariants(ctx)
 
+	/* Handle autoswap state. */
+
+	// the genesis state of autoswap does not keep orders, so they are canceled
+	// and the frozen coins are returned to their senders
+	for _, info := range app.autoSwapKeeper.GetPoolInfos(ctx) {
+		for _, order := range app.autoSwapKeeper.GetAllOrders(ctx, info.Symbol) {
+			msg := autoswap.MsgCancelOrder{Sender: order.Sender, OrderID: order.GetOrderID()}
+			if err := app.autoSwapKeeper.DeleteOrder(ctx, msg); err != nil {
+				panic(err)
+			}
+		}
+	}
+	// the authx module account mirrors the locked and frozen coins of accountXs
+	app.accountXKeeper.PreTotalSupply(ctx)
+
 	/* Handle fee distribution state. */
 
 	// withdraw all validator commission
@@ -0,0 +1,309 @@
+package app
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// GenesisManifestKey is the only key in the app state of a genesis file whose
+// module states are split into several files
+const GenesisManifestKey = "genesis_manifest"
+
+// ModuleFilter selects the modules whose state is exported
+type ModuleFilter struct {
+	include map[string]bool
+	exclude map[string]bool
+}
+
+// ParseModuleFilter parses a comma separated list of module names, such as
+// "bank,staking" or "-comment,-incentive". Names starting with '-' are excluded
+// and the others are included; an empty list selects all the modules.
+func ParseModuleFilter(s string) (ModuleFilter, error) {
+	filter := ModuleFilter{include: make(map[string]bool), exclude: make(map[string]bool)}
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if len(name) == 0 {
+			continue
+		}
+		if strings.HasPrefix(name, "-") {
+			filter.exclude[name[1:]] = true
+		} else {
+			filter.include[name] = true
+		}
+	}
+	if len(filter.include) != 0 && len(filter.exclude) != 0 {
+		return filter, fmt.Errorf("can not include and exclude modules at the same time: %s", s)
+	}
+	return filter, nil
+}
+
+// Allows returns whether the state of a module should be exported
+func (filter ModuleFilter) Allows(name string) bool {
+	if len(filter.include) != 0 {
+		return filter.include[name]
+	}
+	return !filter.exclude[name]
+}
+
+func (filter ModuleFilter) validate(modules []string) error {
+	known := make(map[string]bool, len(modules))
+	for _, name := range modules {
+		known[name] = true
+	}
+	for _, names := range []map[string]bool{filter.include, filter.exclude} {
+		for name := range names {
+			if !known[name] {
+				return fmt.Errorf("unknown module: %s", name)
+			}
+		}
+	}
+	return nil
+}
+
+// GenesisWriter receives the exported state module by module
+type GenesisWriter interface {
+	WriteModuleState(module string, state json.RawMessage) error
+	// Close is called after all the module states are written
+	Close(validators []tmtypes.GenesisValidator) error
+}
+
+// ExportAppStateStream works like ExportAppStateAndValidators, but it hands
+// the state to w one module at a time, so the state of only one module is kept
+// in memory. The progress is logged after each module.
+func (app *CetChainApp) ExportAppStateStream(forZeroHeight bool, jailWhiteList []string,
+	filter ModuleFilter, w GenesisWriter) error {
+
+	if err := filter.validate(app.mm.OrderExportGenesis); err != nil {
+		return err
+	}
+	var modules []string
+	for _, name := range app.mm.OrderExportGenesis {
+		if filter.Allows(name) {
+			modules = append(modules, name)
+		}
+	}
+
+	// as if they could withdraw from the start of the next block
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	if forZeroHeight {
+		app.prepForZeroHeightGenesis(ctx, jailWhiteList)
+	}
+
+	start := time.Now()
+	for i, name := range modules {
+		begin := time.Now()
+		genState := map[string]json.RawMessage{name: app.mm.Modules[name].ExportGenesis(ctx)}
+		if forZeroHeight {
+			rebaseGenesisHeights(genState, ctx.BlockHeight())
+		}
+		// genutil exports nothing, which encoding/json marshals as null
+		if len(genState[name]) == 0 {
+			genState[name] = json.RawMessage("null")
+		}
+		if err := w.WriteModuleState(name, genState[name]); err != nil {
+			return err
+		}
+		app.Logger().Info("Exported module state", "module", name,
+			"progress", fmt.Sprintf("%d/%d", i+1, len(modules)),
+			"bytes", len(genState[name]), "elapsed", time.Since(begin).String())
+	}
+	app.Logger().Info("Exported app state", "modules", len(modules), "elapsed", time.Since(start).String())
+
+	return w.Close(staking.WriteValidators(ctx, app.stakingKeeper))
+}
+
+//-----------------------------------------------------------------------------
+
+var _ GenesisWriter = (*GenesisDocWriter)(nil)
+
+// GenesisDocWriter writes a genesis doc to an io.Writer, the module states
+// are written as soon as they are received and the validators come last.
+type GenesisDocWriter struct {
+	cdc   *codec.Codec
+	w     *bufio.Writer
+	count int
+}
+
+// NewGenesisDocWriter writes all the fields of doc except for the app state
+// and the validators, which are written by the returned GenesisDocWriter
+func NewGenesisDocWriter(cdc *codec.Codec, w io.Writer, doc *tmtypes.GenesisDoc) (*GenesisDocWriter, error) {
+	header := *doc
+	header.AppState = nil
+	header.Validators = nil
+	bz, err := cdc.MarshalJSON(header)
+	if err != nil {
+		return nil, err
+	}
+
+	gw := &GenesisDocWriter{cdc: cdc, w: bufio.NewWriter(w)}
+	// drop the closing brace and open the app state
+	if _, err := gw.w.Write(bz[:len(bz)-1]); err != nil {
+		return nil, err
+	}
+	if _, err := gw.w.WriteString(`,"app_state":{`); err != nil {
+		return nil, err
+	}
+	return gw, nil
+}
+
+func (gw *GenesisDocWriter) WriteModuleState(module string, state json.RawMessage) error {
+	if gw.count != 0 {
+		if err := gw.w.WriteByte(','); err != nil {
+			return err
+		}
+	}
+	gw.count++
+
+	name, err := json.Marshal(module)
+	if err != nil {
+		return err
+	}
+	sorted, err := sdk.SortJSON(state)
+	if err != nil {
+		return err
+	}
+	for _, bz := range [][]byte{name, []byte(":"), sorted} {
+		if _, err := gw.w.Write(bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (gw *GenesisDocWriter) Close(validators []tmtypes.GenesisValidator) error {
+	bz, err := gw.cdc.MarshalJSON(validators)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(gw.w, `},"validators":%s}`+"\n", bz); err != nil {
+		return err
+	}
+	return gw.w.Flush()
+}
+
+//-----------------------------------------------------------------------------
+
+// GenesisManifest lists the files of a split genesis, it replaces the module
+// states in the app state of the genesis file. initChainer loads the module
+// states back from the files, whose paths are relative to the directory of the
+// genesis file, so that a split genesis can be copied to other paths or nodes.
+type GenesisManifest struct {
+	Modules []GenesisManifestEntry `json:"modules"`
+}
+
+type GenesisManifestEntry struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	SHA256 string `json:"sha256"`
+}
+
+var _ GenesisWriter = (*SplitGenesisWriter)(nil)
+
+// SplitGenesisWriter writes the state of each module to its own file in a
+// directory, and a genesis.json in the same directory whose app state is a
+// manifest of these files.
+type SplitGenesisWriter struct {
+	cdc      *codec.Codec
+	dir      string
+	doc      *tmtypes.GenesisDoc
+	manifest GenesisManifest
+}
+
+func NewSplitGenesisWriter(cdc *codec.Codec, dir string, doc *tmtypes.GenesisDoc) (*SplitGenesisWriter, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &SplitGenesisWriter{
+		cdc:      cdc,
+		dir:      dir,
+		doc:      doc,
+		manifest: GenesisManifest{Modules: []GenesisManifestEntry{}},
+	}, nil
+}
+
+func (sw *SplitGenesisWriter) WriteModuleState(module string, state json.RawMessage) error {
+	sorted, err := sdk.SortJSON(state)
+	if err != nil {
+		return err
+	}
+	file := module + ".json"
+	if err := ioutil.WriteFile(filepath.Join(sw.dir, file), sorted, 0644); err != nil {
+		return err
+	}
+	sum := sha256.Sum256(sorted)
+	sw.manifest.Modules = append(sw.manifest.Modules, GenesisManifestEntry{
+		Name:   module,
+		File:   file,
+		SHA256: hex.EncodeToString(sum[:]),
+	})
+	return nil
+}
+
+func (sw *SplitGenesisWriter) Close(validators []tmtypes.GenesisValidator) error {
+	bz, err := sw.cdc.MarshalJSON(sw.manifest)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(filepath.Join(sw.dir, "genesis.json"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gw, err := NewGenesisDocWriter(sw.cdc, f, sw.doc)
+	if err != nil {
+		return err
+	}
+	if err := gw.WriteModuleState(GenesisManifestKey, bz); err != nil {
+		return err
+	}
+	return gw.Close(validators)
+}
+
+// LoadGenesisManifest reads the module states listed in a manifest and checks
+// their hashes, the relative paths of the files are resolved against dir
+func LoadGenesisManifest(cdc *codec.Codec, bz json.RawMessage, dir string) (map[string]json.RawMessage, error) {
+	var manifest GenesisManifest
+	if err := cdc.UnmarshalJSON(bz, &manifest); err != nil {
+		return nil, err
+	}
+
+	genesisState := make(map[string]json.RawMessage, len(manifest.Modules))
+	for _, entry := range manifest.Modules {
+		if _, ok := genesisState[entry.Name]; ok {
+			return nil, fmt.Errorf("duplicate module in genesis manifest: %s", entry.Name)
+		}
+		file := entry.File
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		state, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(state)
+		if hex.EncodeToString(sum[:]) != entry.SHA256 {
+			return nil, fmt.Errorf("sha256 of %s does not match the genesis manifest", entry.File)
+		}
+		genesisState[entry.Name] = state
+	}
+	return genesisState, nil
+}
@@ -0,0 +1,169 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func startAppForStreamExport(t *testing.T) (*CetChainApp, sdk.AccAddress) {
+	amount := cetToken().GetTotalSupply().Int64()
+	sk, pk, addr := testutil.KeyPubAddr()
+	acc := auth.BaseAccount{Address: addr, Coins: dex.NewCetCoins(amount)}
+	return startAppWithOneValidator(acc, addr, pk, sk, t), addr
+}
+
+func newTestGenesisDoc() *tmtypes.GenesisDoc {
+	return &tmtypes.GenesisDoc{
+		GenesisTime: time.Unix(1577836800, 0).UTC(),
+		ChainID:     testChainID,
+	}
+}
+
+func TestParseModuleFilter(t *testing.T) {
+	filter, err := ParseModuleFilter("")
+	require.Nil(t, err)
+	require.True(t, filter.Allows(bank.ModuleName))
+
+	filter, err = ParseModuleFilter("bank, staking")
+	require.Nil(t, err)
+	require.True(t, filter.Allows(bank.ModuleName))
+	require.True(t, filter.Allows(staking.ModuleName))
+	require.False(t, filter.Allows(auth.ModuleName))
+
+	filter, err = ParseModuleFilter("-bank")
+	require.Nil(t, err)
+	require.False(t, filter.Allows(bank.ModuleName))
+	require.True(t, filter.Allows(staking.ModuleName))
+
+	_, err = ParseModuleFilter("bank,-staking")
+	require.Error(t, err)
+}
+
+func TestExportAppStateStream(t *testing.T) {
+	app, _ := startAppForStreamExport(t)
+
+	appState, validators, err := app.ExportAppStateAndValidators(false, nil)
+	require.Nil(t, err)
+	var expected map[string]json.RawMessage
+	require.Nil(t, app.cdc.UnmarshalJSON(appState, &expected))
+
+	var buf bytes.Buffer
+	gw, err := NewGenesisDocWriter(app.cdc, &buf, newTestGenesisDoc())
+	require.Nil(t, err)
+	require.Nil(t, app.ExportAppStateStream(false, nil, ModuleFilter{}, gw))
+
+	doc, err := tmtypes.GenesisDocFromJSON(buf.Bytes())
+	require.Nil(t, err)
+	require.Equal(t, testChainID, doc.ChainID)
+	require.Len(t, doc.Validators, len(validators))
+	require.Equal(t, validators[0].PubKey, doc.Validators[0].PubKey)
+	require.Equal(t, validators[0].Power, doc.Validators[0].Power)
+
+	var streamed map[string]json.RawMessage
+	require.Nil(t, app.cdc.UnmarshalJSON(doc.AppState, &streamed))
+	require.Equal(t, len(expected), len(streamed))
+	for name, state := range expected {
+		require.Contains(t, streamed, name)
+		if len(state) == 0 {
+			// null is unmarshaled as an empty RawMessage
+			require.Empty(t, streamed[name], name)
+			continue
+		}
+		require.Equal(t, string(sdk.MustSortJSON(state)), string(streamed[name]), name)
+	}
+}
+
+func TestExportAppStateStreamWithFilter(t *testing.T) {
+	app, _ := startAppForStreamExport(t)
+
+	filter, err := ParseModuleFilter("bank,staking")
+	require.Nil(t, err)
+	var buf bytes.Buffer
+	gw, err := NewGenesisDocWriter(app.cdc, &buf, newTestGenesisDoc())
+	require.Nil(t, err)
+	require.Nil(t, app.ExportAppStateStream(false, nil, filter, gw))
+
+	doc, err := tmtypes.GenesisDocFromJSON(buf.Bytes())
+	require.Nil(t, err)
+	var streamed map[string]json.RawMessage
+	require.Nil(t, app.cdc.UnmarshalJSON(doc.AppState, &streamed))
+	require.Len(t, streamed, 2)
+	require.Contains(t, streamed, bank.ModuleName)
+	require.Contains(t, streamed, staking.ModuleName)
+
+	filter, err = ParseModuleFilter("-nosuchmodule")
+	require.Nil(t, err)
+	require.Error(t, app.ExportAppStateStream(false, nil, filter, gw))
+}
+
+func TestSplitGenesisLoadedByInitChainer(t *testing.T) {
+	app1, addr := startAppForStreamExport(t)
+
+	root, err := ioutil.TempDir("", "split-genesis")
+	require.Nil(t, err)
+	defer os.RemoveAll(root)
+	dir := filepath.Join(root, "exported")
+	require.Nil(t, os.Mkdir(dir, 0700))
+	sw, err := NewSplitGenesisWriter(app1.cdc, dir, newTestGenesisDoc())
+	require.Nil(t, err)
+	require.Nil(t, app1.ExportAppStateStream(false, nil, ModuleFilter{}, sw))
+
+	doc, err := tmtypes.GenesisDocFromFile(filepath.Join(dir, "genesis.json"))
+	require.Nil(t, err)
+	require.Len(t, doc.Validators, 1)
+	var appState map[string]json.RawMessage
+	require.Nil(t, app1.cdc.UnmarshalJSON(doc.AppState, &appState))
+	require.Len(t, appState, 1)
+	require.Contains(t, appState, GenesisManifestKey)
+	require.NotContains(t, string(appState[GenesisManifestKey]), dir)
+
+	// the split genesis is loaded from another directory
+	copied := filepath.Join(root, "copied")
+	require.Nil(t, os.Mkdir(copied, 0700))
+	files, err := ioutil.ReadDir(dir)
+	require.Nil(t, err)
+	for _, f := range files {
+		bz, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		require.Nil(t, err)
+		require.Nil(t, ioutil.WriteFile(filepath.Join(copied, f.Name()), bz, 0644))
+	}
+	require.Nil(t, os.RemoveAll(dir))
+	viper.Set("genesis_file", filepath.Join(copied, "genesis.json"))
+	defer viper.Set("genesis_file", nil)
+
+	app2 := newApp()
+	app2.InitChain(abci.RequestInitChain{ChainId: testChainID, AppStateBytes: doc.AppState})
+	app2.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app2.EndBlock(abci.RequestEndBlock{Height: 1})
+	app2.Commit()
+
+	ctx1 := app1.NewContext(true, abci.Header{Height: app1.LastBlockHeight()})
+	ctx2 := app2.NewContext(true, abci.Header{Height: app2.LastBlockHeight()})
+	require.Equal(t, app1.accountKeeper.GetAccount(ctx1, addr).GetCoins(),
+		app2.accountKeeper.GetAccount(ctx2, addr).GetCoins())
+	require.Len(t, app2.stakingKeeper.GetLastValidators(ctx2), 1)
+
+	// a module file changed after export is rejected
+	file := filepath.Join(copied, bank.ModuleName+".json")
+	require.Nil(t, ioutil.WriteFile(file, []byte(`{"send_enabled":false}`), 0644))
+	_, err = LoadGenesisManifest(app1.cdc, appState[GenesisManifestKey], copied)
+	require.Error(t, err)
+}
@@ -1,8 +1,13 @@
 package app
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -18,8 +23,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
 	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
+	"github.com/coinexchain/cet-sdk/modules/incentive"
+	"github.com/coinexchain/cet-sdk/modules/market"
 	"github.com/coinexchain/cet-sdk/testutil"
 	dex "github.com/coinexchain/cet-sdk/types"
+	"github.com/coinexchain/dex/app/vesting"
 )
 
 func TestExportRestore(t *testing.T) {
@@ -55,6 +64,51 @@ func startAppWithOneAccountThenExport() (*CetChainApp, GenesisState) {
 	return app1, genState1
 }
 
+func TestExportRestorePeriodicVestingAccount(t *testing.T) {
+	_, _, addr := testutil.KeyPubAddr()
+	baseAcc := auth.NewBaseAccountWithAddress(addr)
+	baseAcc.Coins = dex.NewCetCoins(1000)
+	periods := vesting.Periods{
+		{Length: 100, Amount: dex.NewCetCoins(300)},
+		{Length: 200, Amount: dex.NewCetCoins(200)},
+	}
+	genAcc, schedule, err := vesting.NewGenesisEntries(vesting.NewPeriodicVestingAccount(&baseAcc, 1577836800, periods))
+	require.Nil(t, err)
+
+	app1 := initApp(func(genState *GenesisState) {
+		genState.Accounts = append(genState.Accounts, genAcc)
+		genState.VestingData.Schedules = append(genState.VestingData.Schedules, schedule)
+		addAccountForDanglingCET(1000, genState)
+		genState.AuthData = GetDefaultAuthGenesisState()
+	})
+	ctx1 := app1.NewContext(false, abci.Header{Height: app1.LastBlockHeight()})
+	acc, ok := app1.accountKeeper.GetAccount(ctx1, addr).(*vesting.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, periods, acc.VestingPeriods)
+	require.Equal(t, "800cet", acc.SpendableCoins(time.Unix(1577836900, 0)).String())
+
+	genState1 := app1.ExportGenesisState(ctx1)
+	require.Equal(t, []vesting.Schedule{schedule}, genState1.VestingData.Schedules)
+	require.Empty(t, ValidateGenesisDeep(genState1))
+
+	app2, genState2 := startAppFromGenesisThenExport(genState1)
+	json1, err1 := codec.MarshalJSONIndent(app1.cdc, genState1)
+	json2, err2 := codec.MarshalJSONIndent(app2.cdc, genState2)
+	require.Nil(t, err1)
+	require.Nil(t, err2)
+	require.Equal(t, json1, json2)
+
+	// vesting schedules are in unix time, so a zero height export keeps them unchanged
+	app2.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app2.EndBlock(abci.RequestEndBlock{Height: 1})
+	app2.Commit()
+	appState, _, err := app2.ExportAppStateAndValidators(true, nil)
+	require.Nil(t, err)
+	var genState3 GenesisState
+	require.Nil(t, app2.cdc.UnmarshalJSON(appState, &genState3))
+	require.Equal(t, []vesting.Schedule{schedule}, genState3.VestingData.Schedules)
+}
+
 func TestExportGenesisState(t *testing.T) {
 	amount := cetToken().GetTotalSupply().Int64()
 	state := startAppWithAccountX(amount)
@@ -253,3 +307,93 @@ func initAppWithValidators(gs GenesisState) *CetChainApp {
 
 	return app
 }
+
+func TestZeroHeightExportPassesInvariants(t *testing.T) {
+	amount := cetToken().GetTotalSupply().Int64()
+	sk, pk, addr := testutil.KeyPubAddr()
+	acc := auth.BaseAccount{Address: addr, Coins: dex.NewCetCoins(amount)}
+	app1 := startAppWithOneValidator(acc, addr, pk, sk, t)
+	autoSwapParams := autoswap.DefaultParams()
+	autoSwapParams.TakerFeeRate = 40
+	incentiveParams := incentive.Params{DefaultRewardPerBlock: 1e8}
+	for height := int64(2); height <= 5; height++ {
+		app1.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		if height == 2 {
+			// an autoswap order which freezes 1000cet
+			ctx := app1.NewContext(false, abci.Header{Height: height})
+			app1.autoSwapKeeper.SetParams(ctx, autoSwapParams)
+			app1.incentiveKeeper.SetParams(ctx, incentiveParams)
+			app1.autoSwapKeeper.SetPoolInfo(ctx, "abc/cet", &autoswap.PoolInfo{
+				Symbol:                "abc/cet",
+				StockAmmReserve:       sdk.ZeroInt(),
+				MoneyAmmReserve:       sdk.ZeroInt(),
+				StockOrderBookReserve: sdk.ZeroInt(),
+				MoneyOrderBookReserve: sdk.ZeroInt(),
+				TotalSupply:           sdk.ZeroInt(),
+				PricePrecision:        8,
+				LastExecutedPrice:     sdk.ZeroDec(),
+			})
+			msg := autoswap.MsgCreateOrder{Sender: addr, TradingPair: "abc/cet", Price: 1, Quantity: 1000, Side: market.BUY}
+			require.Nil(t, app1.autoSwapKeeper.AddLimitOrder(ctx, msg.GetOrder()))
+			require.Len(t, app1.autoSwapKeeper.GetAllOrders(ctx, "abc/cet"), 1)
+		}
+		app1.EndBlock(abci.RequestEndBlock{Height: height})
+		app1.Commit()
+	}
+
+	appState, valset, err := app1.ExportAppStateAndValidators(true, nil)
+	require.Nil(t, err)
+	require.Len(t, valset, 1)
+
+	var genState GenesisState
+	require.Nil(t, app1.cdc.UnmarshalJSON(appState, &genState))
+	require.Equal(t, int64(5), genState.Incentive.State.HeightAdjustment)
+	// the autoswap order is canceled and its coins are not frozen any more
+	var rawState map[string]json.RawMessage
+	require.Nil(t, app1.cdc.UnmarshalJSON(appState, &rawState))
+	var autoSwapData autoswap.GenesisState
+	require.Nil(t, app1.cdc.UnmarshalJSON(rawState[autoswap.ModuleName], &autoSwapData))
+	require.Len(t, autoSwapData.PoolInfos, 1)
+	require.True(t, autoSwapData.PoolInfos[0].MoneyOrderBookReserve.IsZero())
+	for _, accx := range genState.AuthXData.AccountXs {
+		require.True(t, accx.FrozenCoins.IsZero())
+	}
+	require.Empty(t, ValidateGenesisDeep(genState))
+
+	// re-import and run the first block of the new chain
+	app2 := newApp()
+	app2.InitChain(abci.RequestInitChain{ChainId: testChainID, AppStateBytes: appState})
+	app2.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app2.EndBlock(abci.RequestEndBlock{Height: 1})
+	app2.Commit()
+
+	ctx2 := app2.NewContext(true, abci.Header{Height: app2.LastBlockHeight()})
+	require.NotPanics(t, func() { app2.crisisKeeper.AssertInvariants(ctx2) })
+	require.Len(t, app2.stakingKeeper.GetLastValidators(ctx2), 1)
+	// block 1 of the new chain is not the DEX3 start, so the params are kept
+	require.Equal(t, autoSwapParams, app2.autoSwapKeeper.GetParams(ctx2))
+	require.Equal(t, incentiveParams, app2.incentiveKeeper.GetParams(ctx2))
+	require.Equal(t, int64(5), app2.incentiveKeeper.GetState(ctx2).HeightAdjustment)
+}
+
+func TestLoadJailWhiteList(t *testing.T) {
+	_, _, addr := testutil.KeyPubAddr()
+	valAddr := sdk.ValAddress(addr).String()
+	dir, err := ioutil.TempDir("", "jail-whitelist")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "whitelist")
+
+	require.Nil(t, ioutil.WriteFile(file, []byte("# validators\n\n"+valAddr+"\n"), 0644))
+	list, err := LoadJailWhiteList(file)
+	require.Nil(t, err)
+	require.Equal(t, []string{valAddr}, list)
+
+	require.Nil(t, ioutil.WriteFile(file, []byte("# validators\n"), 0644))
+	_, err = LoadJailWhiteList(file)
+	require.Error(t, err)
+
+	require.Nil(t, ioutil.WriteFile(file, []byte(valAddr+"\nnot-an-address\n"), 0644))
+	_, err = LoadJailWhiteList(file)
+	require.Error(t, err)
+}
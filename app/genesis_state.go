@@ -2,6 +2,7 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -24,6 +25,7 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/incentive"
 	"github.com/coinexchain/cet-sdk/modules/market"
 	"github.com/coinexchain/cet-sdk/modules/stakingx"
+	"github.com/coinexchain/dex/app/vesting"
 )
 
 // State to Unmarshal
@@ -47,6 +49,7 @@ type GenesisState struct {
 	Incentive    incentive.GenesisState    `json:"incentive"`
 	Supply       supply.GenesisState       `json:"supply"`
 	GenUtil      genutil.GenesisState      `json:"genutil"`
+	VestingData  vesting.GenesisState      `json:"vesting"`
 }
 
 func NewDefaultGenesisState() GenesisState {
@@ -70,42 +73,58 @@ func NewDefaultGenesisState() GenesisState {
 		Incentive:    incentive.DefaultGenesisState(),
 		Supply:       supply.DefaultGenesisState(),
 		GenUtil:      genutil.GenesisState{},
+		VestingData:  vesting.DefaultGenesisState(),
 	}
 }
 
 func FromMap(cdc *codec.Codec, g map[string]json.RawMessage) GenesisState {
-	gs := GenesisState{}
-
-	unmarshalField(cdc, g[genaccounts.ModuleName], &gs.Accounts)
-	unmarshalField(cdc, g[auth.ModuleName], &gs.AuthData)
-	unmarshalField(cdc, g[authx.ModuleName], &gs.AuthXData)
-	unmarshalField(cdc, g[bank.ModuleName], &gs.BankData)
-	unmarshalField(cdc, g[bankx.ModuleName], &gs.BankXData)
-	unmarshalField(cdc, g[staking.ModuleName], &gs.StakingData)
-	unmarshalField(cdc, g[stakingx.ModuleName], &gs.StakingXData)
-	unmarshalField(cdc, g[distribution.ModuleName], &gs.DistrData)
-	unmarshalField(cdc, g[gov.ModuleName], &gs.GovData)
-	unmarshalField(cdc, g[crisis.ModuleName], &gs.CrisisData)
-	unmarshalField(cdc, g[slashing.ModuleName], &gs.SlashingData)
-	unmarshalField(cdc, g[asset.ModuleName], &gs.AssetData)
-	unmarshalField(cdc, g[market.ModuleName], &gs.MarketData)
-	unmarshalField(cdc, g[bancorlite.ModuleName], &gs.BancorData)
-	unmarshalField(cdc, g[comment.ModuleName], &gs.CommentData)
-	unmarshalField(cdc, g[alias.ModuleName], &gs.AliasData)
-	unmarshalField(cdc, g[incentive.ModuleName], &gs.Incentive)
-	unmarshalField(cdc, g[supply.ModuleName], &gs.Supply)
-	unmarshalField(cdc, g[genutil.ModuleName], &gs.GenUtil)
-
+	gs, err := TryFromMap(cdc, g)
+	if err != nil {
+		panic(err)
+	}
 	return gs
 }
 
-func unmarshalField(cdc *codec.Codec, bz []byte, ptr interface{}) {
-	if bz != nil {
-		cdc.MustUnmarshalJSON(bz, ptr)
+// TryFromMap is FromMap returning an error, instead of panicking, if the state of
+// a module can not be unmarshaled
+func TryFromMap(cdc *codec.Codec, g map[string]json.RawMessage) (GenesisState, error) {
+	gs := GenesisState{}
+	fields := []struct {
+		module string
+		ptr    interface{}
+	}{
+		{genaccounts.ModuleName, &gs.Accounts},
+		{auth.ModuleName, &gs.AuthData},
+		{authx.ModuleName, &gs.AuthXData},
+		{bank.ModuleName, &gs.BankData},
+		{bankx.ModuleName, &gs.BankXData},
+		{staking.ModuleName, &gs.StakingData},
+		{stakingx.ModuleName, &gs.StakingXData},
+		{distribution.ModuleName, &gs.DistrData},
+		{gov.ModuleName, &gs.GovData},
+		{crisis.ModuleName, &gs.CrisisData},
+		{slashing.ModuleName, &gs.SlashingData},
+		{asset.ModuleName, &gs.AssetData},
+		{market.ModuleName, &gs.MarketData},
+		{bancorlite.ModuleName, &gs.BancorData},
+		{comment.ModuleName, &gs.CommentData},
+		{alias.ModuleName, &gs.AliasData},
+		{incentive.ModuleName, &gs.Incentive},
+		{supply.ModuleName, &gs.Supply},
+		{genutil.ModuleName, &gs.GenUtil},
+		{vesting.ModuleName, &gs.VestingData},
+	}
+	for _, field := range fields {
+		if bz := g[field.module]; bz != nil {
+			if err := cdc.UnmarshalJSON(bz, field.ptr); err != nil {
+				return gs, fmt.Errorf("error unmarshaling %s: %s", field.module, err.Error())
+			}
+		}
 	}
+	return gs, nil
 }
 
-func (gs GenesisState) toMap(cdc *codec.Codec) map[string]json.RawMessage {
+func (gs GenesisState) ToMap(cdc *codec.Codec) map[string]json.RawMessage {
 	m := make(map[string]json.RawMessage)
 	m[genaccounts.ModuleName] = cdc.MustMarshalJSON(gs.Accounts)
 	m[auth.ModuleName] = cdc.MustMarshalJSON(gs.AuthData)
@@ -126,5 +145,6 @@ func (gs GenesisState) toMap(cdc *codec.Codec) map[string]json.RawMessage {
 	m[incentive.ModuleName] = cdc.MustMarshalJSON(gs.Incentive)
 	m[supply.ModuleName] = cdc.MustMarshalJSON(gs.Supply)
 	m[genutil.ModuleName] = cdc.MustMarshalJSON(gs.GenUtil)
+	m[vesting.ModuleName] = cdc.MustMarshalJSON(gs.VestingData)
 	return m
 }
@@ -27,7 +27,7 @@ func TestFromToMap(t *testing.T) {
 	gsMap := ModuleBasics.DefaultGenesis()
 	cdc := MakeCodec()
 	m := FromMap(cdc, gsMap)
-	m.toMap(cdc)
+	m.ToMap(cdc)
 }
 
 func TestDefaultGenesisState(t *testing.T) {
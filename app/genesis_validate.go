@@ -0,0 +1,211 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// ValidateGenesisDeep runs the cross-module checks which can not be done by
+// ModuleBasics.ValidateGenesis, because each module only sees its own data.
+// All the violations are collected and returned, not just the first one.
+func ValidateGenesisDeep(gs GenesisState) []error {
+	var errs []error
+	errs = append(errs, checkGenesisSupply(gs)...)
+	errs = append(errs, checkGenesisDenoms(gs)...)
+	errs = append(errs, checkGenesisOrders(gs)...)
+	errs = append(errs, checkGenesisAuthXPool(gs)...)
+	errs = append(errs, checkGenesisStakingPools(gs)...)
+	errs = append(errs, checkGenesisVestingSchedules(gs)...)
+	return errs
+}
+
+// the sum of all the accounts' coins (module accounts included) must be equal to the total supply
+func checkGenesisSupply(gs GenesisState) []error {
+	// supply module will calculate the total supply if it is not provided
+	if gs.Supply.Supply.Empty() {
+		return nil
+	}
+
+	var total sdk.Coins
+	for _, acc := range gs.Accounts {
+		total = total.Add(acc.Coins)
+	}
+	if total.IsEqual(gs.Supply.Supply) {
+		return nil
+	}
+
+	var errs []error
+	for _, denom := range sortedDenoms(total, gs.Supply.Supply) {
+		held, supply := total.AmountOf(denom), gs.Supply.Supply.AmountOf(denom)
+		if !held.Equal(supply) {
+			errs = append(errs, fmt.Errorf("supply of %s is %s, but accounts hold %s", denom, supply, held))
+		}
+	}
+	return errs
+}
+
+// every denom held by some account must be issued as a token in asset module
+func checkGenesisDenoms(gs GenesisState) []error {
+	tokens := make(map[string]bool, len(gs.AssetData.Tokens))
+	for _, token := range gs.AssetData.Tokens {
+		tokens[token.GetSymbol()] = true
+	}
+
+	holders := make(map[string]int)
+	for _, acc := range gs.Accounts {
+		for _, coin := range acc.Coins {
+			holders[coin.Denom]++
+		}
+	}
+	for _, accx := range gs.AuthXData.AccountXs {
+		for _, coin := range accx.GetAllCoins() {
+			holders[coin.Denom]++
+		}
+	}
+
+	denoms := make([]string, 0, len(holders))
+	for denom := range holders {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	var errs []error
+	for _, denom := range denoms {
+		if !tokens[denom] {
+			errs = append(errs, fmt.Errorf("denom %s is held by %d account(s), but it is not a token in asset", denom, holders[denom]))
+		}
+	}
+	return errs
+}
+
+// every open order must belong to a trading pair in market
+func checkGenesisOrders(gs GenesisState) []error {
+	pairs := make(map[string]bool, len(gs.MarketData.MarketInfos))
+	for _, info := range gs.MarketData.MarketInfos {
+		pairs[dex.GetSymbol(info.Stock, info.Money)] = true
+	}
+
+	var errs []error
+	for _, order := range gs.MarketData.Orders {
+		if !pairs[order.TradingPair] {
+			errs = append(errs, fmt.Errorf("order %s refers to trading pair %s, which does not exist", order.OrderID(), order.TradingPair))
+		}
+	}
+	return errs
+}
+
+// authx module account holds all the locked and frozen coins of the AccountXs
+func checkGenesisAuthXPool(gs GenesisState) []error {
+	pool := findGenesisModuleAccount(gs, authx.ModuleName)
+	if pool == nil {
+		return nil
+	}
+
+	var expected sdk.Coins
+	for _, accx := range gs.AuthXData.AccountXs {
+		expected = expected.Add(accx.GetAllCoins())
+	}
+	if pool.Coins.IsEqual(expected) {
+		return nil
+	}
+	return []error{fmt.Errorf("%s module account holds %s, but accountXs have %s locked or frozen",
+		authx.ModuleName, pool.Coins, expected)}
+}
+
+// bonded and not-bonded pools must match the tokens of validators and unbonding delegations
+func checkGenesisStakingPools(gs GenesisState) []error {
+	bonded, notBonded := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, val := range gs.StakingData.Validators {
+		switch val.GetStatus() {
+		case sdk.Bonded:
+			bonded = bonded.Add(val.GetTokens())
+		case sdk.Unbonding, sdk.Unbonded:
+			notBonded = notBonded.Add(val.GetTokens())
+		}
+	}
+	for _, ubd := range gs.StakingData.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			notBonded = notBonded.Add(entry.Balance)
+		}
+	}
+
+	var errs []error
+	bondDenom := gs.StakingData.Params.BondDenom
+	if err := checkGenesisPoolBalance(gs, staking.BondedPoolName, bondDenom, bonded); err != nil {
+		errs = append(errs, err)
+	}
+	if err := checkGenesisPoolBalance(gs, staking.NotBondedPoolName, bondDenom, notBonded); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// every periodic vesting schedule must match a vesting account in genaccounts
+func checkGenesisVestingSchedules(gs GenesisState) []error {
+	accounts := make(map[string]*genaccounts.GenesisAccount, len(gs.Accounts))
+	for i := range gs.Accounts {
+		accounts[gs.Accounts[i].Address.String()] = &gs.Accounts[i]
+	}
+
+	var errs []error
+	for _, schedule := range gs.VestingData.Schedules {
+		addr := schedule.Address.String()
+		acc, ok := accounts[addr]
+		if !ok {
+			errs = append(errs, fmt.Errorf("vesting schedule of %s has no account", addr))
+			continue
+		}
+		if !acc.OriginalVesting.IsEqual(schedule.VestingPeriods.TotalAmount()) {
+			errs = append(errs, fmt.Errorf("account %s has original vesting %s, but its vesting periods sum up to %s",
+				addr, acc.OriginalVesting, schedule.VestingPeriods.TotalAmount()))
+		}
+		if acc.StartTime != schedule.StartTime || acc.EndTime != schedule.StartTime+schedule.VestingPeriods.TotalLength() {
+			errs = append(errs, fmt.Errorf("account %s vests from %d to %d, but its vesting schedule is from %d to %d",
+				addr, acc.StartTime, acc.EndTime, schedule.StartTime, schedule.StartTime+schedule.VestingPeriods.TotalLength()))
+		}
+	}
+	return errs
+}
+
+func checkGenesisPoolBalance(gs GenesisState, poolName, denom string, expected sdk.Int) error {
+	pool := findGenesisModuleAccount(gs, poolName)
+	if pool == nil {
+		return nil
+	}
+	if balance := pool.Coins.AmountOf(denom); !balance.Equal(expected) {
+		return fmt.Errorf("%s module account holds %s%s, but staking expects %s%s",
+			poolName, balance, denom, expected, denom)
+	}
+	return nil
+}
+
+func findGenesisModuleAccount(gs GenesisState, moduleName string) *genaccounts.GenesisAccount {
+	for i := range gs.Accounts {
+		if gs.Accounts[i].ModuleName == moduleName {
+			return &gs.Accounts[i]
+		}
+	}
+	return nil
+}
+
+func sortedDenoms(coinsList ...sdk.Coins) []string {
+	seen := make(map[string]bool)
+	var denoms []string
+	for _, coins := range coinsList {
+		for _, coin := range coins {
+			if !seen[coin.Denom] {
+				seen[coin.Denom] = true
+				denoms = append(denoms, coin.Denom)
+			}
+		}
+	}
+	sort.Strings(denoms)
+	return denoms
+}
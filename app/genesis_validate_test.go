@@ -0,0 +1,92 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+	"github.com/coinexchain/dex/app/vesting"
+)
+
+func newDeepValidateGenesisState() GenesisState {
+	genState := NewDefaultGenesisState()
+	genState.AssetData.Tokens = append(genState.AssetData.Tokens, cetToken())
+	genState.StakingData.Params.BondDenom = dex.DefaultBondDenom
+
+	_, _, addr := testutil.KeyPubAddr()
+	acc := auth.NewBaseAccountWithAddress(addr)
+	acc.Coins = dex.NewCetCoins(1000)
+	genState.Accounts = append(genState.Accounts, genaccounts.NewGenesisAccount(&acc))
+	return genState
+}
+
+func TestValidateGenesisDeep(t *testing.T) {
+	genState := newDeepValidateGenesisState()
+	require.Empty(t, ValidateGenesisDeep(genState))
+
+	genState.Supply = supply.NewGenesisState(dex.NewCetCoins(1000))
+	require.Empty(t, ValidateGenesisDeep(genState))
+}
+
+func TestValidateGenesisDeepViolations(t *testing.T) {
+	genState := newDeepValidateGenesisState()
+
+	// supply mismatch & unknown denom
+	genState.Accounts[0].Coins = genState.Accounts[0].Coins.Add(sdk.NewCoins(sdk.NewInt64Coin("abc", 10)))
+	genState.Supply = supply.NewGenesisState(dex.NewCetCoins(900))
+
+	// order of unknown trading pair
+	genState.MarketData.Orders = append(genState.MarketData.Orders, &market.Order{
+		Sender:      genState.Accounts[0].Address,
+		Sequence:    1,
+		TradingPair: "abc/cet",
+	})
+
+	// bonded pool holds coins which do not belong to any validator
+	bondedPool := supply.NewEmptyModuleAccount(staking.BondedPoolName, supply.Burner, supply.Staking)
+	bondedPool.Coins = dex.NewCetCoins(100)
+	poolAcc, err := genaccounts.NewGenesisAccountI(bondedPool)
+	require.NoError(t, err)
+	genState.Accounts = append(genState.Accounts, poolAcc)
+
+	errs := ValidateGenesisDeep(genState)
+	require.Len(t, errs, 5)
+	require.Contains(t, errs[0].Error(), "supply of abc")
+	require.Contains(t, errs[1].Error(), "supply of cet")
+	require.Contains(t, errs[2].Error(), "denom abc")
+	require.Contains(t, errs[3].Error(), "abc/cet")
+	require.Contains(t, errs[4].Error(), staking.BondedPoolName)
+}
+
+func TestValidateGenesisDeepVestingSchedules(t *testing.T) {
+	genState := newDeepValidateGenesisState()
+	periods := vesting.Periods{{Length: 100, Amount: dex.NewCetCoins(500)}}
+	genState.VestingData.Schedules = append(genState.VestingData.Schedules,
+		vesting.Schedule{Address: genState.Accounts[0].Address, StartTime: 1000, VestingPeriods: periods})
+
+	// the account is not a vesting account
+	errs := ValidateGenesisDeep(genState)
+	require.Len(t, errs, 2)
+	require.Contains(t, errs[0].Error(), "original vesting")
+	require.Contains(t, errs[1].Error(), "vests from 0 to 0")
+
+	genState.Accounts[0].OriginalVesting = dex.NewCetCoins(500)
+	genState.Accounts[0].StartTime = 1000
+	genState.Accounts[0].EndTime = 1100
+	require.Empty(t, ValidateGenesisDeep(genState))
+
+	_, _, addr := testutil.KeyPubAddr()
+	genState.VestingData.Schedules[0].Address = addr
+	errs = ValidateGenesisDeep(genState)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Error(), "has no account")
+}
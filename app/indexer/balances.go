@@ -0,0 +1,356 @@
+package indexer
+
+import (
+	"fmt"
+	"sort"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Sources of the balance changes
+const (
+	// SourceSnapshot is the balance of an address when the history starts
+	SourceSnapshot   = "snapshot"
+	SourceBeginBlock = "begin_block"
+	SourceTx         = "tx"
+	SourceEndBlock   = "end_block"
+)
+
+// EventFee marks the change of the balance of the fee payer of a tx
+const EventFee = "fee"
+
+var (
+	balanceChangeKeyPrefix   = []byte{0x04}
+	balanceChangeCountPrefix = []byte{0x05}
+	latestBalanceKeyPrefix   = []byte{0x06}
+	balanceIndexHeightKey    = []byte{0x07}
+	balanceIndexStartKey     = []byte{0x08}
+)
+
+var balanceCdc = codec.New()
+
+// CoinDelta is the change of the balance of a denom
+type CoinDelta struct {
+	Denom string  `json:"denom"`
+	Delta sdk.Int `json:"delta"`
+}
+
+// BalanceChange is a change of the balance of an address, with what caused it.
+// Events are the event attributes which refer to the address, as "type.key",
+// in the block phase or the tx which made the change.
+type BalanceChange struct {
+	Height   int64        `json:"height"`
+	Source   string       `json:"source"`
+	TxIndex  uint32       `json:"tx_index,omitempty"`
+	TxHash   cmn.HexBytes `json:"tx_hash,omitempty"`
+	Events   []string     `json:"events"`
+	Deltas   []CoinDelta  `json:"deltas"`
+	Balances sdk.Coins    `json:"balances"`
+}
+
+// BalanceHistory is a page of the balance changes of an address, the latest first
+type BalanceHistory struct {
+	Address sdk.AccAddress  `json:"address"`
+	Total   int64           `json:"total"`
+	Page    int             `json:"page"`
+	Limit   int             `json:"limit"`
+	Changes []BalanceChange `json:"changes"`
+}
+
+// BalanceAt is the balance of an address at the end of a block
+type BalanceAt struct {
+	Address  sdk.AccAddress `json:"address"`
+	Height   int64          `json:"height"`
+	Balances sdk.Coins      `json:"balances"`
+}
+
+// BalanceUpdate is the balance of an address after a block phase or a tx
+type BalanceUpdate struct {
+	Address  sdk.AccAddress
+	Balances sdk.Coins
+	Events   []string
+}
+
+// BalanceIndex keeps the history of the balances of the addresses. The balances
+// are the spendable, frozen and locked coins of an address together, so that
+// freezing and locking coins are not changes. The changes of a block are kept
+// in memory until the block is committed.
+type BalanceIndex struct {
+	db dbm.DB
+
+	// the block being executed, the number of its txs so far, and whether it
+	// is already indexed, as when the blocks are replayed
+	height int64
+	txs    uint32
+	skip   bool
+	// the changes to write on commit, and the balances after them
+	seq      uint32
+	pending  map[string]BalanceChange
+	balances map[string]sdk.Coins
+	started  bool
+}
+
+// NewBalanceIndex returns the index kept in db
+func NewBalanceIndex(db dbm.DB) *BalanceIndex {
+	return &BalanceIndex{
+		db:       db,
+		pending:  make(map[string]BalanceChange),
+		balances: make(map[string]sdk.Coins),
+	}
+}
+
+// Started tells whether the balances of all the addresses have been recorded
+// by a snapshot, since when the history is kept
+func (idx *BalanceIndex) Started() bool {
+	return idx.started || idx.db.Has(balanceIndexStartKey)
+}
+
+// StartHeight returns the height of the snapshot which starts the history
+func (idx *BalanceIndex) StartHeight() int64 {
+	return bytesToInt64(idx.db.Get(balanceIndexStartKey))
+}
+
+// Height returns the latest height indexed
+func (idx *BalanceIndex) Height() int64 {
+	return bytesToInt64(idx.db.Get(balanceIndexHeightKey))
+}
+
+// BeginBlock starts recording the balance changes of the block at height
+func (idx *BalanceIndex) BeginBlock(height int64) {
+	idx.height = height
+	idx.txs = 0
+	idx.skip = height <= idx.Height()
+	idx.seq = 0
+	idx.pending = make(map[string]BalanceChange)
+	idx.balances = make(map[string]sdk.Coins)
+}
+
+// Snapshot records the balances of all the addresses before the block, which
+// starts the history. It must be called before any other update of the block.
+func (idx *BalanceIndex) Snapshot(updates []BalanceUpdate) {
+	if idx.skip {
+		return
+	}
+	idx.started = true
+	for _, update := range updates {
+		idx.record(update, BalanceChange{Height: idx.height - 1, Source: SourceSnapshot})
+	}
+}
+
+// AddBlockUpdates records the balances after the BeginBlock or the EndBlock of the block
+func (idx *BalanceIndex) AddBlockUpdates(source string, updates []BalanceUpdate) {
+	if idx.skip {
+		return
+	}
+	for _, update := range updates {
+		idx.record(update, BalanceChange{Height: idx.height, Source: source})
+	}
+}
+
+// AddTx records the balances after the next tx of the block. It must be called
+// for every tx of the block, so the index of each tx matches its position.
+func (idx *BalanceIndex) AddTx(hash []byte, updates []BalanceUpdate) {
+	if !idx.skip {
+		for _, update := range updates {
+			idx.record(update, BalanceChange{Height: idx.height, Source: SourceTx, TxIndex: idx.txs, TxHash: hash})
+		}
+	}
+	idx.txs++
+}
+
+// record adds the change of the balances of an address, if they changed
+func (idx *BalanceIndex) record(update BalanceUpdate, change BalanceChange) {
+	if len(update.Address) == 0 {
+		return
+	}
+	balances := update.Balances
+	if balances == nil {
+		balances = sdk.NewCoins()
+	}
+	change.Deltas = coinDeltas(idx.latestBalances(update.Address), balances)
+	if len(change.Deltas) == 0 {
+		return
+	}
+	change.Events = update.Events
+	change.Balances = balances
+	idx.pending[string(balanceChangeKey(update.Address, change.Height, idx.seq))] = change
+	idx.balances[string(update.Address)] = balances
+	idx.seq++
+}
+
+// latestBalances returns the balances of addr after the latest change recorded
+func (idx *BalanceIndex) latestBalances(addr sdk.AccAddress) sdk.Coins {
+	if balances, ok := idx.balances[string(addr)]; ok {
+		return balances
+	}
+	bz := idx.db.Get(latestBalanceKey(addr))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var balances sdk.Coins
+	balanceCdc.MustUnmarshalBinaryBare(bz, &balances)
+	return balances
+}
+
+// Commit writes the balance changes of the block, and records its height as
+// the latest height indexed. It must be called before the block is committed,
+// so no block committed is missing from the index after a crash, and the block
+// replayed then is skipped.
+func (idx *BalanceIndex) Commit() {
+	if idx.skip {
+		return
+	}
+	counts := make(map[string]int64)
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	for key, change := range idx.pending {
+		batch.Set([]byte(key), balanceCdc.MustMarshalBinaryBare(change))
+		counts[string(balanceChangeAddress([]byte(key)))]++
+	}
+	for addr, n := range counts {
+		countKey := balanceChangeCountKey(sdk.AccAddress(addr))
+		batch.Set(countKey, int64ToBytes(bytesToInt64(idx.db.Get(countKey))+n))
+	}
+	for addr, balances := range idx.balances {
+		batch.Set(latestBalanceKey(sdk.AccAddress(addr)), balanceCdc.MustMarshalBinaryBare(balances))
+	}
+	if idx.started && !idx.db.Has(balanceIndexStartKey) {
+		batch.Set(balanceIndexStartKey, int64ToBytes(idx.height-1))
+	}
+	batch.Set(balanceIndexHeightKey, int64ToBytes(idx.height))
+	batch.WriteSync()
+	idx.pending = make(map[string]BalanceChange)
+	idx.balances = make(map[string]sdk.Coins)
+}
+
+// History returns a page of the balance changes of addr, the latest first.
+// The pages are numbered from 1.
+func (idx *BalanceIndex) History(addr sdk.AccAddress, page, limit int) (BalanceHistory, error) {
+	if page <= 0 {
+		return BalanceHistory{}, fmt.Errorf("invalid page %d, pages are numbered from 1", page)
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		return BalanceHistory{}, fmt.Errorf("invalid limit %d, the max is %d", limit, MaxLimit)
+	}
+	res := BalanceHistory{
+		Address: addr,
+		Total:   bytesToInt64(idx.db.Get(balanceChangeCountKey(addr))),
+		Page:    page,
+		Limit:   limit,
+		Changes: []BalanceChange{},
+	}
+
+	prefix := balanceChangePrefix(addr)
+	it := idx.db.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	defer it.Close()
+	skip := (page - 1) * limit
+	for ; it.Valid() && len(res.Changes) < limit; it.Next() {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		change, err := decodeBalanceChange(it.Key(), it.Value())
+		if err != nil {
+			return res, err
+		}
+		res.Changes = append(res.Changes, change)
+	}
+	return res, nil
+}
+
+// BalanceAt returns the balances of addr at the end of the block at height,
+// which must be between the start of the history and the latest height indexed
+func (idx *BalanceIndex) BalanceAt(addr sdk.AccAddress, height int64) (BalanceAt, error) {
+	if !idx.Started() {
+		return BalanceAt{}, fmt.Errorf("the balance history has not started yet")
+	}
+	if start, latest := idx.StartHeight(), idx.Height(); height < start || height > latest {
+		return BalanceAt{}, fmt.Errorf("invalid height %d, the balance history is kept from %d to %d",
+			height, start, latest)
+	}
+	res := BalanceAt{Address: addr, Height: height, Balances: sdk.NewCoins()}
+
+	prefix := balanceChangePrefix(addr)
+	it := idx.db.ReverseIterator(prefix, balanceChangeKey(addr, height+1, 0))
+	defer it.Close()
+	if it.Valid() {
+		change, err := decodeBalanceChange(it.Key(), it.Value())
+		if err != nil {
+			return res, err
+		}
+		res.Balances = change.Balances
+	}
+	return res, nil
+}
+
+// decodeBalanceChange decodes the change kept at key, the empty slices of which
+// are decoded as nil
+func decodeBalanceChange(key, value []byte) (BalanceChange, error) {
+	var change BalanceChange
+	if err := balanceCdc.UnmarshalBinaryBare(value, &change); err != nil {
+		return change, fmt.Errorf("malformed balance change %X: %s", key, err)
+	}
+	if change.Events == nil {
+		change.Events = []string{}
+	}
+	if change.Balances == nil {
+		change.Balances = sdk.NewCoins()
+	}
+	return change, nil
+}
+
+// coinDeltas returns the changes from the balances before to after, by denom
+func coinDeltas(before, after sdk.Coins) []CoinDelta {
+	denoms := make(map[string]struct{})
+	for _, coin := range before {
+		denoms[coin.Denom] = struct{}{}
+	}
+	for _, coin := range after {
+		denoms[coin.Denom] = struct{}{}
+	}
+	deltas := make([]CoinDelta, 0, len(denoms))
+	for denom := range denoms {
+		if delta := after.AmountOf(denom).Sub(before.AmountOf(denom)); !delta.IsZero() {
+			deltas = append(deltas, CoinDelta{Denom: denom, Delta: delta})
+		}
+	}
+	sort.Slice(deltas, func(i, j int) bool { return deltas[i].Denom < deltas[j].Denom })
+	return deltas
+}
+
+func balanceChangePrefix(addr sdk.AccAddress) []byte {
+	prefix := make([]byte, 0, len(balanceChangeKeyPrefix)+1+len(addr))
+	prefix = append(prefix, balanceChangeKeyPrefix...)
+	prefix = append(prefix, byte(len(addr)))
+	return append(prefix, addr...)
+}
+
+// balanceChangeKey is the prefix of addr followed by the height and the order
+// of the change in the block, so the changes of an address are iterated in
+// the order they are made
+func balanceChangeKey(addr sdk.AccAddress, height int64, seq uint32) []byte {
+	key := balanceChangePrefix(addr)
+	key = append(key, int64ToBytes(height)...)
+	return append(key, uint32ToBytes(seq)...)
+}
+
+func balanceChangeAddress(key []byte) sdk.AccAddress {
+	n := int(key[len(balanceChangeKeyPrefix)])
+	start := len(balanceChangeKeyPrefix) + 1
+	return key[start : start+n]
+}
+
+func balanceChangeCountKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, balanceChangeCountPrefix...), addr...)
+}
+
+func latestBalanceKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, latestBalanceKeyPrefix...), addr...)
+}
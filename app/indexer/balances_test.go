@@ -0,0 +1,112 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBalanceIndex(t *testing.T) {
+	alice, bob := sdk.AccAddress("alice_______________"), sdk.AccAddress("bob_________________")
+	cet := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("cet", amount)) }
+	idx := NewBalanceIndex(dbm.NewMemDB())
+	require.False(t, idx.Started())
+
+	idx.BeginBlock(3)
+	idx.Snapshot([]BalanceUpdate{{Address: alice, Balances: cet(100)}, {Address: bob, Balances: nil}})
+	idx.AddBlockUpdates(SourceBeginBlock, []BalanceUpdate{{Address: alice, Balances: cet(100)}})
+	idx.AddTx([]byte{0x01}, []BalanceUpdate{
+		{Address: alice, Balances: cet(60), Events: []string{"message.sender", EventFee}},
+		{Address: bob, Balances: sdk.NewCoins(sdk.NewInt64Coin("abc", 5), sdk.NewInt64Coin("cet", 30)),
+			Events: []string{"transfer.recipient"}},
+	})
+	idx.AddTx([]byte{0x02}, []BalanceUpdate{{Address: bob, Balances: cet(30)}})
+	idx.AddBlockUpdates(SourceEndBlock, []BalanceUpdate{{Address: alice, Balances: cet(61)}})
+	idx.Commit()
+	require.True(t, idx.Started())
+	require.Equal(t, int64(2), idx.StartHeight())
+	require.Equal(t, int64(3), idx.Height())
+
+	res, err := idx.History(bob, 1, 0)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), res.Total)
+	require.Equal(t, []BalanceChange{
+		{Height: 3, Source: SourceTx, TxIndex: 1, TxHash: []byte{0x02}, Events: []string{},
+			Deltas: []CoinDelta{{Denom: "abc", Delta: sdk.NewInt(-5)}}, Balances: cet(30)},
+		{Height: 3, Source: SourceTx, TxIndex: 0, TxHash: []byte{0x01}, Events: []string{"transfer.recipient"},
+			Deltas:   []CoinDelta{{Denom: "abc", Delta: sdk.NewInt(5)}, {Denom: "cet", Delta: sdk.NewInt(30)}},
+			Balances: sdk.NewCoins(sdk.NewInt64Coin("abc", 5), sdk.NewInt64Coin("cet", 30))},
+	}, res.Changes)
+
+	res, err = idx.History(alice, 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, int64(3), res.Total)
+	require.Equal(t, SourceEndBlock, res.Changes[0].Source)
+	require.Equal(t, []CoinDelta{{Denom: "cet", Delta: sdk.NewInt(-40)}}, res.Changes[1].Deltas)
+	res, err = idx.History(alice, 2, 2)
+	require.Nil(t, err)
+	require.Equal(t, SourceSnapshot, res.Changes[0].Source)
+	require.Equal(t, int64(2), res.Changes[0].Height)
+
+	// replaying a block records nothing
+	idx.BeginBlock(3)
+	idx.AddTx([]byte{0x01}, []BalanceUpdate{{Address: alice, Balances: cet(1)}})
+	idx.Commit()
+	idx.BeginBlock(4)
+	idx.AddTx([]byte{0x03}, []BalanceUpdate{{Address: alice, Balances: cet(50)}})
+	idx.Commit()
+
+	res, err = idx.History(alice, 1, 1)
+	require.Nil(t, err)
+	require.Equal(t, int64(4), res.Total)
+	require.Equal(t, []CoinDelta{{Denom: "cet", Delta: sdk.NewInt(-11)}}, res.Changes[0].Deltas)
+
+	for height, amount := range map[int64]int64{2: 100, 3: 61, 4: 50} {
+		at, err := idx.BalanceAt(alice, height)
+		require.Nil(t, err)
+		require.Equal(t, cet(amount), at.Balances)
+	}
+	at, err := idx.BalanceAt(bob, 2)
+	require.Nil(t, err)
+	require.True(t, at.Balances.IsZero())
+	_, err = idx.BalanceAt(alice, 1)
+	require.Error(t, err)
+	_, err = idx.BalanceAt(alice, 5)
+	require.Error(t, err)
+
+	_, err = idx.History(alice, 0, 2)
+	require.Error(t, err)
+	_, err = idx.History(alice, 1, MaxLimit+1)
+	require.Error(t, err)
+}
+
+func TestBalanceQuerier(t *testing.T) {
+	cdc := codec.New()
+	alice := sdk.AccAddress("alice_______________")
+	idx := NewBalanceIndex(dbm.NewMemDB())
+	idx.BeginBlock(1)
+	idx.Snapshot([]BalanceUpdate{{Address: alice, Balances: sdk.NewCoins(sdk.NewInt64Coin("cet", 100))}})
+	idx.Commit()
+
+	req := abci.RequestQuery{Data: cdc.MustMarshalJSON(NewQueryBalanceHistoryParams(alice, 1, 10))}
+	bz, err := NewQuerier(cdc, nil, idx)(sdk.Context{}, []string{QueryBalanceHistory}, req)
+	require.Nil(t, err)
+	var history BalanceHistory
+	cdc.MustUnmarshalJSON(bz, &history)
+	require.Equal(t, int64(1), history.Total)
+
+	req = abci.RequestQuery{Data: cdc.MustMarshalJSON(NewQueryBalanceAtParams(alice, 0))}
+	bz, err = NewQuerier(cdc, nil, idx)(sdk.Context{}, []string{QueryBalanceAt}, req)
+	require.Nil(t, err)
+	var at BalanceAt
+	cdc.MustUnmarshalJSON(bz, &at)
+	require.Equal(t, "100cet", at.Balances.String())
+
+	_, err = NewQuerier(cdc, nil, nil)(sdk.Context{}, []string{QueryBalanceAt}, req)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cosmos-utils/client/cliutil"
+	"github.com/coinexchain/dex/app/indexer"
+)
+
+const (
+	flagPage     = "page"
+	flagLimit    = "limit"
+	flagAtHeight = "at-height"
+)
+
+// GetBalanceHistoryCmd queries the balance changes of an address, or its
+// balances at a height, from a node keeping the balance history
+func GetBalanceHistoryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-history [address]",
+		Short: "Query the balance changes of an address, or its balances at a height",
+		Long: `Query the balance changes of an address, the latest first, with the running
+balances and the events which caused each change. The balances include the
+frozen and locked coins. With --at-height, query the balances at the end of
+the block at that height instead. The node must be started with --index-balance-history.
+
+Example:
+	cetcli query balance-history coinex167w96tdvmazakdwkw2u57227eduula2cy572lf --page=2 --limit=10
+	cetcli query balance-history coinex167w96tdvmazakdwkw2u57227eduula2cy572lf --at-height=1000
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(flagAtHeight) {
+				route := fmt.Sprintf("custom/%s/%s", indexer.QuerierRoute, indexer.QueryBalanceAt)
+				param := indexer.NewQueryBalanceAtParams(addr, viper.GetInt64(flagAtHeight))
+				return cliutil.CliQuery(cdc, route, &param)
+			}
+			route := fmt.Sprintf("custom/%s/%s", indexer.QuerierRoute, indexer.QueryBalanceHistory)
+			param := indexer.NewQueryBalanceHistoryParams(addr, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+	cmd.Flags().Int(flagPage, 1, "Query a specific page of the balance changes")
+	cmd.Flags().Int(flagLimit, indexer.DefaultLimit, "Number of balance changes in a page")
+	cmd.Flags().Int64(flagAtHeight, 0, "Query the balances at the end of the block at this height")
+	return flags.GetCommands(cmd)[0]
+}
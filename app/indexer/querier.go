@@ -0,0 +1,132 @@
+package indexer
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// QuerierRoute is the route of the custom queries of the indexes
+	QuerierRoute = "indexer"
+
+	QueryAddressTxs     = "address-txs"
+	QueryBalanceHistory = "balance-history"
+	QueryBalanceAt      = "balance-at"
+)
+
+// QueryAddressTxsParams are the params of QueryAddressTxs
+type QueryAddressTxsParams struct {
+	Address sdk.AccAddress `json:"address"`
+	Page    int            `json:"page"`
+	Limit   int            `json:"limit"`
+}
+
+func NewQueryAddressTxsParams(addr sdk.AccAddress, page, limit int) QueryAddressTxsParams {
+	return QueryAddressTxsParams{Address: addr, Page: page, Limit: limit}
+}
+
+// QueryBalanceHistoryParams are the params of QueryBalanceHistory
+type QueryBalanceHistoryParams struct {
+	Address sdk.AccAddress `json:"address"`
+	Page    int            `json:"page"`
+	Limit   int            `json:"limit"`
+}
+
+func NewQueryBalanceHistoryParams(addr sdk.AccAddress, page, limit int) QueryBalanceHistoryParams {
+	return QueryBalanceHistoryParams{Address: addr, Page: page, Limit: limit}
+}
+
+// QueryBalanceAtParams are the params of QueryBalanceAt
+type QueryBalanceAtParams struct {
+	Address sdk.AccAddress `json:"address"`
+	Height  int64          `json:"height"`
+}
+
+func NewQueryBalanceAtParams(addr sdk.AccAddress, height int64) QueryBalanceAtParams {
+	return QueryBalanceAtParams{Address: addr, Height: height}
+}
+
+// NewQuerier returns the querier of the indexes, txIndex and balanceIndex are
+// nil if the node does not keep them
+func NewQuerier(cdc *codec.Codec, txIndex *TxIndex, balanceIndex *BalanceIndex) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryAddressTxs:
+			return queryAddressTxs(cdc, txIndex, req)
+		case QueryBalanceHistory:
+			return queryBalanceHistory(cdc, balanceIndex, req)
+		case QueryBalanceAt:
+			return queryBalanceAt(cdc, balanceIndex, req)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown indexer query endpoint: " + path[0])
+		}
+	}
+}
+
+func queryAddressTxs(cdc *codec.Codec, txIndex *TxIndex, req abci.RequestQuery) ([]byte, sdk.Error) {
+	if txIndex == nil {
+		return nil, sdk.ErrUnknownRequest("the tx index is not enabled on this node")
+	}
+	var params QueryAddressTxsParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	if len(params.Address) == 0 {
+		return nil, sdk.ErrInvalidAddress("missing address")
+	}
+	res, err := txIndex.AddressTxs(params.Address, params.Page, params.Limit)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	bz, err := codec.MarshalJSONIndent(cdc, res)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
+
+func queryBalanceHistory(cdc *codec.Codec, balanceIndex *BalanceIndex, req abci.RequestQuery) ([]byte, sdk.Error) {
+	if balanceIndex == nil {
+		return nil, sdk.ErrUnknownRequest("the balance history is not enabled on this node")
+	}
+	var params QueryBalanceHistoryParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	if len(params.Address) == 0 {
+		return nil, sdk.ErrInvalidAddress("missing address")
+	}
+	res, err := balanceIndex.History(params.Address, params.Page, params.Limit)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	bz, err := codec.MarshalJSONIndent(cdc, res)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
+
+func queryBalanceAt(cdc *codec.Codec, balanceIndex *BalanceIndex, req abci.RequestQuery) ([]byte, sdk.Error) {
+	if balanceIndex == nil {
+		return nil, sdk.ErrUnknownRequest("the balance history is not enabled on this node")
+	}
+	var params QueryBalanceAtParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	if len(params.Address) == 0 {
+		return nil, sdk.ErrInvalidAddress("missing address")
+	}
+	res, err := balanceIndex.BalanceAt(params.Address, params.Height)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	bz, err := codec.MarshalJSONIndent(cdc, res)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
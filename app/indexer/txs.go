@@ -0,0 +1,244 @@
+// Package indexer keeps the indexes of the chain data which the wallets and the
+// explorers query by address, in a local DB of the node beside the app state.
+// They are not part of the consensus state, and are only kept if enabled.
+package indexer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Roles of an address in a msg of a tx
+const (
+	RoleSigner    = "signer"
+	RoleSender    = "sender"
+	RoleRecipient = "recipient"
+)
+
+var roleCodes = map[string]byte{
+	RoleSigner:    1,
+	RoleSender:    2,
+	RoleRecipient: 3,
+}
+
+var roleNames = map[byte]string{
+	1: RoleSigner,
+	2: RoleSender,
+	3: RoleRecipient,
+}
+
+var (
+	addressTxKeyPrefix   = []byte{0x01}
+	addressTxCountPrefix = []byte{0x02}
+	txIndexHeightKey     = []byte{0x03}
+)
+
+const (
+	// DefaultLimit is the number of txs in a page if the limit is not given
+	DefaultLimit = 30
+	// MaxLimit is the max number of txs in a page
+	MaxLimit = 100
+)
+
+// TxRef refers to a msg of a tx in which an address takes a role
+type TxRef struct {
+	Height   int64        `json:"height"`
+	TxIndex  uint32       `json:"tx_index"`
+	Hash     cmn.HexBytes `json:"hash"`
+	MsgIndex uint16       `json:"msg_index"`
+	Role     string       `json:"role"`
+}
+
+// AddressTxs is a page of the txs in which an address takes part, the latest first
+type AddressTxs struct {
+	Address sdk.AccAddress `json:"address"`
+	Total   int64          `json:"total"`
+	Page    int            `json:"page"`
+	Limit   int            `json:"limit"`
+	Txs     []TxRef        `json:"txs"`
+}
+
+// TxParty is an address taking a role in a msg of a tx
+type TxParty struct {
+	Address  sdk.AccAddress
+	MsgIndex uint16
+	Role     string
+}
+
+// TxIndex indexes the txs of each block by the addresses taking part in them.
+// The txs of a block are kept in memory until the block is committed.
+type TxIndex struct {
+	db dbm.DB
+
+	// the block being executed and the number of its txs so far
+	height int64
+	txs    uint32
+	// the keys to write on commit, with the addresses of them
+	pending map[string]sdk.AccAddress
+}
+
+// NewTxIndex returns the index kept in db
+func NewTxIndex(db dbm.DB) *TxIndex {
+	return &TxIndex{
+		db:      db,
+		pending: make(map[string]sdk.AccAddress),
+	}
+}
+
+// BeginBlock starts indexing the txs of the block at height
+func (idx *TxIndex) BeginBlock(height int64) {
+	idx.height = height
+	idx.txs = 0
+	idx.pending = make(map[string]sdk.AccAddress)
+}
+
+// AddTx indexes the next tx of the block by its parties. It must be called for
+// every tx of the block, with no parties if the tx can not be decoded, so the
+// index of each tx matches its position in the block.
+func (idx *TxIndex) AddTx(hash []byte, parties []TxParty) {
+	for _, party := range parties {
+		if len(party.Address) == 0 {
+			continue
+		}
+		ref := TxRef{
+			Height:   idx.height,
+			TxIndex:  idx.txs,
+			Hash:     hash,
+			MsgIndex: party.MsgIndex,
+			Role:     party.Role,
+		}
+		idx.pending[string(addressTxKey(party.Address, ref))] = party.Address
+	}
+	idx.txs++
+}
+
+// Commit writes the txs of the block, and records its height as the latest
+// height indexed. It must be called before the block is committed, so no block
+// committed is missing from the index after a crash. The txs already in the DB,
+// as when the blocks are replayed, are not counted again.
+func (idx *TxIndex) Commit() {
+	counts := make(map[string]int64)
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	for key, addr := range idx.pending {
+		if idx.db.Has([]byte(key)) {
+			continue
+		}
+		batch.Set([]byte(key), []byte{})
+		counts[string(addr)]++
+	}
+	for addr, n := range counts {
+		countKey := addressTxCountKey(sdk.AccAddress(addr))
+		batch.Set(countKey, int64ToBytes(bytesToInt64(idx.db.Get(countKey))+n))
+	}
+	batch.Set(txIndexHeightKey, int64ToBytes(idx.height))
+	batch.WriteSync()
+	idx.pending = make(map[string]sdk.AccAddress)
+}
+
+// Height returns the latest height indexed
+func (idx *TxIndex) Height() int64 {
+	return bytesToInt64(idx.db.Get(txIndexHeightKey))
+}
+
+// AddressTxs returns a page of the txs in which addr takes part, the latest
+// first. The pages are numbered from 1.
+func (idx *TxIndex) AddressTxs(addr sdk.AccAddress, page, limit int) (AddressTxs, error) {
+	if page <= 0 {
+		return AddressTxs{}, fmt.Errorf("invalid page %d, pages are numbered from 1", page)
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		return AddressTxs{}, fmt.Errorf("invalid limit %d, the max is %d", limit, MaxLimit)
+	}
+	res := AddressTxs{
+		Address: addr,
+		Total:   bytesToInt64(idx.db.Get(addressTxCountKey(addr))),
+		Page:    page,
+		Limit:   limit,
+		Txs:     []TxRef{},
+	}
+
+	prefix := addressTxPrefix(addr)
+	it := idx.db.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	defer it.Close()
+	skip := (page - 1) * limit
+	for ; it.Valid() && len(res.Txs) < limit; it.Next() {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		ref, ok := parseAddressTxKey(it.Key()[len(prefix):])
+		if !ok {
+			return res, fmt.Errorf("malformed key %X in the tx index", it.Key())
+		}
+		res.Txs = append(res.Txs, ref)
+	}
+	return res, nil
+}
+
+func addressTxPrefix(addr sdk.AccAddress) []byte {
+	prefix := make([]byte, 0, len(addressTxKeyPrefix)+1+len(addr))
+	prefix = append(prefix, addressTxKeyPrefix...)
+	prefix = append(prefix, byte(len(addr)))
+	return append(prefix, addr...)
+}
+
+// addressTxKey is the prefix of addr followed by the height, the index of the
+// tx in the block, the index of the msg in the tx, the role and the tx hash,
+// so the references of an address are iterated in the order of the txs
+func addressTxKey(addr sdk.AccAddress, ref TxRef) []byte {
+	key := addressTxPrefix(addr)
+	key = append(key, int64ToBytes(ref.Height)...)
+	key = append(key, uint32ToBytes(ref.TxIndex)...)
+	key = append(key, byte(ref.MsgIndex>>8), byte(ref.MsgIndex))
+	key = append(key, roleCodes[ref.Role])
+	return append(key, ref.Hash...)
+}
+
+func parseAddressTxKey(bz []byte) (TxRef, bool) {
+	if len(bz) < 15 {
+		return TxRef{}, false
+	}
+	role, ok := roleNames[bz[14]]
+	if !ok {
+		return TxRef{}, false
+	}
+	return TxRef{
+		Height:   bytesToInt64(bz[:8]),
+		TxIndex:  binary.BigEndian.Uint32(bz[8:12]),
+		MsgIndex: binary.BigEndian.Uint16(bz[12:14]),
+		Role:     role,
+		Hash:     append(cmn.HexBytes{}, bz[15:]...),
+	}, true
+}
+
+func addressTxCountKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, addressTxCountPrefix...), addr...)
+}
+
+func int64ToBytes(n int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(n))
+	return bz
+}
+
+func bytesToInt64(bz []byte) int64 {
+	if len(bz) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func uint32ToBytes(n uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, n)
+	return bz
+}
@@ -0,0 +1,92 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTxIndex(t *testing.T) {
+	alice, bob := sdk.AccAddress("alice_______________"), sdk.AccAddress("bob_________________")
+	idx := NewTxIndex(dbm.NewMemDB())
+
+	idx.BeginBlock(5)
+	idx.AddTx([]byte{0x01}, []TxParty{
+		{Address: alice, MsgIndex: 0, Role: RoleSigner},
+		{Address: alice, MsgIndex: 0, Role: RoleSender},
+		{Address: bob, MsgIndex: 0, Role: RoleRecipient},
+	})
+	idx.AddTx([]byte{0x02}, nil)
+	idx.AddTx([]byte{0x03}, []TxParty{
+		{Address: bob, MsgIndex: 1, Role: RoleSigner},
+		{Address: bob, MsgIndex: 1, Role: RoleSigner},
+		{Address: nil, MsgIndex: 1, Role: RoleRecipient},
+	})
+	idx.Commit()
+	require.Equal(t, int64(5), idx.Height())
+
+	res, err := idx.AddressTxs(bob, 1, 0)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), res.Total)
+	require.Equal(t, DefaultLimit, res.Limit)
+	require.Equal(t, []TxRef{
+		{Height: 5, TxIndex: 2, Hash: []byte{0x03}, MsgIndex: 1, Role: RoleSigner},
+		{Height: 5, TxIndex: 0, Hash: []byte{0x01}, MsgIndex: 0, Role: RoleRecipient},
+	}, res.Txs)
+
+	// replaying a block does not count its txs again
+	idx.BeginBlock(5)
+	idx.AddTx([]byte{0x01}, []TxParty{{Address: alice, MsgIndex: 0, Role: RoleSigner}})
+	idx.Commit()
+	idx.BeginBlock(6)
+	idx.AddTx([]byte{0x04}, []TxParty{{Address: alice, MsgIndex: 0, Role: RoleSigner}})
+	idx.Commit()
+
+	res, err = idx.AddressTxs(alice, 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, int64(3), res.Total)
+	require.Equal(t, []TxRef{
+		{Height: 6, TxIndex: 0, Hash: []byte{0x04}, MsgIndex: 0, Role: RoleSigner},
+		{Height: 5, TxIndex: 0, Hash: []byte{0x01}, MsgIndex: 0, Role: RoleSender},
+	}, res.Txs)
+	res, err = idx.AddressTxs(alice, 2, 2)
+	require.Nil(t, err)
+	require.Equal(t, []TxRef{
+		{Height: 5, TxIndex: 0, Hash: []byte{0x01}, MsgIndex: 0, Role: RoleSigner},
+	}, res.Txs)
+	res, err = idx.AddressTxs(alice, 3, 2)
+	require.Nil(t, err)
+	require.Empty(t, res.Txs)
+
+	_, err = idx.AddressTxs(alice, 0, 2)
+	require.Error(t, err)
+	_, err = idx.AddressTxs(alice, 1, MaxLimit+1)
+	require.Error(t, err)
+}
+
+func TestQuerier(t *testing.T) {
+	cdc := codec.New()
+	alice := sdk.AccAddress("alice_______________")
+	idx := NewTxIndex(dbm.NewMemDB())
+	idx.BeginBlock(1)
+	idx.AddTx([]byte{0x01}, []TxParty{{Address: alice, MsgIndex: 0, Role: RoleSigner}})
+	idx.Commit()
+
+	req := abci.RequestQuery{Data: cdc.MustMarshalJSON(NewQueryAddressTxsParams(alice, 1, 10))}
+	bz, err := NewQuerier(cdc, idx, nil)(sdk.Context{}, []string{QueryAddressTxs}, req)
+	require.Nil(t, err)
+	var res AddressTxs
+	cdc.MustUnmarshalJSON(bz, &res)
+	require.Equal(t, int64(1), res.Total)
+	require.Equal(t, RoleSigner, res.Txs[0].Role)
+
+	_, err = NewQuerier(cdc, nil, nil)(sdk.Context{}, []string{QueryAddressTxs}, req)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+	_, err = NewQuerier(cdc, idx, nil)(sdk.Context{}, []string{"unknown"}, req)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
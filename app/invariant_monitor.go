@@ -0,0 +1,212 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+)
+
+const (
+	// FlagInvariantMonitorPeriod makes the node check the registered invariants every N
+	// blocks, right after the block is committed. Unlike the crisis module, broken invariants
+	// do not halt the node, they are only reported, so it is meant for the non-validator nodes.
+	FlagInvariantMonitorPeriod = "invariant-monitor-period"
+	// FlagInvariantMonitorReport is the file to write the report of the latest check to
+	FlagInvariantMonitorReport = "invariant-monitor-report"
+
+	defaultInvariantReportFile = "invariant-report.json"
+)
+
+// BrokenInvariant is an invariant which does not hold, with the message it returned
+type BrokenInvariant struct {
+	Module  string `json:"module"`
+	Route   string `json:"route"`
+	Message string `json:"message"`
+}
+
+// InvariantReport is the result of checking all of the registered invariants at a height
+type InvariantReport struct {
+	Height    int64             `json:"height"`
+	BlockTime time.Time         `json:"block_time"`
+	CheckedAt time.Time         `json:"checked_at"`
+	Duration  float64           `json:"duration_seconds"`
+	Checked   int               `json:"checked"`
+	Broken    []BrokenInvariant `json:"broken"`
+}
+
+// invariantMonitor checks the invariants against the state committed last. The check
+// runs within Commit, as the keepers cache what they read (e.g. the validators of staking)
+// and must not be used by the next block or the queries meanwhile.
+type invariantMonitor struct {
+	period     int64
+	reportFile string
+	cms        sdk.CommitMultiStore
+	metrics    *invariantMetrics
+
+	// header of the block being executed
+	header abci.Header
+}
+
+func newInvariantMonitor(period int64, reportFile string, cms sdk.CommitMultiStore) *invariantMonitor {
+	if reportFile == "" {
+		reportFile = filepath.Join(viper.GetString(cli.HomeFlag), "data", defaultInvariantReportFile)
+	}
+	return &invariantMonitor{
+		period:     period,
+		reportFile: reportFile,
+		cms:        cms,
+		metrics:    getInvariantMetrics(),
+	}
+}
+
+// checkCommitted checks the invariants against the state just committed if it is at the period
+func (m *invariantMonitor) checkCommitted(logger log.Logger, routes []crisis.InvarRoute) {
+	if m.header.Height%m.period != 0 {
+		return
+	}
+	ctx := sdk.NewContext(m.cms.CacheMultiStore(), m.header, false, logger)
+	m.publish(logger, routes, checkInvariants(ctx, routes))
+}
+
+// checkInvariants runs each invariant in a cache of ctx, an invariant which panics is broken
+func checkInvariants(ctx sdk.Context, routes []crisis.InvarRoute) InvariantReport {
+	start := time.Now()
+	report := InvariantReport{
+		Height:    ctx.BlockHeight(),
+		BlockTime: ctx.BlockHeader().Time,
+		Checked:   len(routes),
+		Broken:    []BrokenInvariant{},
+	}
+	for _, route := range routes {
+		cacheCtx, _ := ctx.CacheContext()
+		if msg, broken := runInvariant(cacheCtx, route.Invar); broken {
+			report.Broken = append(report.Broken, BrokenInvariant{
+				Module:  route.ModuleName,
+				Route:   route.Route,
+				Message: msg,
+			})
+		}
+	}
+	report.CheckedAt = time.Now().UTC()
+	report.Duration = report.CheckedAt.Sub(start).Seconds()
+	return report
+}
+
+func runInvariant(ctx sdk.Context, invar sdk.Invariant) (msg string, broken bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg, broken = fmt.Sprintf("panic: %v", r), true
+		}
+	}()
+	return invar(ctx)
+}
+
+// publish logs the report, updates the metrics and writes the report file
+func (m *invariantMonitor) publish(logger log.Logger, routes []crisis.InvarRoute, report InvariantReport) {
+	for _, b := range report.Broken {
+		logger.Error("Invariant broken", "height", report.Height,
+			"module", b.Module, "route", b.Route, "msg", b.Message)
+	}
+	logger.Info("Checked invariants", "height", report.Height,
+		"checked", report.Checked, "broken", len(report.Broken), "duration", report.Duration)
+
+	m.metrics.set(routes, report)
+	if err := writeInvariantReport(m.reportFile, report); err != nil {
+		logger.Error("failed to write invariant report", "file", m.reportFile, "err", err)
+	}
+}
+
+// writeInvariantReport replaces the file with the report, readers never see a partial one
+func writeInvariantReport(file string, report InvariantReport) error {
+	bz, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpFile := file + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, bz, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, file)
+}
+
+// invariantMetrics are served by the prometheus server of tendermint, if it is enabled
+type invariantMetrics struct {
+	// Height of the latest state checked
+	Height metrics.Gauge
+	// Number of the broken invariants at the height
+	NumBroken metrics.Gauge
+	// 1 if the invariant of the module and route is broken at the height, 0 otherwise
+	Broken metrics.Gauge
+	// Seconds spent in checking the invariants
+	Duration metrics.Gauge
+}
+
+var (
+	invariantMetricsOnce sync.Once
+	invariantMetricsInst *invariantMetrics
+)
+
+// getInvariantMetrics registers the metrics once, as the registry refuses duplicates
+func getInvariantMetrics() *invariantMetrics {
+	invariantMetricsOnce.Do(func() {
+		invariantMetricsInst = &invariantMetrics{
+			Height: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+				Namespace: "cetd",
+				Subsystem: "invariants",
+				Name:      "height",
+				Help:      "Height of the latest state whose invariants are checked.",
+			}, nil),
+			NumBroken: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+				Namespace: "cetd",
+				Subsystem: "invariants",
+				Name:      "num_broken",
+				Help:      "Number of the invariants broken at the height.",
+			}, nil),
+			Broken: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+				Namespace: "cetd",
+				Subsystem: "invariants",
+				Name:      "broken",
+				Help:      "Whether the invariant is broken at the height.",
+			}, []string{"module", "route"}),
+			Duration: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+				Namespace: "cetd",
+				Subsystem: "invariants",
+				Name:      "duration_seconds",
+				Help:      "Time spent in checking the invariants.",
+			}, nil),
+		}
+	})
+	return invariantMetricsInst
+}
+
+func (m *invariantMetrics) set(routes []crisis.InvarRoute, report InvariantReport) {
+	m.Height.Set(float64(report.Height))
+	m.NumBroken.Set(float64(len(report.Broken)))
+	m.Duration.Set(report.Duration)
+	broken := make(map[string]bool, len(report.Broken))
+	for _, b := range report.Broken {
+		broken[crisis.NewInvarRoute(b.Module, b.Route, nil).FullRoute()] = true
+	}
+	for _, route := range routes {
+		v := 0.0
+		if broken[route.FullRoute()] {
+			v = 1
+		}
+		m.Broken.With("module", route.ModuleName, "route", route.Route).Set(v)
+	}
+}
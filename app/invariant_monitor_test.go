@@ -0,0 +1,156 @@
+package app
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestCheckInvariants(t *testing.T) {
+	routes := []crisis.InvarRoute{
+		crisis.NewInvarRoute("a", "ok", func(sdk.Context) (string, bool) { return "fine", false }),
+		crisis.NewInvarRoute("a", "broken", func(sdk.Context) (string, bool) { return "bad", true }),
+		crisis.NewInvarRoute("b", "panic", func(sdk.Context) (string, bool) { panic("boom") }),
+	}
+	ctx := sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB()), abci.Header{Height: 7}, false, log.NewNopLogger())
+	report := checkInvariants(ctx, routes)
+	require.Equal(t, int64(7), report.Height)
+	require.Equal(t, 3, report.Checked)
+	require.Equal(t, []BrokenInvariant{
+		{Module: "a", Route: "broken", Message: "bad"},
+		{Module: "b", Route: "panic", Message: "panic: boom"},
+	}, report.Broken)
+}
+
+func TestInvariantMonitor(t *testing.T) {
+	app := initApp(nil)
+	require.Nil(t, app.invariantMonitor)
+
+	dir, err := ioutil.TempDir("", "invariant-monitor")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	reportFile := filepath.Join(dir, "report.json")
+	viper.Set(FlagInvariantMonitorPeriod, 2)
+	viper.Set(FlagInvariantMonitorReport, reportFile)
+	defer viper.Set(FlagInvariantMonitorPeriod, 0)
+	defer viper.Set(FlagInvariantMonitorReport, "")
+	app = initApp(nil)
+	require.NotNil(t, app.invariantMonitor)
+
+	var broken bool
+	app.crisisKeeper.RegisterRoute("test", "broken", func(ctx sdk.Context) (string, bool) {
+		return "broken at " + ctx.BlockHeader().ChainID, broken
+	})
+	for height := int64(1); height <= 2; height++ {
+		broken = height == 2
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height, ChainID: "c"}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// the report is written by the time the block is committed
+	report := readInvariantReport(t, reportFile)
+	require.Equal(t, int64(2), report.Height)
+	require.Equal(t, len(app.crisisKeeper.Routes()), report.Checked)
+	require.Contains(t, report.Broken, BrokenInvariant{Module: "test", Route: "broken", Message: "broken at c"})
+}
+
+// TestInvariantMonitorWithBlocks checks the invariants every block while other
+// connections keep querying the validators, run it with -race
+func TestInvariantMonitorWithBlocks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "invariant-monitor")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	reportFile := filepath.Join(dir, "report.json")
+	viper.Set(FlagInvariantMonitorPeriod, 1)
+	viper.Set(FlagInvariantMonitorReport, reportFile)
+	defer viper.Set(FlagInvariantMonitorPeriod, 0)
+	defer viper.Set(FlagInvariantMonitorReport, "")
+
+	sk, pk, addr := testutil.KeyPubAddr()
+	acc := auth.BaseAccount{Address: addr, Coins: dex.NewCetCoins(cetToken().GetTotalSupply().Int64())}
+	app := startAppWithOneValidator(acc, addr, pk, sk, t)
+	require.NotNil(t, app.invariantMonitor)
+	valAddr := sdk.ValAddress(addr)
+	app.crisisKeeper.RegisterRoute("test", "validator", func(ctx sdk.Context) (string, bool) {
+		_, found := app.stakingKeeper.GetValidator(ctx, valAddr)
+		return "validator not found", !found
+	})
+
+	// the consensus, mempool and query connections share the client like in a node
+	client := abcicli.NewLocalClient(new(sync.Mutex), app)
+	query := abci.RequestQuery{
+		Path: "custom/staking/validator",
+		Data: app.cdc.MustMarshalJSON(staking.NewQueryValidatorParams(valAddr)),
+	}
+	done := make(chan struct{})
+	queried := make(chan int)
+	go func() {
+		count := 0
+		for {
+			select {
+			case <-done:
+				queried <- count
+				return
+			default:
+			}
+			if res, err := client.QuerySync(query); err == nil && res.Code == uint32(sdk.CodeOK) {
+				count++
+			}
+		}
+	}()
+
+	for height := app.LastBlockHeight() + 1; height <= 20; height++ {
+		_, err := client.BeginBlockSync(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		require.Nil(t, err)
+		_, err = client.EndBlockSync(abci.RequestEndBlock{Height: height})
+		require.Nil(t, err)
+		_, err = client.CommitSync()
+		require.Nil(t, err)
+
+		report := readInvariantReport(t, reportFile)
+		require.Equal(t, height, report.Height)
+		require.Empty(t, report.Broken)
+	}
+	close(done)
+	require.NotZero(t, <-queried)
+}
+
+func readInvariantReport(t *testing.T, file string) (report InvariantReport) {
+	bz, err := ioutil.ReadFile(file)
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(bz, &report))
+	return
+}
+
+func TestWriteInvariantReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "invariant-report")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "report.json")
+	m := &invariantMonitor{reportFile: file, metrics: getInvariantMetrics()}
+	m.publish(log.NewNopLogger(), nil, InvariantReport{Height: 3, Broken: []BrokenInvariant{}})
+	bz, err := ioutil.ReadFile(file)
+	require.Nil(t, err)
+	require.Contains(t, string(bz), `"broken": []`)
+	require.Error(t, writeInvariantReport(filepath.Join(file, "x"), InvariantReport{}))
+}
@@ -0,0 +1,237 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
+	"github.com/coinexchain/cet-sdk/modules/incentive"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// at most this many accounts are listed in the message of a broken invariant
+const maxInvariantEntries = 10
+
+// registerInvariants registers the invariants which involve the states of
+// several modules, beside the ones of each module
+func (app *CetChainApp) registerInvariants() {
+	app.crisisKeeper.RegisterRoute(authx.ModuleName, "pre-total-supply", authx.PreTotalSupplyInvariant(app.accountXKeeper))
+	app.crisisKeeper.RegisterRoute(authx.ModuleName, "frozen-coins", app.frozenCoinsInvariant())
+	app.crisisKeeper.RegisterRoute(autoswap.ModuleName, "pool-reserves", app.poolReservesInvariant())
+	app.crisisKeeper.RegisterRoute(incentive.ModuleName, "pool-schedule", app.incentivePoolInvariant())
+	app.crisisKeeper.RegisterRoute(staking.ModuleName, "bonded-pools", app.bondedPoolsInvariant())
+}
+
+// frozenCoinsInvariant checks that the frozen coins of each account are the sum of
+// what it commits to the open market orders, the autoswap orders and its bancors
+func (app *CetChainApp) frozenCoinsInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]map[string]sdk.Int)
+		commit := func(addr sdk.AccAddress, denom string, amount sdk.Int) {
+			coins, ok := expected[string(addr)]
+			if !ok {
+				coins = make(map[string]sdk.Int)
+				expected[string(addr)] = coins
+			}
+			if sum, ok := coins[denom]; ok {
+				amount = sum.Add(amount)
+			}
+			coins[denom] = amount
+		}
+
+		for _, order := range app.marketKeeper.GetAllOrders(ctx) {
+			commit(order.Sender, order.GetOrderUsedDenom(), sdk.NewInt(order.Freeze))
+			commit(order.Sender, dex.CET, sdk.NewInt(order.FrozenCommission+order.FrozenFeatureFee))
+		}
+		for _, info := range app.autoSwapKeeper.GetPoolInfos(ctx) {
+			for _, order := range app.autoSwapKeeper.GetAllOrders(ctx, info.Symbol) {
+				denom := order.Stock()
+				if order.IsBuy {
+					denom = order.Money()
+				}
+				commit(order.Sender, denom, sdk.NewInt(order.Freeze))
+			}
+		}
+		for _, bi := range app.bancorKeeper.GetAllBancorInfos(ctx) {
+			commit(bi.Owner, bi.Stock, bi.StockInPool)
+			commit(bi.Owner, bi.Money, bi.MoneyInPool)
+		}
+
+		var mismatches []string
+		check := func(addr sdk.AccAddress, frozen sdk.Coins) {
+			coins := expected[string(addr)]
+			delete(expected, string(addr))
+			for _, coin := range frozen {
+				if amount, ok := coins[coin.Denom]; !ok || !amount.Equal(coin.Amount) {
+					mismatches = append(mismatches, fmt.Sprintf("%s: frozen %s, expected %s",
+						addr, frozen, formatAmounts(coins)))
+					return
+				}
+			}
+			for denom, amount := range coins {
+				if !amount.Equal(frozen.AmountOf(denom)) {
+					mismatches = append(mismatches, fmt.Sprintf("%s: frozen %s, expected %s",
+						addr, frozen, formatAmounts(coins)))
+					return
+				}
+			}
+		}
+		app.accountXKeeper.IterateAccounts(ctx, func(acc authx.AccountX) bool {
+			check(acc.Address, acc.FrozenCoins)
+			return false
+		})
+		// the accounts which commit coins but have no AccountX
+		for addr := range expected {
+			check(sdk.AccAddress(addr), nil)
+		}
+
+		broken := len(mismatches) != 0
+		return sdk.FormatInvariant(authx.ModuleName, "frozen-coins",
+			fmt.Sprintf("\t%d accounts with mismatched frozen coins%s", len(mismatches),
+				listEntries(mismatches))), broken
+	}
+}
+
+// poolReservesInvariant checks that the pool module account of autoswap holds
+// exactly the AMM reserves of all the pools, the reserves of the order books are
+// frozen in the accounts of the orders' senders instead
+func (app *CetChainApp) poolReservesInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		reserves := sdk.NewCoins()
+		for _, info := range app.autoSwapKeeper.GetPoolInfos(ctx) {
+			stock, money := dex.SplitSymbol(info.Symbol)
+			reserves = reserves.Add(sdk.NewCoins(
+				sdk.NewCoin(stock, info.StockAmmReserve),
+				sdk.NewCoin(money, info.MoneyAmmReserve)))
+		}
+		balance := app.moduleAccountCoins(ctx, autoswap.PoolModuleAcc)
+
+		broken := !balance.IsEqual(reserves)
+		return sdk.FormatInvariant(autoswap.ModuleName, "pool-reserves",
+			fmt.Sprintf("\tsum of AMM reserves: %s\n\t%s module account: %s\n",
+				reserves, autoswap.PoolModuleAcc, balance)), broken
+	}
+}
+
+// incentivePoolInvariant checks the balances which follow the reward schedule.
+// The rewards minted to the incentive module account are sent to the fee
+// collector in the same BeginBlock, so it is always empty. Before DEX3, the
+// incentive pool must hold the rewards of the remaining blocks of the plans,
+// which are cleared along with the pool when DEX3 starts.
+func (app *CetChainApp) incentivePoolInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg strings.Builder
+		moduleCoins := app.moduleAccountCoins(ctx, incentive.ModuleName)
+		broken := !moduleCoins.IsZero()
+		fmt.Fprintf(&msg, "\t%s module account: %s\n", incentive.ModuleName, moduleCoins)
+
+		plans := app.incentiveKeeper.GetParams(ctx).Plans
+		if ctx.BlockHeight() >= Dex3StartHeight {
+			broken = broken || len(plans) != 0
+			fmt.Fprintf(&msg, "\t%d plans left after DEX3\n", len(plans))
+		} else {
+			height := ctx.BlockHeight() + app.incentiveKeeper.GetState(ctx).HeightAdjustment
+			remaining := sdk.ZeroInt()
+			for _, plan := range plans {
+				from := plan.StartHeight
+				if from <= height {
+					from = height + 1
+				}
+				if from < plan.EndHeight {
+					remaining = remaining.Add(sdk.NewInt(plan.EndHeight - from).MulRaw(plan.RewardPerBlock))
+				}
+			}
+			var poolCET sdk.Int
+			if acc := app.accountKeeper.GetAccount(ctx, incentive.PoolAddr); acc != nil {
+				poolCET = acc.GetCoins().AmountOf(dex.CET)
+			} else {
+				poolCET = sdk.ZeroInt()
+			}
+			broken = broken || poolCET.LT(remaining)
+			fmt.Fprintf(&msg, "\tremaining rewards of the plans: %s%s\n\tincentive pool: %s%s\n",
+				remaining, dex.CET, poolCET, dex.CET)
+		}
+		return sdk.FormatInvariant(incentive.ModuleName, "pool-schedule", msg.String()), broken
+	}
+}
+
+// bondedPoolsInvariant checks that the bonded pool holds exactly the tokens of
+// the validators in the last validator set, that the not bonded pool holds the
+// tokens of the other validators and of the unbonding delegations, and that
+// both pools hold only the bond denom
+func (app *CetChainApp) bondedPoolsInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		bondDenom := app.stakingKeeper.BondDenom(ctx)
+		bondedTokens := sdk.ZeroInt()
+		app.stakingKeeper.IterateLastValidators(ctx, func(_ int64, validator exported.ValidatorI) bool {
+			bondedTokens = bondedTokens.Add(validator.GetTokens())
+			return false
+		})
+		notBondedTokens := sdk.ZeroInt()
+		app.stakingKeeper.IterateValidators(ctx, func(_ int64, validator exported.ValidatorI) bool {
+			if !validator.IsBonded() {
+				notBondedTokens = notBondedTokens.Add(validator.GetTokens())
+			}
+			return false
+		})
+		app.stakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd staking.UnbondingDelegation) bool {
+			for _, entry := range ubd.Entries {
+				notBondedTokens = notBondedTokens.Add(entry.Balance)
+			}
+			return false
+		})
+		bonded := app.moduleAccountCoins(ctx, staking.BondedPoolName)
+		notBonded := app.moduleAccountCoins(ctx, staking.NotBondedPoolName)
+
+		broken := !bonded.IsEqual(sdk.NewCoins(sdk.NewCoin(bondDenom, bondedTokens))) ||
+			!notBonded.IsEqual(sdk.NewCoins(sdk.NewCoin(bondDenom, notBondedTokens)))
+		return sdk.FormatInvariant(staking.ModuleName, "bonded-pools",
+			fmt.Sprintf("\ttokens of the last validators: %s%s\n\tbonded pool: %s\n"+
+				"\ttokens of the other validators and unbonding delegations: %s%s\n\tnot bonded pool: %s\n",
+				bondedTokens, bondDenom, bonded, notBondedTokens, bondDenom, notBonded)), broken
+	}
+}
+
+// moduleAccountCoins returns the coins of a module account, without creating
+// the account as the supply keeper would, since invariants must not write
+func (app *CetChainApp) moduleAccountCoins(ctx sdk.Context, name string) sdk.Coins {
+	if acc := app.accountKeeper.GetAccount(ctx, supply.NewModuleAddress(name)); acc != nil {
+		return acc.GetCoins()
+	}
+	return sdk.NewCoins()
+}
+
+func formatAmounts(amounts map[string]sdk.Int) string {
+	denoms := make([]string, 0, len(amounts))
+	for denom := range amounts {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	coins := make([]string, 0, len(denoms))
+	for _, denom := range denoms {
+		if !amounts[denom].IsZero() {
+			coins = append(coins, amounts[denom].String()+denom)
+		}
+	}
+	return strings.Join(coins, ",")
+}
+
+func listEntries(entries []string) string {
+	sort.Strings(entries)
+	var sb strings.Builder
+	for i, entry := range entries {
+		if i == maxInvariantEntries {
+			fmt.Fprintf(&sb, "\n\t...")
+			break
+		}
+		fmt.Fprintf(&sb, "\n\t%s", entry)
+	}
+	return sb.String() + "\n"
+}
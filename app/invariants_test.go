@@ -0,0 +1,113 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite"
+	"github.com/coinexchain/cet-sdk/modules/incentive"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func newInvariantTestApp(t *testing.T) (*CetChainApp, sdk.Context, sdk.AccAddress) {
+	_, _, addr := testutil.KeyPubAddr()
+	acc := auth.BaseAccount{Address: addr, Coins: dex.NewCetCoins(1e10)}
+	app := initAppWithBaseAccounts(acc)
+	ctx := app.NewContext(false, abci.Header{Height: Dex3StartHeight})
+	for _, route := range app.crisisKeeper.Routes() {
+		msg, broken := route.Invar(ctx)
+		require.False(t, broken, msg)
+	}
+	return app, ctx, addr
+}
+
+func TestFrozenCoinsInvariant(t *testing.T) {
+	app, ctx, addr := newInvariantTestApp(t)
+	invar := app.frozenCoinsInvariant()
+
+	require.Nil(t, app.bancorKeeper.FreezeCoins(ctx, addr, dex.NewCetCoins(100)))
+	msg, broken := invar(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, addr.String()+": frozen 100cet, expected ")
+
+	bi := &bancorlite.BancorInfo{
+		Owner:       addr,
+		Stock:       "foo",
+		Money:       dex.CET,
+		InitPrice:   sdk.ZeroDec(),
+		MaxSupply:   sdk.NewInt(1000),
+		MaxPrice:    sdk.OneDec(),
+		MaxMoney:    sdk.NewInt(1000),
+		Price:       sdk.ZeroDec(),
+		StockInPool: sdk.ZeroInt(),
+		MoneyInPool: sdk.NewInt(100),
+	}
+	app.bancorKeeper.Save(ctx, bi)
+	msg, broken = invar(ctx)
+	require.False(t, broken, msg)
+
+	bi.StockInPool = sdk.NewInt(10)
+	app.bancorKeeper.Save(ctx, bi)
+	msg, broken = invar(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "expected 100cet,10foo")
+}
+
+func TestPoolReservesInvariant(t *testing.T) {
+	app, ctx, addr := newInvariantTestApp(t)
+	invar := app.poolReservesInvariant()
+
+	require.Nil(t, app.supplyKeeper.SendCoinsFromAccountToModule(ctx, addr, autoswap.PoolModuleAcc, dex.NewCetCoins(100)))
+	msg, broken := invar(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "sum of AMM reserves: \n")
+	require.Contains(t, msg, "autoswap-pool module account: 100cet")
+}
+
+func TestIncentivePoolInvariant(t *testing.T) {
+	app, ctx, addr := newInvariantTestApp(t)
+	invar := app.incentivePoolInvariant()
+
+	params := app.incentiveKeeper.GetParams(ctx)
+	params.Plans = []incentive.Plan{{StartHeight: 0, EndHeight: 10, RewardPerBlock: 10, TotalIncentive: 100}}
+	app.incentiveKeeper.SetParams(ctx, params)
+	_, broken := invar(ctx)
+	require.True(t, broken)
+
+	// before DEX3, the pool holds the rewards of the blocks after the current one
+	ctx = ctx.WithBlockHeight(Dex3StartHeight - 1)
+	msg, broken := invar(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "remaining rewards of the plans: 90cet")
+	require.Nil(t, app.bankKeeper.SendCoins(ctx, addr, incentive.PoolAddr, dex.NewCetCoins(90)))
+	msg, broken = invar(ctx)
+	require.False(t, broken, msg)
+
+	require.Nil(t, app.supplyKeeper.MintCoins(ctx, incentive.ModuleName, dex.NewCetCoins(1)))
+	_, broken = invar(ctx)
+	require.True(t, broken)
+}
+
+func TestBondedPoolsInvariant(t *testing.T) {
+	app, ctx, addr := newInvariantTestApp(t)
+	invar := app.bondedPoolsInvariant()
+
+	require.Nil(t, app.supplyKeeper.SendCoinsFromAccountToModule(ctx, addr, staking.BondedPoolName, dex.NewCetCoins(100)))
+	msg, broken := invar(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "tokens of the last validators: 0cet")
+
+	cacheCtx, _ := ctx.CacheContext()
+	require.Nil(t, app.supplyKeeper.SendCoinsFromAccountToModule(cacheCtx, addr, staking.NotBondedPoolName, dex.NewCetCoins(100)))
+	msg, broken = invar(cacheCtx)
+	require.True(t, broken)
+	require.Contains(t, msg, "not bonded pool: 100cet")
+}
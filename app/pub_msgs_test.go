@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -52,3 +53,25 @@ func TestDiscardKafkaEvents(t *testing.T) {
 	require.Equal(t, "other", events[0].Type)
 	require.Equal(t, "other", events[1].Type)
 }
+
+func TestNotifyTxJSON(t *testing.T) {
+	cdc := MakeCodec()
+	tx := newTestTx()
+	tx.Memo = "<memo> &  "
+	fakeApp := &CetChainApp{cdc: cdc}
+	fakeApp.notifyTx(abci.RequestDeliverTx{Tx: []byte("tx")}, tx, abci.ResponseDeliverTx{})
+	require.Equal(t, 1, len(fakeApp.pubMsgs))
+	require.Equal(t, "notify_tx", string(fakeApp.pubMsgs[0].Key))
+
+	var n4s NotificationTx
+	require.Nil(t, json.Unmarshal(fakeApp.pubMsgs[0].Value, &n4s))
+	expected, err := json.Marshal(&tx)
+	require.Nil(t, err)
+	require.Equal(t, string(expected), n4s.TxJSON)
+	require.Equal(t, []string{"MsgSend", "MsgCreateOrder"}, n4s.MsgTypes)
+
+	fakeApp = &CetChainApp{cdc: cdc, notifyAminoTxJSON: true}
+	fakeApp.notifyTx(abci.RequestDeliverTx{Tx: []byte("tx")}, tx, abci.ResponseDeliverTx{})
+	require.Nil(t, json.Unmarshal(fakeApp.pubMsgs[0].Value, &n4s))
+	require.Equal(t, string(cdc.MustMarshalJSON(tx)), n4s.TxJSON)
+}
@@ -0,0 +1,183 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+)
+
+// ShadowForkParams tells how to turn an exported genesis state into a shadow fork
+type ShadowForkParams struct {
+	// the consensus key of the local node, which takes over a validator
+	ConsPubKey crypto.PubKey
+	// the validator taken over, the bonded one with the most tokens if empty
+	Operator sdk.ValAddress
+	// the test accounts which get FundAmount each, the coins are minted
+	FundAccounts []sdk.AccAddress
+	FundAmount   sdk.Coins
+	// when the other validators are unbonded
+	GenesisTime time.Time
+}
+
+// ShadowFork changes an exported genesis state, so that the chain can run on
+// one local node against the real state. One bonded validator is taken over by
+// the consensus key of the node, and all the other validators are jailed and
+// unbonded, so the local node has all the voting power. Delegations and rewards
+// are left untouched. The returned validator is for the genesis doc.
+func ShadowFork(gs *GenesisState, params ShadowForkParams) (tmtypes.GenesisValidator, error) {
+	if !gs.StakingData.Exported {
+		return tmtypes.GenesisValidator{}, errors.New("staking state is not exported from a running chain")
+	}
+
+	target, err := findShadowForkValidator(gs.StakingData.Validators, params.Operator)
+	if err != nil {
+		return tmtypes.GenesisValidator{}, err
+	}
+	validators := gs.StakingData.Validators
+	oldConsAddr := validators[target].GetConsAddr()
+	validators[target].ConsPubKey = params.ConsPubKey
+
+	unbonded := sdk.ZeroInt()
+	for i := range validators {
+		if i == target {
+			continue
+		}
+		if validators[i].Status == sdk.Bonded {
+			unbonded = unbonded.Add(validators[i].Tokens)
+			validators[i].Status = sdk.Unbonded
+			validators[i].UnbondingHeight = 0
+			validators[i].UnbondingCompletionTime = params.GenesisTime
+		}
+		// jailed validators are not in the power index, so they never get bonded again
+		validators[i].Jailed = true
+	}
+	if err := moveGenesisPoolTokens(gs, staking.BondedPoolName, staking.NotBondedPoolName, unbonded); err != nil {
+		return tmtypes.GenesisValidator{}, err
+	}
+
+	val := validators[target]
+	power := val.ConsensusPower()
+	gs.StakingData.LastValidatorPowers = []staking.LastValidatorPower{{Address: val.OperatorAddress, Power: power}}
+	gs.StakingData.LastTotalPower = sdk.NewInt(power)
+
+	// the signing history of the old key does not belong to the new one
+	newConsAddr := val.GetConsAddr()
+	if gs.SlashingData.SigningInfos == nil {
+		gs.SlashingData.SigningInfos = make(map[string]slashing.ValidatorSigningInfo)
+	}
+	delete(gs.SlashingData.SigningInfos, oldConsAddr.String())
+	delete(gs.SlashingData.MissedBlocks, oldConsAddr.String())
+	gs.SlashingData.SigningInfos[newConsAddr.String()] = slashing.NewValidatorSigningInfo(
+		newConsAddr, 0, 0, time.Unix(0, 0), false, 0)
+
+	if err := fundShadowForkAccounts(gs, params.FundAccounts, params.FundAmount); err != nil {
+		return tmtypes.GenesisValidator{}, err
+	}
+
+	return tmtypes.GenesisValidator{
+		Address: params.ConsPubKey.Address(),
+		PubKey:  params.ConsPubKey,
+		Power:   power,
+		Name:    val.Description.Moniker,
+	}, nil
+}
+
+func findShadowForkValidator(validators staking.Validators, operator sdk.ValAddress) (int, error) {
+	target := -1
+	for i, val := range validators {
+		if val.Status != sdk.Bonded || val.Jailed {
+			continue
+		}
+		if operator.Empty() {
+			if target < 0 || val.Tokens.GT(validators[target].Tokens) {
+				target = i
+			}
+		} else if val.OperatorAddress.Equals(operator) {
+			return i, nil
+		}
+	}
+	if target < 0 {
+		if operator.Empty() {
+			return target, errors.New("no bonded validator found")
+		}
+		return target, fmt.Errorf("%s is not a bonded validator", operator)
+	}
+	return target, nil
+}
+
+func moveGenesisPoolTokens(gs *GenesisState, from, to string, amt sdk.Int) error {
+	fromPool := findGenesisModuleAccount(*gs, from)
+	toPool := findGenesisModuleAccount(*gs, to)
+	if fromPool == nil || toPool == nil {
+		return fmt.Errorf("module account of %s or %s not found", from, to)
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(gs.StakingData.Params.BondDenom, amt))
+	left, neg := fromPool.Coins.SafeSub(coins)
+	if neg {
+		return fmt.Errorf("%s module account has less than %s", from, coins)
+	}
+	fromPool.Coins = left
+	toPool.Coins = toPool.Coins.Add(coins)
+	return nil
+}
+
+func fundShadowForkAccounts(gs *GenesisState, addrs []sdk.AccAddress, amt sdk.Coins) error {
+	if len(addrs) == 0 || amt.IsZero() {
+		return nil
+	}
+
+	minted := sdk.NewCoins()
+	for range addrs {
+		minted = minted.Add(amt)
+	}
+	for _, coin := range minted {
+		token := findGenesisToken(*gs, coin.Denom)
+		if token == nil {
+			return fmt.Errorf("token %s not found", coin.Denom)
+		}
+		if err := token.SetTotalSupply(token.GetTotalSupply().Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+	if !gs.Supply.Supply.Empty() {
+		gs.Supply.Supply = gs.Supply.Supply.Add(minted)
+	}
+
+	for _, addr := range addrs {
+		if acc := findGenesisAccount(*gs, addr); acc != nil {
+			acc.Coins = acc.Coins.Add(amt)
+			continue
+		}
+		gs.Accounts = append(gs.Accounts, genaccounts.NewGenesisAccountRaw(
+			addr, amt, sdk.NewCoins(), 0, 0, ""))
+	}
+	return nil
+}
+
+func findGenesisAccount(gs GenesisState, addr sdk.AccAddress) *genaccounts.GenesisAccount {
+	for i := range gs.Accounts {
+		if gs.Accounts[i].Address.Equals(addr) {
+			return &gs.Accounts[i]
+		}
+	}
+	return nil
+}
+
+func findGenesisToken(gs GenesisState, symbol string) asset.Token {
+	for _, token := range gs.AssetData.Tokens {
+		if token.GetSymbol() == symbol {
+			return token
+		}
+	}
+	return nil
+}
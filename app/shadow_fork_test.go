@@ -0,0 +1,141 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func newCreateValidatorTx(addr sdk.AccAddress, pk crypto.PubKey, sk crypto.PrivKey,
+	accNum uint64, selfDelegation int64) auth.StdTx {
+
+	createValMsg := testutil.NewMsgCreateValidatorBuilder(sdk.ValAddress(addr), pk).
+		MinSelfDelegation(1e8).SelfDelegation(selfDelegation).
+		Commission("0.1", "0.1", "0.01").
+		Build()
+	return newStdTxBuilder().
+		Msgs(createValMsg).
+		GasAndFee(1000000, 100).AccNumSeqKey(accNum, 0, sk).Build()
+}
+
+// startAppWithTwoValidators starts a chain whose second validator has more tokens
+func startAppWithTwoValidators(t *testing.T) (*CetChainApp, sdk.ValAddress, sdk.ValAddress) {
+	sk1, pk1, addr1 := testutil.KeyPubAddr()
+	sk2, pk2, addr2 := testutil.KeyPubAddr()
+	acc1 := auth.BaseAccount{Address: addr1, Coins: dex.NewCetCoins(1e10)}
+	acc2 := auth.BaseAccount{Address: addr2, Coins: dex.NewCetCoins(1e10)}
+	app := initApp(func(genState *GenesisState) {
+		addGenesisAccounts(genState, acc1, acc2)
+		genState.StakingXData.Params.MinSelfDelegation = 1e8
+	})
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	require.Equal(t, sdk.CodeOK, app.Deliver(newCreateValidatorTx(addr1, pk1, sk1, 0, 1e8)).Code)
+	require.Equal(t, sdk.CodeOK, app.Deliver(newCreateValidatorTx(addr2, pk2, sk2, 1, 2e8)).Code)
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	for height := int64(2); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+	return app, sdk.ValAddress(addr1), sdk.ValAddress(addr2)
+}
+
+func TestShadowFork(t *testing.T) {
+	app1, val1, val2 := startAppWithTwoValidators(t)
+	appState, _, err := app1.ExportAppStateAndValidators(true, nil)
+	require.Nil(t, err)
+	var m map[string]json.RawMessage
+	require.Nil(t, app1.cdc.UnmarshalJSON(appState, &m))
+	gs := FromMap(app1.cdc, m)
+
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	_, _, fundAddr := testutil.KeyPubAddr()
+	genVal, err := ShadowFork(&gs, ShadowForkParams{
+		ConsPubKey:   consPubKey,
+		FundAccounts: []sdk.AccAddress{fundAddr},
+		FundAmount:   dex.NewCetCoins(100),
+		GenesisTime:  time.Unix(1577836800, 0),
+	})
+	require.Nil(t, err)
+	require.Equal(t, consPubKey, genVal.PubKey)
+	require.Equal(t, int64(200), genVal.Power)
+	require.Empty(t, ValidateGenesisDeep(gs))
+	for _, val := range gs.StakingData.Validators {
+		if val.OperatorAddress.Equals(val2) {
+			require.Equal(t, sdk.Bonded, val.Status)
+			require.False(t, val.Jailed)
+		} else {
+			require.Equal(t, val1, val.OperatorAddress)
+			require.Equal(t, sdk.Unbonded, val.Status)
+			require.True(t, val.Jailed)
+		}
+	}
+
+	// start the shadow fork, with the new key signing the blocks
+	for name, state := range gs.ToMap(app1.cdc) {
+		if _, ok := m[name]; ok {
+			m[name] = state
+		}
+	}
+	appState, err = app1.cdc.MarshalJSON(m)
+	require.Nil(t, err)
+	app2 := newApp()
+	res := app2.InitChain(abci.RequestInitChain{ChainId: testChainID, AppStateBytes: appState})
+	require.Len(t, res.Validators, 1)
+	require.Equal(t, tmtypes.TM2PB.PubKey(consPubKey), res.Validators[0].PubKey)
+
+	votes := abci.LastCommitInfo{Votes: []abci.VoteInfo{{
+		Validator:       abci.Validator{Address: consPubKey.Address(), Power: genVal.Power},
+		SignedLastBlock: true,
+	}}}
+	for height := int64(1); height <= 3; height++ {
+		app2.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}, LastCommitInfo: votes})
+		app2.EndBlock(abci.RequestEndBlock{Height: height})
+		app2.Commit()
+	}
+
+	ctx := app2.NewContext(true, abci.Header{Height: app2.LastBlockHeight()})
+	require.NotPanics(t, func() { app2.crisisKeeper.AssertInvariants(ctx) })
+	lastValidators := app2.stakingKeeper.GetLastValidators(ctx)
+	require.Len(t, lastValidators, 1)
+	require.Equal(t, val2, lastValidators[0].OperatorAddress)
+	require.Equal(t, dex.NewCetCoins(100), app2.accountKeeper.GetAccount(ctx, fundAddr).GetCoins())
+}
+
+func TestShadowForkErrors(t *testing.T) {
+	app, val1, _ := startAppWithTwoValidators(t)
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	params := ShadowForkParams{ConsPubKey: ed25519.GenPrivKey().PubKey()}
+
+	gs := app.ExportGenesisState(ctx)
+	gs.StakingData.Exported = false
+	_, err := ShadowFork(&gs, params)
+	require.Error(t, err)
+
+	gs = app.ExportGenesisState(ctx)
+	_, _, addr := testutil.KeyPubAddr()
+	params.Operator = sdk.ValAddress(addr)
+	_, err = ShadowFork(&gs, params)
+	require.Error(t, err)
+
+	params.Operator = val1
+	params.FundAccounts = []sdk.AccAddress{addr}
+	params.FundAmount = sdk.NewCoins(sdk.NewInt64Coin("abc", 100))
+	_, err = ShadowFork(&gs, params)
+	require.Error(t, err)
+}
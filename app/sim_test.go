@@ -966,6 +966,37 @@ func BenchmarkInvariants(b *testing.B) {
 	}
 }
 
+// TestAppInvariants runs a few blocks on a simulated genesis, with the crisis
+// module checking the invariants, including the ones across the modules, at
+// genesis and every block
+func TestAppInvariants(t *testing.T) {
+	r := rand.New(rand.NewSource(seed))
+	appState, _, chainID, genesisTimestamp := appStateFn(r, simulation.RandomAccounts(r, 20))
+
+	app := NewCetChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 1)
+	app.InitChain(abci.RequestInitChain{ChainId: chainID, Time: genesisTimestamp, AppStateBytes: appState})
+	header := abci.Header{ChainID: chainID, Time: genesisTimestamp}
+	for i := 0; i < 3; i++ {
+		header.Height = app.LastBlockHeight() + 1
+		header.Time = header.Time.Add(5 * time.Second)
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+
+	ctx := app.NewContext(true, header)
+	routes := make(map[string]bool)
+	for _, cr := range app.crisisKeeper.Routes() {
+		res, broken := cr.Invar(ctx)
+		require.False(t, broken, res)
+		routes[cr.FullRoute()] = true
+	}
+	for _, route := range []string{"authx/frozen-coins", "autoswap/pool-reserves",
+		"incentive/pool-schedule", "staking/bonded-pools"} {
+		require.True(t, routes[route], route)
+	}
+}
+
 // AppStateFromGenesisFileFn util function to generate the genesis AppState
 // from a genesis.json file
 func AppStateFromGenesisFileFn(
@@ -985,7 +1016,7 @@ func AppStateFromGenesisFileFn(
 	var appState GenesisState
 	cdc.MustUnmarshalJSON(genesis.AppState, &appState)
 
-	accounts := genaccounts.GetGenesisStateFromAppState(cdc, appState.toMap(cdc))
+	accounts := genaccounts.GetGenesisStateFromAppState(cdc, appState.ToMap(cdc))
 
 	var newAccs []simulation.Account
 	for _, acc := range accounts {
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/coinexchain/dex/app/storeutil"
+)
+
+// FlagLogStoreHashes makes the node log the commit hash of each store every block,
+// to find out which store diverges when nodes disagree on the app hash
+const FlagLogStoreHashes = "log-store-hashes"
+
+func (app *CetChainApp) logStoreHashes() {
+	version := app.LastCommitID().Version
+	keyvals, err := storeHashesKeyvals(app.storeHashesDB, version)
+	if err != nil {
+		app.Logger().Error("failed to load store hashes", "height", version, "err", err)
+		return
+	}
+	app.Logger().Info("Committed store hashes", keyvals...)
+}
+
+// storeHashesKeyvals returns the height and the commit hash of each store
+// sorted by name, as the key-value pairs to log
+func storeHashesKeyvals(db dbm.DB, version int64) ([]interface{}, error) {
+	ci, err := storeutil.GetCommitInfo(db, version)
+	if err != nil {
+		return nil, err
+	}
+	infos := ci.SortedStoreInfos()
+	keyvals := make([]interface{}, 0, 2*len(infos)+2)
+	keyvals = append(keyvals, "height", version)
+	for _, si := range infos {
+		keyvals = append(keyvals, si.Name, fmt.Sprintf("%X", si.Core.CommitID.Hash))
+	}
+	return keyvals, nil
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/coinexchain/dex/app/storeutil"
+)
+
+func TestStoreHashes(t *testing.T) {
+	app := initApp(nil)
+	require.Nil(t, app.storeHashesDB)
+
+	viper.Set(FlagLogStoreHashes, true)
+	defer viper.Set(FlagLogStoreHashes, false)
+	app = initApp(nil)
+	require.NotNil(t, app.storeHashesDB)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	res := app.Commit()
+
+	keyvals, err := storeHashesKeyvals(app.storeHashesDB, 1)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"height", int64(1)}, keyvals[:2])
+
+	ci, err := storeutil.GetCommitInfo(app.storeHashesDB, 1)
+	require.Nil(t, err)
+	require.Equal(t, res.Data, ci.Hash())
+	require.Len(t, keyvals, 2+2*len(ci.StoreInfos))
+	var names []string
+	for i := 2; i < len(keyvals); i += 2 {
+		names = append(names, keyvals[i].(string))
+	}
+	require.True(t, sort.StringsAreSorted(names))
+	require.Equal(t, "acc", keyvals[2])
+	for _, si := range ci.StoreInfos {
+		if si.Name == "acc" {
+			require.Equal(t, fmt.Sprintf("%X", si.Core.CommitID.Hash), keyvals[3])
+		}
+	}
+
+	_, err = storeHashesKeyvals(app.storeHashesDB, 2)
+	require.Error(t, err)
+}
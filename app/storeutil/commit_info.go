@@ -0,0 +1,107 @@
+// Package storeutil reads and writes the versions of the app's multistore
+// directly in its DB, for the tools which run against the DB of a stopped node.
+package storeutil
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the same keys and encoding as the ones of store/rootmulti
+const (
+	latestVersionKey = "s/latest"
+	commitInfoKeyFmt = "s/%d"
+)
+
+var cdc = codec.New()
+
+// CommitInfo is what the multistore saves for each version, it has the same
+// layout as the unexported commitInfo of store/rootmulti
+type CommitInfo struct {
+	Version    int64
+	StoreInfos []StoreInfo
+}
+
+// StoreInfo is the commit ID of a store in a version
+type StoreInfo struct {
+	Name string
+	Core StoreCore
+}
+
+// StoreCore is the same as the one of store/rootmulti
+type StoreCore struct {
+	CommitID sdk.CommitID
+}
+
+// Hash returns the app hash of the version, the simple merkle root hash of the
+// stores sorted by name
+func (ci CommitInfo) Hash() []byte {
+	m := make(map[string][]byte, len(ci.StoreInfos))
+	for _, si := range ci.StoreInfos {
+		m[si.Name] = tmhash.Sum(si.Core.CommitID.Hash)
+	}
+	return merkle.SimpleHashFromMap(m)
+}
+
+// StoreNames returns the names of the stores in the version
+func (ci CommitInfo) StoreNames() []string {
+	names := make([]string, len(ci.StoreInfos))
+	for i, si := range ci.StoreInfos {
+		names[i] = si.Name
+	}
+	return names
+}
+
+// SortedStoreInfos returns the store infos sorted by store name, the order in
+// which they are hashed into the app hash
+func (ci CommitInfo) SortedStoreInfos() []StoreInfo {
+	infos := append([]StoreInfo{}, ci.StoreInfos...)
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// GetLatestVersion returns the latest version of the multistore in db, 0 if nothing is committed
+func GetLatestVersion(db dbm.DB) int64 {
+	var latest int64
+	bz := db.Get([]byte(latestVersionKey))
+	if bz == nil {
+		return 0
+	}
+	cdc.MustUnmarshalBinaryLengthPrefixed(bz, &latest)
+	return latest
+}
+
+// SetLatestVersion sets the version the multistore loads by default
+func SetLatestVersion(db dbm.DB, version int64) {
+	db.SetSync([]byte(latestVersionKey), cdc.MustMarshalBinaryLengthPrefixed(version))
+}
+
+// GetCommitInfo loads the commit info of a version
+func GetCommitInfo(db dbm.DB, version int64) (CommitInfo, error) {
+	var ci CommitInfo
+	bz := db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, version)))
+	if bz == nil {
+		return ci, fmt.Errorf("commit info of version %d not found", version)
+	}
+	if err := cdc.UnmarshalBinaryLengthPrefixed(bz, &ci); err != nil {
+		return ci, fmt.Errorf("commit info of version %d is corrupted: %v", version, err)
+	}
+	return ci, nil
+}
+
+// SetCommitInfo saves the commit info of a version
+func SetCommitInfo(db dbm.DB, ci CommitInfo) {
+	db.Set([]byte(fmt.Sprintf(commitInfoKeyFmt, ci.Version)), cdc.MustMarshalBinaryLengthPrefixed(ci))
+}
+
+// StoreDB returns the DB of a mounted KV store, which is under a prefix of the multistore's DB
+func StoreDB(db dbm.DB, name string) dbm.DB {
+	return dbm.NewPrefixDB(db, []byte("s/k:"+name+"/"))
+}
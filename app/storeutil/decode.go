@@ -0,0 +1,271 @@
+package storeutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreDecoder decodes the raw entries of the app's KV stores into JSON
+type StoreDecoder struct {
+	cdc *codec.Codec
+}
+
+// NewStoreDecoder returns a decoder with the app codec, which is normally a new
+// one from app.MakeCodec(). The codec is changed to decode any of its registered
+// concrete types without knowing the type in advance, so it must not be sealed.
+func NewStoreDecoder(cdc *codec.Codec) *StoreDecoder {
+	cdc.RegisterInterface((*interface{})(nil), nil)
+	return &StoreDecoder{cdc: cdc}
+}
+
+// Entry is a decoded entry of a KV store
+type Entry struct {
+	Key       string          `json:"key"`
+	Prefix    string          `json:"prefix,omitempty"`
+	KeyFields []string        `json:"key_fields,omitempty"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// Decode decodes an entry of store name, using the key prefixes of the module
+// owning the store to decode the key, and to pick the type of the value
+func (d *StoreDecoder) Decode(name string, key, value []byte) Entry {
+	entry := Entry{Key: hex.EncodeToString(key)}
+	p := FindKeyPrefix(name, key)
+	if p == nil {
+		entry.Value = d.DecodeValue(value)
+		return entry
+	}
+	entry.Prefix = p.Name
+	entry.KeyFields = p.decodeKey(key[len(p.Prefix):])
+	if p.value != nil && value != nil {
+		if out, ok := p.value(d.cdc, value); ok {
+			entry.Value = out
+			return entry
+		}
+	}
+	entry.Value = d.DecodeValue(value)
+	return entry
+}
+
+// DecodeValue decodes a value as a registered concrete type of the codec, or
+// as JSON, which is how the params are saved. Otherwise the value is returned
+// as a hex string, and a nil value as null.
+func (d *StoreDecoder) DecodeValue(bz []byte) json.RawMessage {
+	if bz == nil {
+		return json.RawMessage("null")
+	}
+	var v interface{}
+	if out, ok := decodeAmino(d.cdc, bz, &v); ok {
+		return out
+	}
+	if json.Valid(bz) {
+		return bz
+	}
+	return hexJSON(bz)
+}
+
+// decodeAmino decodes bz into ptr, either bare or length prefixed, and returns it as JSON
+func decodeAmino(cdc *codec.Codec, bz []byte, ptr interface{}) (json.RawMessage, bool) {
+	if cdc.UnmarshalBinaryBare(bz, ptr) != nil && cdc.UnmarshalBinaryLengthPrefixed(bz, ptr) != nil {
+		return nil, false
+	}
+	out, err := cdc.MarshalJSON(ptr)
+	return out, err == nil
+}
+
+func hexJSON(bz []byte) json.RawMessage {
+	out, _ := json.Marshal(hex.EncodeToString(bz))
+	return out
+}
+
+// valueDecoder decodes the values under a key prefix, it returns false if the
+// value is not in the expected format
+type valueDecoder func(cdc *codec.Codec, bz []byte) (json.RawMessage, bool)
+
+// valueOf decodes the values with the codec, into the type newPtr points to
+func valueOf(newPtr func() interface{}) valueDecoder {
+	return func(cdc *codec.Codec, bz []byte) (json.RawMessage, bool) {
+		return decodeAmino(cdc, bz, newPtr())
+	}
+}
+
+func valueInt64(_ *codec.Codec, bz []byte) (json.RawMessage, bool) {
+	if len(bz) != 8 {
+		return nil, false
+	}
+	out, _ := json.Marshal(int64(binary.BigEndian.Uint64(bz)))
+	return out, true
+}
+
+func valueUint64LittleEndian(_ *codec.Codec, bz []byte) (json.RawMessage, bool) {
+	if len(bz) != 8 {
+		return nil, false
+	}
+	out, _ := json.Marshal(binary.LittleEndian.Uint64(bz))
+	return out, true
+}
+
+func valueString(_ *codec.Codec, bz []byte) (json.RawMessage, bool) {
+	if !isPrintable(bz) {
+		return nil, false
+	}
+	out, _ := json.Marshal(string(bz))
+	return out, true
+}
+
+func valueAccAddress(_ *codec.Codec, bz []byte) (json.RawMessage, bool) {
+	if len(bz) != sdk.AddrLen {
+		return nil, false
+	}
+	out, _ := json.Marshal(sdk.AccAddress(bz).String())
+	return out, true
+}
+
+// keyReader splits the key after a prefix into readable fields
+type keyReader struct {
+	bz     []byte
+	fields []string
+	failed bool
+}
+
+func (r *keyReader) add(field string, n int) {
+	r.fields = append(r.fields, field)
+	r.bz = r.bz[n:]
+}
+
+func (r *keyReader) take(n int) []byte {
+	if r.failed || len(r.bz) < n {
+		r.failed = true
+		return nil
+	}
+	return r.bz[:n]
+}
+
+// str reads the rest of the key as a string
+func (r *keyReader) str() {
+	r.strN(len(r.bz))
+}
+
+func (r *keyReader) strN(n int) {
+	if bz := r.take(n); bz != nil {
+		if !isPrintable(bz) {
+			r.failed = true
+			return
+		}
+		r.add(string(bz), n)
+	}
+}
+
+// strUntil reads a string terminated by sep, and skips sep
+func (r *keyReader) strUntil(sep byte) {
+	i := bytes.IndexByte(r.bz, sep)
+	if r.failed || i < 0 {
+		r.failed = true
+		return
+	}
+	r.strN(i)
+	if !r.failed {
+		r.bz = r.bz[1:]
+	}
+}
+
+// strBeforeAddr reads a string followed by an address at the end of the key
+func (r *keyReader) strBeforeAddr() {
+	r.strN(len(r.bz) - sdk.AddrLen)
+	r.accAddr()
+}
+
+func (r *keyReader) skip(n int) {
+	if r.take(n) != nil {
+		r.bz = r.bz[n:]
+	}
+}
+
+func (r *keyReader) accAddr() {
+	if bz := r.take(sdk.AddrLen); bz != nil {
+		r.add(sdk.AccAddress(bz).String(), sdk.AddrLen)
+	}
+}
+
+func (r *keyReader) valAddr() {
+	if bz := r.take(sdk.AddrLen); bz != nil {
+		r.add(sdk.ValAddress(bz).String(), sdk.AddrLen)
+	}
+}
+
+func (r *keyReader) consAddr() {
+	if bz := r.take(sdk.AddrLen); bz != nil {
+		r.add(sdk.ConsAddress(bz).String(), sdk.AddrLen)
+	}
+}
+
+// lenAccAddr reads an address after a byte of its length
+func (r *keyReader) lenAccAddr() {
+	if bz := r.take(1); bz != nil {
+		if int(bz[0]) != sdk.AddrLen {
+			r.failed = true
+			return
+		}
+		r.bz = r.bz[1:]
+		r.accAddr()
+	}
+}
+
+func (r *keyReader) int64() {
+	if bz := r.take(8); bz != nil {
+		r.add(big.NewInt(int64(binary.BigEndian.Uint64(bz))).String(), 8)
+	}
+}
+
+func (r *keyReader) uint64LittleEndian() {
+	if bz := r.take(8); bz != nil {
+		r.add(new(big.Int).SetUint64(binary.LittleEndian.Uint64(bz)).String(), 8)
+	}
+}
+
+func (r *keyReader) uint32() {
+	if bz := r.take(4); bz != nil {
+		r.add(big.NewInt(int64(binary.BigEndian.Uint32(bz))).String(), 4)
+	}
+}
+
+// time reads a time in sdk.SortableTimeFormat
+func (r *keyReader) time() {
+	r.strN(len(sdk.SortableTimeFormat))
+}
+
+// dec reads a positive sdk.Dec in big endian bytes of size n
+func (r *keyReader) dec(n int) {
+	if bz := r.take(n); bz != nil {
+		d := sdk.NewDecFromBigIntWithPrec(new(big.Int).SetBytes(bz), sdk.Precision)
+		r.add(d.String(), n)
+	}
+}
+
+// result returns the fields read, and the rest of the key in hex
+func (r *keyReader) result() []string {
+	if len(r.bz) != 0 {
+		r.fields = append(r.fields, hex.EncodeToString(r.bz))
+	}
+	return r.fields
+}
+
+func isPrintable(bz []byte) bool {
+	if !utf8.Valid(bz) {
+		return false
+	}
+	for _, c := range string(bz) {
+		if !unicode.IsPrint(c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+package storeutil
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/cet-sdk/modules/market"
+)
+
+func newTestDecoder() (*StoreDecoder, *codec.Codec) {
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	return NewStoreDecoder(cdc), cdc
+}
+
+func concat(parts ...[]byte) []byte {
+	var bz []byte
+	for _, p := range parts {
+		bz = append(bz, p...)
+	}
+	return bz
+}
+
+func TestStoreDecoder(t *testing.T) {
+	d, cdc := newTestDecoder()
+	addr := sdk.AccAddress("addr----------------")
+
+	var acc auth.Account = &auth.BaseAccount{Address: addr, AccountNumber: 3}
+	e := d.Decode("acc", concat([]byte{0x01}, addr), cdc.MustMarshalBinaryBare(acc))
+	require.Equal(t, "account", e.Prefix)
+	require.Equal(t, []string{addr.String()}, e.KeyFields)
+	var v struct {
+		Type string `json:"type"`
+	}
+	require.Nil(t, json.Unmarshal(e.Value, &v))
+	require.Equal(t, "cosmos-sdk/Account", v.Type)
+
+	e = d.Decode("asset", concat([]byte{0x02}, []byte("abc:"), addr), []byte{})
+	require.Equal(t, "whitelist", e.Prefix)
+	require.Equal(t, []string{"abc", addr.String()}, e.KeyFields)
+	require.Equal(t, `""`, string(e.Value))
+
+	price := market.DecToBigEndianBytes(sdk.MustNewDecFromStr("1.5"))
+	e = d.Decode("market", concat([]byte{0x12}, []byte("abc/cet"), []byte{0x0}, price, []byte("order-1")), []byte{})
+	require.Equal(t, "bid", e.Prefix)
+	require.Equal(t, []string{"abc/cet", "1.500000000000000000", "order-1"}, e.KeyFields)
+
+	index := make([]byte, 4)
+	binary.BigEndian.PutUint32(index, 2)
+	e = d.Decode("autoswap", concat([]byte{0x08}, []byte("abc/cet"), []byte{0x02, 0x0}, price, index, []byte("order-2")),
+		[]byte{0x0})
+	require.Equal(t, "ask", e.Prefix)
+	require.Equal(t, []string{"abc/cet", "sell", "1.500000000000000000", "2", "order-2"}, e.KeyFields)
+
+	e = d.Decode("alias", concat([]byte{0x10}, []byte("bob")), concat([]byte{0x1}, addr))
+	require.Equal(t, []string{"bob"}, e.KeyFields)
+	require.Equal(t, `{"as_default":true,"address":"`+addr.String()+`"}`, string(e.Value))
+
+	count := make([]byte, 8)
+	binary.LittleEndian.PutUint64(count, 7)
+	e = d.Decode("comment", concat([]byte{0x10}, []byte("abc")), count)
+	require.Equal(t, "7", string(e.Value))
+
+	// the rest of a key which can not be decoded is in hex
+	e = d.Decode("asset", concat([]byte{0x02}, []byte("abc"), []byte{0xff}), nil)
+	require.Equal(t, []string{"616263ff"}, e.KeyFields)
+	require.Equal(t, "null", string(e.Value))
+
+	e = d.Decode("params", []byte("asset/IssueTokenFee"), []byte(`"100"`))
+	require.Equal(t, Entry{Key: "61737365742f4973737565546f6b656e466565", Value: json.RawMessage(`"100"`)}, e)
+	require.Equal(t, `"0102"`, string(d.DecodeValue([]byte{1, 2})))
+}
+
+func TestParseKeyPrefix(t *testing.T) {
+	prefix, err := ParseKeyPrefix("market", "order")
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x11, 0x0}, prefix)
+	prefix, err = ParseKeyPrefix("market", "1100")
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x11, 0x0}, prefix)
+	_, err = ParseKeyPrefix("market", "orders")
+	require.Error(t, err)
+
+	require.Equal(t, "order", FindKeyPrefix("market", []byte{0x11, 0x0, 'a'}).Name)
+	require.Nil(t, FindKeyPrefix("market", []byte{0x11, 0x1}))
+	require.Nil(t, FindKeyPrefix("params", []byte("asset/IssueTokenFee")))
+}
@@ -0,0 +1,196 @@
+package storeutil
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// the key formats of the nodes and roots in iavl's nodeDB
+var (
+	nodeKeyFormat = iavl.NewKeyFormat('n', tmhash.Size)
+	rootKeyFormat = iavl.NewKeyFormat('r', 8)
+)
+
+// the written entries are flushed to DB in batches of this size
+const writeBatchSize = 10000
+
+// CopyVersion copies a version of the multistore in src to dst, which is
+// normally an empty DB. Only the IAVL nodes reachable from the roots of this
+// version are copied, so it becomes the only and the latest version in dst.
+// The nodes are checked against their hashes on the way, and the root hashes
+// against the commit info.
+func CopyVersion(src, dst dbm.DB, version int64) (CommitInfo, error) {
+	ci, err := GetCommitInfo(src, version)
+	if err != nil {
+		return ci, err
+	}
+	for _, si := range ci.StoreInfos {
+		w := &batchWriter{db: StoreDB(dst, si.Name)}
+		rootHash, err := walkTree(StoreDB(src, si.Name), version, w.set)
+		if err != nil {
+			return ci, fmt.Errorf("store %s: %v", si.Name, err)
+		}
+		w.flush()
+		if !bytes.Equal(rootHash, si.Core.CommitID.Hash) {
+			return ci, fmt.Errorf("store %s: root hash %X does not match commit hash %X",
+				si.Name, rootHash, si.Core.CommitID.Hash)
+		}
+	}
+	SetCommitInfo(dst, ci)
+	SetLatestVersion(dst, version)
+	return ci, nil
+}
+
+// VerifyVersion checks every IAVL node of a version of the multistore in db
+// against its hash, and the root hashes against the commit info, whose hash
+// is the app hash of the version.
+func VerifyVersion(db dbm.DB, version int64) (CommitInfo, error) {
+	ci, err := GetCommitInfo(db, version)
+	if err != nil {
+		return ci, err
+	}
+	for _, si := range ci.StoreInfos {
+		rootHash, err := walkTree(StoreDB(db, si.Name), version, func(_, _ []byte) {})
+		if err != nil {
+			return ci, fmt.Errorf("store %s: %v", si.Name, err)
+		}
+		if !bytes.Equal(rootHash, si.Core.CommitID.Hash) {
+			return ci, fmt.Errorf("store %s: root hash %X does not match commit hash %X",
+				si.Name, rootHash, si.Core.CommitID.Hash)
+		}
+	}
+	return ci, nil
+}
+
+// walkTree calls fn with the root entry and the entries of all the nodes of
+// the tree at version, after checking that each node hashes to its key.
+// It returns the root hash, which is nil for an empty tree.
+func walkTree(db dbm.DB, version int64, fn func(key, value []byte)) ([]byte, error) {
+	rootKey := rootKeyFormat.Key(version)
+	rootHash := db.Get(rootKey)
+	if rootHash == nil {
+		return nil, fmt.Errorf("version %d not found, it may have been pruned", version)
+	}
+	fn(rootKey, rootHash)
+	if len(rootHash) == 0 {
+		return nil, nil
+	}
+
+	// an IAVL tree has no shared subtrees, so each node is visited once
+	stack := [][]byte{rootHash}
+	for len(stack) != 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		key := nodeKeyFormat.KeyBytes(hash)
+		bz := db.Get(key)
+		if bz == nil {
+			return nil, fmt.Errorf("node %X not found", hash)
+		}
+		left, right, err := checkNode(hash, bz)
+		if err != nil {
+			return nil, fmt.Errorf("node %X: %v", hash, err)
+		}
+		fn(key, bz)
+		if left != nil {
+			stack = append(stack, right, left)
+		}
+	}
+	return rootHash, nil
+}
+
+// checkNode decodes a node saved by iavl, and checks that the node hashes to
+// hash in the same way as iavl does. It returns the hashes of the children
+// if it is an inner node.
+func checkNode(hash, bz []byte) (left, right []byte, err error) {
+	height, n, err := amino.DecodeInt8(bz)
+	if err != nil {
+		return nil, nil, err
+	}
+	bz = bz[n:]
+	size, n, err := amino.DecodeVarint(bz)
+	if err != nil {
+		return nil, nil, err
+	}
+	bz = bz[n:]
+	version, n, err := amino.DecodeVarint(bz)
+	if err != nil {
+		return nil, nil, err
+	}
+	bz = bz[n:]
+	key, n, err := amino.DecodeByteSlice(bz)
+	if err != nil {
+		return nil, nil, err
+	}
+	bz = bz[n:]
+
+	var buf bytes.Buffer
+	_ = amino.EncodeInt8(&buf, height)
+	_ = amino.EncodeVarint(&buf, size)
+	_ = amino.EncodeVarint(&buf, version)
+	if height == 0 {
+		value, _, err := amino.DecodeByteSlice(bz)
+		if err != nil {
+			return nil, nil, err
+		}
+		_ = amino.EncodeByteSlice(&buf, key)
+		_ = amino.EncodeByteSlice(&buf, tmhash.Sum(value))
+	} else {
+		if left, n, err = amino.DecodeByteSlice(bz); err != nil {
+			return nil, nil, err
+		}
+		if right, _, err = amino.DecodeByteSlice(bz[n:]); err != nil {
+			return nil, nil, err
+		}
+		if len(left) != tmhash.Size || len(right) != tmhash.Size {
+			return nil, nil, errors.New("invalid child hash")
+		}
+		_ = amino.EncodeByteSlice(&buf, left)
+		_ = amino.EncodeByteSlice(&buf, right)
+	}
+	if !bytes.Equal(tmhash.Sum(buf.Bytes()), hash) {
+		return nil, nil, errors.New("hash mismatch")
+	}
+	return left, right, nil
+}
+
+type batchWriter struct {
+	db    dbm.DB
+	batch dbm.Batch
+	size  int
+}
+
+func (w *batchWriter) set(key, value []byte) {
+	if w.batch == nil {
+		w.batch = w.db.NewBatch()
+	}
+	w.batch.Set(key, value)
+	if w.size++; w.size >= writeBatchSize {
+		w.flush()
+	}
+}
+
+func (w *batchWriter) delete(key []byte) {
+	if w.batch == nil {
+		w.batch = w.db.NewBatch()
+	}
+	w.batch.Delete(key)
+	if w.size++; w.size >= writeBatchSize {
+		w.flush()
+	}
+}
+
+func (w *batchWriter) flush() {
+	if w.batch != nil {
+		w.batch.Write()
+		w.batch.Close()
+		w.batch = nil
+		w.size = 0
+	}
+}
@@ -0,0 +1,110 @@
+package storeutil
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	keyA = sdk.NewKVStoreKey("a")
+	keyB = sdk.NewKVStoreKey("b")
+	keyC = sdk.NewKVStoreKey("c")
+)
+
+func newMultiStore(db dbm.DB) *rootmulti.Store {
+	ms := rootmulti.NewStore(db)
+	ms.SetPruning(storetypes.PruneNothing)
+	ms.MountStoreWithDB(keyA, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyB, sdk.StoreTypeIAVL, nil)
+	// c is always empty
+	ms.MountStoreWithDB(keyC, sdk.StoreTypeIAVL, nil)
+	return ms
+}
+
+// commitVersions commits 3 versions, and returns the commit IDs
+func commitVersions(t *testing.T, db dbm.DB) []sdk.CommitID {
+	ms := newMultiStore(db)
+	require.Nil(t, ms.LoadLatestVersion())
+	var ids []sdk.CommitID
+	for ver := 1; ver <= 3; ver++ {
+		for i := 0; i < 50; i++ {
+			ms.GetKVStore(keyA).Set([]byte(fmt.Sprintf("key%d", i*ver)), []byte(fmt.Sprintf("v%d", ver)))
+		}
+		ms.GetKVStore(keyB).Set([]byte(fmt.Sprintf("b%d", ver)), []byte("b"))
+		ms.GetKVStore(keyA).Delete([]byte("key3"))
+		ids = append(ids, ms.Commit())
+	}
+	return ids
+}
+
+func TestCopyVersion(t *testing.T) {
+	src := dbm.NewMemDB()
+	ids := commitVersions(t, src)
+	require.Equal(t, int64(3), GetLatestVersion(src))
+
+	dst := dbm.NewMemDB()
+	ci, err := CopyVersion(src, dst, 2)
+	require.Nil(t, err)
+	require.Equal(t, ids[1].Hash, ci.Hash())
+	require.Equal(t, []string{"a", "b", "c"}, sortedNames(ci))
+
+	ms := newMultiStore(dst)
+	require.Nil(t, ms.LoadLatestVersion())
+	require.Equal(t, ids[1], ms.LastCommitID())
+	require.Equal(t, []byte("v2"), ms.GetKVStore(keyA).Get([]byte("key2")))
+	require.Nil(t, ms.GetKVStore(keyA).Get([]byte("key3")))
+	require.Nil(t, ms.GetKVStore(keyB).Get([]byte("b3")))
+	// other versions are not copied
+	require.Error(t, newMultiStore(dst).LoadVersion(1))
+	require.Error(t, newMultiStore(dst).LoadVersion(3))
+
+	// the copy keeps going
+	ms = newMultiStore(dst)
+	require.Nil(t, ms.LoadLatestVersion())
+	ms.GetKVStore(keyB).Set([]byte("b3"), []byte("b"))
+	require.Equal(t, int64(3), ms.Commit().Version)
+
+	_, err = CopyVersion(src, dbm.NewMemDB(), 4)
+	require.Error(t, err)
+}
+
+func TestVerifyVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ids := commitVersions(t, db)
+	ci, err := VerifyVersion(db, 3)
+	require.Nil(t, err)
+	require.Equal(t, ids[2].Hash, ci.Hash())
+
+	// change the value in a leaf node
+	storeDB := StoreDB(db, "b")
+	it := storeDB.Iterator(nodeKeyFormat.Key(), nil)
+	var key, value []byte
+	for ; it.Valid(); it.Next() {
+		if it.Value()[0] == 0 { // height of leaf nodes
+			key, value = it.Key(), append([]byte{}, it.Value()...)
+			break
+		}
+	}
+	it.Close()
+	require.NotNil(t, key)
+	value[len(value)-1]++
+	storeDB.Set(key, value)
+	_, err = VerifyVersion(db, 3)
+	require.Error(t, err)
+	_, err = CopyVersion(db, dbm.NewMemDB(), 3)
+	require.Error(t, err)
+}
+
+func sortedNames(ci CommitInfo) []string {
+	names := ci.StoreNames()
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,306 @@
+package storeutil
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/alias"
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
+	"github.com/coinexchain/cet-sdk/modules/bancorlite"
+	"github.com/coinexchain/cet-sdk/modules/comment"
+	"github.com/coinexchain/cet-sdk/modules/incentive"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/cet-sdk/modules/stakingx"
+)
+
+// KeyPrefix is a prefix of the keys a module saves in its store, the ones of
+// the cet-sdk modules are copied from their internal packages
+type KeyPrefix struct {
+	Name   string
+	Prefix []byte
+	key    func(r *keyReader)
+	value  valueDecoder
+}
+
+func (p *KeyPrefix) decodeKey(bz []byte) []string {
+	r := &keyReader{bz: bz}
+	if p.key != nil {
+		p.key(r)
+	}
+	return r.result()
+}
+
+// the side of an autoswap order between the trading pair and a 0x0 in its keys
+func autoswapPairAndSide(r *keyReader) {
+	i := bytes.IndexByte(r.bz, 0x0)
+	if i < 1 {
+		r.failed = true
+		return
+	}
+	r.strN(i - 1)
+	if !r.failed {
+		side := "buy"
+		if r.bz[0] != 0x01 {
+			side = "sell"
+		}
+		r.add(side, 2)
+	}
+}
+
+func autoswapOrderKey(r *keyReader) {
+	autoswapPairAndSide(r)
+	r.dec(market.DecByteCount)
+	r.uint32()
+	r.str()
+}
+
+// an alias is saved with a byte of whether it is the default one, then the address
+func aliasValue(_ *codec.Codec, bz []byte) (json.RawMessage, bool) {
+	if len(bz) != 1+sdk.AddrLen {
+		return nil, false
+	}
+	out, _ := json.Marshal(struct {
+		AsDefault bool   `json:"as_default"`
+		Address   string `json:"address"`
+	}{bz[0] == 1, sdk.AccAddress(bz[1:]).String()})
+	return out, true
+}
+
+var storeKeyPrefixes = map[string][]KeyPrefix{
+	auth.StoreKey: {
+		{Name: "account", Prefix: auth.AddressStoreKeyPrefix, key: (*keyReader).accAddr},
+		{Name: "global_account_number", Prefix: auth.GlobalAccountNumberKey,
+			value: valueOf(func() interface{} { return new(uint64) })},
+	},
+	authx.StoreKey: {
+		{Name: "accountx", Prefix: []byte{0x01}, key: (*keyReader).accAddr},
+		{Name: "unlocked_coins_queue", Prefix: []byte("UnlockedCoinsQueue;"), key: func(r *keyReader) {
+			r.time()
+			r.skip(1)
+			r.accAddr()
+		}, value: valueAccAddress},
+	},
+	supply.StoreKey: {
+		{Name: "supply", Prefix: supply.SupplyKey},
+	},
+	staking.StoreKey: {
+		{Name: "last_validator_power", Prefix: staking.LastValidatorPowerKey, key: (*keyReader).valAddr,
+			value: valueOf(func() interface{} { return new(int64) })},
+		{Name: "last_total_power", Prefix: staking.LastTotalPowerKey,
+			value: valueOf(func() interface{} { return new(sdk.Int) })},
+		{Name: "validator", Prefix: staking.ValidatorsKey, key: (*keyReader).valAddr,
+			value: valueOf(func() interface{} { return new(staking.Validator) })},
+		{Name: "validator_by_cons_addr", Prefix: staking.ValidatorsByConsAddrKey, key: (*keyReader).consAddr},
+		{Name: "validator_by_power", Prefix: staking.ValidatorsByPowerIndexKey},
+		{Name: "delegation", Prefix: staking.DelegationKey, key: func(r *keyReader) {
+			r.accAddr()
+			r.valAddr()
+		}, value: valueOf(func() interface{} { return new(staking.Delegation) })},
+		{Name: "unbonding_delegation", Prefix: staking.UnbondingDelegationKey, key: func(r *keyReader) {
+			r.accAddr()
+			r.valAddr()
+		}, value: valueOf(func() interface{} { return new(staking.UnbondingDelegation) })},
+		{Name: "unbonding_delegation_by_validator", Prefix: staking.UnbondingDelegationByValIndexKey,
+			key: func(r *keyReader) {
+				r.valAddr()
+				r.accAddr()
+			}},
+		{Name: "redelegation", Prefix: staking.RedelegationKey, key: func(r *keyReader) {
+			r.accAddr()
+			r.valAddr()
+			r.valAddr()
+		}, value: valueOf(func() interface{} { return new(staking.Redelegation) })},
+		{Name: "redelegation_by_src_validator", Prefix: staking.RedelegationByValSrcIndexKey,
+			key: func(r *keyReader) {
+				r.valAddr()
+				r.accAddr()
+				r.valAddr()
+			}},
+		{Name: "redelegation_by_dst_validator", Prefix: staking.RedelegationByValDstIndexKey,
+			key: func(r *keyReader) {
+				r.valAddr()
+				r.accAddr()
+				r.valAddr()
+			}},
+		{Name: "unbonding_queue", Prefix: staking.UnbondingQueueKey, key: (*keyReader).time},
+		{Name: "redelegation_queue", Prefix: staking.RedelegationQueueKey, key: (*keyReader).time},
+		{Name: "validator_queue", Prefix: staking.ValidatorQueueKey, key: (*keyReader).time},
+	},
+	stakingx.StoreKey: {
+		{Name: "non_bondable_addresses", Prefix: []byte("0x01"),
+			value: valueOf(func() interface{} { return new([]sdk.AccAddress) })},
+	},
+	distr.StoreKey: {
+		{Name: "fee_pool", Prefix: distr.FeePoolKey,
+			value: valueOf(func() interface{} { return new(distr.FeePool) })},
+		{Name: "proposer", Prefix: distr.ProposerKey,
+			value: valueOf(func() interface{} { return new(sdk.ConsAddress) })},
+		{Name: "validator_outstanding_rewards", Prefix: distr.ValidatorOutstandingRewardsPrefix,
+			key:   (*keyReader).valAddr,
+			value: valueOf(func() interface{} { return new(distr.ValidatorOutstandingRewards) })},
+		{Name: "delegator_withdraw_address", Prefix: distr.DelegatorWithdrawAddrPrefix,
+			key: (*keyReader).accAddr, value: valueAccAddress},
+		{Name: "delegator_starting_info", Prefix: distr.DelegatorStartingInfoPrefix, key: func(r *keyReader) {
+			r.valAddr()
+			r.accAddr()
+		}, value: valueOf(func() interface{} { return new(distr.DelegatorStartingInfo) })},
+		{Name: "validator_historical_rewards", Prefix: distr.ValidatorHistoricalRewardsPrefix,
+			key: func(r *keyReader) {
+				r.valAddr()
+				r.uint64LittleEndian()
+			}, value: valueOf(func() interface{} { return new(distr.ValidatorHistoricalRewards) })},
+		{Name: "validator_current_rewards", Prefix: distr.ValidatorCurrentRewardsPrefix,
+			key:   (*keyReader).valAddr,
+			value: valueOf(func() interface{} { return new(distr.ValidatorCurrentRewards) })},
+		{Name: "validator_accumulated_commission", Prefix: distr.ValidatorAccumulatedCommissionPrefix,
+			key:   (*keyReader).valAddr,
+			value: valueOf(func() interface{} { return new(distr.ValidatorAccumulatedCommission) })},
+		{Name: "validator_slash_event", Prefix: distr.ValidatorSlashEventPrefix, key: func(r *keyReader) {
+			r.valAddr()
+			r.int64()
+			r.int64()
+		}, value: valueOf(func() interface{} { return new(distr.ValidatorSlashEvent) })},
+	},
+	slashing.StoreKey: {
+		{Name: "validator_signing_info", Prefix: slashing.ValidatorSigningInfoKey, key: (*keyReader).consAddr,
+			value: valueOf(func() interface{} { return new(slashing.ValidatorSigningInfo) })},
+		{Name: "missed_block_bit_array", Prefix: slashing.ValidatorMissedBlockBitArrayKey,
+			key: func(r *keyReader) {
+				r.consAddr()
+				r.uint64LittleEndian()
+			}, value: valueOf(func() interface{} { return new(bool) })},
+		{Name: "address_pubkey", Prefix: slashing.AddrPubkeyRelationKey, key: (*keyReader).consAddr},
+	},
+	gov.StoreKey: {
+		{Name: "proposal", Prefix: gov.ProposalsKeyPrefix, key: (*keyReader).uint64LittleEndian,
+			value: valueOf(func() interface{} { return new(gov.Proposal) })},
+		{Name: "active_proposal_queue", Prefix: gov.ActiveProposalQueuePrefix, key: func(r *keyReader) {
+			r.time()
+			r.uint64LittleEndian()
+		}, value: valueOf(func() interface{} { return new(uint64) })},
+		{Name: "inactive_proposal_queue", Prefix: gov.InactiveProposalQueuePrefix, key: func(r *keyReader) {
+			r.time()
+			r.uint64LittleEndian()
+		}, value: valueOf(func() interface{} { return new(uint64) })},
+		{Name: "proposal_id", Prefix: gov.ProposalIDKey,
+			value: valueOf(func() interface{} { return new(uint64) })},
+		{Name: "deposit", Prefix: gov.DepositsKeyPrefix, key: func(r *keyReader) {
+			r.uint64LittleEndian()
+			r.accAddr()
+		}, value: valueOf(func() interface{} { return new(gov.Deposit) })},
+		{Name: "vote", Prefix: gov.VotesKeyPrefix, key: func(r *keyReader) {
+			r.uint64LittleEndian()
+			r.accAddr()
+		}, value: valueOf(func() interface{} { return new(gov.Vote) })},
+	},
+	asset.StoreKey: {
+		{Name: "token", Prefix: []byte{0x01}, key: (*keyReader).str},
+		{Name: "whitelist", Prefix: []byte{0x02}, key: func(r *keyReader) {
+			r.strUntil(':')
+			r.accAddr()
+		}},
+		{Name: "forbidden_address", Prefix: []byte{0x03}, key: func(r *keyReader) {
+			r.strUntil(':')
+			r.accAddr()
+		}},
+	},
+	market.StoreKey: {
+		{Name: "order", Prefix: []byte{0x11, 0x0}, key: (*keyReader).str},
+		{Name: "bid", Prefix: []byte{0x12}, key: func(r *keyReader) {
+			r.strUntil(0x0)
+			r.dec(market.DecByteCount)
+			r.str()
+		}},
+		{Name: "ask", Prefix: []byte{0x13}, key: func(r *keyReader) {
+			r.strUntil(0x0)
+			r.dec(market.DecByteCount)
+			r.str()
+		}},
+		{Name: "order_queue", Prefix: []byte{0x14}, key: func(r *keyReader) {
+			r.strUntil(0x0)
+			r.int64()
+			r.str()
+		}},
+		{Name: "market", Prefix: []byte{0x15}, key: (*keyReader).str},
+		{Name: "last_order_clean_up_time", Prefix: []byte{0x20}, value: valueInt64},
+		{Name: "delist", Prefix: []byte{0x40}, key: func(r *keyReader) {
+			r.int64()
+			r.skip(1)
+			r.str()
+		}, value: valueString},
+		{Name: "delist_time", Prefix: []byte{0x42}, key: (*keyReader).str, value: valueInt64},
+		{Name: "newly_added", Prefix: []byte{0x66}, key: (*keyReader).str},
+	},
+	autoswap.StoreKey: {
+		{Name: "order", Prefix: []byte{0x01}, key: (*keyReader).str},
+		{Name: "pool", Prefix: []byte{0x02}, key: (*keyReader).str,
+			value: valueOf(func() interface{} { return new(autoswap.PoolInfo) })},
+		{Name: "liquidity", Prefix: []byte{0x05}, key: (*keyReader).strBeforeAddr},
+		{Name: "bid", Prefix: []byte{0x07}, key: autoswapOrderKey},
+		{Name: "ask", Prefix: []byte{0x08}, key: autoswapOrderKey},
+		{Name: "order_in_market", Prefix: []byte{0x09, 0x0}, key: (*keyReader).str},
+	},
+	bancorlite.StoreKey: {
+		{Name: "bancor_info", Prefix: []byte{0x10}, key: (*keyReader).str,
+			value: valueOf(func() interface{} { return new(bancorlite.BancorInfo) })},
+	},
+	alias.StoreKey: {
+		{Name: "alias_to_account", Prefix: []byte{0x10}, key: (*keyReader).str, value: aliasValue},
+		{Name: "account_to_alias", Prefix: []byte{0x12}, key: func(r *keyReader) {
+			r.lenAccAddr()
+			r.str()
+		}},
+	},
+	comment.StoreKey: {
+		{Name: "comment_count", Prefix: []byte{0x10}, key: (*keyReader).str, value: valueUint64LittleEndian},
+	},
+	incentive.StoreKey: {
+		{Name: "state", Prefix: []byte{0x01}},
+	},
+}
+
+// KeyPrefixes returns the known key prefixes of store name
+func KeyPrefixes(name string) []KeyPrefix {
+	return storeKeyPrefixes[name]
+}
+
+// FindKeyPrefix returns the longest known prefix of key in store name, or nil
+func FindKeyPrefix(name string, key []byte) *KeyPrefix {
+	var found *KeyPrefix
+	prefixes := storeKeyPrefixes[name]
+	for i := range prefixes {
+		p := &prefixes[i]
+		if bytes.HasPrefix(key, p.Prefix) && (found == nil || len(p.Prefix) > len(found.Prefix)) {
+			found = p
+		}
+	}
+	return found
+}
+
+// ParseKeyPrefix parses a key prefix of store name given by its name, such as
+// token in the asset store, or in hex
+func ParseKeyPrefix(name, s string) ([]byte, error) {
+	for _, p := range storeKeyPrefixes[name] {
+		if p.Name == s {
+			return p.Prefix, nil
+		}
+	}
+	prefix, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s is neither a key prefix of store %s nor in hex", s, name)
+	}
+	return prefix, nil
+}
@@ -0,0 +1,126 @@
+package storeutil
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// the key format of the orphans in iavl's nodeDB, o<to-version><from-version><hash>
+var orphanKeyFormat = iavl.NewKeyFormat('o', 8, 8, tmhash.Size)
+
+// CheckVersion checks that a version of the multistore in db is not pruned,
+// that is, the commit info and the roots of all the stores are there
+func CheckVersion(db dbm.DB, version int64) (CommitInfo, error) {
+	ci, err := GetCommitInfo(db, version)
+	if err != nil {
+		return ci, fmt.Errorf("version %d may have been pruned: %v", version, err)
+	}
+	for _, si := range ci.StoreInfos {
+		rootHash := StoreDB(db, si.Name).Get(rootKeyFormat.Key(version))
+		if rootHash == nil {
+			return ci, fmt.Errorf("version %d of store %s has been pruned", version, si.Name)
+		}
+		if !bytes.Equal(rootHash, si.Core.CommitID.Hash) {
+			return ci, fmt.Errorf("store %s: root hash %X does not match commit hash %X",
+				si.Name, rootHash, si.Core.CommitID.Hash)
+		}
+	}
+	return ci, nil
+}
+
+// Rollback makes version the latest version of the multistore in db, all
+// the later versions of every store are deleted, together with the IAVL nodes
+// created by them. It refuses to act if version has been pruned.
+func Rollback(db dbm.DB, version int64) (CommitInfo, error) {
+	ci, err := CheckVersion(db, version)
+	if err != nil {
+		return ci, err
+	}
+	for _, si := range ci.StoreInfos {
+		if err := rollbackTree(StoreDB(db, si.Name), version); err != nil {
+			return ci, fmt.Errorf("store %s: %v", si.Name, err)
+		}
+	}
+
+	latest := GetLatestVersion(db)
+	for v := version + 1; v <= latest; v++ {
+		db.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, v)))
+	}
+	SetLatestVersion(db, version)
+	return ci, nil
+}
+
+// rollbackTree deletes the versions of an IAVL tree after version. The nodes
+// created by them are deleted, and so are the orphan records of the nodes
+// alive at version, since they are alive in the latest version now.
+func rollbackTree(db dbm.DB, version int64) error {
+	var deleted [][]byte
+	collect := func(prefix []byte, shouldDelete func(key, value []byte) (bool, error)) error {
+		it := dbm.IteratePrefix(db, prefix)
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			ok, err := shouldDelete(it.Key(), it.Value())
+			if err != nil {
+				return err
+			}
+			if ok {
+				deleted = append(deleted, append([]byte{}, it.Key()...))
+			}
+		}
+		return nil
+	}
+
+	err := collect(rootKeyFormat.Key(), func(key, _ []byte) (bool, error) {
+		var v int64
+		rootKeyFormat.Scan(key, &v)
+		return v > version, nil
+	})
+	if err != nil {
+		return err
+	}
+	err = collect(orphanKeyFormat.Key(), func(key, _ []byte) (bool, error) {
+		var toVersion int64
+		orphanKeyFormat.Scan(key, &toVersion)
+		return toVersion >= version, nil
+	})
+	if err != nil {
+		return err
+	}
+	err = collect(nodeKeyFormat.Key(), func(key, value []byte) (bool, error) {
+		v, err := nodeVersion(value)
+		if err != nil {
+			return false, fmt.Errorf("node %X: %v", key[1:], err)
+		}
+		return v > version, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	w := &batchWriter{db: db}
+	for _, key := range deleted {
+		w.delete(key)
+	}
+	w.flush()
+	return nil
+}
+
+// nodeVersion returns the version in which a node saved by iavl was created
+func nodeVersion(bz []byte) (int64, error) {
+	_, n, err := amino.DecodeInt8(bz)
+	if err != nil {
+		return 0, err
+	}
+	bz = bz[n:]
+	_, n, err = amino.DecodeVarint(bz)
+	if err != nil {
+		return 0, err
+	}
+	version, _, err := amino.DecodeVarint(bz[n:])
+	return version, err
+}
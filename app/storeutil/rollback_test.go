@@ -0,0 +1,91 @@
+package storeutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func loadPrunedMultiStore(t *testing.T, db dbm.DB) *rootmulti.Store {
+	ms := rootmulti.NewStore(db)
+	ms.SetPruning(storetypes.NewPruningOptions(3, 0))
+	ms.MountStoreWithDB(keyA, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyB, sdk.StoreTypeIAVL, nil)
+	require.Nil(t, ms.LoadLatestVersion())
+	return ms
+}
+
+// commitUntil commits the versions after the latest one until version, each
+// version sets, updates and deletes some keys
+func commitUntil(ms *rootmulti.Store, version int64) []sdk.CommitID {
+	var ids []sdk.CommitID
+	for ver := ms.LastCommitID().Version + 1; ver <= version; ver++ {
+		a := ms.GetKVStore(keyA)
+		for i := int64(0); i < 20; i++ {
+			a.Set([]byte(fmt.Sprintf("key%d", (i*ver)%30)), []byte(fmt.Sprintf("v%d", ver)))
+		}
+		a.Delete([]byte(fmt.Sprintf("key%d", ver%7)))
+		ms.GetKVStore(keyB).Set([]byte(fmt.Sprintf("b%d", ver%4)), []byte(fmt.Sprintf("v%d", ver)))
+		ids = append(ids, ms.Commit())
+	}
+	return ids
+}
+
+func dumpDB(db dbm.DB) map[string]string {
+	m := make(map[string]string)
+	it := db.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		m[string(it.Key())] = string(it.Value())
+	}
+	return m
+}
+
+func TestRollback(t *testing.T) {
+	refDB := dbm.NewMemDB()
+	refIDs := commitUntil(loadPrunedMultiStore(t, refDB), 12)
+
+	db := dbm.NewMemDB()
+	ids := commitUntil(loadPrunedMultiStore(t, db), 8)
+	require.Equal(t, refIDs[:8], ids)
+
+	ci, err := Rollback(db, 6)
+	require.Nil(t, err)
+	require.Equal(t, refIDs[5].Hash, ci.Hash())
+	require.Equal(t, int64(6), GetLatestVersion(db))
+	_, err = GetCommitInfo(db, 7)
+	require.Error(t, err)
+
+	ms := loadPrunedMultiStore(t, db)
+	require.Equal(t, refIDs[5], ms.LastCommitID())
+	require.Equal(t, []byte("v6"), ms.GetKVStore(keyB).Get([]byte("b2")))
+
+	// committing the same versions again, with the old ones pruned on the way,
+	// ends up with exactly the same stores, the order of the stores in commit
+	// infos is random
+	require.Equal(t, refIDs[6:], commitUntil(ms, 12))
+	_, err = VerifyVersion(db, 12)
+	require.Nil(t, err)
+	require.Equal(t, dumpDB(dbm.NewPrefixDB(refDB, []byte("s/k:"))), dumpDB(dbm.NewPrefixDB(db, []byte("s/k:"))))
+}
+
+func TestRollbackPruned(t *testing.T) {
+	db := dbm.NewMemDB()
+	commitUntil(loadPrunedMultiStore(t, db), 8)
+	before := dumpDB(db)
+
+	_, err := Rollback(db, 2)
+	require.Error(t, err)
+	_, err = Rollback(db, 9)
+	require.Error(t, err)
+	require.Equal(t, before, dumpDB(db))
+
+	_, err = CheckVersion(db, 5)
+	require.Nil(t, err)
+}
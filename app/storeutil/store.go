@@ -0,0 +1,43 @@
+package storeutil
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OpenAppDBReadOnly opens the app DB in the data dir of a node without changing it
+func OpenAppDBReadOnly(dataDir string) (dbm.DB, error) {
+	if _, err := os.Stat(filepath.Join(dataDir, "application.db")); err != nil {
+		return nil, fmt.Errorf("no app DB in %s: %v", dataDir, err)
+	}
+	return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+}
+
+// LoadStore loads a version of a mounted IAVL store of the multistore in db,
+// for reading only. It also returns the commit ID of the store in the version.
+func LoadStore(db dbm.DB, name string, version int64) (sdk.KVStore, sdk.CommitID, error) {
+	ci, err := GetCommitInfo(db, version)
+	if err != nil {
+		return nil, sdk.CommitID{}, err
+	}
+	for _, si := range ci.StoreInfos {
+		if si.Name != name {
+			continue
+		}
+		st, err := iavl.LoadStore(StoreDB(db, name), si.Core.CommitID, storetypes.PruneNothing, false)
+		if err != nil {
+			return nil, si.Core.CommitID, fmt.Errorf("store %s: %v", name, err)
+		}
+		return st.(sdk.KVStore), si.Core.CommitID, nil
+	}
+	return nil, sdk.CommitID{}, fmt.Errorf("store %s not found in version %d, the stores are %v",
+		name, version, ci.StoreNames())
+}
@@ -0,0 +1,28 @@
+package storeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestLoadStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	ids := commitVersions(t, db)
+
+	st, id, err := LoadStore(db, "a", 2)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), id.Version)
+	require.Equal(t, []byte("v2"), st.Get([]byte("key0")))
+
+	// an empty store
+	st, _, err = LoadStore(db, "c", 3)
+	require.Nil(t, err)
+	require.False(t, st.Iterator(nil, nil).Valid())
+
+	_, _, err = LoadStore(db, "d", 3)
+	require.Error(t, err)
+	_, _, err = LoadStore(db, "a", ids[2].Version+1)
+	require.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"bytes"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txDecodeCache keeps the tx decoded in the ABCI call being handled, so that
+// CheckTx and DeliverTx of the app, the plugin and BaseApp decode it only once.
+// It is reset at the end of each call, so the txs decoded in a call are never
+// shared with the handlers of another one.
+type txDecodeCache struct {
+	decoder sdk.TxDecoder
+	// disabled makes every call decode the tx, as the benchmarks compare with
+	disabled bool
+
+	mtx     sync.Mutex
+	txBytes []byte
+	tx      sdk.Tx
+	err     sdk.Error
+}
+
+func newTxDecodeCache(decoder sdk.TxDecoder) *txDecodeCache {
+	return &txDecodeCache{decoder: decoder}
+}
+
+// Decode is the TxDecoder of the app, which returns the tx decoded last if it
+// is decoded from the same bytes
+func (c *txDecodeCache) Decode(txBytes []byte) (sdk.Tx, sdk.Error) {
+	if c.disabled {
+		return c.decoder(txBytes)
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.txBytes != nil && bytes.Equal(c.txBytes, txBytes) {
+		return c.tx, c.err
+	}
+	c.tx, c.err = c.decoder(txBytes)
+	c.txBytes = txBytes
+	return c.tx, c.err
+}
+
+// Reset forgets the tx decoded last, at the end of each ABCI call
+func (c *txDecodeCache) Reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.txBytes, c.tx, c.err = nil, nil, nil
+}
@@ -0,0 +1,154 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestTxDecodeCache(t *testing.T) {
+	calls := 0
+	cache := newTxDecodeCache(func(txBytes []byte) (sdk.Tx, sdk.Error) {
+		calls++
+		if len(txBytes) == 0 {
+			return nil, sdk.ErrTxDecode("empty tx")
+		}
+		return auth.StdTx{Memo: string(txBytes)}, nil
+	})
+
+	tx, err := cache.Decode([]byte("a"))
+	require.Nil(t, err)
+	require.Equal(t, "a", tx.(auth.StdTx).Memo)
+	tx, _ = cache.Decode([]byte("a"))
+	require.Equal(t, "a", tx.(auth.StdTx).Memo)
+	require.Equal(t, 1, calls)
+	tx, _ = cache.Decode([]byte("b"))
+	require.Equal(t, "b", tx.(auth.StdTx).Memo)
+	require.Equal(t, 2, calls)
+
+	// the errors are kept too
+	_, err = cache.Decode([]byte{})
+	require.Equal(t, sdk.CodeTxDecode, err.Code())
+	_, err = cache.Decode([]byte{})
+	require.Equal(t, sdk.CodeTxDecode, err.Code())
+	require.Equal(t, 3, calls)
+
+	cache.Reset()
+	_, _ = cache.Decode([]byte{})
+	require.Equal(t, 4, calls)
+
+	cache.disabled = true
+	_, _ = cache.Decode([]byte("c"))
+	_, _ = cache.Decode([]byte("c"))
+	require.Equal(t, 6, calls)
+}
+
+func TestDecodeTxOnce(t *testing.T) {
+	key, _, fromAddr := testutil.KeyPubAddr()
+	_, _, toAddr := testutil.KeyPubAddr()
+	acc0 := auth.BaseAccount{Address: fromAddr, Coins: dex.NewCetCoins(30000000000)}
+	app := initAppWithBaseAccounts(acc0)
+	app.enableUnconfirmedLimit = true
+
+	calls := 0
+	decoder := app.txDecodeCache.decoder
+	app.txDecodeCache.decoder = func(txBytes []byte) (sdk.Tx, sdk.Error) {
+		calls++
+		return decoder(txBytes)
+	}
+
+	// the state of CheckTx is set on commit
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: testChainID, Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: testChainID, Height: 2}})
+	accNum := app.accountKeeper.GetAccount(app.NewContext(true, abci.Header{}), fromAddr).GetAccountNumber()
+	msg := bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(1000000000), 0)
+	tx := newStdTxBuilder().Msgs(msg).GasAndFee(1000000, 100).AccNumSeqKey(accNum, 0, key).Build()
+	require.Equal(t, sdk.CodeOK, app.Check(tx).Code)
+	require.Equal(t, 1, calls)
+	require.Equal(t, sdk.CodeOK, app.Deliver(tx).Code)
+	require.Equal(t, 2, calls)
+}
+
+// BenchmarkMarketOrderBlocks delivers blocks full of txs creating market orders,
+// decoding each tx once or in every call as before
+func BenchmarkMarketOrderBlocks(b *testing.B) {
+	for _, disabled := range []bool{false, true} {
+		name := "decode-once"
+		if disabled {
+			name = "decode-per-call"
+		}
+		b.Run(name, func(b *testing.B) {
+			benchmarkMarketOrderBlocks(b, disabled)
+		})
+	}
+}
+
+func benchmarkMarketOrderBlocks(b *testing.B, disabled bool) {
+	const (
+		blockSize   = 100
+		ordersPerTx = 10
+		stock       = "usdt000"
+	)
+	key, acc := testutil.NewBaseAccount(1e16, 0, 0)
+	app := initAppWithAccounts(acc)
+	app.txDecodeCache.disabled = disabled
+
+	height := int64(1)
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+	msgStock := asset.NewMsgIssueToken(stock, stock, sdk.NewInt(1e15), acc.Address,
+		false, false, false, false, "", "", asset.TestIdentityString)
+	msgMarketInfo := market.MsgCreateTradingPair{Stock: stock, Money: dex.CET, Creator: acc.Address, PricePrecision: 8}
+	for i, msg := range []sdk.Msg{msgStock, msgMarketInfo} {
+		tx := newStdTxBuilder().Msgs(msg).GasAndFee(9000000, 100).AccNumSeqKey(0, uint64(i), key).Build()
+		require.Equal(b, sdk.CodeOK, app.Deliver(tx).Code)
+	}
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+
+	txs := make([][]byte, b.N)
+	for i := range txs {
+		msgs := make([]sdk.Msg, ordersPerTx)
+		for j := range msgs {
+			msgs[j] = market.MsgCreateOrder{
+				Sender:         acc.Address,
+				Identify:       byte(j),
+				TradingPair:    stock + market.SymbolSeparator + dex.CET,
+				OrderType:      market.LimitOrder,
+				PricePrecision: 8,
+				Price:          100,
+				Quantity:       10000000,
+				Side:           market.SELL,
+				TimeInForce:    market.GTE,
+			}
+		}
+		tx := newStdTxBuilder().Msgs(msgs...).GasAndFee(9000000, 100).AccNumSeqKey(0, uint64(i+2), key).Build()
+		txs[i], _ = auth.DefaultTxEncoder(app.cdc)(tx)
+	}
+
+	b.ResetTimer()
+	for start := 0; start < len(txs); start += blockSize {
+		height++
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: testChainID, Height: height}})
+		for i := start; i < start+blockSize && i < len(txs); i++ {
+			if res := app.DeliverTx(abci.RequestDeliverTx{Tx: txs[i]}); !res.IsOK() {
+				b.Fatal(fmt.Sprintf("DeliverTx failed: %s", res.Log))
+			}
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	codon "github.com/coinexchain/dex/codec"
+)
+
+// FlagTxDecoder selects the decoder of the txs in CheckTx and DeliverTx.
+//
+// The txs are always decoded with amino: codon has a binary format of its own and
+// can not decode the amino bytes of the txs, so there is no codon decoder of the
+// wire bytes to switch to. The shadow mode also encodes each tx decoded by amino
+// with codon and decodes it back, logging the mismatches, which checks the
+// generated codec on the txs of the chain.
+const FlagTxDecoder = "tx-decoder"
+
+const (
+	TxDecoderAmino  = "amino"
+	TxDecoderShadow = "shadow"
+)
+
+// newTxDecoder returns the tx decoder of the mode, which is TxDecoderAmino or TxDecoderShadow
+func newTxDecoder(mode string, cdc *codec.Codec, logger log.Logger) (sdk.TxDecoder, error) {
+	aminoDecoder := auth.DefaultTxDecoder(cdc)
+	switch mode {
+	case "", TxDecoderAmino:
+		return aminoDecoder, nil
+	case TxDecoderShadow:
+		return shadowTxDecoder(cdc, aminoDecoder, logger.With("module", "tx-decoder")), nil
+	default:
+		return nil, fmt.Errorf("unknown tx decoder %q, must be %s or %s", mode, TxDecoderAmino, TxDecoderShadow)
+	}
+}
+
+// shadowTxDecoder decodes the txs with amino, and checks that codon decodes
+// the same tx from the codon encoding of each one
+func shadowTxDecoder(cdc *codec.Codec, aminoDecoder sdk.TxDecoder, logger log.Logger) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, sdk.Error) {
+		tx, err := aminoDecoder(txBytes)
+		if err != nil {
+			return tx, err
+		}
+		if mismatch := codonMismatch(cdc, tx); mismatch != "" {
+			logger.Error("codon decodes a different tx", "hash", fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()),
+				"mismatch", mismatch)
+		}
+		return tx, nil
+	}
+}
+
+// codonMismatch encodes tx with codon and decodes it back, returning how the
+// decoded tx differs from tx, or "" if they are the same
+func codonMismatch(cdc *codec.Codec, tx sdk.Tx) string {
+	var buf bytes.Buffer
+	if err := encodeCodonTx(&buf, tx); err != nil {
+		return "encode: " + err.Error()
+	}
+	codonTx, err := decodeCodonTx(buf.Bytes())
+	if err != nil {
+		return "decode: " + err.Error()
+	}
+	aminoMsgs, codonMsgs := tx.GetMsgs(), codonTx.GetMsgs()
+	if len(aminoMsgs) != len(codonMsgs) {
+		return fmt.Sprintf("%d msgs instead of %d", len(codonMsgs), len(aminoMsgs))
+	}
+	for i := range aminoMsgs {
+		// the handlers switch on the types of the msgs, so they must be the same
+		if reflect.TypeOf(aminoMsgs[i]) != reflect.TypeOf(codonMsgs[i]) {
+			return fmt.Sprintf("msg %d is %T instead of %T", i, codonMsgs[i], aminoMsgs[i])
+		}
+	}
+	aminoBz, err := cdc.MarshalBinaryLengthPrefixed(tx)
+	if err != nil {
+		return "amino: " + err.Error()
+	}
+	codonBz, err := cdc.MarshalBinaryLengthPrefixed(codonTx)
+	if err != nil {
+		return "amino of the codon tx: " + err.Error()
+	}
+	if !bytes.Equal(aminoBz, codonBz) {
+		return "different amino encoding"
+	}
+	return ""
+}
+
+func encodeCodonTx(w *bytes.Buffer, tx sdk.Tx) (err error) {
+	defer func() {
+		// EncodeAny panics on the types it does not support
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return codon.EncodeAny(w, tx)
+}
+
+// decodeCodonTx decodes a StdTx encoded with codon, which must take all of txBytes
+func decodeCodonTx(txBytes []byte) (tx sdk.Tx, err error) {
+	defer func() {
+		// the generated decoders panic on the types they do not support
+		if r := recover(); r != nil {
+			tx, err = nil, fmt.Errorf("%v", r)
+		}
+	}()
+	v, n, err := codon.DecodeAny(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(txBytes) {
+		return nil, fmt.Errorf("%d bytes left after the tx", len(txBytes)-n)
+	}
+	stdTx, ok := v.(codon.StdTx)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a StdTx", v)
+	}
+	return stdTx, nil
+}
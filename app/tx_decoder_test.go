@@ -0,0 +1,89 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+	codon "github.com/coinexchain/dex/codec"
+)
+
+type unknownMsg struct {
+	bankx.MsgSend
+}
+
+func newTestTx() auth.StdTx {
+	key, _, fromAddr := testutil.KeyPubAddr()
+	_, _, toAddr := testutil.KeyPubAddr()
+	msgSend := bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(1000000000), 0)
+	msgOrder := market.MsgCreateOrder{Sender: fromAddr, Identify: 1, TradingPair: "abc/cet", OrderType: market.LimitOrder,
+		PricePrecision: 8, Price: 100, Quantity: 10000000, Side: market.SELL, TimeInForce: market.GTE}
+	return newStdTxBuilder().Msgs(msgSend, msgOrder).GasAndFee(1000000, 100).AccNumSeqKey(3, 5, key).Build()
+}
+
+func TestTxDecoderModes(t *testing.T) {
+	cdc := MakeCodec()
+	tx := newTestTx()
+	aminoBz, err := auth.DefaultTxEncoder(cdc)(tx)
+	require.Nil(t, err)
+	var buf bytes.Buffer
+	require.Nil(t, codon.EncodeAny(&buf, tx))
+	codonBz := buf.Bytes()
+
+	for _, mode := range []string{"", TxDecoderAmino, TxDecoderShadow} {
+		decoder, err := newTxDecoder(mode, cdc, log.NewNopLogger())
+		require.Nil(t, err)
+		decoded, sdkErr := decoder(aminoBz)
+		require.Nil(t, sdkErr, mode)
+		require.Equal(t, "", codonMismatch(cdc, decoded), mode)
+		require.Equal(t, tx.Memo, decoded.(auth.StdTx).Memo)
+
+		// the txs encoded with codon are not valid in any mode
+		_, sdkErr = decoder(codonBz)
+		require.Equal(t, sdk.CodeTxDecode, sdkErr.Code(), mode)
+
+		// truncated or invalid txs fail in all the modes
+		_, sdkErr = decoder(codonBz[:len(codonBz)/2])
+		require.NotNil(t, sdkErr, mode)
+		_, sdkErr = decoder(codonBz[:2])
+		require.NotNil(t, sdkErr, mode)
+		_, sdkErr = decoder(nil)
+		require.NotNil(t, sdkErr, mode)
+	}
+
+	_, err = newTxDecoder("protobuf", cdc, log.NewNopLogger())
+	require.NotNil(t, err)
+	_, err = newTxDecoder("codon", cdc, log.NewNopLogger())
+	require.NotNil(t, err)
+}
+
+func TestShadowTxDecoderLogsMismatch(t *testing.T) {
+	cdc := MakeCodec()
+	tx := newTestTx()
+	var logs bytes.Buffer
+	decoder := shadowTxDecoder(cdc, func(txBytes []byte) (sdk.Tx, sdk.Error) {
+		return tx, nil
+	}, log.NewTMLogger(&logs))
+
+	decoded, err := decoder([]byte("tx"))
+	require.Nil(t, err)
+	require.Equal(t, tx, decoded)
+	require.Equal(t, "", logs.String())
+
+	// codon does not know the msg, but the tx of amino is still returned
+	tx.Msgs = append(tx.Msgs, unknownMsg{tx.Msgs[0].(bankx.MsgSend)})
+	decoded, err = decoder([]byte("tx"))
+	require.Nil(t, err)
+	require.Equal(t, tx, decoded)
+	require.Contains(t, logs.String(), "codon decodes a different tx")
+	require.Contains(t, logs.String(), "mismatch=\"encode:")
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/dex/app/indexer"
+)
+
+// FlagIndexAddressTxs makes the node index the txs by the addresses taking part
+// in them, in a local DB which is queried through the LCD
+const FlagIndexAddressTxs = "index-address-txs"
+
+// openIndexerDB opens the DB of the indexes in the data dir of the node
+func openIndexerDB() (dbm.DB, error) {
+	return sdk.NewLevelDB("indexer", filepath.Join(viper.GetString(cli.HomeFlag), "data"))
+}
+
+// indexTx indexes a delivered tx by the signers of each msg, and by the senders
+// and recipients of the transfers made by each msg if the tx succeeded
+func (app *CetChainApp) indexTx(req abci.RequestDeliverTx, stdTx auth.StdTx, ret abci.ResponseDeliverTx) {
+	var parties []indexer.TxParty
+	for i, msg := range stdTx.Msgs {
+		for _, signer := range msg.GetSigners() {
+			parties = append(parties, indexer.TxParty{Address: signer, MsgIndex: uint16(i), Role: indexer.RoleSigner})
+		}
+	}
+	if ret.Code == uint32(sdk.CodeOK) {
+		for i, transfers := range getMsgTransfers(ret.Events) {
+			for _, transfer := range transfers {
+				parties = append(parties, transferParty(transfer.Sender, uint16(i), indexer.RoleSender),
+					transferParty(transfer.Recipient, uint16(i), indexer.RoleRecipient))
+			}
+		}
+	}
+	app.txIndex.AddTx(tmtypes.Tx(req.Tx).Hash(), parties)
+}
+
+// transferParty returns the party of a transfer, with no address if it is malformed
+func transferParty(bech32Addr string, msgIndex uint16, role string) indexer.TxParty {
+	addr, _ := sdk.AccAddressFromBech32(bech32Addr)
+	return indexer.TxParty{Address: addr, MsgIndex: msgIndex, Role: role}
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
+
+	"github.com/coinexchain/dex/app/indexer"
+)
+
+func TestGetMsgTransfers(t *testing.T) {
+	transfer := func(sender, recipient string) []abci.Event {
+		return sdk.Events{
+			sdk.NewEvent("transfer", sdk.NewAttribute("recipient", recipient), sdk.NewAttribute("amount", "1cet")),
+			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeySender, sender)),
+		}.ToABCIEvents()
+	}
+	action := sdk.Events{sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, "send"))}.ToABCIEvents()
+
+	var events []abci.Event
+	events = append(events, transfer("a", "b")...)
+	events = append(events, transfer("a", "c")...)
+	events = append(events, action...)
+	events = append(events, action...)
+	events = append(events, transfer("d", "e")...)
+	events = append(events, action...)
+	require.Equal(t, [][]TransferRecord{
+		{{Sender: "a", Recipient: "b", Amount: "1cet"}, {Sender: "a", Recipient: "c", Amount: "1cet"}},
+		nil,
+		{{Sender: "d", Recipient: "e", Amount: "1cet"}},
+	}, getMsgTransfers(events))
+	require.Empty(t, getMsgTransfers(nil))
+}
+
+func TestIndexAddressTxs(t *testing.T) {
+	_, _, toAddr := testutil.KeyPubAddr()
+	key, _, fromAddr := testutil.KeyPubAddr()
+	acc0 := auth.BaseAccount{Address: fromAddr, Coins: dex.NewCetCoins(30000000000)}
+	home, err := ioutil.TempDir("", "tx-index")
+	require.Nil(t, err)
+	defer os.RemoveAll(home)
+	viper.Set(cli.HomeFlag, home)
+	viper.Set(FlagIndexAddressTxs, true)
+	defer viper.Set(cli.HomeFlag, "")
+	defer viper.Set(FlagIndexAddressTxs, false)
+	app := initAppWithBaseAccounts(acc0)
+	require.NotNil(t, app.txIndex)
+
+	header := abci.Header{Height: 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	msg := bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(1000000000), 0)
+	tx := newStdTxBuilder().Msgs(msg).GasAndFee(1000000, 100).AccNumSeqKey(0, 0, key).Build()
+	require.Equal(t, sdk.CodeOK, app.Deliver(tx).Code)
+	// fails for the insufficient coins, only the signer takes part in it
+	msg = bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(100000000000), 0)
+	tx = newStdTxBuilder().Msgs(msg).GasAndFee(1000000, 100).AccNumSeqKey(0, 1, key).Build()
+	require.NotEqual(t, sdk.CodeOK, app.Deliver(tx).Code)
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	query := func(addr sdk.AccAddress) indexer.AddressTxs {
+		res := app.Query(abci.RequestQuery{
+			Path: "custom/" + indexer.QuerierRoute + "/" + indexer.QueryAddressTxs,
+			Data: app.cdc.MustMarshalJSON(indexer.NewQueryAddressTxsParams(addr, 1, 10)),
+		})
+		require.True(t, res.IsOK(), res.Log)
+		var txs indexer.AddressTxs
+		app.cdc.MustUnmarshalJSON(res.Value, &txs)
+		return txs
+	}
+	txs := query(fromAddr)
+	require.Equal(t, int64(3), txs.Total)
+	require.Equal(t, uint32(1), txs.Txs[0].TxIndex)
+	require.Equal(t, indexer.RoleSigner, txs.Txs[0].Role)
+	require.Equal(t, uint32(0), txs.Txs[1].TxIndex)
+	require.Equal(t, indexer.RoleSender, txs.Txs[1].Role)
+	require.Equal(t, indexer.RoleSigner, txs.Txs[2].Role)
+
+	txs = query(toAddr)
+	require.Equal(t, int64(1), txs.Total)
+	require.Equal(t, indexer.RoleRecipient, txs.Txs[0].Role)
+	require.Equal(t, int64(1), txs.Txs[0].Height)
+}
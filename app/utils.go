@@ -16,12 +16,22 @@ import (
 	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 
 	"github.com/coinexchain/cet-sdk/msgqueue"
+	indexerrest "github.com/coinexchain/dex/app/indexer/client/rest"
 )
 
 const (
 	TSDirCfg = "dir"
 )
 
+// getGenesisFile returns the path of the genesis file in the config of the node
+func getGenesisFile() (string, error) {
+	conf := cfg.DefaultConfig()
+	if err := viper.Unmarshal(conf); err != nil {
+		return "", err
+	}
+	return conf.GenesisFile(), nil
+}
+
 func initConf() (*toml.Tree, error) {
 	conf := cfg.DefaultConfig()
 	err := viper.Unmarshal(conf)
@@ -62,6 +72,7 @@ func CreateContextAndRegisterRoutes(router *mux.Router) {
 	client.RegisterRoutes(ctx, router)
 	authrest.RegisterTxRoutes(ctx, router)
 	ModuleBasics.RegisterRESTRoutes(ctx, router)
+	indexerrest.RegisterRoutes(ctx, router)
 }
 
 // see cosmos-sdk/client/context/context.go#NewCLIContextWithFrom()
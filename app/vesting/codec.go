@@ -0,0 +1,21 @@
+package vesting
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// ModuleCdc is the codec of this module
+var ModuleCdc = codec.New()
+
+func init() {
+	auth.RegisterCodec(ModuleCdc)
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
+
+// RegisterCodec registers the periodic vesting account, with the same name as later cosmos-sdk versions
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
+}
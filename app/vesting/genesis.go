@@ -0,0 +1,126 @@
+package vesting
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+)
+
+const ModuleName = "vesting"
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.Account
+	SetAccount(ctx sdk.Context, acc auth.Account)
+	IterateAccounts(ctx sdk.Context, process func(auth.Account) (stop bool))
+}
+
+// Schedule is the vesting schedule of a periodic vesting account. The other
+// fields of the account (coins, original vesting, start & end time) are kept
+// in the genesis state of genaccounts, which knows nothing about periods.
+type Schedule struct {
+	Address        sdk.AccAddress `json:"address"`
+	StartTime      int64          `json:"start_time"`
+	VestingPeriods Periods        `json:"vesting_periods"`
+}
+
+// GenesisState - all periodic vesting schedules
+type GenesisState struct {
+	Schedules []Schedule `json:"schedules"`
+}
+
+func NewGenesisState(schedules []Schedule) GenesisState {
+	return GenesisState{Schedules: schedules}
+}
+
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]Schedule{})
+}
+
+// NewGenesisEntries returns what a periodic vesting account needs in a genesis
+// file: an account of genaccounts and a schedule of this module
+func NewGenesisEntries(pva *PeriodicVestingAccount) (genaccounts.GenesisAccount, Schedule, error) {
+	schedule := Schedule{
+		Address:        pva.Address,
+		StartTime:      pva.StartTime,
+		VestingPeriods: pva.VestingPeriods,
+	}
+	if err := pva.Validate(); err != nil {
+		return genaccounts.GenesisAccount{}, schedule, err
+	}
+	genAcc, err := genaccounts.NewGenesisAccountI(pva)
+	if err != nil {
+		return genAcc, schedule, err
+	}
+	return genAcc, schedule, genAcc.Validate()
+}
+
+// ValidateGenesis checks each schedule alone, matching them with the accounts
+// in genaccounts is done by app.ValidateGenesisDeep
+func (data GenesisState) ValidateGenesis() error {
+	seen := make(map[string]bool, len(data.Schedules))
+	for _, schedule := range data.Schedules {
+		if schedule.Address.Empty() {
+			return fmt.Errorf("vesting schedule with empty address")
+		}
+		addr := schedule.Address.String()
+		if seen[addr] {
+			return fmt.Errorf("duplicate vesting schedule for %s", addr)
+		}
+		seen[addr] = true
+		if schedule.StartTime <= 0 {
+			return fmt.Errorf("vesting schedule of %s must have a positive start time", addr)
+		}
+		if err := schedule.VestingPeriods.Validate(); err != nil {
+			return fmt.Errorf("invalid vesting schedule of %s: %s", addr, err.Error())
+		}
+	}
+	return nil
+}
+
+// InitGenesis turns the vesting accounts created by genaccounts into periodic
+// vesting accounts, so it must be called right after genaccounts
+func InitGenesis(ctx sdk.Context, ak AccountKeeper, data GenesisState) {
+	for _, schedule := range data.Schedules {
+		acc := ak.GetAccount(ctx, schedule.Address)
+		if acc == nil {
+			panic(fmt.Sprintf("account of vesting schedule not found: %s", schedule.Address))
+		}
+
+		var bva *auth.BaseVestingAccount
+		switch acc := acc.(type) {
+		case *auth.ContinuousVestingAccount:
+			bva = acc.BaseVestingAccount
+		case *auth.DelayedVestingAccount:
+			bva = acc.BaseVestingAccount
+		case *PeriodicVestingAccount:
+			bva = acc.BaseVestingAccount
+		default:
+			panic(fmt.Sprintf("account of vesting schedule is not a vesting account: %s", schedule.Address))
+		}
+
+		pva := NewPeriodicVestingAccountRaw(bva, schedule.StartTime, schedule.VestingPeriods)
+		if err := pva.Validate(); err != nil {
+			panic(fmt.Sprintf("invalid periodic vesting account %s: %s", schedule.Address, err.Error()))
+		}
+		ak.SetAccount(ctx, pva)
+	}
+}
+
+// ExportGenesis returns the schedules of all periodic vesting accounts
+func ExportGenesis(ctx sdk.Context, ak AccountKeeper) GenesisState {
+	schedules := []Schedule{}
+	ak.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+		if pva, ok := acc.(*PeriodicVestingAccount); ok {
+			schedules = append(schedules, Schedule{
+				Address:        pva.Address,
+				StartTime:      pva.StartTime,
+				VestingPeriods: pva.VestingPeriods,
+			})
+		}
+		return false
+	})
+	return NewGenesisState(schedules)
+}
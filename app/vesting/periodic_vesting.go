@@ -0,0 +1,280 @@
+package vesting
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// Period defines a length of time and amount of coins that will vest
+type Period struct {
+	Length int64     `json:"length"` // length of the period, in seconds
+	Amount sdk.Coins `json:"amount"` // amount of coins vesting during this period
+}
+
+func (p Period) String() string {
+	return fmt.Sprintf(`Length: %d
+  Amount: %s`, p.Length, p.Amount)
+}
+
+// Periods stores all vesting periods passed as part of a PeriodicVestingAccount
+type Periods []Period
+
+func (vp Periods) String() string {
+	periodsListString := make([]string, len(vp))
+	for i, period := range vp {
+		periodsListString[i] = period.String()
+	}
+	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
+  %s`, strings.Join(periodsListString, ",\n  ")))
+}
+
+// TotalLength returns the total length in seconds for a period
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of coins for the period
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.Coins{}
+	for _, period := range vp {
+		total = total.Add(period.Amount)
+	}
+	return total
+}
+
+// Validate checks that every period has a positive length and a valid non-zero amount
+func (vp Periods) Validate() error {
+	if len(vp) == 0 {
+		return errors.New("vesting periods can not be empty")
+	}
+	for i, period := range vp {
+		if period.Length <= 0 {
+			return fmt.Errorf("length of vesting period #%d must be positive", i)
+		}
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return fmt.Errorf("amount of vesting period #%d is invalid: %s", i, period.Amount)
+		}
+	}
+	return nil
+}
+
+// ParsePeriods parses periods in the form of "<length>:<coins>[;<length>:<coins>...]",
+// such as "2592000:100cet;2592000:100cet,20abc"
+func ParsePeriods(s string) (Periods, error) {
+	var periods Periods
+	for _, item := range strings.Split(s, ";") {
+		fields := strings.SplitN(strings.TrimSpace(item), ":", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid vesting period: %s", item)
+		}
+		length, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid length of vesting period %s: %s", item, err.Error())
+		}
+		amount, err := sdk.ParseCoins(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount of vesting period %s: %s", item, err.Error())
+		}
+		periods = append(periods, Period{Length: length, Amount: amount})
+	}
+	return periods, periods.Validate()
+}
+
+//-----------------------------------------------------------------------------
+// Periodic Vesting Account
+
+var _ auth.VestingAccount = (*PeriodicVestingAccount)(nil)
+
+// PeriodicVestingAccount implements the VestingAccount interface. It vests the
+// coins of each period at the end of it, so several unlocks of one schedule
+// can be kept in one account.
+type PeriodicVestingAccount struct {
+	*auth.BaseVestingAccount
+
+	StartTime      int64   `json:"start_time"`      // when the coins start to vest
+	VestingPeriods Periods `json:"vesting_periods"` // the vesting schedule
+}
+
+// NewPeriodicVestingAccountRaw creates a new PeriodicVestingAccount object from BaseVestingAccount
+func NewPeriodicVestingAccountRaw(bva *auth.BaseVestingAccount,
+	startTime int64, periods Periods) *PeriodicVestingAccount {
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount, all of its coins are vesting
+func NewPeriodicVestingAccount(baseAcc *auth.BaseAccount, startTime int64, periods Periods) *PeriodicVestingAccount {
+	baseVestingAcc := &auth.BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: periods.TotalAmount(),
+		EndTime:         startTime + periods.TotalLength(),
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	var pubkey string
+
+	if pva.PubKey != nil {
+		pubkey = sdk.MustBech32ifyAccPub(pva.PubKey)
+	}
+
+	return fmt.Sprintf(`Periodic Vesting Account:
+  Address:          %s
+  Pubkey:           %s
+  Coins:            %s
+  AccountNumber:    %d
+  Sequence:         %d
+  OriginalVesting:  %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s
+  StartTime:        %d
+  EndTime:          %d
+  %s`,
+		pva.Address, pubkey, pva.Coins, pva.AccountNumber, pva.Sequence,
+		pva.OriginalVesting, pva.DelegatedFree, pva.DelegatedVesting,
+		pva.StartTime, pva.EndTime, pva.VestingPeriods,
+	)
+}
+
+// Validate checks that the periods add up to the original vesting and the end time
+func (pva PeriodicVestingAccount) Validate() error {
+	if err := pva.VestingPeriods.Validate(); err != nil {
+		return err
+	}
+	if pva.StartTime+pva.VestingPeriods.TotalLength() != pva.EndTime {
+		return errors.New("vesting end time does not match the length of all vesting periods")
+	}
+	if !pva.VestingPeriods.TotalAmount().IsEqual(pva.OriginalVesting) {
+		return errors.New("original vesting coins do not match the sum of all coins in vesting periods")
+	}
+	return nil
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	// track the start time of the next period
+	currentPeriodStartTime := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		x := blockTime.Unix() - currentPeriodStartTime
+		if x < period.Length {
+			break
+		}
+		vestedCoins = vestedCoins.Add(period.Amount)
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// periodic vesting account.
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return spendableCoins(pva.BaseVestingAccount, pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	trackDelegation(pva.BaseVestingAccount, pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a periodic vesting
+// account.
+func (pva *PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// GetEndTime returns the time when vesting ends for a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetEndTime() int64 {
+	return pva.EndTime
+}
+
+// GetVestingPeriods returns the vesting schedule of a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetVestingPeriods() Periods {
+	return pva.VestingPeriods
+}
+
+// The two helpers below follow the unexported methods of auth.BaseVestingAccount,
+// which can not be called from outside of the auth module.
+
+// spendableCoins computes min((BC + DV) - V, BC) for every denom
+func spendableCoins(bva *auth.BaseVestingAccount, vestingCoins sdk.Coins) sdk.Coins {
+	var spendable sdk.Coins
+	for _, coin := range bva.GetCoins() {
+		baseAmt := coin.Amount
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		min := sdk.MinInt(baseAmt.Add(delVestingAmt).Sub(vestingAmt), baseAmt)
+		spendableCoin := sdk.NewCoin(coin.Denom, min)
+		if !spendableCoin.IsZero() {
+			spendable = spendable.Add(sdk.Coins{spendableCoin})
+		}
+	}
+	return spendable
+}
+
+// trackDelegation moves X := min(max(V - DV, 0), D) to delegated vesting and
+// Y := D - X to delegated free
+func trackDelegation(bva *auth.BaseVestingAccount, vestingCoins, amount sdk.Coins) {
+	bc := bva.GetCoins()
+	for _, coin := range amount {
+		baseAmt := bc.AmountOf(coin.Denom)
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		if coin.Amount.IsZero() || baseAmt.LT(coin.Amount) {
+			panic("delegation attempt with zero coins or insufficient funds")
+		}
+
+		x := sdk.MinInt(sdk.MaxInt(vestingAmt.Sub(delVestingAmt), sdk.ZeroInt()), coin.Amount)
+		y := coin.Amount.Sub(x)
+		if !x.IsZero() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Add(sdk.Coins{sdk.NewCoin(coin.Denom, x)})
+		}
+		if !y.IsZero() {
+			bva.DelegatedFree = bva.DelegatedFree.Add(sdk.Coins{sdk.NewCoin(coin.Denom, y)})
+		}
+
+		bva.Coins = bva.Coins.Sub(sdk.Coins{coin})
+	}
+}
@@ -0,0 +1,129 @@
+package vesting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+var (
+	testAddr  = sdk.AccAddress([]byte("addr________________"))
+	startTime = time.Unix(1577836800, 0) // 2020-01-01
+)
+
+func newTestAccount() *PeriodicVestingAccount {
+	periods := Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("cet", 500))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin("cet", 300), sdk.NewInt64Coin("abc", 50))},
+		{Length: 300, Amount: sdk.NewCoins(sdk.NewInt64Coin("cet", 200))},
+	}
+	baseAcc := auth.NewBaseAccountWithAddress(testAddr)
+	baseAcc.Coins = sdk.NewCoins(sdk.NewInt64Coin("cet", 1500), sdk.NewInt64Coin("abc", 50))
+	return NewPeriodicVestingAccount(&baseAcc, startTime.Unix(), periods)
+}
+
+func TestGetVestedCoinsPeriodicVestingAcc(t *testing.T) {
+	pva := newTestAccount()
+	require.NoError(t, pva.Validate())
+	require.Equal(t, startTime.Unix()+600, pva.GetEndTime())
+
+	require.Nil(t, pva.GetVestedCoins(startTime))
+	require.Nil(t, pva.GetVestedCoins(startTime.Add(99*time.Second)))
+	require.Equal(t, "500cet", pva.GetVestedCoins(startTime.Add(100*time.Second)).String())
+	require.Equal(t, "500cet", pva.GetVestedCoins(startTime.Add(299*time.Second)).String())
+	require.Equal(t, "50abc,800cet", pva.GetVestedCoins(startTime.Add(300*time.Second)).String())
+	require.Equal(t, "50abc,1000cet", pva.GetVestedCoins(startTime.Add(600*time.Second)).String())
+
+	require.Equal(t, "50abc,1000cet", pva.GetVestingCoins(startTime).String())
+	require.Equal(t, "200cet", pva.GetVestingCoins(startTime.Add(300*time.Second)).String())
+	require.True(t, pva.GetVestingCoins(startTime.Add(time.Hour)).IsZero())
+}
+
+func TestSpendableCoinsPeriodicVestingAcc(t *testing.T) {
+	pva := newTestAccount()
+	require.Equal(t, "500cet", pva.SpendableCoins(startTime).String())
+	require.Equal(t, "1000cet", pva.SpendableCoins(startTime.Add(100*time.Second)).String())
+	require.Equal(t, "50abc,1500cet", pva.SpendableCoins(startTime.Add(600*time.Second)).String())
+}
+
+func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
+	// all delegated coins are vesting before the first period ends
+	pva := newTestAccount()
+	pva.TrackDelegation(startTime, sdk.NewCoins(sdk.NewInt64Coin("cet", 1200)))
+	require.Equal(t, "1000cet", pva.DelegatedVesting.String())
+	require.Equal(t, "200cet", pva.DelegatedFree.String())
+	require.Equal(t, "50abc,300cet", pva.GetCoins().String())
+	require.Equal(t, "300cet", pva.SpendableCoins(startTime).String())
+
+	pva.TrackUndelegation(sdk.NewCoins(sdk.NewInt64Coin("cet", 1200)))
+	require.True(t, pva.DelegatedVesting.IsZero())
+	require.True(t, pva.DelegatedFree.IsZero())
+
+	// vested coins are delegated as free coins
+	pva = newTestAccount()
+	pva.TrackDelegation(startTime.Add(300*time.Second), sdk.NewCoins(sdk.NewInt64Coin("cet", 300)))
+	require.Equal(t, "200cet", pva.DelegatedVesting.String())
+	require.Equal(t, "100cet", pva.DelegatedFree.String())
+
+	require.Panics(t, func() {
+		pva.TrackDelegation(startTime, sdk.NewCoins(sdk.NewInt64Coin("cet", 5000)))
+	})
+}
+
+func TestPeriodicVestingAccValidate(t *testing.T) {
+	pva := newTestAccount()
+	pva.EndTime++
+	require.Error(t, pva.Validate())
+
+	pva = newTestAccount()
+	pva.OriginalVesting = sdk.NewCoins(sdk.NewInt64Coin("cet", 1000))
+	require.Error(t, pva.Validate())
+
+	pva = newTestAccount()
+	pva.VestingPeriods[1].Length = 0
+	require.Error(t, pva.Validate())
+}
+
+func TestParsePeriods(t *testing.T) {
+	periods, err := ParsePeriods("100:500cet; 200:300cet,50abc")
+	require.NoError(t, err)
+	require.Equal(t, newTestAccount().VestingPeriods[:2], periods)
+
+	for _, s := range []string{"", "100", "x:500cet", "100:500", "0:500cet", "100:0cet"} {
+		_, err = ParsePeriods(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestPeriodicVestingAccAmino(t *testing.T) {
+	pva := newTestAccount()
+	bz, err := ModuleCdc.MarshalBinaryBare(auth.Account(pva))
+	require.NoError(t, err)
+
+	var acc auth.Account
+	require.NoError(t, ModuleCdc.UnmarshalBinaryBare(bz, &acc))
+	require.Equal(t, pva, acc)
+}
+
+func TestGenesisState(t *testing.T) {
+	genAcc, schedule, err := NewGenesisEntries(newTestAccount())
+	require.NoError(t, err)
+	require.Equal(t, startTime.Unix(), genAcc.StartTime)
+	require.Equal(t, startTime.Unix()+600, genAcc.EndTime)
+	require.Equal(t, "50abc,1000cet", genAcc.OriginalVesting.String())
+
+	gs := NewGenesisState([]Schedule{schedule})
+	require.NoError(t, gs.ValidateGenesis())
+	require.NoError(t, DefaultGenesisState().ValidateGenesis())
+
+	gs = NewGenesisState([]Schedule{schedule, schedule})
+	require.Error(t, gs.ValidateGenesis())
+
+	schedule.StartTime = 0
+	gs = NewGenesisState([]Schedule{schedule})
+	require.Error(t, gs.ValidateGenesis())
+}
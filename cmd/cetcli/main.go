@@ -28,6 +28,8 @@ import (
 	distrxcmd "github.com/coinexchain/cet-sdk/modules/distributionx/client/cli"
 	dex "github.com/coinexchain/cet-sdk/types"
 	"github.com/coinexchain/dex/app"
+	indexercli "github.com/coinexchain/dex/app/indexer/client/cli"
+	indexerrest "github.com/coinexchain/dex/app/indexer/client/rest"
 	_ "github.com/coinexchain/dex/cmd/cetcli/statik"
 )
 
@@ -138,6 +140,8 @@ func queryCmd(cdc *codec.Codec) *cobra.Command {
 		authcmd.QueryTxsByEventsCmd(cdc),
 		authcmd.QueryTxCmd(cdc),
 		client.LineBreak,
+		indexercli.GetBalanceHistoryCmd(cdc),
+		client.LineBreak,
 	)
 
 	// add modules' query commands
@@ -188,6 +192,7 @@ func registerRoutes(rs *lcd.RestServer) {
 	client.RegisterRoutes(rs.CliCtx, rs.Mux)
 	authrest.RegisterTxRoutes(rs.CliCtx, rs.Mux)
 	app.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
+	indexerrest.RegisterRoutes(rs.CliCtx, rs.Mux)
 }
 
 func fixDescriptions(cmd *cobra.Command) {
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/app/storeutil"
+)
+
+const flagStore = "store"
+
+// debugCmd groups the commands to look into the state of a stopped node
+func debugCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Tools to debug the state of a stopped node",
+	}
+	cmd.AddCommand(
+		storeHashesCmd(ctx),
+		storeDiffCmd(),
+		replayCmd(ctx),
+	)
+	return cmd
+}
+
+func storeHashesCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-hashes",
+		Short: "Print the commit hash of each store at a height",
+		Long: `Print the commit hash of each KV store at a height, and the app hash made
+of them. Comparing the output of two nodes tells which stores diverged when they
+disagree on the app hash. A running node logs the same hashes every block with
+--log-store-hashes.
+
+Example:
+	cetd debug store-hashes --height 1000
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			db, err := storeutil.OpenAppDBReadOnly(filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height := viper.GetInt64(flagHeight)
+			if height == 0 {
+				height = storeutil.GetLatestVersion(db)
+			}
+			ci, err := storeutil.GetCommitInfo(db, height)
+			if err != nil {
+				return err
+			}
+			for _, si := range ci.SortedStoreInfos() {
+				cmd.Printf("%-12s %X\n", si.Name, si.Core.CommitID.Hash)
+			}
+			cmd.Printf("app hash at height %d: %X\n", height, ci.Hash())
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height of the hashes, 0 for the latest height")
+	return cmd
+}
+
+func storeDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-diff [data-dir-a] [data-dir-b]",
+		Short: "Compare a store of two nodes key by key",
+		Long: `Compare a KV store of two stopped nodes key by key at a height, and print
+each key with different values as a JSON line. The keys are decoded with the key
+prefixes of the module owning the store, as cetdebug store dump does. The values
+are decoded with the app codec when possible, and printed in hex otherwise. A
+value is null if the key is absent on that side.
+
+Example:
+	cetd debug store-diff node0/data node1/data --store market --height 1000
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := viper.GetString(flagStore)
+			if name == "" {
+				return fmt.Errorf("--%s is required", flagStore)
+			}
+			dbA, err := storeutil.OpenAppDBReadOnly(args[0])
+			if err != nil {
+				return err
+			}
+			defer dbA.Close()
+			dbB, err := storeutil.OpenAppDBReadOnly(args[1])
+			if err != nil {
+				return err
+			}
+			defer dbB.Close()
+
+			height := viper.GetInt64(flagHeight)
+			if height == 0 {
+				height = storeutil.GetLatestVersion(dbA)
+				if latestB := storeutil.GetLatestVersion(dbB); latestB < height {
+					height = latestB
+				}
+			}
+
+			decoder := storeutil.NewStoreDecoder(app.MakeCodec())
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			count, err := diffStore(dbA, dbB, name, height, func(key, a, b []byte) error {
+				return enc.Encode(newStoreDiffEntry(decoder, name, key, a, b))
+			})
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("%d different keys in store %s at height %d\n", count, name, height)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagStore, "", "Name of the store to compare, such as acc, market or asset")
+	cmd.Flags().Int64(flagHeight, 0, "Height to compare at, 0 for the latest height of both nodes")
+	return cmd
+}
+
+type storeDiffEntry struct {
+	Key       string          `json:"key"`
+	Prefix    string          `json:"prefix,omitempty"`
+	KeyFields []string        `json:"key_fields,omitempty"`
+	A         json.RawMessage `json:"a"`
+	B         json.RawMessage `json:"b"`
+}
+
+func newStoreDiffEntry(decoder *storeutil.StoreDecoder, name string, key, a, b []byte) storeDiffEntry {
+	entryA := decoder.Decode(name, key, a)
+	return storeDiffEntry{
+		Key:       entryA.Key,
+		Prefix:    entryA.Prefix,
+		KeyFields: entryA.KeyFields,
+		A:         entryA.Value,
+		B:         decoder.Decode(name, key, b).Value,
+	}
+}
+
+// diffStore calls fn with each key of store name whose values differ between
+// the multistores in dbA and dbB at height, the value is nil on the side which
+// does not have the key. It returns the number of such keys.
+func diffStore(dbA, dbB dbm.DB, name string, height int64,
+	fn func(key, a, b []byte) error) (int, error) {
+
+	storeA, idA, err := storeutil.LoadStore(dbA, name, height)
+	if err != nil {
+		return 0, err
+	}
+	storeB, idB, err := storeutil.LoadStore(dbB, name, height)
+	if err != nil {
+		return 0, err
+	}
+	if bytes.Equal(idA.Hash, idB.Hash) {
+		return 0, nil
+	}
+
+	itA := storeA.Iterator(nil, nil)
+	defer itA.Close()
+	itB := storeB.Iterator(nil, nil)
+	defer itB.Close()
+	count := 0
+	for itA.Valid() || itB.Valid() {
+		var key, a, b []byte
+		switch {
+		case !itB.Valid() || (itA.Valid() && bytes.Compare(itA.Key(), itB.Key()) < 0):
+			key, a = itA.Key(), itA.Value()
+			itA.Next()
+		case !itA.Valid() || bytes.Compare(itA.Key(), itB.Key()) > 0:
+			key, b = itB.Key(), itB.Value()
+			itB.Next()
+		default:
+			key, a, b = itA.Key(), itA.Value(), itB.Value()
+			itA.Next()
+			itB.Next()
+			if bytes.Equal(a, b) {
+				continue
+			}
+		}
+		count++
+		if err := fn(key, a, b); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/app/storeutil"
+)
+
+func commitTestStore(t *testing.T, db dbm.DB, kvs map[string][]byte) {
+	key := sdk.NewKVStoreKey("test")
+	ms := rootmulti.NewStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.Nil(t, ms.LoadLatestVersion())
+	for k, v := range kvs {
+		ms.GetKVStore(key).Set([]byte(k), v)
+	}
+	ms.Commit()
+}
+
+func TestDiffStore(t *testing.T) {
+	cdc := app.MakeCodec()
+	decoder := storeutil.NewStoreDecoder(app.MakeCodec())
+	acc := auth.NewBaseAccountWithAddress(sdk.AccAddress("addr"))
+	accBz := cdc.MustMarshalBinaryBare(&acc)
+
+	dbA, dbB := dbm.NewMemDB(), dbm.NewMemDB()
+	commitTestStore(t, dbA, map[string][]byte{"a": []byte("1"), "b": accBz, "c": {0xff}, "d": {1}})
+	commitTestStore(t, dbB, map[string][]byte{"a": []byte("2"), "c": {0xff}, "e": {2}})
+
+	var diffs []storeDiffEntry
+	count, err := diffStore(dbA, dbB, "test", 1, func(key, a, b []byte) error {
+		diffs = append(diffs, newStoreDiffEntry(decoder, "test", key, a, b))
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, 4, count)
+	require.Equal(t, []string{"61", "62", "64", "65"},
+		[]string{diffs[0].Key, diffs[1].Key, diffs[2].Key, diffs[3].Key})
+	require.Equal(t, `1`, string(diffs[0].A))
+	require.Equal(t, `2`, string(diffs[0].B))
+	require.Equal(t, `null`, string(diffs[1].B))
+	require.Equal(t, `"01"`, string(diffs[2].A))
+
+	var decoded struct {
+		Type string `json:"type"`
+	}
+	require.Nil(t, json.Unmarshal(diffs[1].A, &decoded))
+	require.Equal(t, "cosmos-sdk/Account", decoded.Type)
+
+	count, err = diffStore(dbA, dbA, "test", 1, nil)
+	require.Nil(t, err)
+	require.Equal(t, 0, count)
+	_, err = diffStore(dbA, dbB, "acc", 1, nil)
+	require.Error(t, err)
+	_, err = diffStore(dbA, dbB, "test", 2, nil)
+	require.Error(t, err)
+}
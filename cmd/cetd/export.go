@@ -0,0 +1,131 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/dex/app"
+)
+
+// the first three flags are the same as the ones of the export command of server
+const (
+	flagHeight        = "height"
+	flagForZeroHeight = "for-zero-height"
+	flagJailWhitelist = "jail-whitelist"
+	flagModules       = "modules"
+)
+
+// streamExportCmd exports the state module by module, it takes much less memory
+// than the export command on a large state
+func streamExportCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream-export",
+		Short: "Export state to JSON module by module, with progress logs",
+		Long: `Export state to JSON module by module, with progress logs.
+
+The genesis file is written to stdout, or with --output-dir, the state of each
+module is written to its own file in the directory, together with a genesis.json
+whose app state is a manifest of these files. A node started with this
+genesis.json loads the module states from the files.
+
+The progress logs are written to stderr.
+
+Example:
+	cetd stream-export --for-zero-height --modules=-comment,-incentive
+	cetd stream-export --output-dir ./genesis
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			filter, err := app.ParseModuleFilter(viper.GetString(flagModules))
+			if err != nil {
+				return err
+			}
+			jailWhiteList, err := getJailWhiteList(viper.GetStringSlice(flagJailWhitelist))
+			if err != nil {
+				return err
+			}
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			if db.Stats()["leveldb.sstables"] == "" {
+				return errors.New("state is not initialized")
+			}
+
+			doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+			var w app.GenesisWriter
+			if dir := viper.GetString(flagOutputDir); dir != "" {
+				w, err = app.NewSplitGenesisWriter(cdc, dir, doc)
+			} else {
+				w, err = app.NewGenesisDocWriter(cdc, os.Stdout, doc)
+			}
+			if err != nil {
+				return err
+			}
+
+			// stdout may be taken by the genesis file
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stderr))
+			gApp, err := loadAppForExport(logger, db, nil, viper.GetInt64(flagHeight))
+			if err != nil {
+				return err
+			}
+			return gApp.ExportAppStateStream(viper.GetBool(flagForZeroHeight), jailWhiteList, filter, w)
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().Bool(flagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().StringSlice(flagJailWhitelist, []string{}, "List of the validators not to jail in a zero-height export")
+	addJailWhitelistFileFlag(cmd)
+	cmd.Flags().String(flagModules, "",
+		"Comma separated modules to export, or to skip if prefixed with '-', such as \"bank,staking\" or \"-comment\"")
+	cmd.Flags().String(flagOutputDir, "",
+		"Write the state of each module to its own file in this directory, with a genesis.json listing them, "+
+			"which must be kept in the same directory as the files")
+	return cmd
+}
+
+// getJailWhiteList appends the validators in --jail-whitelist-file to jailWhiteList
+func getJailWhiteList(jailWhiteList []string) ([]string, error) {
+	file := viper.GetString(flagJailWhitelistFile)
+	if file == "" {
+		return jailWhiteList, nil
+	}
+	list, err := app.LoadJailWhiteList(file)
+	if err != nil {
+		return nil, err
+	}
+	return append(jailWhiteList, list...), nil
+}
+
+func loadAppForExport(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64) (*app.CetChainApp, error) {
+	if height != -1 {
+		gApp := app.NewCetChainApp(logger, db, traceStore, false, uint(1))
+		if err := gApp.LoadHeight(height); err != nil {
+			return nil, err
+		}
+		return gApp, nil
+	}
+	return app.NewCetChainApp(logger, db, traceStore, true, uint(1)), nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	genaccscli "github.com/cosmos/cosmos-sdk/x/genaccounts/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	"github.com/coinexchain/dex/app/vesting"
+)
+
+// the flags below are defined by the add-genesis-account command of genaccounts
+const (
+	flagClientHome   = "home-client"
+	flagVestingStart = "vesting-start-time"
+	flagVestingEnd   = "vesting-end-time"
+	flagVestingAmt   = "vesting-amount"
+
+	flagVestingPeriods = "vesting-periods"
+)
+
+// addGenesisAccountCmd extends the add-genesis-account command of genaccounts
+// with --vesting-periods, which adds a periodic vesting account
+func addGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec,
+	defaultNodeHome, defaultClientHome string) *cobra.Command {
+
+	cmd := genaccscli.AddGenesisAccountCmd(ctx, cdc, defaultNodeHome, defaultClientHome)
+	addAccount := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(viper.GetString(flagVestingPeriods)) == 0 {
+			return addAccount(cmd, args)
+		}
+		return addPeriodicVestingAccount(ctx, cdc, args)
+	}
+
+	cmd.Flags().String(flagVestingPeriods, "",
+		"vesting periods of a periodic vesting account, such as \"2592000:100cet;2592000:100cet\", "+
+			"each period is its length in seconds and the coins vested at its end, --vesting-start-time is required")
+	return cmd
+}
+
+func addPeriodicVestingAccount(ctx *server.Context, cdc *codec.Codec, args []string) error {
+	config := ctx.Config
+	config.SetRoot(viper.GetString(cli.HomeFlag))
+
+	if len(viper.GetString(flagVestingAmt)) != 0 || viper.GetInt64(flagVestingEnd) != 0 {
+		return errors.New("--vesting-periods can not be used with --vesting-amount or --vesting-end-time")
+	}
+	startTime := viper.GetInt64(flagVestingStart)
+	if startTime <= 0 {
+		return errors.New("--vesting-start-time is required by --vesting-periods")
+	}
+	periods, err := vesting.ParsePeriods(viper.GetString(flagVestingPeriods))
+	if err != nil {
+		return err
+	}
+
+	addr, err := getAddressOrKey(args[0])
+	if err != nil {
+		return err
+	}
+	coins, err := sdk.ParseCoins(args[1])
+	if err != nil {
+		return err
+	}
+
+	baseAcc := auth.NewBaseAccountWithAddress(addr)
+	baseAcc.Coins = coins
+	genAcc, schedule, err := vesting.NewGenesisEntries(vesting.NewPeriodicVestingAccount(&baseAcc, startTime, periods))
+	if err != nil {
+		return err
+	}
+
+	// retrieve the app state
+	genFile := config.GenesisFile()
+	appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+	if err != nil {
+		return err
+	}
+
+	var genesisAccounts genaccounts.GenesisAccounts
+	cdc.MustUnmarshalJSON(appState[genaccounts.ModuleName], &genesisAccounts)
+	if genesisAccounts.Contains(addr) {
+		return fmt.Errorf("cannot add account at existing address %v", addr)
+	}
+	genesisAccounts = append(genesisAccounts, genAcc)
+	appState[genaccounts.ModuleName] = cdc.MustMarshalJSON(genaccounts.GenesisState(genesisAccounts))
+
+	vestingState := vesting.DefaultGenesisState()
+	if bz, ok := appState[vesting.ModuleName]; ok {
+		cdc.MustUnmarshalJSON(bz, &vestingState)
+	}
+	vestingState.Schedules = append(vestingState.Schedules, schedule)
+	appState[vesting.ModuleName] = cdc.MustMarshalJSON(vestingState)
+
+	appStateJSON, err := cdc.MarshalJSON(appState)
+	if err != nil {
+		return err
+	}
+
+	// export app state
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
+
+func getAddressOrKey(s string) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(s)
+	if err == nil {
+		return addr, nil
+	}
+
+	kb, err := keys.NewKeyBaseFromDir(viper.GetString(flagClientHome))
+	if err != nil {
+		return nil, err
+	}
+	info, err := kb.Get(s)
+	if err != nil {
+		return nil, err
+	}
+	return info.GetAddress(), nil
+}
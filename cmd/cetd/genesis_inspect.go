@@ -0,0 +1,451 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
+	dex "github.com/coinexchain/cet-sdk/types"
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/app/vesting"
+)
+
+const (
+	flagTopN  = "top"
+	flagDenom = "denom"
+	flagJSON  = "json"
+)
+
+type holderInfo struct {
+	Address string  `json:"address"`
+	Amount  sdk.Int `json:"amount"`
+}
+
+type moduleAccountInfo struct {
+	Name    string    `json:"name"`
+	Address string    `json:"address"`
+	Coins   sdk.Coins `json:"coins"`
+}
+
+type tokenInfo struct {
+	Symbol      string  `json:"symbol"`
+	Owner       string  `json:"owner"`
+	TotalSupply sdk.Int `json:"total_supply"`
+}
+
+type tradingPairInfo struct {
+	Symbol     string `json:"symbol"`
+	OpenOrders int    `json:"open_orders"`
+}
+
+type poolInfo struct {
+	Symbol string  `json:"symbol"`
+	Owner  string  `json:"owner"`
+	Stock  sdk.Int `json:"stock"`
+	Money  sdk.Int `json:"money"`
+}
+
+type validatorDelegationInfo struct {
+	Operator        string  `json:"operator"`
+	Moniker         string  `json:"moniker"`
+	Status          string  `json:"status"`
+	Tokens          sdk.Int `json:"tokens"`
+	Delegators      int     `json:"delegators"`
+	DelegatorShares sdk.Dec `json:"delegator_shares"`
+}
+
+type proposalInfo struct {
+	ID             uint64    `json:"id"`
+	Title          string    `json:"title"`
+	Status         string    `json:"status"`
+	DepositEndTime time.Time `json:"deposit_end_time"`
+	VotingEndTime  time.Time `json:"voting_end_time"`
+}
+
+// genesisReport summarizes a genesis file or the output of `cetd export`
+type genesisReport struct {
+	ChainID           string                    `json:"chain_id"`
+	GenesisTime       time.Time                 `json:"genesis_time"`
+	InitialHeight     int64                     `json:"genesis_block_height"`
+	TotalSupply       sdk.Coins                 `json:"total_supply"`
+	CirculatingSupply sdk.Coins                 `json:"circulating_supply"`
+	OriginalVesting   sdk.Coins                 `json:"original_vesting"`
+	VestingCoins      sdk.Coins                 `json:"vesting_coins"`
+	LockedCoins       sdk.Coins                 `json:"locked_coins"`
+	FrozenCoins       sdk.Coins                 `json:"frozen_coins"`
+	AccountCount      int                       `json:"account_count"`
+	TopHolderDenom    string                    `json:"top_holder_denom"`
+	TopHolders        []holderInfo              `json:"top_holders"`
+	ModuleAccounts    []moduleAccountInfo       `json:"module_accounts"`
+	Tokens            []tokenInfo               `json:"tokens"`
+	TradingPairs      []tradingPairInfo         `json:"trading_pairs"`
+	BancorPools       []poolInfo                `json:"bancor_pools"`
+	AutoSwapPools     []poolInfo                `json:"autoswap_pools"`
+	Validators        []validatorDelegationInfo `json:"validators"`
+	Proposals         []proposalInfo            `json:"proposals_in_flight"`
+}
+
+func genesisCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis",
+		Short: "Genesis file utilities",
+	}
+	cmd.AddCommand(genesisInspectCmd(ctx, cdc))
+	return cmd
+}
+
+func genesisInspectCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect [file]",
+		Short: "Summarize the genesis file at the default location or at the location passed as an arg",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if viper.GetInt(flagTopN) < 0 {
+				return fmt.Errorf("--%s can not be negative", flagTopN)
+			}
+			genesis := ctx.Config.GenesisFile()
+			if len(args) != 0 {
+				genesis = args[0]
+			}
+			genDoc, err := tmtypes.GenesisDocFromFile(genesis)
+			if err != nil {
+				return fmt.Errorf("error loading genesis doc from %s: %s", genesis, err.Error())
+			}
+
+			report, err := inspectGenesisDoc(cdc, genDoc, viper.GetInt(flagTopN), viper.GetString(flagDenom))
+			if err != nil {
+				return err
+			}
+			if viper.GetBool(flagJSON) {
+				out, err := codec.MarshalJSONIndent(cdc, report)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(out))
+				return nil
+			}
+			printGenesisReport(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagTopN, 20, "Number of top holders to show")
+	cmd.Flags().String(flagDenom, dex.CET, "Denom used to rank the top holders")
+	cmd.Flags().Bool(flagJSON, false, "Print the report as JSON instead of tables")
+	return cmd
+}
+
+func inspectGenesisDoc(cdc *codec.Codec, genDoc *tmtypes.GenesisDoc, topN int, denom string) (genesisReport, error) {
+	var rawState map[string]json.RawMessage
+	if err := cdc.UnmarshalJSON(genDoc.AppState, &rawState); err != nil {
+		return genesisReport{}, fmt.Errorf("error unmarshaling app state: %s", err.Error())
+	}
+
+	// autoswap is not a field of app.GenesisState, and it may be absent from old genesis files
+	var autoSwapData autoswap.GenesisState
+	if raw := rawState[autoswap.ModuleName]; raw != nil {
+		if err := cdc.UnmarshalJSON(raw, &autoSwapData); err != nil {
+			return genesisReport{}, fmt.Errorf("error unmarshaling %s: %s", autoswap.ModuleName, err.Error())
+		}
+	}
+
+	gs, err := app.TryFromMap(cdc, rawState)
+	if err != nil {
+		return genesisReport{}, err
+	}
+	report := buildGenesisReport(gs, autoSwapData, genDoc.GenesisTime, topN, denom)
+	report.ChainID = genDoc.ChainID
+	report.InitialHeight = genDoc.GenesisBlockHeight
+	return report, nil
+}
+
+func buildGenesisReport(gs app.GenesisState, autoSwapData autoswap.GenesisState,
+	genesisTime time.Time, topN int, denom string) genesisReport {
+
+	report := genesisReport{
+		GenesisTime:    genesisTime,
+		TopHolderDenom: denom,
+		AccountCount:   len(gs.Accounts),
+	}
+	fillSupplyAndAccounts(&report, gs, genesisTime, topN, denom)
+	fillAssetsAndMarkets(&report, gs, autoSwapData)
+	fillStakingAndGov(&report, gs)
+	return report
+}
+
+func fillSupplyAndAccounts(report *genesisReport, gs app.GenesisState, genesisTime time.Time, topN int, denom string) {
+	var total, circulating, originalVesting, vestingCoins sdk.Coins
+	var holders []holderInfo
+	schedules := make(map[string]vesting.Schedule, len(gs.VestingData.Schedules))
+	for _, schedule := range gs.VestingData.Schedules {
+		schedules[schedule.Address.String()] = schedule
+	}
+	for i := range gs.Accounts {
+		genAcc := &gs.Accounts[i]
+		total = total.Add(genAcc.Coins)
+		if genAcc.ModuleName != "" {
+			report.ModuleAccounts = append(report.ModuleAccounts, moduleAccountInfo{
+				Name:    genAcc.ModuleName,
+				Address: genAcc.Address.String(),
+				Coins:   genAcc.Coins,
+			})
+			continue
+		}
+
+		acc := genAcc.ToAccount()
+		if schedule, ok := schedules[genAcc.Address.String()]; ok {
+			if cva, ok := acc.(*auth.ContinuousVestingAccount); ok {
+				acc = vesting.NewPeriodicVestingAccountRaw(cva.BaseVestingAccount, schedule.StartTime, schedule.VestingPeriods)
+			}
+		}
+		circulating = circulating.Add(acc.SpendableCoins(genesisTime))
+		if vacc, ok := acc.(auth.VestingAccount); ok {
+			originalVesting = originalVesting.Add(vacc.GetOriginalVesting())
+			vestingCoins = vestingCoins.Add(vacc.GetVestingCoins(genesisTime))
+		}
+		if amount := genAcc.Coins.AmountOf(denom); amount.IsPositive() {
+			holders = append(holders, holderInfo{Address: genAcc.Address.String(), Amount: amount})
+		}
+	}
+	if !gs.Supply.Supply.Empty() {
+		total = gs.Supply.Supply
+	}
+
+	var locked, frozen sdk.Coins
+	for _, accx := range gs.AuthXData.AccountXs {
+		for _, lc := range accx.LockedCoins {
+			locked = locked.Add(sdk.Coins{lc.Coin})
+		}
+		frozen = frozen.Add(accx.FrozenCoins)
+	}
+
+	sort.SliceStable(holders, func(i, j int) bool {
+		return holders[i].Amount.GT(holders[j].Amount)
+	})
+	if len(holders) > topN {
+		holders = holders[:topN]
+	}
+	sort.Slice(report.ModuleAccounts, func(i, j int) bool {
+		return report.ModuleAccounts[i].Name < report.ModuleAccounts[j].Name
+	})
+
+	report.TotalSupply = total
+	report.CirculatingSupply = circulating
+	report.OriginalVesting = originalVesting
+	report.VestingCoins = vestingCoins
+	report.LockedCoins = locked
+	report.FrozenCoins = frozen
+	report.TopHolders = holders
+}
+
+func fillAssetsAndMarkets(report *genesisReport, gs app.GenesisState, autoSwapData autoswap.GenesisState) {
+	for _, token := range gs.AssetData.Tokens {
+		report.Tokens = append(report.Tokens, tokenInfo{
+			Symbol:      token.GetSymbol(),
+			Owner:       token.GetOwner().String(),
+			TotalSupply: token.GetTotalSupply(),
+		})
+	}
+	sort.Slice(report.Tokens, func(i, j int) bool {
+		return report.Tokens[i].Symbol < report.Tokens[j].Symbol
+	})
+
+	openOrders := make(map[string]int)
+	for _, order := range gs.MarketData.Orders {
+		openOrders[order.TradingPair]++
+	}
+	for _, info := range gs.MarketData.MarketInfos {
+		symbol := dex.GetSymbol(info.Stock, info.Money)
+		report.TradingPairs = append(report.TradingPairs, tradingPairInfo{
+			Symbol:     symbol,
+			OpenOrders: openOrders[symbol],
+		})
+	}
+	sort.Slice(report.TradingPairs, func(i, j int) bool {
+		return report.TradingPairs[i].Symbol < report.TradingPairs[j].Symbol
+	})
+
+	for symbol, bi := range gs.BancorData.BancorInfoMap {
+		report.BancorPools = append(report.BancorPools, poolInfo{
+			Symbol: symbol,
+			Owner:  bi.Owner.String(),
+			Stock:  bi.StockInPool,
+			Money:  bi.MoneyInPool,
+		})
+	}
+	sort.Slice(report.BancorPools, func(i, j int) bool {
+		return report.BancorPools[i].Symbol < report.BancorPools[j].Symbol
+	})
+
+	for _, pi := range autoSwapData.PoolInfos {
+		report.AutoSwapPools = append(report.AutoSwapPools, poolInfo{
+			Symbol: pi.Symbol,
+			Owner:  pi.Owner.String(),
+			Stock:  pi.StockAmmReserve,
+			Money:  pi.MoneyAmmReserve,
+		})
+	}
+	sort.Slice(report.AutoSwapPools, func(i, j int) bool {
+		return report.AutoSwapPools[i].Symbol < report.AutoSwapPools[j].Symbol
+	})
+}
+
+func fillStakingAndGov(report *genesisReport, gs app.GenesisState) {
+	delegators := make(map[string]int)
+	shares := make(map[string]sdk.Dec)
+	for _, d := range gs.StakingData.Delegations {
+		valAddr := d.ValidatorAddress.String()
+		delegators[valAddr]++
+		if s, ok := shares[valAddr]; ok {
+			shares[valAddr] = s.Add(d.Shares)
+		} else {
+			shares[valAddr] = d.Shares
+		}
+	}
+	for _, val := range gs.StakingData.Validators {
+		valAddr := val.OperatorAddress.String()
+		delegatorShares, ok := shares[valAddr]
+		if !ok {
+			delegatorShares = sdk.ZeroDec()
+		}
+		report.Validators = append(report.Validators, validatorDelegationInfo{
+			Operator:        valAddr,
+			Moniker:         val.Description.Moniker,
+			Status:          val.Status.String(),
+			Tokens:          val.Tokens,
+			Delegators:      delegators[valAddr],
+			DelegatorShares: delegatorShares,
+		})
+	}
+	sort.SliceStable(report.Validators, func(i, j int) bool {
+		return report.Validators[i].Tokens.GT(report.Validators[j].Tokens)
+	})
+
+	for _, p := range gs.GovData.Proposals {
+		if p.Status != gov.StatusDepositPeriod && p.Status != gov.StatusVotingPeriod {
+			continue
+		}
+		report.Proposals = append(report.Proposals, proposalInfo{
+			ID:             p.ProposalID,
+			Title:          p.GetTitle(),
+			Status:         p.Status.String(),
+			DepositEndTime: p.DepositEndTime,
+			VotingEndTime:  p.VotingEndTime,
+		})
+	}
+}
+
+func printGenesisReport(w io.Writer, report genesisReport) {
+	fmt.Fprintf(w, "chain-id: %s, genesis time: %s, genesis block height: %d, accounts: %d\n\n",
+		report.ChainID, report.GenesisTime.UTC().Format(time.RFC3339), report.InitialHeight, report.AccountCount)
+
+	printTable(w, "Supply", []string{"Denom", "Total", "Circulating", "Original Vesting", "Vesting", "Locked", "Frozen"},
+		supplyRows(report))
+
+	rows := make([][]string, len(report.TopHolders))
+	for i, h := range report.TopHolders {
+		rows[i] = []string{strconv.Itoa(i + 1), h.Address, h.Amount.String()}
+	}
+	printTable(w, fmt.Sprintf("Top %d holders of %s", len(report.TopHolders), report.TopHolderDenom),
+		[]string{"#", "Address", "Amount"}, rows)
+
+	rows = make([][]string, len(report.ModuleAccounts))
+	for i, m := range report.ModuleAccounts {
+		rows[i] = []string{m.Name, m.Address, m.Coins.String()}
+	}
+	printTable(w, "Module accounts", []string{"Module", "Address", "Coins"}, rows)
+
+	rows = make([][]string, len(report.Tokens))
+	for i, t := range report.Tokens {
+		rows[i] = []string{t.Symbol, t.Owner, t.TotalSupply.String()}
+	}
+	printTable(w, fmt.Sprintf("Tokens (%d)", len(report.Tokens)), []string{"Symbol", "Owner", "Total Supply"}, rows)
+
+	rows = make([][]string, len(report.TradingPairs))
+	for i, p := range report.TradingPairs {
+		rows[i] = []string{p.Symbol, strconv.Itoa(p.OpenOrders)}
+	}
+	printTable(w, fmt.Sprintf("Trading pairs (%d)", len(report.TradingPairs)), []string{"Symbol", "Open Orders"}, rows)
+
+	printTable(w, "Bancor pools", []string{"Symbol", "Owner", "Stock In Pool", "Money In Pool"},
+		poolRows(report.BancorPools))
+	printTable(w, "AutoSwap pools", []string{"Symbol", "Owner", "Stock Reserve", "Money Reserve"},
+		poolRows(report.AutoSwapPools))
+
+	rows = make([][]string, len(report.Validators))
+	for i, v := range report.Validators {
+		rows[i] = []string{v.Operator, v.Moniker, v.Status, v.Tokens.String(),
+			strconv.Itoa(v.Delegators), v.DelegatorShares.String()}
+	}
+	printTable(w, "Delegations per validator",
+		[]string{"Operator", "Moniker", "Status", "Tokens", "Delegators", "Delegator Shares"}, rows)
+
+	rows = make([][]string, len(report.Proposals))
+	for i, p := range report.Proposals {
+		rows[i] = []string{strconv.FormatUint(p.ID, 10), p.Title, p.Status,
+			p.DepositEndTime.UTC().Format(time.RFC3339), p.VotingEndTime.UTC().Format(time.RFC3339)}
+	}
+	printTable(w, "Governance proposals in flight",
+		[]string{"ID", "Title", "Status", "Deposit End Time", "Voting End Time"}, rows)
+}
+
+func supplyRows(report genesisReport) [][]string {
+	denoms := make(map[string]bool)
+	for _, coins := range []sdk.Coins{report.TotalSupply, report.CirculatingSupply, report.OriginalVesting,
+		report.VestingCoins, report.LockedCoins, report.FrozenCoins} {
+		for _, c := range coins {
+			denoms[c.Denom] = true
+		}
+	}
+	sorted := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sorted = append(sorted, denom)
+	}
+	sort.Strings(sorted)
+
+	rows := make([][]string, len(sorted))
+	for i, denom := range sorted {
+		rows[i] = []string{denom,
+			report.TotalSupply.AmountOf(denom).String(),
+			report.CirculatingSupply.AmountOf(denom).String(),
+			report.OriginalVesting.AmountOf(denom).String(),
+			report.VestingCoins.AmountOf(denom).String(),
+			report.LockedCoins.AmountOf(denom).String(),
+			report.FrozenCoins.AmountOf(denom).String(),
+		}
+	}
+	return rows
+}
+
+func poolRows(pools []poolInfo) [][]string {
+	rows := make([][]string, len(pools))
+	for i, p := range pools {
+		rows[i] = []string{p.Symbol, p.Owner, p.Stock.String(), p.Money.String()}
+	}
+	return rows
+}
+
+func printTable(w io.Writer, title string, header []string, rows [][]string) {
+	fmt.Fprintln(w, title)
+	table := tablewriter.NewWriter(w)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	dex "github.com/coinexchain/cet-sdk/types"
+	"github.com/coinexchain/dex/app"
+)
+
+func TestBuildGenesisReport(t *testing.T) {
+	genesisTime := time.Unix(1000, 0)
+	gs := app.NewDefaultGenesisState()
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	acc1 := auth.NewBaseAccountWithAddress(addr1)
+	acc1.Coins = dex.NewCetCoins(100)
+	acc2 := auth.NewBaseAccountWithAddress(addr2)
+	acc2.Coins = dex.NewCetCoins(300)
+	vacc := auth.NewDelayedVestingAccount(&acc2, genesisTime.Add(time.Hour).Unix())
+	vacc.OriginalVesting = dex.NewCetCoins(200)
+
+	gacc1 := genaccounts.NewGenesisAccount(&acc1)
+	gacc2, err := genaccounts.NewGenesisAccountI(vacc)
+	require.NoError(t, err)
+	pool := supply.NewEmptyModuleAccount(staking.BondedPoolName, supply.Burner, supply.Staking)
+	pool.Coins = dex.NewCetCoins(50)
+	gacc3, err := genaccounts.NewGenesisAccountI(pool)
+	require.NoError(t, err)
+	gs.Accounts = genaccounts.GenesisState{gacc1, gacc2, gacc3}
+
+	gs.MarketData.MarketInfos = []market.MarketInfo{{Stock: "abc", Money: "cet"}, {Stock: "xyz", Money: "cet"}}
+	gs.MarketData.Orders = []*market.Order{
+		{Sender: addr1, Sequence: 1, TradingPair: "abc/cet"},
+		{Sender: addr1, Sequence: 2, TradingPair: "abc/cet"},
+	}
+
+	autoSwapData := autoswap.DefaultGenesisState()
+	autoSwapData.PoolInfos = []autoswap.PoolInfo{
+		{Symbol: "xyz/cet", Owner: addr1, StockAmmReserve: sdk.NewInt(1), MoneyAmmReserve: sdk.NewInt(2)},
+		{Symbol: "abc/cet", Owner: addr2, StockAmmReserve: sdk.NewInt(3), MoneyAmmReserve: sdk.NewInt(4)},
+	}
+
+	report := buildGenesisReport(gs, autoSwapData, genesisTime, 1, dex.CET)
+	require.Equal(t, 3, report.AccountCount)
+	require.Equal(t, dex.NewCetCoins(450), report.TotalSupply)
+	require.Equal(t, dex.NewCetCoins(200), report.CirculatingSupply)
+	require.Equal(t, dex.NewCetCoins(200), report.OriginalVesting)
+	require.Equal(t, dex.NewCetCoins(200), report.VestingCoins)
+	require.Equal(t, []holderInfo{{Address: addr2.String(), Amount: sdk.NewInt(300)}}, report.TopHolders)
+	require.Len(t, report.ModuleAccounts, 1)
+	require.Equal(t, staking.BondedPoolName, report.ModuleAccounts[0].Name)
+	require.Equal(t, []tradingPairInfo{{"abc/cet", 2}, {"xyz/cet", 0}}, report.TradingPairs)
+	require.Len(t, report.AutoSwapPools, 2)
+	require.Equal(t, "abc/cet", report.AutoSwapPools[0].Symbol)
+	require.Equal(t, "xyz/cet", report.AutoSwapPools[1].Symbol)
+
+	var buf bytes.Buffer
+	printGenesisReport(&buf, report)
+	require.Contains(t, buf.String(), "Top 1 holders of cet")
+	require.Contains(t, buf.String(), "abc/cet")
+}
+
+func TestGenesisInspectNegativeTop(t *testing.T) {
+	cmd := genesisInspectCmd(server.NewDefaultContext(), app.MakeCodec())
+	viper.Set(flagTopN, -1)
+	defer viper.Set(flagTopN, 20)
+	err := cmd.RunE(cmd, []string{"nonexistent.json"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "--top")
+}
+
+func TestInspectMalformedGenesis(t *testing.T) {
+	cdc := app.MakeCodec()
+	genDoc := &tmtypes.GenesisDoc{ChainID: "c", AppState: []byte(`{"bankx":{"params":"x"}}`)}
+	_, err := inspectGenesisDoc(cdc, genDoc, 20, dex.CET)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "bankx")
+}
+
+func TestSupplyRowsOriginalVesting(t *testing.T) {
+	report := genesisReport{
+		TotalSupply:     dex.NewCetCoins(100),
+		OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("abc", 5)),
+	}
+	require.Equal(t, [][]string{
+		{"abc", "0", "0", "5", "0", "0", "0"},
+		{"cet", "100", "0", "0", "0", "0", "0"},
+	}, supplyRows(report))
+}
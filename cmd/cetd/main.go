@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -22,7 +23,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/cosmos/cosmos-sdk/x/genaccounts"
-	genaccscli "github.com/cosmos/cosmos-sdk/x/genaccounts/client/cli"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
@@ -34,7 +34,10 @@ import (
 )
 
 // cetd custom flags
-const flagInvCheckPeriod = "inv-check-period"
+const (
+	flagInvCheckPeriod    = "inv-check-period"
+	flagJailWhitelistFile = "jail-whitelist-file"
+)
 
 var invCheckPeriod uint
 
@@ -68,6 +71,17 @@ func createCetdCmd() *cobra.Command {
 	addInitCommands(ctx, cdc, rootCmd)
 	rootCmd.AddCommand(client.NewCompletionCmd(rootCmd, true))
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
+	exportCmd, _, err := rootCmd.Find([]string{"export"})
+	if err != nil {
+		panic(err)
+	}
+	addJailWhitelistFileFlag(exportCmd)
+	startFlags := startCmdFlags(rootCmd)
+	addLogStoreHashesFlag(startFlags)
+	addInvariantMonitorFlags(startFlags)
+	addIndexerFlags(startFlags)
+	addTxDecoderFlag(startFlags)
+	addNotifyAminoTxJSONFlag(startFlags)
 
 	rootCmd.PersistentFlags().UintVar(&invCheckPeriod, flagInvCheckPeriod,
 		0, "Assert registered invariants every N blocks")
@@ -86,11 +100,17 @@ func addInitCommands(ctx *server.Context, cdc *codec.Codec, rootCmd *cobra.Comma
 	rootCmd.AddCommand(genutilcli.CollectGenTxsCmd(ctx, cdc, genaccounts.AppModuleBasic{}, app.DefaultNodeHome))
 	rootCmd.AddCommand(genutilcli.GenTxCmd(ctx, cdc, rawBasicManager, staking.AppModuleBasic{},
 		genaccounts.AppModuleBasic{}, app.DefaultNodeHome, app.DefaultCLIHome))
-	rootCmd.AddCommand(genutilcli.ValidateGenesisCmd(ctx, cdc, rawBasicManager))
-	rootCmd.AddCommand(genaccscli.AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
+	rootCmd.AddCommand(validateGenesisCmd(ctx, cdc, rawBasicManager))
+	rootCmd.AddCommand(addGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
 	rootCmd.AddCommand(assetcli.AddGenesisTokenCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
 	rootCmd.AddCommand(testnetCmd(ctx, cdc, app.ModuleBasics, genaccounts.AppModuleBasic{}))
 	rootCmd.AddCommand(migrateCmd(cdc))
+	rootCmd.AddCommand(genesisCmd(ctx, cdc))
+	rootCmd.AddCommand(streamExportCmd(ctx, cdc))
+	rootCmd.AddCommand(shadowForkCmd(ctx, cdc))
+	rootCmd.AddCommand(snapshotCmd(ctx))
+	rootCmd.AddCommand(rollbackCmd(ctx))
+	rootCmd.AddCommand(debugCmd(ctx))
 }
 
 func adjustBlockCommitSpeed(config *tmconfig.Config) {
@@ -119,18 +139,67 @@ func checkMinGasPrice(bApp *app.CetChainApp, logger log.Logger) {
 	}
 }
 
+// addJailWhitelistFileFlag lets an export command load the jail whitelist from a file
+func addJailWhitelistFileFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagJailWhitelistFile, "",
+		"File of the validators not to jail in a zero-height export, one operator address per line")
+}
+
+// startCmdFlags returns the flags of the start command of server
+func startCmdFlags(rootCmd *cobra.Command) *pflag.FlagSet {
+	startCmd, _, err := rootCmd.Find([]string{"start"})
+	if err != nil {
+		panic(err)
+	}
+	return startCmd.Flags()
+}
+
+// addLogStoreHashesFlag lets the start command of server log the commit hash of each store every block
+func addLogStoreHashesFlag(startFlags *pflag.FlagSet) {
+	startFlags.Bool(app.FlagLogStoreHashes, false,
+		"Log the commit hash of each store every block, to find the stores diverged from other nodes")
+}
+
+// addInvariantMonitorFlags lets the start command of server check the invariants after the blocks are committed
+func addInvariantMonitorFlags(startFlags *pflag.FlagSet) {
+	startFlags.Int64(app.FlagInvariantMonitorPeriod, 0,
+		"Check the registered invariants every N blocks after they are committed without halting on broken ones, 0 to disable")
+	startFlags.String(app.FlagInvariantMonitorReport, "",
+		"File to write the JSON report of the latest invariant check to, $HOME/data/invariant-report.json by default")
+}
+
+// addIndexerFlags lets the start command of server keep the indexes queried through the LCD
+func addIndexerFlags(startFlags *pflag.FlagSet) {
+	startFlags.Bool(app.FlagIndexAddressTxs, false,
+		"Index the txs by the addresses taking part in them, in $HOME/data/indexer.db")
+	startFlags.Bool(app.FlagIndexBalanceHistory, false,
+		"Keep the history of the balances of all the addresses, in $HOME/data/indexer.db")
+}
+
+// addTxDecoderFlag lets the start command of server select the decoder of the txs
+func addTxDecoderFlag(startFlags *pflag.FlagSet) {
+	startFlags.String(app.FlagTxDecoder, app.TxDecoderAmino,
+		"Decoder of the txs: amino; or shadow to also encode each tx decoded by amino with codon, decode it back "+
+			"and log the mismatches, which never decodes the bytes of the txs with codon")
+}
+
+// addNotifyAminoTxJSONFlag lets the start command of server publish the txs as amino JSON
+func addNotifyAminoTxJSONFlag(startFlags *pflag.FlagSet) {
+	startFlags.Bool(app.FlagNotifyAminoTxJSON, false,
+		"Write tx_json of the notify_tx messages as the amino JSON of the tx instead of the output of encoding/json")
+}
+
 func exportAppStateAndTMValidators(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string,
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
 
-	if height != -1 {
-		gApp := app.NewCetChainApp(logger, db, traceStore, false, uint(1))
-		err := gApp.LoadHeight(height)
-		if err != nil {
-			return nil, nil, err
-		}
-		return gApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+	jailWhiteList, err := getJailWhiteList(jailWhiteList)
+	if err != nil {
+		return nil, nil, err
+	}
+	gApp, err := loadAppForExport(logger, db, traceStore, height)
+	if err != nil {
+		return nil, nil, err
 	}
-	gApp := app.NewCetChainApp(logger, db, traceStore, true, uint(1))
 	return gApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 }
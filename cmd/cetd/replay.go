@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/coinexchain/cet-sdk/msgqueue"
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/app/storeutil"
+)
+
+const (
+	flagFrom = "from"
+	flagTo   = "to"
+)
+
+func replayCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-execute the stored blocks of a stopped node and compare the results",
+		Long: `Re-execute the blocks after a height from the block store of a stopped node,
+without networking, and compare the app hash after each block and the result of
+each tx with the ones recorded by the node. The first divergence is printed as
+JSON with the tx and its events, and the command fails.
+
+The app state at --from is copied to a temporary DB first, so the node's data is
+not changed, and it must not have been pruned. Running it with a new binary
+tells whether the binary reproduces the chain before it is deployed. The
+brokers, indexes, invariant monitor and store hash logging configured for the
+node are turned off in the replay.
+
+Example:
+	cetd debug replay --from 1000 --to 2000
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			from, to := viper.GetInt64(flagFrom), viper.GetInt64(flagTo)
+			dbs, err := openNodeDBs(config)
+			if err != nil {
+				return err
+			}
+			defer dbs.Close()
+			if to == 0 {
+				to = store.NewBlockStore(dbs.blockStore).Height()
+			}
+			if from <= 0 || to <= from {
+				return fmt.Errorf("invalid range of heights to replay: (%d, %d]", from, to)
+			}
+
+			tmpDir, err := ioutil.TempDir("", "cetd-replay")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmpDir)
+			scratch, err := sdk.NewLevelDB("application", tmpDir)
+			if err != nil {
+				return err
+			}
+			defer scratch.Close()
+
+			div, err := replayBlocks(ctx.Logger, dbs, scratch, from, to, func(height int64, txs int) {
+				cmd.PrintErrf("Replayed block %d with %d txs\n", height, txs)
+			})
+			if err != nil {
+				return err
+			}
+			if div != nil {
+				bz, err := json.MarshalIndent(div, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+				return fmt.Errorf("diverged at height %d: %s", div.Height, div.Reason)
+			}
+			cmd.PrintErrf("Replayed blocks %d to %d, all the results match\n", from+1, to)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "Height of the app state to start from, the blocks after it are replayed")
+	cmd.Flags().Int64(flagTo, 0, "Height of the last block to replay, 0 for the latest block")
+	return cmd
+}
+
+// replayDivergence is the first difference between the replayed blocks and
+// the ones recorded by the node
+type replayDivergence struct {
+	Height          int64               `json:"height"`
+	Reason          string              `json:"reason"`
+	ExpectedAppHash cmn.HexBytes        `json:"expected_app_hash,omitempty"`
+	AppHash         cmn.HexBytes        `json:"app_hash,omitempty"`
+	DivergedStores  []string            `json:"diverged_stores,omitempty"`
+	Tx              *replayTxDivergence `json:"tx,omitempty"`
+}
+
+type replayTxDivergence struct {
+	Index    int                     `json:"index"`
+	Hash     cmn.HexBytes            `json:"hash"`
+	Tx       json.RawMessage         `json:"tx"`
+	Fields   []string                `json:"diverged_fields"`
+	Expected *abci.ResponseDeliverTx `json:"expected"`
+	Actual   *abci.ResponseDeliverTx `json:"actual"`
+}
+
+// recordingApp keeps the DeliverTx results of the current block
+type recordingApp struct {
+	abci.Application
+	deliverTxs []*abci.ResponseDeliverTx
+}
+
+func (a *recordingApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	a.deliverTxs = nil
+	return a.Application.BeginBlock(req)
+}
+
+func (a *recordingApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := a.Application.DeliverTx(req)
+	a.deliverTxs = append(a.deliverTxs, &res)
+	return res
+}
+
+// replayBlocks copies the app state at from into scratch, and executes the
+// blocks from+1 to to on it in the same way as tendermint does. It returns
+// the first divergence from the recorded app hashes and tx results, or nil.
+func replayBlocks(logger log.Logger, dbs *nodeDBs, scratch dbm.DB, from, to int64,
+	progress func(height int64, txs int)) (*replayDivergence, error) {
+
+	blockStore := store.NewBlockStore(dbs.blockStore)
+	if to > blockStore.Height() {
+		return nil, fmt.Errorf("block %d not found, the latest one is %d", to, blockStore.Height())
+	}
+	expectedAppHash, _, err := recordedHashes(dbs.state, blockStore, from)
+	if err != nil {
+		return nil, err
+	}
+	ci, err := storeutil.CopyVersion(dbs.app, scratch, from)
+	if err != nil {
+		return nil, fmt.Errorf("can not load app state at height %d: %v", from, err)
+	}
+	if !bytes.Equal(ci.Hash(), expectedAppHash) {
+		return nil, fmt.Errorf("app hash %X at height %d does not match %X recorded by tendermint",
+			ci.Hash(), from, expectedAppHash)
+	}
+
+	disableNodeSideEffects()
+	cdc := app.MakeCodec()
+	rec := &recordingApp{Application: app.NewCetChainApp(logger, scratch, nil, true, 0)}
+	conn := proxy.NewAppConnConsensus(abcicli.NewLocalClient(new(sync.Mutex), rec))
+	for height := from + 1; height <= to; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+		appHash, err := sm.ExecCommitBlock(conn, block, logger, dbs.state)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute block %d: %v", height, err)
+		}
+		progress(height, len(block.Txs))
+
+		expectedAppHash, expectedResultsHash, err := recordedHashes(dbs.state, blockStore, height)
+		if err != nil {
+			return nil, err
+		}
+		if responses, err := sm.LoadABCIResponses(dbs.state, height); err == nil {
+			if div := diffDeliverTxs(cdc, block, responses.DeliverTx, rec.deliverTxs); div != nil {
+				return &replayDivergence{Height: height, Reason: "tx result mismatch", Tx: div}, nil
+			}
+		}
+		if expectedResultsHash != nil && !bytes.Equal(tmtypes.NewResults(rec.deliverTxs).Hash(), expectedResultsHash) {
+			return &replayDivergence{Height: height, Reason: "tx results hash mismatch"}, nil
+		}
+		if !bytes.Equal(appHash, expectedAppHash) {
+			return &replayDivergence{
+				Height:          height,
+				Reason:          "app hash mismatch",
+				ExpectedAppHash: expectedAppHash,
+				AppHash:         appHash,
+				DivergedStores:  divergedStores(dbs.app, scratch, height),
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// disableNodeSideEffects turns off the options of the node in the config which
+// make the app push messages to the brokers, write the indexes or check the
+// invariants, as the replayed app must not do so
+func disableNodeSideEffects() {
+	viper.Set(msgqueue.FlagBrokers, []string{})
+	viper.Set(msgqueue.FlagFeatureToggle, false)
+	viper.Set(app.FlagIndexAddressTxs, false)
+	viper.Set(app.FlagIndexBalanceHistory, false)
+	viper.Set(app.FlagInvariantMonitorPeriod, int64(0))
+	viper.Set(app.FlagLogStoreHashes, false)
+}
+
+// recordedHashes returns the app hash after the block at height was committed,
+// and the hash of its tx results, which are in the header of the next block or
+// in the latest state. The results hash is nil if they are not recorded yet.
+func recordedHashes(stateDB dbm.DB, blockStore *store.BlockStore, height int64) (appHash, resultsHash []byte, err error) {
+	if meta := blockStore.LoadBlockMeta(height + 1); meta != nil {
+		return meta.Header.AppHash, meta.Header.LastResultsHash, nil
+	}
+	state := sm.LoadState(stateDB)
+	if state.LastBlockHeight == height {
+		return state.AppHash, state.LastResultsHash, nil
+	}
+	return nil, nil, fmt.Errorf("app hash of height %d not found", height)
+}
+
+// diffDeliverTxs compares the DeliverTx results of a block with the recorded ones
+func diffDeliverTxs(cdc *codec.Codec, block *tmtypes.Block, expected, actual []*abci.ResponseDeliverTx) *replayTxDivergence {
+	for i, tx := range block.Txs {
+		var exp, act *abci.ResponseDeliverTx
+		if i < len(expected) {
+			exp = expected[i]
+		}
+		if i < len(actual) {
+			act = actual[i]
+		}
+		fields := diffDeliverTx(exp, act)
+		if len(fields) == 0 {
+			continue
+		}
+		return &replayTxDivergence{
+			Index:    i,
+			Hash:     tx.Hash(),
+			Tx:       decodeTxJSON(cdc, tx),
+			Fields:   fields,
+			Expected: exp,
+			Actual:   act,
+		}
+	}
+	return nil
+}
+
+// diffDeliverTx returns the names of the fields which differ between two
+// DeliverTx results, the events are compared in their amino encoding, which
+// does not tell a nil slice from an empty one
+func diffDeliverTx(exp, act *abci.ResponseDeliverTx) []string {
+	if exp == nil || act == nil {
+		if exp == act {
+			return nil
+		}
+		return []string{"missing"}
+	}
+	var fields []string
+	if exp.Code != act.Code || exp.Codespace != act.Codespace {
+		fields = append(fields, "code")
+	}
+	if !bytes.Equal(exp.Data, act.Data) {
+		fields = append(fields, "data")
+	}
+	if exp.Log != act.Log {
+		fields = append(fields, "log")
+	}
+	if exp.GasWanted != act.GasWanted || exp.GasUsed != act.GasUsed {
+		fields = append(fields, "gas")
+	}
+	if !bytes.Equal(tmCdc.MustMarshalBinaryBare(exp.Events), tmCdc.MustMarshalBinaryBare(act.Events)) {
+		fields = append(fields, "events")
+	}
+	return fields
+}
+
+func decodeTxJSON(cdc *codec.Codec, txBytes []byte) json.RawMessage {
+	if tx, err := auth.DefaultTxDecoder(cdc)(txBytes); err == nil {
+		if bz, err := cdc.MarshalJSON(tx); err == nil {
+			return bz
+		}
+	}
+	bz, _ := json.Marshal(hex.EncodeToString(txBytes))
+	return bz
+}
+
+// divergedStores returns the stores whose commit hashes at height differ
+// between the node and the replay, if the node still has the version
+func divergedStores(nodeDB, replayDB dbm.DB, height int64) []string {
+	nodeCI, err := storeutil.GetCommitInfo(nodeDB, height)
+	if err != nil {
+		return nil
+	}
+	replayCI, err := storeutil.GetCommitInfo(replayDB, height)
+	if err != nil {
+		return nil
+	}
+	hashes := make(map[string][]byte)
+	for _, si := range nodeCI.StoreInfos {
+		hashes[si.Name] = si.Core.CommitID.Hash
+	}
+	var names []string
+	for _, si := range replayCI.SortedStoreInfos() {
+		if hash, ok := hashes[si.Name]; !ok || !bytes.Equal(hash, si.Core.CommitID.Hash) {
+			names = append(names, si.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/dex/app"
+)
+
+func TestDiffDeliverTxs(t *testing.T) {
+	events := []abci.Event{{Type: "message", Attributes: []cmn.KVPair{{Key: []byte("action"), Value: []byte("send")}}}}
+	res := func() *abci.ResponseDeliverTx {
+		return &abci.ResponseDeliverTx{Log: "ok", GasWanted: 200000, GasUsed: 50000, Events: events}
+	}
+	require.Nil(t, diffDeliverTx(res(), res()))
+	require.Nil(t, diffDeliverTx(nil, nil))
+	require.Equal(t, []string{"missing"}, diffDeliverTx(res(), nil))
+
+	act := res()
+	act.Code, act.GasUsed = 12, 50001
+	act.Events = nil
+	require.Equal(t, []string{"code", "gas", "events"}, diffDeliverTx(res(), act))
+
+	block := &tmtypes.Block{Data: tmtypes.Data{Txs: tmtypes.Txs{[]byte("tx0"), []byte("tx1")}}}
+	cdc := app.MakeCodec()
+	require.Nil(t, diffDeliverTxs(cdc, block, []*abci.ResponseDeliverTx{res(), res()},
+		[]*abci.ResponseDeliverTx{res(), res()}))
+	div := diffDeliverTxs(cdc, block, []*abci.ResponseDeliverTx{res(), res()},
+		[]*abci.ResponseDeliverTx{res(), act})
+	require.Equal(t, 1, div.Index)
+	require.Equal(t, cmn.HexBytes(tmtypes.Tx("tx1").Hash()), div.Hash)
+	require.Equal(t, `"747831"`, string(div.Tx))
+	require.Equal(t, act, div.Actual)
+	div = diffDeliverTxs(cdc, block, []*abci.ResponseDeliverTx{res(), res()}, []*abci.ResponseDeliverTx{res()})
+	require.Equal(t, []string{"missing"}, div.Fields)
+}
+
+// commitTestChain executes and stores the blocks 1 to height with a tx which
+// fails to decode in each, as a node with no validators would do
+func commitTestChain(t *testing.T, height int64) *nodeDBs {
+	dbs := &nodeDBs{app: dbm.NewMemDB(), state: dbm.NewMemDB(), blockStore: dbm.NewMemDB()}
+	logger := log.NewNopLogger()
+	node := &recordingApp{Application: app.NewCetChainApp(logger, dbs.app, nil, true, 0,
+		baseapp.SetPruning(storetypes.PruneNothing))}
+	cdc := app.MakeCodec()
+	genState := app.ModuleBasics.DefaultGenesis()
+	addCetTokenForTesting(cdc, genState, sdk.NewInt(1e8), sdk.AccAddress("owner_address_20byte"))
+	appState := cdc.MustMarshalJSON(genState)
+	node.InitChain(abci.RequestInitChain{ChainId: "test", AppStateBytes: appState})
+
+	vals := tmtypes.NewValidatorSet(nil)
+	state := sm.State{
+		ChainID:                     "test",
+		Validators:                  vals,
+		NextValidators:              vals,
+		LastValidators:              vals,
+		LastHeightValidatorsChanged: 2,
+		ConsensusParams:             *tmtypes.DefaultConsensusParams(),
+	}
+	sm.SaveState(dbs.state, state)
+
+	blockStore := store.NewBlockStore(dbs.blockStore)
+	conn := proxy.NewAppConnConsensus(abcicli.NewLocalClient(new(sync.Mutex), node))
+	var appHash, resultsHash []byte
+	for h := int64(1); h <= height; h++ {
+		block := tmtypes.MakeBlock(h, []tmtypes.Tx{[]byte(fmt.Sprintf("tx%d", h))}, &tmtypes.Commit{}, nil)
+		block.ChainID, block.Time = "test", time.Unix(1600000000+h, 0).UTC()
+		block.AppHash, block.LastResultsHash = appHash, resultsHash
+		var err error
+		appHash, err = sm.ExecCommitBlock(conn, block, logger, dbs.state)
+		require.Nil(t, err)
+		resultsHash = tmtypes.NewResults(node.deliverTxs).Hash()
+		dbs.state.Set(calcABCIResponsesKey(h), (&sm.ABCIResponses{DeliverTx: node.deliverTxs}).Bytes())
+		blockStore.SaveBlock(block, block.MakePartSet(tmtypes.BlockPartSizeBytes), &tmtypes.Commit{})
+
+		// the validators are saved as changed at every height, as tendermint
+		// can not load an empty set saved at an earlier height
+		state.LastBlockHeight, state.LastHeightValidatorsChanged = h, h+2
+		state.AppHash, state.LastResultsHash = appHash, resultsHash
+		sm.SaveState(dbs.state, state)
+	}
+	return dbs
+}
+
+func TestReplayBlocks(t *testing.T) {
+	dbs := commitTestChain(t, 4)
+	viper.Set(app.FlagIndexAddressTxs, true)
+	var replayed []int64
+	div, err := replayBlocks(log.NewNopLogger(), dbs, dbm.NewMemDB(), 1, 4, func(height int64, txs int) {
+		require.Equal(t, 1, txs)
+		replayed = append(replayed, height)
+	})
+	require.Nil(t, err)
+	require.Nil(t, div)
+	require.Equal(t, []int64{2, 3, 4}, replayed)
+	require.False(t, viper.GetBool(app.FlagIndexAddressTxs))
+
+	_, err = replayBlocks(log.NewNopLogger(), dbs, dbm.NewMemDB(), 1, 5, func(int64, int) {})
+	require.Error(t, err)
+
+	// the recorded result of the tx in block 3 is changed
+	responses, err := sm.LoadABCIResponses(dbs.state, 3)
+	require.Nil(t, err)
+	responses.DeliverTx[0].Log = "changed"
+	dbs.state.Set(calcABCIResponsesKey(3), responses.Bytes())
+	div, err = replayBlocks(log.NewNopLogger(), dbs, dbm.NewMemDB(), 1, 4, func(int64, int) {})
+	require.Nil(t, err)
+	require.Equal(t, int64(3), div.Height)
+	require.Equal(t, "tx result mismatch", div.Reason)
+	require.Equal(t, []string{"log"}, div.Tx.Fields)
+	require.Equal(t, `"747833"`, string(div.Tx.Tx))
+}
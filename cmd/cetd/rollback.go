@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmconfig "github.com/tendermint/tendermint/config"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/coinexchain/dex/app/storeutil"
+)
+
+const flagBlocks = "blocks"
+
+// rollbackCmd moves the app state and the tendermint state of a stopped node
+// back to an earlier height
+func rollbackCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll the state of a stopped node back by some blocks",
+		Long: `Roll the state of a stopped node back by some blocks, to re-execute them.
+
+Every store of the app state and the tendermint state are moved back to the
+height N blocks before the latest one, and the blocks after it are deleted from
+the block store, so they are synced from peers again when the node restarts.
+It refuses to act if the app state at that height has been pruned, or does not
+match the app hash recorded by tendermint.
+
+A validator keeps its priv_validator_state.json, so it does not sign the rolled
+back heights again.
+
+Example:
+	cetd rollback --blocks 1
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			blocks := viper.GetInt64(flagBlocks)
+			if blocks <= 0 {
+				return fmt.Errorf("--%s must be positive", flagBlocks)
+			}
+			from, state, err := rollback(config, blocks)
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("Rolled back from height %d to %d, app hash %X\n",
+				from, state.LastBlockHeight, state.AppHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagBlocks, 1, "Number of the latest blocks to roll back")
+	return cmd
+}
+
+func rollback(config *tmconfig.Config, blocks int64) (int64, sm.State, error) {
+	dbs, err := openNodeDBs(config)
+	if err != nil {
+		return 0, sm.State{}, err
+	}
+	defer dbs.Close()
+
+	latest := sm.LoadState(dbs.state).LastBlockHeight
+	height := latest - blocks
+	if height < 1 {
+		return 0, sm.State{}, fmt.Errorf("can not roll back %d blocks from height %d", blocks, latest)
+	}
+	state, err := loadStateAtHeight(dbs.state, store.NewBlockStore(dbs.blockStore), height)
+	if err != nil {
+		return 0, state, err
+	}
+	ci, err := storeutil.CheckVersion(dbs.app, height)
+	if err != nil {
+		return 0, state, err
+	}
+	if !bytes.Equal(ci.Hash(), state.AppHash) {
+		return 0, state, fmt.Errorf("app hash %X at height %d does not match %X recorded by tendermint",
+			ci.Hash(), height, state.AppHash)
+	}
+
+	if _, err := storeutil.Rollback(dbs.app, height); err != nil {
+		return 0, state, err
+	}
+	if err := rollbackBlockStore(dbs.blockStore, height); err != nil {
+		return 0, state, err
+	}
+	sm.SaveState(dbs.state, state)
+	return latest, state, nil
+}
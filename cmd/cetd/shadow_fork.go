@@ -0,0 +1,235 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmconfig "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	dex "github.com/coinexchain/cet-sdk/types"
+	"github.com/coinexchain/dex/app"
+)
+
+const (
+	flagValidator    = "validator"
+	flagFundAccounts = "fund-accounts"
+	flagFundAmount   = "fund-amount"
+	flagMigrate      = "migrate"
+)
+
+// shadowForkCmd creates the home dirs of a one-node chain which runs against
+// the real state exported from another chain
+func shadowForkCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shadow-fork [exported-genesis-file]",
+		Short: "Create a one-node chain with the real state, to rehearse upgrades locally",
+		Long: `Create the home dirs of a one-node chain with the state exported from a real chain.
+
+The state is read from a genesis file made by 'cetd export --for-zero-height',
+or when it is not given, exported with --for-zero-height from the node at --home.
+
+The bonded validator with the most tokens (or --validator) is taken over by a
+newly generated consensus key, and the other validators are jailed and unbonded,
+so the new node has all the voting power. With --fund-accounts, test keys are
+created in the cli home and funded with newly minted --fund-amount.
+
+Example:
+	cetd shadow-fork exported.json --output-dir ./shadow --fund-accounts 2
+	cetd start --home ./shadow/cetd
+`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			var genDoc *tmtypes.GenesisDoc
+			var err error
+			if len(args) != 0 {
+				genDoc, err = tmtypes.GenesisDocFromFile(args[0])
+			} else {
+				genDoc, err = exportForShadowFork(config)
+			}
+			if err != nil {
+				return err
+			}
+
+			params, err := getShadowForkParams()
+			if err != nil {
+				return err
+			}
+			return initShadowFork(cmd, config, cdc, genDoc, params,
+				viper.GetString(flagOutputDir), viper.GetString(client.FlagChainID),
+				viper.GetString(server.FlagMinGasPrices), viper.GetInt(flagFundAccounts))
+		},
+	}
+
+	cmd.Flags().StringP(flagOutputDir, "o", "./shadowfork",
+		"Directory to store the home dirs of the node's daemon and cli")
+	cmd.Flags().String(client.FlagChainID, "",
+		"Chain-id of the shadow fork, the original chain-id with a suffix of '-shadow' if left blank")
+	cmd.Flags().String(flagValidator, "",
+		"Operator address of the validator to take over, the bonded one with the most tokens if left blank")
+	cmd.Flags().Int(flagFundAccounts, 0, "Number of test accounts to create and fund")
+	cmd.Flags().String(flagFundAmount, "1000000000000cet", "Coins minted for each test account")
+	cmd.Flags().Bool(flagMigrate, false, "Upgrade the genesis state like the migrate command")
+	cmd.Flags().String(
+		server.FlagMinGasPrices, fmt.Sprintf("%s%s", authx.DefaultMinGasPriceLimit, dex.DefaultBondDenom),
+		"Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 20cet)")
+	return cmd
+}
+
+func getShadowForkParams() (app.ShadowForkParams, error) {
+	var params app.ShadowForkParams
+	if s := viper.GetString(flagValidator); s != "" {
+		operator, err := sdk.ValAddressFromBech32(s)
+		if err != nil {
+			return params, err
+		}
+		params.Operator = operator
+	}
+	if viper.GetInt(flagFundAccounts) < 0 {
+		return params, errors.New("--fund-accounts can not be negative")
+	}
+	amount, err := sdk.ParseCoins(viper.GetString(flagFundAmount))
+	if err != nil {
+		return params, err
+	}
+	params.FundAmount = amount
+	params.GenesisTime = tmtime.Now()
+	return params, nil
+}
+
+// exportForShadowFork exports the state of the node at config's root for zero height
+func exportForShadowFork(config *tmconfig.Config) (*tmtypes.GenesisDoc, error) {
+	db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	if db.Stats()["leveldb.sstables"] == "" {
+		return nil, errors.New("state is not initialized")
+	}
+
+	genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return nil, err
+	}
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stderr))
+	gApp, err := loadAppForExport(logger, db, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	genDoc.AppState, _, err = gApp.ExportAppStateAndValidators(true, nil)
+	return genDoc, err
+}
+
+func initShadowFork(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
+	genDoc *tmtypes.GenesisDoc, params app.ShadowForkParams,
+	outputDir, chainID, minGasPrices string, numFundAccounts int) error {
+
+	nodeDir := filepath.Join(outputDir, "cetd")
+	clientDir := filepath.Join(outputDir, "cetcli")
+	if err := mkNodeHomeDirs(outputDir, nodeDir, clientDir); err != nil {
+		return err
+	}
+
+	config.SetRoot(nodeDir)
+	config.Moniker = "shadow"
+	config.P2P.Seeds = ""
+	config.P2P.PersistentPeers = ""
+	adjustBlockCommitSpeed(config)
+
+	_, valPubKey, err := genutil.InitializeNodeValidatorFiles(config)
+	if err != nil {
+		_ = os.RemoveAll(outputDir)
+		return err
+	}
+	params.ConsPubKey = valPubKey
+
+	secrets := make(map[string]string, numFundAccounts)
+	for i := 0; i < numFundAccounts; i++ {
+		name := fmt.Sprintf("test%d", i)
+		addr, secret, err := server.GenerateSaveCoinKey(clientDir, name, app.DefaultKeyPass, true)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+		params.FundAccounts = append(params.FundAccounts, addr)
+		secrets[name] = secret
+	}
+
+	var appState map[string]json.RawMessage
+	if err := cdc.UnmarshalJSON(genDoc.AppState, &appState); err != nil {
+		return err
+	}
+	genState := app.FromMap(cdc, appState)
+	if viper.GetBool(flagMigrate) {
+		upgradeGenesisState(&genState)
+	}
+	genVal, err := app.ShadowFork(&genState, params)
+	if err != nil {
+		_ = os.RemoveAll(outputDir)
+		return err
+	}
+	if errs := app.ValidateGenesisDeep(genState); len(errs) != 0 {
+		for _, err := range errs {
+			cmd.PrintErrln(err)
+		}
+		cmd.PrintErrln("WARNING: the state of the shadow fork is not consistent")
+	}
+	// modules which are not in GenesisState, such as autoswap, are kept as is
+	for name, state := range genState.ToMap(cdc) {
+		if _, ok := appState[name]; ok {
+			appState[name] = state
+		}
+	}
+
+	if chainID == "" {
+		chainID = genDoc.ChainID + "-shadow"
+	}
+	genDoc.ChainID = chainID
+	genDoc.GenesisTime = params.GenesisTime
+	genDoc.Validators = []tmtypes.GenesisValidator{genVal}
+	if genDoc.AppState, err = cdc.MarshalJSON(appState); err != nil {
+		return err
+	}
+	if err := genDoc.SaveAs(config.GenesisFile()); err != nil {
+		return err
+	}
+
+	if numFundAccounts != 0 {
+		bz, err := json.Marshal(secrets)
+		if err != nil {
+			return err
+		}
+		// save private key seed words
+		if err := writeFile("key_seeds.json", clientDir, bz); err != nil {
+			return err
+		}
+	}
+	dexConfig := srvconfig.DefaultConfig()
+	dexConfig.MinGasPrices = minGasPrices
+	srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config/app.toml"), dexConfig)
+	tmconfig.WriteConfigFile(filepath.Join(nodeDir, "config/config.toml"), config)
+
+	cmd.PrintErrf("Shadow fork %s is initialized in %s, validator %s is taken over\n",
+		chainID, outputDir, genVal.Name)
+	return nil
+}
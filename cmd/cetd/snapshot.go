@@ -0,0 +1,524 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmconfig "github.com/tendermint/tendermint/config"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/coinexchain/dex/app/storeutil"
+)
+
+const (
+	snapshotManifestFile = "snapshot.json"
+	snapshotDataDir      = "data"
+
+	flagTrustHeight  = "trust-height"
+	flagTrustAppHash = "trust-app-hash"
+)
+
+// the DB dirs in a snapshot, under snapshotDataDir
+var snapshotDBDirs = []string{"application.db", "state.db", "blockstore.db"}
+
+// SnapshotManifest is the first file of a snapshot archive
+type SnapshotManifest struct {
+	ChainID string         `json:"chain_id"`
+	Height  int64          `json:"height"`
+	AppHash cmn.HexBytes   `json:"app_hash"`
+	Files   []SnapshotFile `json:"files"`
+}
+
+// snapshotTrust is the height and the app hash of a snapshot, as known from a
+// source trusted more than the provider of the snapshot
+type snapshotTrust struct {
+	Height  int64
+	AppHash cmn.HexBytes
+}
+
+// SnapshotFile is the checksum of a file in a snapshot archive
+type SnapshotFile struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+func snapshotCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Create or restore state snapshots, to bootstrap new nodes",
+	}
+	cmd.AddCommand(
+		snapshotCreateCmd(ctx),
+		snapshotRestoreCmd(ctx),
+	)
+	return cmd
+}
+
+func snapshotCreateCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Write the state at a height to a snapshot archive",
+		Long: `Write the app state and the tendermint state at a height to a compressed archive.
+
+Only the version of the IAVL stores at the height and the last block are taken,
+every IAVL node is checked against its hash, and the app hash against the one
+recorded by tendermint. The node must be stopped.
+
+Example:
+	cetd snapshot create --height 1000000 --output snapshot.tar.gz
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			output := viper.GetString(flagOutput)
+			manifest, err := createSnapshot(config, viper.GetInt64(flagHeight), output)
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("Snapshot of %s at height %d (app hash %s) is written to %s\n",
+				manifest.ChainID, manifest.Height, manifest.AppHash, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height of the snapshot, the latest height if 0")
+	cmd.Flags().StringP(flagOutput, "o", "snapshot.tar.gz", "Path of the snapshot archive")
+	return cmd
+}
+
+func snapshotRestoreCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [snapshot-file]",
+		Short: "Restore the data dir of a new node from a snapshot archive",
+		Long: `Restore the data dir of a new node from a snapshot archive.
+
+The checksums of the files in the archive are checked, and every IAVL node is
+checked against its hash, before the app hash is checked against the one in
+the snapshot and the one recorded by tendermint, and the last block against
+the commit signed by its validators. The DBs are moved to the data dir only
+after all these checks pass. The data dir must have no DBs, and the genesis
+file, if any, must be of the same chain.
+
+All these checks are against the archive itself, so a consistent archive with
+a forged state passes them. Pass --trust-height and --trust-app-hash, taken
+from a source you trust, such as your own node or a block explorer, to check
+the app state against them: the app hash of the state at a height is the
+app_hash in the header of the next block. Without them, the restored state
+is only as trustworthy as whoever provided the archive.
+
+Example:
+	cetd init mynode --chain-id coinexdex2
+	cetd snapshot restore snapshot.tar.gz --trust-height 1000000 --trust-app-hash 5E3A...
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			trust, err := getSnapshotTrust()
+			if err != nil {
+				return err
+			}
+			if trust == nil {
+				cmd.PrintErrln("WARNING: no --trust-height and --trust-app-hash are given, " +
+					"the snapshot is trusted as much as whoever provided it")
+			}
+			manifest, err := restoreSnapshot(config, args[0], trust)
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("Snapshot of %s at height %d (app hash %s) is restored to %s\n",
+				manifest.ChainID, manifest.Height, manifest.AppHash, config.RootDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagTrustHeight, 0, "Height of the snapshot, from a trusted source")
+	cmd.Flags().String(flagTrustAppHash, "",
+		"App hash in hex of the state at --trust-height, from a trusted source, which is the app_hash of the next block")
+	return cmd
+}
+
+// getSnapshotTrust returns nil if no trusted height and app hash are given
+func getSnapshotTrust() (*snapshotTrust, error) {
+	height, appHash := viper.GetInt64(flagTrustHeight), viper.GetString(flagTrustAppHash)
+	if height == 0 && appHash == "" {
+		return nil, nil
+	}
+	if height <= 0 || appHash == "" {
+		return nil, fmt.Errorf("--%s and --%s must be given together", flagTrustHeight, flagTrustAppHash)
+	}
+	bz, err := hex.DecodeString(appHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %s", flagTrustAppHash, err.Error())
+	}
+	return &snapshotTrust{Height: height, AppHash: bz}, nil
+}
+
+func createSnapshot(config *tmconfig.Config, height int64, output string) (*SnapshotManifest, error) {
+	src, err := openNodeDBs(config)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	if height == 0 {
+		height = sm.LoadState(src.state).LastBlockHeight
+	}
+	state, err := loadStateAtHeight(src.state, store.NewBlockStore(src.blockStore), height)
+	if err != nil {
+		return nil, err
+	}
+	if latest := storeutil.GetLatestVersion(src.app); height > latest {
+		return nil, fmt.Errorf("height %d is beyond the app state, whose latest height is %d", height, latest)
+	}
+
+	tmpDir, err := ioutil.TempDir(filepath.Dir(output), "snapshot")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	dataDir := filepath.Join(tmpDir, snapshotDataDir)
+	dst, err := openDBs(dbm.GoLevelDBBackend, dataDir, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	ci, err := storeutil.CopyVersion(src.app, dst.app, height)
+	if err == nil && !bytes.Equal(ci.Hash(), state.AppHash) {
+		err = fmt.Errorf("app hash %X at height %d does not match %X recorded by tendermint",
+			ci.Hash(), height, state.AppHash)
+	}
+	if err == nil {
+		err = copyTendermintState(state, src.state, src.blockStore, dst.state, dst.blockStore)
+	}
+	dst.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &SnapshotManifest{
+		ChainID: state.ChainID,
+		Height:  height,
+		AppHash: state.AppHash,
+	}
+	if err := writeSnapshotArchive(tmpDir, manifest, output); err != nil {
+		_ = os.Remove(output)
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// restoreSnapshot checks the snapshot against trust, unless it is nil
+func restoreSnapshot(config *tmconfig.Config, file string, trust *snapshotTrust) (*SnapshotManifest, error) {
+	appDataDir := filepath.Join(config.RootDir, "data")
+	for _, dir := range snapshotDBDirs {
+		path := snapshotDBPath(config, dir)
+		if _, err := os.Stat(path); err == nil {
+			return nil, fmt.Errorf("%s exists, the data dir must be empty to restore a snapshot", path)
+		}
+	}
+	if err := os.MkdirAll(appDataDir, 0700); err != nil {
+		return nil, err
+	}
+
+	// extract to the data dir, so that the DBs can be moved by renaming
+	tmpDir, err := ioutil.TempDir(appDataDir, "snapshot")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	manifest, err := extractSnapshotArchive(file, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSnapshotTrust(manifest, trust); err != nil {
+		return nil, err
+	}
+	if err := verifySnapshot(config, manifest, filepath.Join(tmpDir, snapshotDataDir)); err != nil {
+		return nil, err
+	}
+
+	for _, dir := range snapshotDBDirs {
+		path := snapshotDBPath(config, dir)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return nil, err
+		}
+		if err := os.Rename(filepath.Join(tmpDir, snapshotDataDir, dir), path); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// snapshotDBPath returns where a DB in snapshots is in the node at config's root
+func snapshotDBPath(config *tmconfig.Config, dir string) string {
+	if dir == "application.db" {
+		return filepath.Join(config.RootDir, "data", dir)
+	}
+	return filepath.Join(config.DBDir(), dir)
+}
+
+// checkSnapshotTrust checks the manifest against the trusted height and app hash,
+// verifySnapshot then checks the DBs against the manifest
+func checkSnapshotTrust(manifest *SnapshotManifest, trust *snapshotTrust) error {
+	if trust == nil {
+		return nil
+	}
+	if manifest.Height != trust.Height {
+		return fmt.Errorf("snapshot is at height %d, not the trusted height %d", manifest.Height, trust.Height)
+	}
+	if !bytes.Equal(manifest.AppHash, trust.AppHash) {
+		return fmt.Errorf("app hash of the snapshot is %s, not the trusted %s", manifest.AppHash, trust.AppHash)
+	}
+	return nil
+}
+
+// verifySnapshot checks the extracted DBs in dataDir against the app hash of the snapshot,
+// and the last block against its commit
+func verifySnapshot(config *tmconfig.Config, manifest *SnapshotManifest, dataDir string) error {
+	if genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile()); err == nil {
+		if genDoc.ChainID != manifest.ChainID {
+			return fmt.Errorf("snapshot of %s can not be restored to a node of %s", manifest.ChainID, genDoc.ChainID)
+		}
+	}
+
+	dbs, err := openDBs(dbm.GoLevelDBBackend, dataDir, dataDir)
+	if err != nil {
+		return err
+	}
+	defer dbs.Close()
+
+	if latest := storeutil.GetLatestVersion(dbs.app); latest != manifest.Height {
+		return fmt.Errorf("latest height of the app state is %d, not %d", latest, manifest.Height)
+	}
+	ci, err := storeutil.VerifyVersion(dbs.app, manifest.Height)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(ci.Hash(), manifest.AppHash) {
+		return fmt.Errorf("app hash of the app state is %X, not %s", ci.Hash(), manifest.AppHash)
+	}
+
+	state := sm.LoadState(dbs.state)
+	if state.IsEmpty() || state.LastBlockHeight != manifest.Height ||
+		state.ChainID != manifest.ChainID || !bytes.Equal(state.AppHash, manifest.AppHash) {
+		return errors.New("tendermint state does not match the snapshot")
+	}
+	blockStore := store.NewBlockStore(dbs.blockStore)
+	meta := blockStore.LoadBlockMeta(manifest.Height)
+	commit := blockStore.LoadSeenCommit(manifest.Height)
+	if blockStore.Height() != manifest.Height || meta == nil || !meta.BlockID.Equals(state.LastBlockID) || commit == nil {
+		return errors.New("block store does not match the snapshot")
+	}
+	if !bytes.Equal(meta.Header.ValidatorsHash, state.LastValidators.Hash()) ||
+		!bytes.Equal(meta.Header.NextValidatorsHash, state.Validators.Hash()) {
+		return errors.New("validators of the tendermint state do not match the last block")
+	}
+	if err := state.LastValidators.VerifyCommit(state.ChainID, state.LastBlockID, manifest.Height, commit); err != nil {
+		return fmt.Errorf("last block is not signed by its validators: %s", err.Error())
+	}
+	return nil
+}
+
+// writeSnapshotArchive writes the files under dir to a gzipped tar file,
+// with the manifest as the first file
+func writeSnapshotArchive(dir string, manifest *SnapshotManifest, output string) error {
+	var paths []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// the lock and the logs of leveldb are not needed
+		if name := info.Name(); info.Mode().IsRegular() && name != "LOCK" && !strings.HasPrefix(name, "LOG") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+
+	manifest.Files = manifest.Files[:0]
+	for _, path := range paths {
+		sum, size, err := sha256File(path)
+		if err != nil {
+			return err
+		}
+		name, _ := filepath.Rel(dir, path)
+		manifest.Files = append(manifest.Files, SnapshotFile{
+			Name:   filepath.ToSlash(name),
+			Size:   size,
+			SHA256: sum,
+		})
+	}
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	if err := writeTarFile(tw, snapshotManifestFile, int64(len(bz)), bytes.NewReader(bz)); err != nil {
+		return err
+	}
+	for i, path := range paths {
+		if err := copyToTar(tw, manifest.Files[i], path); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func copyToTar(tw *tar.Writer, file SnapshotFile, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return writeTarFile(tw, file.Name, file.Size, f)
+}
+
+func writeTarFile(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     0600,
+		Size:     size,
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := io.CopyN(tw, r, size)
+	return err
+}
+
+func sha256File(path string) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
+
+// extractSnapshotArchive extracts a snapshot archive to dir, and checks the
+// files against the checksums in its manifest
+func extractSnapshotArchive(file, dir string) (*SnapshotManifest, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(gr)
+
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != snapshotManifestFile {
+		return nil, errors.New("not a snapshot archive, the manifest is missing")
+	}
+	var manifest SnapshotManifest
+	if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %v", err)
+	}
+	files := make(map[string]SnapshotFile, len(manifest.Files))
+	for _, file := range manifest.Files {
+		files[file.Name] = file
+	}
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		file, ok := files[hdr.Name]
+		if !ok || hdr.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("unexpected file %s in snapshot archive", hdr.Name)
+		}
+		delete(files, hdr.Name)
+		if err := extractSnapshotFile(tr, file, dir); err != nil {
+			return nil, err
+		}
+	}
+	if len(files) != 0 {
+		var missing []string
+		for name := range files {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("missing files in snapshot archive: %s", strings.Join(missing, ", "))
+	}
+	return &manifest, nil
+}
+
+func extractSnapshotFile(r io.Reader, file SnapshotFile, dir string) error {
+	name := filepath.FromSlash(file.Name)
+	if filepath.IsAbs(name) || strings.HasPrefix(filepath.Clean(name), "..") {
+		return fmt.Errorf("invalid file name %s in snapshot archive", file.Name)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, h), r)
+	if err != nil {
+		return err
+	}
+	if size != file.Size || hex.EncodeToString(h.Sum(nil)) != file.SHA256 {
+		return fmt.Errorf("checksum mismatch of %s in snapshot archive", file.Name)
+	}
+	return f.Sync()
+}
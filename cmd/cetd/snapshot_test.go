@@ -0,0 +1,101 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestSnapshotDir(t *testing.T, dir string) {
+	require.Nil(t, os.MkdirAll(filepath.Join(dir, "data", "state.db"), 0700))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "data", "state.db", "000001.log"), []byte("state"), 0600))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "data", "state.db", "LOCK"), nil, 0600))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "data", "app"), []byte("app"), 0600))
+}
+
+func TestSnapshotArchive(t *testing.T) {
+	root, err := ioutil.TempDir("", "snapshot")
+	require.Nil(t, err)
+	defer os.RemoveAll(root)
+	dir := filepath.Join(root, "home")
+	writeTestSnapshotDir(t, dir)
+	output := filepath.Join(root, "snapshot.tar.gz")
+	manifest := &SnapshotManifest{ChainID: "test", Height: 10, AppHash: []byte{1, 2, 3}}
+	require.Nil(t, writeSnapshotArchive(dir, manifest, output))
+	require.Len(t, manifest.Files, 2)
+	require.Equal(t, "data/app", manifest.Files[0].Name)
+	require.Equal(t, "data/state.db/000001.log", manifest.Files[1].Name)
+
+	extracted := filepath.Join(root, "extracted")
+	m, err := extractSnapshotArchive(output, extracted)
+	require.Nil(t, err)
+	require.Equal(t, manifest, m)
+	bz, err := ioutil.ReadFile(filepath.Join(extracted, "data", "state.db", "000001.log"))
+	require.Nil(t, err)
+	require.Equal(t, "state", string(bz))
+
+	// the file does not match the checksum
+	manifest.Files = manifest.Files[:1]
+	manifest.Files[0].SHA256 = strings.Repeat("0", 64)
+	writeTestArchive(t, output, manifest, "data/app", "app")
+	_, err = extractSnapshotArchive(output, filepath.Join(root, "checksum"))
+	require.Error(t, err)
+
+	// the file is not in the manifest
+	manifest.Files = nil
+	writeTestArchive(t, output, manifest, "data/app", "app")
+	_, err = extractSnapshotArchive(output, filepath.Join(root, "unexpected"))
+	require.Error(t, err)
+
+	_, err = extractSnapshotArchive(filepath.Join(dir, "data", "app"), filepath.Join(root, "invalid"))
+	require.Error(t, err)
+}
+
+func writeTestArchive(t *testing.T, output string, manifest *SnapshotManifest, name, content string) {
+	f, err := os.Create(output)
+	require.Nil(t, err)
+	defer f.Close()
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	bz, err := json.Marshal(manifest)
+	require.Nil(t, err)
+	require.Nil(t, writeTarFile(tw, snapshotManifestFile, int64(len(bz)), bytes.NewReader(bz)))
+	require.Nil(t, writeTarFile(tw, name, int64(len(content)), strings.NewReader(content)))
+	require.Nil(t, tw.Close())
+	require.Nil(t, gw.Close())
+}
+
+func TestSnapshotTrust(t *testing.T) {
+	defer viper.Reset()
+	trust, err := getSnapshotTrust()
+	require.Nil(t, err)
+	require.Nil(t, trust)
+
+	viper.Set(flagTrustHeight, 10)
+	_, err = getSnapshotTrust()
+	require.Error(t, err)
+	viper.Set(flagTrustAppHash, "xyz")
+	_, err = getSnapshotTrust()
+	require.Error(t, err)
+	viper.Set(flagTrustAppHash, "010203")
+	trust, err = getSnapshotTrust()
+	require.Nil(t, err)
+	require.Equal(t, &snapshotTrust{Height: 10, AppHash: []byte{1, 2, 3}}, trust)
+
+	manifest := &SnapshotManifest{ChainID: "test", Height: 10, AppHash: []byte{1, 2, 3}}
+	require.Nil(t, checkSnapshotTrust(manifest, nil))
+	require.Nil(t, checkSnapshotTrust(manifest, trust))
+	manifest.AppHash = []byte{1, 2, 4}
+	require.Error(t, checkSnapshotTrust(manifest, trust))
+	manifest.AppHash, manifest.Height = []byte{1, 2, 3}, 11
+	require.Error(t, checkSnapshotTrust(manifest, trust))
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	amino "github.com/tendermint/go-amino"
+	tmconfig "github.com/tendermint/tendermint/config"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the keys of tendermint's state DB and block store, which are not exported
+const (
+	genesisDocKey = "genesisDoc"
+
+	// the same as the one of tendermint/state
+	valSetCheckpointInterval = 100000
+)
+
+func calcValidatorsKey(height int64) []byte {
+	return []byte(fmt.Sprintf("validatorsKey:%v", height))
+}
+
+func calcConsensusParamsKey(height int64) []byte {
+	return []byte(fmt.Sprintf("consensusParamsKey:%v", height))
+}
+
+func calcABCIResponsesKey(height int64) []byte {
+	return []byte(fmt.Sprintf("abciResponsesKey:%v", height))
+}
+
+func calcBlockMetaKey(height int64) []byte {
+	return []byte(fmt.Sprintf("H:%v", height))
+}
+
+func calcBlockPartKey(height int64, partIndex int) []byte {
+	return []byte(fmt.Sprintf("P:%v:%v", height, partIndex))
+}
+
+func calcBlockCommitKey(height int64) []byte {
+	return []byte(fmt.Sprintf("C:%v", height))
+}
+
+func calcSeenCommitKey(height int64) []byte {
+	return []byte(fmt.Sprintf("SC:%v", height))
+}
+
+var tmCdc = amino.NewCodec()
+
+func init() {
+	cryptoAmino.RegisterAmino(tmCdc)
+}
+
+// nodeDBs are the DBs of a node under its data dir
+type nodeDBs struct {
+	app        dbm.DB
+	state      dbm.DB
+	blockStore dbm.DB
+}
+
+// openNodeDBs opens the DBs of the node at config's root
+func openNodeDBs(config *tmconfig.Config) (*nodeDBs, error) {
+	return openDBs(dbm.DBBackendType(config.DBBackend),
+		filepath.Join(config.RootDir, "data"), config.DBDir())
+}
+
+// openDBs opens the app DB under appDir and the tendermint DBs under tmDir,
+// the dirs are created if they do not exist
+func openDBs(backend dbm.DBBackendType, appDir, tmDir string) (*nodeDBs, error) {
+	appDB, err := sdk.NewLevelDB("application", appDir)
+	if err != nil {
+		return nil, err
+	}
+	return &nodeDBs{
+		app:        appDB,
+		state:      dbm.NewDB("state", backend, tmDir),
+		blockStore: dbm.NewDB("blockstore", backend, tmDir),
+	}, nil
+}
+
+func (dbs *nodeDBs) Close() {
+	dbs.app.Close()
+	dbs.state.Close()
+	dbs.blockStore.Close()
+}
+
+// loadStateAtHeight rebuilds the tendermint state right after the block at
+// height was committed, from the validator and consensus params history in
+// the state DB, and from the headers in the block store
+func loadStateAtHeight(stateDB dbm.DB, blockStore *store.BlockStore, height int64) (sm.State, error) {
+	state := sm.LoadState(stateDB)
+	if state.IsEmpty() {
+		return state, errors.New("tendermint state is not initialized")
+	}
+	if height == state.LastBlockHeight {
+		return state, nil
+	}
+	if height < 1 || height > state.LastBlockHeight {
+		return state, fmt.Errorf("height %d is out of range [1, %d]", height, state.LastBlockHeight)
+	}
+
+	meta := blockStore.LoadBlockMeta(height)
+	nextMeta := blockStore.LoadBlockMeta(height + 1)
+	if meta == nil || nextMeta == nil {
+		return state, fmt.Errorf("block %d or %d not found in block store", height, height+1)
+	}
+	lastValidators, err := sm.LoadValidators(stateDB, height)
+	if err != nil {
+		return state, err
+	}
+	validators, err := sm.LoadValidators(stateDB, height+1)
+	if err != nil {
+		return state, err
+	}
+	nextValidators, err := sm.LoadValidators(stateDB, height+2)
+	if err != nil {
+		return state, err
+	}
+	valInfo, err := loadValidatorsInfo(stateDB, height+2)
+	if err != nil {
+		return state, err
+	}
+	params, err := sm.LoadConsensusParams(stateDB, height+1)
+	if err != nil {
+		return state, err
+	}
+	paramsInfo, err := loadConsensusParamsInfo(stateDB, height+1)
+	if err != nil {
+		return state, err
+	}
+
+	state.Version.Consensus = nextMeta.Header.Version
+	state.LastBlockHeight = height
+	state.LastBlockTotalTx = meta.Header.TotalTxs
+	state.LastBlockID = meta.BlockID
+	state.LastBlockTime = meta.Header.Time
+	state.NextValidators = nextValidators
+	state.Validators = validators
+	state.LastValidators = lastValidators
+	state.LastHeightValidatorsChanged = valInfo.LastHeightChanged
+	state.ConsensusParams = params
+	state.LastHeightConsensusParamsChanged = paramsInfo.LastHeightChanged
+	// the results and the app hash of a block are in the header of the next one
+	state.LastResultsHash = nextMeta.Header.LastResultsHash
+	state.AppHash = nextMeta.Header.AppHash
+	return state, nil
+}
+
+func loadValidatorsInfo(db dbm.DB, height int64) (*sm.ValidatorsInfo, error) {
+	bz := db.Get(calcValidatorsKey(height))
+	if len(bz) == 0 {
+		return nil, sm.ErrNoValSetForHeight{Height: height}
+	}
+	info := new(sm.ValidatorsInfo)
+	if err := tmCdc.UnmarshalBinaryBare(bz, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func loadConsensusParamsInfo(db dbm.DB, height int64) (*sm.ConsensusParamsInfo, error) {
+	bz := db.Get(calcConsensusParamsKey(height))
+	if len(bz) == 0 {
+		return nil, sm.ErrNoConsensusParamsForHeight{Height: height}
+	}
+	info := new(sm.ConsensusParamsInfo)
+	if err := tmCdc.UnmarshalBinaryBare(bz, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// copyTendermintState saves state to dstState, together with the validators
+// and consensus params it refers to, and copies the last block of state to
+// dstBlocks, which is what a node needs to start at the height of state.
+// The validator sets are saved in full, so that the history before the height
+// is not needed.
+func copyTendermintState(state sm.State, srcState, srcBlocks, dstState, dstBlocks dbm.DB) error {
+	height := state.LastBlockHeight
+	if bz := srcState.Get([]byte(genesisDocKey)); bz != nil {
+		dstState.Set([]byte(genesisDocKey), bz)
+	}
+	sm.SaveState(dstState, state)
+
+	valHeights := []int64{height, height + 1, height + 2, state.LastHeightValidatorsChanged}
+	if checkpoint := (height + 2) - (height+2)%valSetCheckpointInterval; checkpoint > 0 {
+		valHeights = append(valHeights, checkpoint)
+	}
+	for _, h := range valHeights {
+		info, err := loadValidatorsInfo(srcState, h)
+		if err != nil {
+			return err
+		}
+		if info.ValidatorSet, err = sm.LoadValidators(srcState, h); err != nil {
+			return err
+		}
+		dstState.Set(calcValidatorsKey(h), tmCdc.MustMarshalBinaryBare(info))
+	}
+	for _, h := range []int64{height, state.LastHeightConsensusParamsChanged} {
+		bz := srcState.Get(calcConsensusParamsKey(h))
+		if len(bz) == 0 {
+			return sm.ErrNoConsensusParamsForHeight{Height: h}
+		}
+		dstState.Set(calcConsensusParamsKey(h), bz)
+	}
+	if bz := srcState.Get(calcABCIResponsesKey(height)); bz != nil {
+		dstState.Set(calcABCIResponsesKey(height), bz)
+	}
+
+	meta := store.NewBlockStore(srcBlocks).LoadBlockMeta(height)
+	if meta == nil {
+		return fmt.Errorf("block %d not found in block store", height)
+	}
+	keys := [][]byte{calcBlockMetaKey(height), calcBlockCommitKey(height - 1), calcSeenCommitKey(height)}
+	for i := 0; i < meta.BlockID.PartsHeader.Total; i++ {
+		keys = append(keys, calcBlockPartKey(height, i))
+	}
+	for _, key := range keys {
+		bz := srcBlocks.Get(key)
+		if bz == nil {
+			return fmt.Errorf("%s not found in block store", key)
+		}
+		dstBlocks.Set(key, bz)
+	}
+	// the commit of the block in the next one, if the block is not the last
+	if bz := srcBlocks.Get(calcBlockCommitKey(height)); bz != nil {
+		dstBlocks.Set(calcBlockCommitKey(height), bz)
+	}
+	store.BlockStoreStateJSON{Height: height}.Save(dstBlocks)
+	return nil
+}
+
+// rollbackBlockStore deletes the blocks after height from the block store
+func rollbackBlockStore(db dbm.DB, height int64) error {
+	blockStore := store.NewBlockStore(db)
+	for h := blockStore.Height(); h > height; h-- {
+		meta := blockStore.LoadBlockMeta(h)
+		if meta == nil {
+			return fmt.Errorf("block %d not found in block store", h)
+		}
+		for i := 0; i < meta.BlockID.PartsHeader.Total; i++ {
+			db.Delete(calcBlockPartKey(h, i))
+		}
+		db.Delete(calcBlockMetaKey(h))
+		db.Delete(calcBlockCommitKey(h - 1))
+		db.Delete(calcSeenCommitKey(h))
+	}
+	store.BlockStoreStateJSON{Height: height}.Save(db)
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+
+	"github.com/coinexchain/dex/app"
+)
+
+const flagDeep = "deep"
+
+// validateGenesisCmd extends the validate-genesis command of genutil with the
+// cross-module checks of app.ValidateGenesisDeep, which are enabled by --deep
+func validateGenesisCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager) *cobra.Command {
+	cmd := genutilcli.ValidateGenesisCmd(ctx, cdc, mbm)
+	validateModules := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := validateModules(cmd, args); err != nil {
+			return err
+		}
+		if !viper.GetBool(flagDeep) {
+			return nil
+		}
+
+		genesis := ctx.Config.GenesisFile()
+		if len(args) != 0 {
+			genesis = args[0]
+		}
+		return validateGenesisFileDeep(cdc, genesis)
+	}
+
+	cmd.Flags().Bool(flagDeep, false, "Also run the cross-module consistency checks and list every violation")
+	return cmd
+}
+
+func validateGenesisFileDeep(cdc *codec.Codec, genesis string) error {
+	genDoc, err := tmtypes.GenesisDocFromFile(genesis)
+	if err != nil {
+		return fmt.Errorf("error loading genesis doc from %s: %s", genesis, err.Error())
+	}
+
+	var rawState map[string]json.RawMessage
+	if err = cdc.UnmarshalJSON(genDoc.AppState, &rawState); err != nil {
+		return fmt.Errorf("error unmarshaling genesis doc %s: %s", genesis, err.Error())
+	}
+
+	violations := app.ValidateGenesisDeep(app.FromMap(cdc, rawState))
+	for _, v := range violations {
+		fmt.Fprintln(os.Stderr, v.Error())
+	}
+	if len(violations) != 0 {
+		return fmt.Errorf("genesis file %s has %d cross-module violation(s)", genesis, len(violations))
+	}
+
+	fmt.Printf("File at %s passed the cross-module checks\n", genesis)
+	return nil
+}
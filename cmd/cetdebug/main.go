@@ -28,6 +28,7 @@ func init() {
 	rootCmd.AddCommand(pubkeyCmd)
 	rootCmd.AddCommand(addrCmd)
 	rootCmd.AddCommand(rawBytesCmd)
+	rootCmd.AddCommand(storeCmd)
 }
 
 var rootCmd = &cobra.Command{
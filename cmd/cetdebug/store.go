@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/app/storeutil"
+)
+
+const (
+	flagHome   = "home"
+	flagHeight = "height"
+	flagPrefix = "prefix"
+	flagCount  = "count"
+)
+
+func init() {
+	storeCmd.AddCommand(storeDumpCmd)
+
+	storeDumpCmd.Flags().String(flagHome, app.DefaultNodeHome, "Home directory of the node")
+	storeDumpCmd.Flags().Int64(flagHeight, 0, "Height of the state, 0 for the latest height")
+	storeDumpCmd.Flags().StringSlice(flagPrefix, nil,
+		"Only dump the keys under these prefixes, by name (eg. token) or in hex")
+	storeDumpCmd.Flags().Bool(flagCount, false, "Print the number of keys under each prefix instead of the entries")
+}
+
+var storeCmd = &cobra.Command{
+	Use:   "store",
+	Short: "Inspect the KV stores of a stopped node",
+}
+
+var storeDumpCmd = &cobra.Command{
+	Use:   "dump [store]",
+	Short: "Dump a KV store of a stopped node as JSON lines",
+	Long: `Open the app DB of a stopped node read-only, and print each entry of a KV
+store at a height as a JSON line. The keys are decoded with the key prefixes of the
+module owning the store, and the values with the app codec. Values in unknown
+formats are printed in hex.
+
+Example:
+	cetdebug store dump asset --prefix token
+	cetdebug store dump market --height 1000 --count
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: runStoreDumpCmd,
+}
+
+type prefixCount struct {
+	Prefix string `json:"prefix"`
+	Count  int    `json:"count"`
+}
+
+func runStoreDumpCmd(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	home, _ := cmd.Flags().GetString(flagHome)
+	height, _ := cmd.Flags().GetInt64(flagHeight)
+	prefixArgs, _ := cmd.Flags().GetStringSlice(flagPrefix)
+	count, _ := cmd.Flags().GetBool(flagCount)
+
+	var prefixes [][]byte
+	for _, s := range prefixArgs {
+		prefix, err := storeutil.ParseKeyPrefix(name, s)
+		if err != nil {
+			return err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	db, err := storeutil.OpenAppDBReadOnly(filepath.Join(home, "data"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if height == 0 {
+		height = storeutil.GetLatestVersion(db)
+	}
+	store, _, err := storeutil.LoadStore(db, name, height)
+	if err != nil {
+		return err
+	}
+
+	if count {
+		return countStore(os.Stdout, store, name, prefixes)
+	}
+	return dumpStore(os.Stdout, store, name, prefixes, storeutil.NewStoreDecoder(app.MakeCodec()))
+}
+
+// iterateStore calls fn with each entry of store under any of prefixes, or
+// with all of the entries if there are no prefixes
+func iterateStore(store sdk.KVStore, prefixes [][]byte, fn func(key, value []byte) error) error {
+	if len(prefixes) == 0 {
+		prefixes = [][]byte{{}}
+	}
+	for _, prefix := range prefixes {
+		if err := func() error {
+			it := sdk.KVStorePrefixIterator(store, prefix)
+			defer it.Close()
+			for ; it.Valid(); it.Next() {
+				if err := fn(it.Key(), it.Value()); err != nil {
+					return err
+				}
+			}
+			return nil
+		}(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dumpStore(w io.Writer, store sdk.KVStore, name string, prefixes [][]byte,
+	decoder *storeutil.StoreDecoder) error {
+
+	enc := json.NewEncoder(w)
+	return iterateStore(store, prefixes, func(key, value []byte) error {
+		return enc.Encode(decoder.Decode(name, key, value))
+	})
+}
+
+// countStore prints the number of keys under each known prefix, the keys not
+// under any known prefix are counted with an empty prefix
+func countStore(w io.Writer, store sdk.KVStore, name string, prefixes [][]byte) error {
+	var counts []prefixCount
+	index := make(map[string]int)
+	err := iterateStore(store, prefixes, func(key, _ []byte) error {
+		var prefix string
+		if p := storeutil.FindKeyPrefix(name, key); p != nil {
+			prefix = p.Name
+		}
+		i, ok := index[prefix]
+		if !ok {
+			i = len(counts)
+			index[prefix] = i
+			counts = append(counts, prefixCount{Prefix: prefix})
+		}
+		counts[i].Count++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	for _, c := range counts {
+		if err := enc.Encode(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
+	"github.com/coinexchain/cet-sdk/modules/market"
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/app/vesting"
+)
+
+const (
+	flagOutputFile = "output-file"
+
+	vestingDelayed    = "delayed"
+	vestingContinuous = "continuous"
+	vestingPeriodic   = "periodic"
+)
+
+// genesisSpec is the declarative description of a network, loaded from YAML.
+// See docs/genesis_spec_example.yaml for an example.
+type genesisSpec struct {
+	ChainID      string                            `yaml:"chain_id"`
+	GenesisTime  string                            `yaml:"genesis_time"`
+	Accounts     []accountSpec                     `yaml:"accounts"`
+	Tokens       []tokenSpec                       `yaml:"tokens"`
+	TradingPairs []tradingPairSpec                 `yaml:"trading_pairs"`
+	Params       map[string]map[string]interface{} `yaml:"params"`
+	GenTxs       []string                          `yaml:"gentxs"`
+}
+
+type accountSpec struct {
+	Address         string       `yaml:"address"`
+	Coins           string       `yaml:"coins"`
+	Vesting         string       `yaml:"vesting"`
+	OriginalVesting string       `yaml:"original_vesting"`
+	StartTime       int64        `yaml:"start_time"`
+	EndTime         int64        `yaml:"end_time"`
+	Periods         []periodSpec `yaml:"periods"`
+}
+
+type periodSpec struct {
+	Length int64  `yaml:"length"`
+	Amount string `yaml:"amount"`
+}
+
+type tokenSpec struct {
+	Name             string `yaml:"name"`
+	Symbol           string `yaml:"symbol"`
+	Owner            string `yaml:"owner"`
+	TotalSupply      string `yaml:"total_supply"`
+	Mintable         bool   `yaml:"mintable"`
+	Burnable         bool   `yaml:"burnable"`
+	AddrForbiddable  bool   `yaml:"addr_forbiddable"`
+	TokenForbiddable bool   `yaml:"token_forbiddable"`
+	URL              string `yaml:"url"`
+	Description      string `yaml:"description"`
+	Identity         string `yaml:"identity"`
+}
+
+type tradingPairSpec struct {
+	Stock             string `yaml:"stock"`
+	Money             string `yaml:"money"`
+	PricePrecision    byte   `yaml:"price_precision"`
+	OrderPrecision    byte   `yaml:"order_precision"`
+	LastExecutedPrice string `yaml:"last_executed_price"`
+}
+
+func BuildGenesisCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-genesis <spec.yaml>",
+		Short: "Build a validated genesis.json from a YAML spec",
+		Long: `Build a validated genesis.json from a YAML spec, which lists chain-id,
+accounts (plain, delayed, continuous or periodic vesting), tokens, trading pairs,
+params overrides per module and gentx files.
+
+The params overrides of a module are merged into the module's default
+genesis JSON, so the keys must use the JSON names of the module's genesis
+state. Relative gentx paths are resolved against the directory of the spec.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spec, err := loadGenesisSpec(args[0])
+			if err != nil {
+				return err
+			}
+			genDocBytes, err := buildGenesis(cdc, spec, filepath.Dir(args[0]))
+			if err != nil {
+				return err
+			}
+			if output := viper.GetString(flagOutputFile); output != "" {
+				return ioutil.WriteFile(output, genDocBytes, 0644)
+			}
+			fmt.Println(string(genDocBytes))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagOutputFile, "", "Write genesis.json to this file instead of stdout")
+	return cmd
+}
+
+func loadGenesisSpec(file string) (*genesisSpec, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	spec := &genesisSpec{}
+	if err := yaml.UnmarshalStrict(bz, spec); err != nil {
+		return nil, fmt.Errorf("invalid spec %s: %s", file, err.Error())
+	}
+	if spec.ChainID == "" {
+		return nil, fmt.Errorf("invalid spec %s: chain_id is missing", file)
+	}
+	return spec, nil
+}
+
+func buildGenesis(cdc *codec.Codec, spec *genesisSpec, specDir string) ([]byte, error) {
+	genState := app.FromMap(cdc, app.ModuleBasics.DefaultGenesis())
+
+	for _, accSpec := range spec.Accounts {
+		acc, schedule, err := accSpec.toGenesisAccount()
+		if err != nil {
+			return nil, err
+		}
+		genState.Accounts = append(genState.Accounts, acc)
+		if schedule != nil {
+			genState.VestingData.Schedules = append(genState.VestingData.Schedules, *schedule)
+		}
+	}
+	for _, tokenSpec := range spec.Tokens {
+		token, err := tokenSpec.toToken(genState.Accounts)
+		if err != nil {
+			return nil, err
+		}
+		genState.AssetData.Tokens = append(genState.AssetData.Tokens, token)
+	}
+	for _, pairSpec := range spec.TradingPairs {
+		info, err := pairSpec.toMarketInfo()
+		if err != nil {
+			return nil, err
+		}
+		genState.MarketData.MarketInfos = append(genState.MarketData.MarketInfos, info)
+	}
+	for _, file := range spec.GenTxs {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(specDir, file)
+		}
+		genTx, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		genState.GenUtil.GenTxs = append(genState.GenUtil.GenTxs, genTx)
+	}
+
+	// the params overrides are applied to the raw JSON of each module
+	var rawState map[string]json.RawMessage
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(genState), &rawState)
+	rawState[autoswap.ModuleName] = autoswap.AppModuleBasic{}.DefaultGenesis()
+	for moduleName, overrides := range spec.Params {
+		merged, err := mergeModuleGenesis(rawState[moduleName], overrides)
+		if err != nil {
+			return nil, fmt.Errorf("invalid params of %s: %s", moduleName, err.Error())
+		}
+		rawState[moduleName] = merged
+	}
+
+	if err := validateBuiltGenesis(cdc, rawState); err != nil {
+		return nil, err
+	}
+
+	appState, err := codec.MarshalJSONIndent(cdc, rawState)
+	if err != nil {
+		return nil, err
+	}
+	var genesisTime time.Time
+	if spec.GenesisTime != "" {
+		if genesisTime, err = time.Parse(time.RFC3339, spec.GenesisTime); err != nil {
+			return nil, fmt.Errorf("invalid genesis_time: %s", err.Error())
+		}
+	}
+	return makeGenesisDoc(cdc, appState, spec.ChainID, genesisTime)
+}
+
+func validateBuiltGenesis(cdc *codec.Codec, rawState map[string]json.RawMessage) error {
+	if err := app.ModuleBasics.ValidateGenesis(rawState); err != nil {
+		return err
+	}
+	violations := app.ValidateGenesisDeep(app.FromMap(cdc, rawState))
+	if len(violations) == 0 {
+		return nil
+	}
+	msg := fmt.Sprintf("genesis has %d cross-module violation(s):", len(violations))
+	for _, v := range violations {
+		msg += "\n  " + v.Error()
+	}
+	return fmt.Errorf("%s", msg)
+}
+
+// toGenesisAccount also returns the vesting schedule of a periodic vesting account
+func (spec accountSpec) toGenesisAccount() (genaccounts.GenesisAccount, *vesting.Schedule, error) {
+	addr, err := sdk.AccAddressFromBech32(spec.Address)
+	if err != nil {
+		return genaccounts.GenesisAccount{}, nil, fmt.Errorf("invalid account address %s: %s", spec.Address, err.Error())
+	}
+	coins, err := sdk.ParseCoins(spec.Coins)
+	if err != nil {
+		return genaccounts.GenesisAccount{}, nil, fmt.Errorf("invalid coins of %s: %s", spec.Address, err.Error())
+	}
+	baseAcc := &auth.BaseAccount{Address: addr, Coins: coins}
+	if spec.Vesting == "" {
+		return genaccounts.NewGenesisAccount(baseAcc), nil, nil
+	}
+	if spec.Vesting == vestingPeriodic {
+		return spec.toPeriodicVestingAccount(baseAcc)
+	}
+	if len(spec.Periods) != 0 {
+		return genaccounts.GenesisAccount{}, nil, fmt.Errorf("periods of %s are only used by periodic vesting", spec.Address)
+	}
+
+	originalVesting := coins
+	if spec.OriginalVesting != "" {
+		if originalVesting, err = sdk.ParseCoins(spec.OriginalVesting); err != nil {
+			return genaccounts.GenesisAccount{}, nil, fmt.Errorf("invalid original_vesting of %s: %s", spec.Address, err.Error())
+		}
+	}
+	bva := &auth.BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         spec.EndTime,
+	}
+
+	var acc auth.Account
+	switch spec.Vesting {
+	case vestingDelayed:
+		acc = &auth.DelayedVestingAccount{BaseVestingAccount: bva}
+	case vestingContinuous:
+		acc = &auth.ContinuousVestingAccount{BaseVestingAccount: bva, StartTime: spec.StartTime}
+	default:
+		return genaccounts.GenesisAccount{}, nil, fmt.Errorf("invalid vesting type of %s: %s", spec.Address, spec.Vesting)
+	}
+
+	genAcc, err := genaccounts.NewGenesisAccountI(acc)
+	if err != nil {
+		return genaccounts.GenesisAccount{}, nil, err
+	}
+	return genAcc, nil, genAcc.Validate()
+}
+
+// the original vesting coins and the end time of a periodic vesting account are derived from its periods
+func (spec accountSpec) toPeriodicVestingAccount(baseAcc *auth.BaseAccount) (genaccounts.GenesisAccount, *vesting.Schedule, error) {
+	if spec.OriginalVesting != "" || spec.EndTime != 0 {
+		return genaccounts.GenesisAccount{}, nil, fmt.Errorf("original_vesting and end_time of %s are derived from its periods", spec.Address)
+	}
+	periods := make(vesting.Periods, len(spec.Periods))
+	for i, p := range spec.Periods {
+		amount, err := sdk.ParseCoins(p.Amount)
+		if err != nil {
+			return genaccounts.GenesisAccount{}, nil, fmt.Errorf("invalid amount of period #%d of %s: %s", i, spec.Address, err.Error())
+		}
+		periods[i] = vesting.Period{Length: p.Length, Amount: amount}
+	}
+
+	genAcc, schedule, err := vesting.NewGenesisEntries(vesting.NewPeriodicVestingAccount(baseAcc, spec.StartTime, periods))
+	if err != nil {
+		return genaccounts.GenesisAccount{}, nil, fmt.Errorf("invalid periodic vesting account %s: %s", spec.Address, err.Error())
+	}
+	return genAcc, &schedule, nil
+}
+
+// total_supply defaults to the sum of the token held by all the accounts
+func (spec tokenSpec) toToken(accounts genaccounts.GenesisState) (asset.Token, error) {
+	owner, err := sdk.AccAddressFromBech32(spec.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner of token %s: %s", spec.Symbol, err.Error())
+	}
+
+	totalSupply := sdk.ZeroInt()
+	if spec.TotalSupply != "" {
+		var ok bool
+		if totalSupply, ok = sdk.NewIntFromString(spec.TotalSupply); !ok {
+			return nil, fmt.Errorf("invalid total_supply of token %s: %s", spec.Symbol, spec.TotalSupply)
+		}
+	} else {
+		for _, acc := range accounts {
+			totalSupply = totalSupply.Add(acc.Coins.AmountOf(spec.Symbol))
+		}
+	}
+
+	token := &asset.BaseToken{
+		Name:             spec.Name,
+		Symbol:           spec.Symbol,
+		TotalSupply:      totalSupply,
+		SendLock:         sdk.ZeroInt(),
+		Owner:            owner,
+		Mintable:         spec.Mintable,
+		Burnable:         spec.Burnable,
+		AddrForbiddable:  spec.AddrForbiddable,
+		TokenForbiddable: spec.TokenForbiddable,
+		TotalBurn:        sdk.ZeroInt(),
+		TotalMint:        sdk.ZeroInt(),
+		URL:              spec.URL,
+		Description:      spec.Description,
+		Identity:         spec.Identity,
+	}
+	if err := token.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid token %s: %s", spec.Symbol, err.Error())
+	}
+	return token, nil
+}
+
+func (spec tradingPairSpec) toMarketInfo() (market.MarketInfo, error) {
+	lastExecutedPrice := sdk.ZeroDec()
+	if spec.LastExecutedPrice != "" {
+		var err error
+		if lastExecutedPrice, err = sdk.NewDecFromStr(spec.LastExecutedPrice); err != nil {
+			return market.MarketInfo{}, fmt.Errorf("invalid last_executed_price of %s/%s: %s",
+				spec.Stock, spec.Money, err.Error())
+		}
+	}
+	return market.MarketInfo{
+		Stock:             spec.Stock,
+		Money:             spec.Money,
+		PricePrecision:    spec.PricePrecision,
+		LastExecutedPrice: lastExecutedPrice,
+		OrderPrecision:    spec.OrderPrecision,
+	}, nil
+}
+
+func mergeModuleGenesis(raw json.RawMessage, overrides map[string]interface{}) (json.RawMessage, error) {
+	if raw == nil {
+		return nil, fmt.Errorf("unknown module")
+	}
+	// the numbers are kept as json.Number, as float64 can not hold all the int64 values
+	var state map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return nil, err
+	}
+	for k, v := range overrides {
+		mergeJSONValue(state, k, toJSONValue(v))
+	}
+	return json.Marshal(state)
+}
+
+func mergeJSONValue(dst map[string]interface{}, key string, value interface{}) {
+	src, srcIsMap := value.(map[string]interface{})
+	old, oldIsMap := dst[key].(map[string]interface{})
+	if !srcIsMap || !oldIsMap {
+		dst[key] = value
+		return
+	}
+	for k, v := range src {
+		mergeJSONValue(old, k, v)
+	}
+}
+
+// yaml.v2 decodes maps as map[interface{}]interface{}, which can not be encoded as JSON
+func toJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			m[fmt.Sprintf("%v", k)] = toJSONValue(val)
+		}
+		return m
+	case []interface{}:
+		for i, val := range v {
+			v[i] = toJSONValue(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+	yaml "gopkg.in/yaml.v2"
+
+	dex "github.com/coinexchain/cet-sdk/types"
+	"github.com/coinexchain/dex/app"
+)
+
+const exampleSpec = "../../docs/genesis_spec_example.yaml"
+
+func TestMain(m *testing.M) {
+	dex.InitSdkConfig()
+	os.Exit(m.Run())
+}
+
+func TestBuildGenesisFromExample(t *testing.T) {
+	cdc := app.MakeCodec()
+	spec, err := loadGenesisSpec(exampleSpec)
+	require.Nil(t, err)
+	genDocBytes, err := buildGenesis(cdc, spec, filepath.Dir(exampleSpec))
+	require.Nil(t, err)
+
+	genDoc, err := tmtypes.GenesisDocFromJSON(genDocBytes)
+	require.Nil(t, err)
+	require.Equal(t, "coinexdex-private", genDoc.ChainID)
+	var rawState map[string]json.RawMessage
+	require.Nil(t, cdc.UnmarshalJSON(genDoc.AppState, &rawState))
+	require.Nil(t, app.ModuleBasics.ValidateGenesis(rawState))
+	gs := app.FromMap(cdc, rawState)
+	require.Empty(t, app.ValidateGenesisDeep(gs))
+
+	require.Len(t, gs.Accounts, 5)
+	require.Len(t, gs.VestingData.Schedules, 1)
+	require.Len(t, gs.AssetData.Tokens, 2)
+	require.Len(t, gs.MarketData.MarketInfos, 1)
+	require.Equal(t, uint16(21), gs.StakingData.Params.MaxValidators)
+}
+
+// writeSpec writes the example spec with its lines replaced
+func writeSpec(t *testing.T, dir, old, new string) string {
+	bz, err := ioutil.ReadFile(exampleSpec)
+	require.Nil(t, err)
+	s := string(bz)
+	require.Contains(t, s, old)
+	file := filepath.Join(dir, "spec.yaml")
+	require.Nil(t, ioutil.WriteFile(file, []byte(strings.Replace(s, old, new, 1)), 0644))
+	return file
+}
+
+func TestBuildGenesisErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "build-genesis")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		name, old, new, err string
+	}{
+		{"unknown module", "  gov:\n", "  nosuchmodule:\n", "invalid params of nosuchmodule: unknown module"},
+		{"bad coins", "coins: 500000000000000cet", "coins: -5cet", "invalid coins of"},
+		{"bad period amount", "amount: 50000000000000cet", "amount: 50.5.0cet", "invalid amount of period #0"},
+		{"bad original vesting", "original_vesting: 80000000000000cet", "original_vesting: abc", "invalid original_vesting of"},
+		{"bad vesting type", "vesting: delayed", "vesting: yearly", "invalid vesting type of"},
+		{"bad address", "coinex133w8vwj73s4h2uynqft9gyyy52cr6rg8dskv3h\n    coins",
+			"coinex1invalid\n    coins", "invalid account address"},
+		{"bad price", "price_precision: 8", "price_precision: 8\n    last_executed_price: x", "invalid last_executed_price of abc/cet"},
+		{"no token", "100000000000000abc", "100000000000000abc,5xyz", "denom xyz is held by 1 account(s)"},
+		{"missing gentx", "gentxs: []", "gentxs: [nosuchfile.json]", "nosuchfile.json"},
+		{"unknown field", "chain_id:", "chain:", "invalid spec"},
+	}
+	cdc := app.MakeCodec()
+	for _, c := range cases {
+		file := writeSpec(t, dir, c.old, c.new)
+		spec, err := loadGenesisSpec(file)
+		if err == nil {
+			_, err = buildGenesis(cdc, spec, dir)
+		}
+		require.Error(t, err, c.name)
+		require.Contains(t, err.Error(), c.err, c.name)
+	}
+}
+
+func TestMergeModuleGenesisKeepsInt64(t *testing.T) {
+	raw := json.RawMessage(`{"params":{"big":9007199254740993,"quoted":"9007199254740993","small":7}}`)
+	var overrides map[string]interface{}
+	require.Nil(t, yaml.Unmarshal([]byte("params:\n  other: 9007199254740995\n  small: 8\n"), &overrides))
+	merged, err := mergeModuleGenesis(raw, overrides)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"params":{"big":9007199254740993,"quoted":"9007199254740993","small":8,"other":9007199254740995}}`,
+		string(merged))
+	require.Contains(t, string(merged), "9007199254740993,")
+	require.Contains(t, string(merged), "9007199254740995")
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/coinexchain/dex/codec/codecbench"
+)
+
+const (
+	flagBenchTime = "benchtime"
+	flagTypes     = "types"
+	flagSeed      = "seed"
+)
+
+func CodecBenchCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "codec-bench",
+		Short: "Compare the codon codec with amino on each type codon supports",
+		Long: `Encode and decode a random value of each type codon supports with codon and
+amino, and print the time, the allocations and the sizes of both side by side.
+Run it before and after the codon generator changes to spot regressions.
+
+Example:
+	cetdev codec-bench --types 'StdTx|Msg.*Order' --benchtime 1s
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			types, err := regexp.Compile(viper.GetString(flagTypes))
+			if err != nil {
+				return err
+			}
+			// testing.Benchmark runs each benchmark for -test.benchtime
+			testing.Init()
+			if err := flag.Set("test.benchtime", viper.GetString(flagBenchTime)); err != nil {
+				return err
+			}
+
+			var cases []codecbench.BenchCase
+			for _, c := range codecbench.GetBenchCases(cdc, viper.GetInt64(flagSeed)) {
+				if types.MatchString(c.Alias) {
+					cases = append(cases, c)
+				}
+			}
+			printCodecBench(codecbench.RunBenchmarks(cdc, cases))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagBenchTime, "100ms", "Time to run each benchmark for, or Nx to run it N times")
+	cmd.Flags().String(flagTypes, "", "Regular expression of the types to benchmark")
+	cmd.Flags().Int64(flagSeed, 0, "Seed of the random values")
+	return cmd
+}
+
+func printCodecBench(results []codecbench.BenchResult) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Type", "Size codon/amino",
+		"Encode ns codon", "Encode ns amino", "Encode allocs codon/amino",
+		"Decode ns codon", "Decode ns amino", "Decode allocs codon/amino"})
+	table.SetAutoFormatHeaders(false)
+	table.SetColumnAlignment([]int{
+		tablewriter.ALIGN_LEFT,
+		tablewriter.ALIGN_RIGHT,
+		tablewriter.ALIGN_RIGHT,
+		tablewriter.ALIGN_RIGHT,
+		tablewriter.ALIGN_RIGHT,
+		tablewriter.ALIGN_RIGHT,
+		tablewriter.ALIGN_RIGHT,
+		tablewriter.ALIGN_RIGHT,
+	})
+
+	byType := make(map[string]map[string]codecbench.BenchResult)
+	var order []string
+	for _, res := range results {
+		if _, ok := byType[res.Alias]; !ok {
+			byType[res.Alias] = make(map[string]codecbench.BenchResult)
+			order = append(order, res.Alias)
+		}
+		byType[res.Alias][res.Codec+"/"+res.Op] = res
+	}
+	for _, alias := range order {
+		r := byType[alias]
+		table.Append([]string{alias,
+			pair(r, codecbench.OpEncode, func(res codecbench.BenchResult) int64 { return int64(res.Size) }),
+			nsPerOp(r, codecbench.CodecCodon, codecbench.OpEncode),
+			nsPerOp(r, codecbench.CodecAmino, codecbench.OpEncode),
+			pair(r, codecbench.OpEncode, func(res codecbench.BenchResult) int64 { return res.AllocsPerOp }),
+			nsPerOp(r, codecbench.CodecCodon, codecbench.OpDecode),
+			nsPerOp(r, codecbench.CodecAmino, codecbench.OpDecode),
+			pair(r, codecbench.OpDecode, func(res codecbench.BenchResult) int64 { return res.AllocsPerOp }),
+		})
+	}
+	table.Render()
+}
+
+// nsPerOp returns the time of the benchmark, or "-" if amino can not encode the type
+func nsPerOp(r map[string]codecbench.BenchResult, name, op string) string {
+	res, ok := r[name+"/"+op]
+	if !ok {
+		return "-"
+	}
+	return fmt.Sprintf("%d", res.NsPerOp)
+}
+
+// pair returns a value of the benchmarks of codon and amino as "codon/amino"
+func pair(r map[string]codecbench.BenchResult, op string, value func(codecbench.BenchResult) int64) string {
+	s := fmt.Sprintf("%d/", value(r[codecbench.CodecCodon+"/"+op]))
+	if res, ok := r[codecbench.CodecAmino+"/"+op]; ok {
+		return s + fmt.Sprintf("%d", value(res))
+	}
+	return s + "-"
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	tm "github.com/tendermint/tendermint/types"
@@ -40,23 +42,29 @@ func printGenesisState(cdc *codec.Codec, genState app.GenesisState, chainID stri
 		return err
 	}
 
+	genDocBytes, err := makeGenesisDoc(cdc, gneStateBytes, chainID, time.Time{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(genDocBytes))
+	return nil
+}
+
+// makeGenesisDoc uses the current time if genesisTime is zero
+func makeGenesisDoc(cdc *codec.Codec, appState json.RawMessage, chainID string, genesisTime time.Time) ([]byte, error) {
 	genDoc := tm.GenesisDoc{
-		ChainID:    chainID,
-		Validators: nil,
-		AppState:   gneStateBytes,
+		GenesisTime: genesisTime,
+		ChainID:     chainID,
+		Validators:  nil,
+		AppState:    appState,
 	}
 
 	if err := genDoc.ValidateAndComplete(); err != nil {
-		return err
+		return nil, err
 	}
 
 	genDoc.ConsensusParams.Evidence.MaxAge = app.DefaultEvidenceMaxAge
 
-	genDocBytes, err := cdc.MarshalJSONIndent(genDoc, "", "  ")
-	if err != nil {
-		return err
-	}
-
-	fmt.Println(string(genDocBytes))
-	return nil
+	return cdc.MarshalJSONIndent(genDoc, "", "  ")
 }
@@ -14,12 +14,16 @@ import (
 	"github.com/coinexchain/cet-sdk/testutil"
 	dex "github.com/coinexchain/cet-sdk/types"
 	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/app/vesting"
 )
 
 func createExampleGenesisState(cdc *codec.Codec) app.GenesisState {
 	gsMap := app.ModuleBasics.DefaultGenesis()
 	genState := app.FromMap(cdc, gsMap)
 	genState.Accounts = createExampleGenesisAccounts()
+	periodicAcc, schedule := createExamplePeriodicVestingAccount()
+	genState.Accounts = append(genState.Accounts, periodicAcc)
+	genState.VestingData.Schedules = append(genState.VestingData.Schedules, schedule)
 	//genState.StakingData.Pool.NotBondedTokens = sdk.NewInt(588788547005740000)
 	genState.AssetData = createExampleGenesisAssetData()
 	genState.MarketData = createExampleGenesisMarketData()
@@ -32,15 +36,18 @@ func createExampleGenesisAccounts() (accs []genaccounts.GenesisAccount) {
 		newBaseGenesisAccount(incentive.PoolAddr.String(), 31500000000000000),
 		newBaseGenesisAccount("coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke", 288800000000000000),
 		newBaseGenesisAccount("coinex133w8vwj73s4h2uynqft9gyyy52cr6rg8dskv3h", 88500000000000000),
-		newVestingGenesisAccount("coinex1zvf0hx6rpz0n7dkuzu34s39dnsyr8eygqs8h3q", 36000000000000000, 1577836800),
-		newVestingGenesisAccount("coinex1rfeae36tmm9t3gzacfq59hnv9j7fnaed3m4hhg", 36000000000000000, 1609459200),
-		newVestingGenesisAccount("coinex1ekevrsx6s853fqjt6rln9r84u8cwuft7e4wp47", 36000000000000000, 1640995200),
-		newVestingGenesisAccount("coinex1p9ek7d3r9z4l288v4lrkwwrnh9k5htezk2q68g", 36000000000000000, 1672531200),
-		newVestingGenesisAccount("coinex1qyy6tvx7ymw44t4444sfmexpvczchr0tcp2p6p", 36000000000000000, 1704067200),
 	)
 	return
 }
 
+// the foundation unlocks 36000000000000000 at the start of each year from 2020 to 2024,
+// kept in one periodic vesting account
+func createExamplePeriodicVestingAccount() (genaccounts.GenesisAccount, vesting.Schedule) {
+	const year = 365 * 24 * 3600
+	return newPeriodicVestingGenesisAccount("coinex1c7qn7hkpq35a9ar2krjsnqgkxkjtmezz48jdkj", 36000000000000000,
+		1546300800, year, year+24*3600, year, year, year)
+}
+
 func createExampleGenesisAssetData() asset.GenesisState {
 	cet := createCetToken("coinex133w8vwj73s4h2uynqft9gyyy52cr6rg8dskv3h")
 	abc := createAbcToken()
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestExampleGenesisAccounts(t *testing.T) {
+	periodicAcc, schedule := createExamplePeriodicVestingAccount()
+	accs := append(createExampleGenesisAccounts(), periodicAcc)
+	total := sdk.ZeroInt()
+	for _, acc := range accs {
+		total = total.Add(acc.Coins.AmountOf(dex.CET))
+	}
+	// the foundation unlocks are only counted once, in the periodic vesting account
+	require.Equal(t, sdk.NewInt(588800000000000000), total)
+	require.Len(t, accs, 4)
+	require.Equal(t, dex.NewCetCoins(180000000000000000), periodicAcc.OriginalVesting)
+	require.Len(t, schedule.VestingPeriods, 5)
+}
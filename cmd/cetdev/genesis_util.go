@@ -7,6 +7,7 @@ import (
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
 	dex "github.com/coinexchain/cet-sdk/types"
+	"github.com/coinexchain/dex/app/vesting"
 )
 
 func newBaseGenesisAccount(address string, amt int64) genaccounts.GenesisAccount {
@@ -16,21 +17,24 @@ func newBaseGenesisAccount(address string, amt int64) genaccounts.GenesisAccount
 	})
 }
 
-func newVestingGenesisAccount(address string, amt int64, endTime int64) genaccounts.GenesisAccount {
-	acc, err := genaccounts.NewGenesisAccountI(&auth.DelayedVestingAccount{
-		BaseVestingAccount: &auth.BaseVestingAccount{
-			BaseAccount: &auth.BaseAccount{
-				Address: accAddressFromBech32(address),
-				Coins:   dex.NewCetCoins(amt),
-			},
-			OriginalVesting: dex.NewCetCoins(amt),
-			EndTime:         endTime,
-		},
-	})
+// newPeriodicVestingGenesisAccount returns a periodic vesting account, which unlocks amt at the end of
+// each period, and its vesting schedule, which must be added to the genesis state of vesting
+func newPeriodicVestingGenesisAccount(address string, amt int64, startTime int64,
+	periodLengths ...int64) (genaccounts.GenesisAccount, vesting.Schedule) {
+
+	periods := make(vesting.Periods, len(periodLengths))
+	for i, length := range periodLengths {
+		periods[i] = vesting.Period{Length: length, Amount: dex.NewCetCoins(amt)}
+	}
+	baseAcc := &auth.BaseAccount{
+		Address: accAddressFromBech32(address),
+		Coins:   periods.TotalAmount(),
+	}
+	acc, schedule, err := vesting.NewGenesisEntries(vesting.NewPeriodicVestingAccount(baseAcc, startTime, periods))
 	if err != nil {
 		panic(err)
 	}
-	return acc
+	return acc, schedule
 }
 
 func accAddressFromBech32(address string) sdk.AccAddress {
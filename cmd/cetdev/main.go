@@ -43,9 +43,11 @@ func createRootCmd(cdc *codec.Codec) *cobra.Command {
 
 	rootCmd.AddCommand(
 		ExampleGenesisCmd(cdc),
+		BuildGenesisCmd(cdc),
 		DefaultParamsCmd(),
 		CosmosHubParamsCmd(cdc),
 		RestEndpointsCmd(registerRoutes),
+		CodecBenchCmd(cdc),
 		//ShowCommandTreeCmd(),
 	)
 
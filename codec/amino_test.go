@@ -0,0 +1,33 @@
+package codec
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	amino "github.com/tendermint/go-amino"
+)
+
+// The codon encoding agrees with the bare amino encoding only on the unsigned
+// integers and the bools, which are the leaves encoded the same way by both
+func TestLeavesMatchAmino(t *testing.T) {
+	cdc := amino.NewCodec()
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 1000; i++ {
+		u := r.Uint64() >> uint(r.Intn(64))
+		var buf bytes.Buffer
+		require.Nil(t, codonEncodeUvarint(&buf, u))
+		require.Equal(t, cdc.MustMarshalBinaryBare(u), buf.Bytes(), "%d", u)
+
+		var n int
+		var err error
+		require.Equal(t, u, codonDecodeUint64(cdc.MustMarshalBinaryBare(u), &n, &err))
+		require.Nil(t, err)
+	}
+	for _, b := range []bool{false, true} {
+		var buf bytes.Buffer
+		require.Nil(t, codonEncodeBool(&buf, b))
+		require.Equal(t, cdc.MustMarshalBinaryBare(b), buf.Bytes())
+	}
+}
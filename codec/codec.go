@@ -1,14 +1,19 @@
-//nolint
+// nolint
 package codec
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"io"
 	"math"
+	"math/big"
+	"strconv"
 	"time"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"unicode/utf8"
 )
 
 func codonEncodeBool(w io.Writer, v bool) error {
@@ -76,7 +81,7 @@ func codonEncodeString(w io.Writer, v string) error {
 }
 func codonDecodeBool(bz []byte, n *int, err *error) bool {
 	if len(bz) < 1 {
-		*err = errors.New("Not enough bytes to read")
+		*err = ErrNotEnoughBytes
 		return false
 	}
 	*n = 1
@@ -87,7 +92,7 @@ func codonDecodeInt(bz []byte, m *int, err *error) int {
 	i, n := binary.Varint(bz)
 	if n == 0 {
 		// buf too small
-		*err = errors.New("buffer too small")
+		*err = ErrNotEnoughBytes
 	} else if n < 0 {
 		// value larger than 64 bits (overflow)
 		// and -n is the number of bytes read
@@ -99,7 +104,7 @@ func codonDecodeInt(bz []byte, m *int, err *error) int {
 }
 func codonDecodeInt8(bz []byte, n *int, err *error) int8 {
 	if len(bz) < 1 {
-		*err = errors.New("Not enough bytes to read")
+		*err = ErrNotEnoughBytes
 		return 0
 	}
 	*err = nil
@@ -108,7 +113,7 @@ func codonDecodeInt8(bz []byte, n *int, err *error) int8 {
 }
 func codonDecodeInt16(bz []byte, n *int, err *error) int16 {
 	if len(bz) < 2 {
-		*err = errors.New("Not enough bytes to read")
+		*err = ErrNotEnoughBytes
 		return 0
 	}
 	*n = 2
@@ -123,7 +128,7 @@ func codonDecodeInt64(bz []byte, m *int, err *error) int64 {
 	i, n := binary.Varint(bz)
 	if n == 0 {
 		// buf too small
-		*err = errors.New("buffer too small")
+		*err = ErrNotEnoughBytes
 	} else if n < 0 {
 		// value larger than 64 bits (overflow)
 		// and -n is the number of bytes read
@@ -131,7 +136,6 @@ func codonDecodeInt64(bz []byte, m *int, err *error) int64 {
 		*err = errors.New("EOF decoding varint")
 	}
 	*m = n
-	*err = nil
 	return int64(i)
 }
 func codonDecodeUint(bz []byte, n *int, err *error) uint {
@@ -140,7 +144,7 @@ func codonDecodeUint(bz []byte, n *int, err *error) uint {
 }
 func codonDecodeUint8(bz []byte, n *int, err *error) uint8 {
 	if len(bz) < 1 {
-		*err = errors.New("Not enough bytes to read")
+		*err = ErrNotEnoughBytes
 		return 0
 	}
 	*n = 1
@@ -149,7 +153,7 @@ func codonDecodeUint8(bz []byte, n *int, err *error) uint8 {
 }
 func codonDecodeUint16(bz []byte, n *int, err *error) uint16 {
 	if len(bz) < 2 {
-		*err = errors.New("Not enough bytes to read")
+		*err = ErrNotEnoughBytes
 		return 0
 	}
 	*n = 2
@@ -164,7 +168,7 @@ func codonDecodeUint64(bz []byte, m *int, err *error) uint64 {
 	i, n := binary.Uvarint(bz)
 	if n == 0 {
 		// buf too small
-		*err = errors.New("buffer too small")
+		*err = ErrNotEnoughBytes
 	} else if n < 0 {
 		// value larger than 64 bits (overflow)
 		// and -n is the number of bytes read
@@ -172,12 +176,11 @@ func codonDecodeUint64(bz []byte, m *int, err *error) uint64 {
 		*err = errors.New("EOF decoding varint")
 	}
 	*m = n
-	*err = nil
 	return uint64(i)
 }
 func codonDecodeFloat64(bz []byte, n *int, err *error) float64 {
 	if len(bz) < 8 {
-		*err = errors.New("Not enough bytes to read")
+		*err = ErrNotEnoughBytes
 		return 0
 	}
 	*n = 8
@@ -187,7 +190,7 @@ func codonDecodeFloat64(bz []byte, n *int, err *error) float64 {
 }
 func codonDecodeFloat32(bz []byte, n *int, err *error) float32 {
 	if len(bz) < 4 {
-		*err = errors.New("Not enough bytes to read")
+		*err = ErrNotEnoughBytes
 		return 0
 	}
 	*n = 4
@@ -196,8 +199,11 @@ func codonDecodeFloat32(bz []byte, n *int, err *error) float32 {
 	return math.Float32frombits(i)
 }
 func codonGetByteSlice(bz []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, errors.New("Invalid length")
+	}
 	if len(bz) < length {
-		return nil, 0, errors.New("Not enough bytes to read")
+		return nil, 0, ErrNotEnoughBytes
 	}
 	return bz[:length], length, nil
 }
@@ -214,6 +220,24 @@ func codonDecodeString(bz []byte, n *int, err *error) string {
 	return string(bs)
 }
 
+// codonDecodeLength decodes the length of a slice, which can not be more than
+// the limit, if not negative. As each element takes at least one byte, a length
+// more than the bytes left means the bytes are cut short.
+func codonDecodeLength(bz []byte, n *int, err *error, limit int) int {
+	length := codonDecodeInt(bz, n, err)
+	if *err != nil {
+		return length
+	}
+	if length < 0 {
+		*err = errors.New("Invalid length")
+	} else if limit >= 0 && length > limit {
+		*err = ErrSliceTooLong
+	} else if length > len(bz)-*n {
+		*err = ErrNotEnoughBytes
+	}
+	return length
+}
+
 func EncodeTime(w io.Writer, t time.Time) error {
 	t = t.UTC()
 	sec := t.Unix()
@@ -238,7 +262,7 @@ func DecodeTime(bz []byte) (time.Time, int, error) {
 	var err error
 	if n == 0 {
 		// buf too small
-		err = errors.New("buffer too small")
+		err = ErrNotEnoughBytes
 	} else if n < 0 {
 		// value larger than 64 bits (overflow)
 		// and -n is the number of bytes read
@@ -252,7 +276,7 @@ func DecodeTime(bz []byte) (time.Time, int, error) {
 	nanosec, m := binary.Varint(bz[n:])
 	if m == 0 {
 		// buf too small
-		err = errors.New("buffer too small")
+		err = ErrNotEnoughBytes
 	} else if m < 0 {
 		// value larger than 64 bits (overflow)
 		// and -m is the number of bytes read
@@ -335,10 +359,146 @@ func RandDec(r RandSrc) sdk.Dec {
 	for i := 0; i < count; i++ {
 		res = res.MulInt64(r.GetInt64())
 	}
-	res = res.QuoInt64(r.GetInt64() & 0xFFFFFFFF)
+	res = res.QuoInt64(r.GetInt64()&0xFFFFFFFF + 1)
 	return res
 }
 
+func DeepCopyTime(t time.Time) time.Time {
+	return t
+}
+
+// EqualTime compares the instants, as the times are encoded in UTC
+func EqualTime(a, b time.Time) bool {
+	return a.Equal(b)
+}
+
+func DeepCopyInt(v sdk.Int) sdk.Int {
+	if v == (sdk.Int{}) {
+		return v
+	}
+	return sdk.NewIntFromBigInt(v.BigInt())
+}
+
+func EqualInt(a, b sdk.Int) bool {
+	if a == (sdk.Int{}) || b == (sdk.Int{}) {
+		return a == b
+	}
+	return a.Equal(b)
+}
+
+func DeepCopyDec(v sdk.Dec) sdk.Dec {
+	if v.IsNil() {
+		return v
+	}
+	return sdk.Dec{Int: new(big.Int).Set(v.Int)}
+}
+
+func EqualDec(a, b sdk.Dec) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() && b.IsNil()
+	}
+	return a.Equal(b)
+}
+
+// codonJSONEscapes holds the escapes of the ASCII bytes written by encoding/json,
+// which escapes the control bytes and <, > and & for HTML
+var codonJSONEscapes [utf8.RuneSelf]string
+
+func init() {
+	for c := 0; c < utf8.RuneSelf; c++ {
+		bz, _ := json.Marshal(string(rune(c)))
+		if s := string(bz[1 : len(bz)-1]); s != string(rune(c)) {
+			codonJSONEscapes[c] = s
+		}
+	}
+}
+
+// codonWriteJSONString writes s as json.Marshal does, with the invalid UTF-8
+// replaced by U+FFFD and U+2028 and U+2029 escaped
+func codonWriteJSONString(w *bytes.Buffer, s string) {
+	w.WriteByte('"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if codonJSONEscapes[c] != "" {
+				w.WriteString(s[start:i])
+				w.WriteString(codonJSONEscapes[c])
+				start = i + 1
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			w.WriteString(s[start:i])
+			w.WriteString("\ufffd")
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			w.WriteString(s[start:i])
+			w.WriteString("\\u202")
+			w.WriteByte(byte('8' + r - '\u2028'))
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	w.WriteString(s[start:])
+	w.WriteByte('"')
+}
+
+func codonWriteJSONBytes(w *bytes.Buffer, bz []byte) {
+	w.WriteByte('"')
+	buf := make([]byte, base64.StdEncoding.EncodedLen(len(bz)))
+	base64.StdEncoding.Encode(buf, bz)
+	w.Write(buf)
+	w.WriteByte('"')
+}
+
+func codonWriteJSONBool(w *bytes.Buffer, v bool) {
+	if v {
+		w.WriteString("true")
+	} else {
+		w.WriteString("false")
+	}
+}
+
+func codonWriteJSONInt(w *bytes.Buffer, v int64) {
+	var buf [20]byte
+	w.Write(strconv.AppendInt(buf[:0], v, 10))
+}
+
+func codonWriteJSONUint(w *bytes.Buffer, v uint64) {
+	var buf [20]byte
+	w.Write(strconv.AppendUint(buf[:0], v, 10))
+}
+
+// amino quotes the 64-bit integers, which JavaScript can not handle
+func codonWriteJSONQuotedInt(w *bytes.Buffer, v int64) {
+	w.WriteByte('"')
+	codonWriteJSONInt(w, v)
+	w.WriteByte('"')
+}
+
+func codonWriteJSONQuotedUint(w *bytes.Buffer, v uint64) {
+	w.WriteByte('"')
+	codonWriteJSONUint(w, v)
+	w.WriteByte('"')
+}
+
+// codonWriteJSON writes the output of MarshalJSON as it is, as amino does
+func codonWriteJSON(w *bytes.Buffer, v json.Marshaler) error {
+	bz, err := v.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	w.Write(bz)
+	return nil
+}
+
 // Non-Interface
 func EncodeDuplicateVoteEvidence(w io.Writer, v DuplicateVoteEvidence) error {
 	// codon version: 1
@@ -437,13 +597,17 @@ func EncodeDuplicateVoteEvidence(w io.Writer, v DuplicateVoteEvidence) error {
 } //End of EncodeDuplicateVoteEvidence
 
 func DecodeDuplicateVoteEvidence(bz []byte) (DuplicateVoteEvidence, int, error) {
+	return decodeDuplicateVoteEvidence(bz, -1)
+}
+
+func decodeDuplicateVoteEvidence(bz []byte, limit int) (DuplicateVoteEvidence, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v DuplicateVoteEvidence
 	var n int
 	var total int
-	v.PubKey, n, err = DecodePubKey(bz)
+	v.PubKey, n, err = decodePubKey(bz, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -468,7 +632,7 @@ func DecodeDuplicateVoteEvidence(bz []byte) (DuplicateVoteEvidence, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -486,7 +650,7 @@ func DecodeDuplicateVoteEvidence(bz []byte) (DuplicateVoteEvidence, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -506,7 +670,7 @@ func DecodeDuplicateVoteEvidence(bz []byte) (DuplicateVoteEvidence, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -524,7 +688,7 @@ func DecodeDuplicateVoteEvidence(bz []byte) (DuplicateVoteEvidence, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -556,7 +720,7 @@ func DecodeDuplicateVoteEvidence(bz []byte) (DuplicateVoteEvidence, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -574,7 +738,7 @@ func DecodeDuplicateVoteEvidence(bz []byte) (DuplicateVoteEvidence, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -594,7 +758,7 @@ func DecodeDuplicateVoteEvidence(bz []byte) (DuplicateVoteEvidence, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -612,7 +776,7 @@ func DecodeDuplicateVoteEvidence(bz []byte) (DuplicateVoteEvidence, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -684,18 +848,25 @@ func EncodePrivKeyEd25519(w io.Writer, v PrivKeyEd25519) error {
 } //End of EncodePrivKeyEd25519
 
 func DecodePrivKeyEd25519(bz []byte) (PrivKeyEd25519, int, error) {
+	return decodePrivKeyEd25519(bz, -1)
+}
+
+func decodePrivKeyEd25519(bz []byte, limit int) (PrivKeyEd25519, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v PrivKeyEd25519
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, -1)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
+	if length != len(v) {
+		return v, total, errors.New("Invalid length")
+	}
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //array of uint8
 		v[_0] = uint8(codonDecodeUint8(bz, &n, &err))
 		if err != nil {
@@ -730,18 +901,25 @@ func EncodePrivKeySecp256k1(w io.Writer, v PrivKeySecp256k1) error {
 } //End of EncodePrivKeySecp256k1
 
 func DecodePrivKeySecp256k1(bz []byte) (PrivKeySecp256k1, int, error) {
+	return decodePrivKeySecp256k1(bz, -1)
+}
+
+func decodePrivKeySecp256k1(bz []byte, limit int) (PrivKeySecp256k1, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v PrivKeySecp256k1
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, -1)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
+	if length != len(v) {
+		return v, total, errors.New("Invalid length")
+	}
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //array of uint8
 		v[_0] = uint8(codonDecodeUint8(bz, &n, &err))
 		if err != nil {
@@ -776,18 +954,25 @@ func EncodePubKeyEd25519(w io.Writer, v PubKeyEd25519) error {
 } //End of EncodePubKeyEd25519
 
 func DecodePubKeyEd25519(bz []byte) (PubKeyEd25519, int, error) {
+	return decodePubKeyEd25519(bz, -1)
+}
+
+func decodePubKeyEd25519(bz []byte, limit int) (PubKeyEd25519, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v PubKeyEd25519
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, -1)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
+	if length != len(v) {
+		return v, total, errors.New("Invalid length")
+	}
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //array of uint8
 		v[_0] = uint8(codonDecodeUint8(bz, &n, &err))
 		if err != nil {
@@ -822,18 +1007,25 @@ func EncodePubKeySecp256k1(w io.Writer, v PubKeySecp256k1) error {
 } //End of EncodePubKeySecp256k1
 
 func DecodePubKeySecp256k1(bz []byte) (PubKeySecp256k1, int, error) {
+	return decodePubKeySecp256k1(bz, -1)
+}
+
+func decodePubKeySecp256k1(bz []byte, limit int) (PubKeySecp256k1, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v PubKeySecp256k1
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, -1)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
+	if length != len(v) {
+		return v, total, errors.New("Invalid length")
+	}
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //array of uint8
 		v[_0] = uint8(codonDecodeUint8(bz, &n, &err))
 		if err != nil {
@@ -878,6 +1070,10 @@ func EncodePubKeyMultisigThreshold(w io.Writer, v PubKeyMultisigThreshold) error
 } //End of EncodePubKeyMultisigThreshold
 
 func DecodePubKeyMultisigThreshold(bz []byte) (PubKeyMultisigThreshold, int, error) {
+	return decodePubKeyMultisigThreshold(bz, -1)
+}
+
+func decodePubKeyMultisigThreshold(bz []byte, limit int) (PubKeyMultisigThreshold, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -890,7 +1086,7 @@ func DecodePubKeyMultisigThreshold(bz []byte) (PubKeyMultisigThreshold, int, err
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -898,7 +1094,7 @@ func DecodePubKeyMultisigThreshold(bz []byte) (PubKeyMultisigThreshold, int, err
 	total += n
 	v.PubKeys = make([]PubKey, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of interface
-		v.PubKeys[_0], n, err = DecodePubKey(bz)
+		v.PubKeys[_0], n, err = decodePubKey(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -933,6 +1129,10 @@ func EncodeSignedMsgType(w io.Writer, v SignedMsgType) error {
 } //End of EncodeSignedMsgType
 
 func DecodeSignedMsgType(bz []byte) (SignedMsgType, int, error) {
+	return decodeSignedMsgType(bz, -1)
+}
+
+func decodeSignedMsgType(bz []byte, limit int) (SignedMsgType, int, error) {
 	// codon version: 1
 	var err error
 	var v SignedMsgType
@@ -966,6 +1166,10 @@ func EncodeVoteOption(w io.Writer, v VoteOption) error {
 } //End of EncodeVoteOption
 
 func DecodeVoteOption(bz []byte) (VoteOption, int, error) {
+	return decodeVoteOption(bz, -1)
+}
+
+func decodeVoteOption(bz []byte, limit int) (VoteOption, int, error) {
 	// codon version: 1
 	var err error
 	var v VoteOption
@@ -1037,6 +1241,10 @@ func EncodeVote(w io.Writer, v Vote) error {
 } //End of EncodeVote
 
 func DecodeVote(bz []byte) (Vote, int, error) {
+	return decodeVote(bz, -1)
+}
+
+func decodeVote(bz []byte, limit int) (Vote, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -1061,7 +1269,7 @@ func DecodeVote(bz []byte) (Vote, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1079,7 +1287,7 @@ func DecodeVote(bz []byte) (Vote, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1099,7 +1307,7 @@ func DecodeVote(bz []byte) (Vote, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1117,7 +1325,7 @@ func DecodeVote(bz []byte) (Vote, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1171,6 +1379,10 @@ func EncodeCoin(w io.Writer, v Coin) error {
 } //End of EncodeCoin
 
 func DecodeCoin(bz []byte) (Coin, int, error) {
+	return decodeCoin(bz, -1)
+}
+
+func decodeCoin(bz []byte, limit int) (Coin, int, error) {
 	// codon version: 1
 	var err error
 	var v Coin
@@ -1232,6 +1444,10 @@ func EncodeLockedCoin(w io.Writer, v LockedCoin) error {
 } //End of EncodeLockedCoin
 
 func DecodeLockedCoin(bz []byte) (LockedCoin, int, error) {
+	return decodeLockedCoin(bz, -1)
+}
+
+func decodeLockedCoin(bz []byte, limit int) (LockedCoin, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -1257,7 +1473,7 @@ func DecodeLockedCoin(bz []byte) (LockedCoin, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1269,7 +1485,7 @@ func DecodeLockedCoin(bz []byte) (LockedCoin, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1322,19 +1538,23 @@ func EncodeStdSignature(w io.Writer, v StdSignature) error {
 } //End of EncodeStdSignature
 
 func DecodeStdSignature(bz []byte) (StdSignature, int, error) {
+	return decodeStdSignature(bz, -1)
+}
+
+func decodeStdSignature(bz []byte, limit int) (StdSignature, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v StdSignature
 	var n int
 	var total int
-	v.PubKey, n, err = DecodePubKey(bz)
+	v.PubKey, n, err = decodePubKey(bz, limit)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n // interface_decode
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1383,6 +1603,10 @@ func EncodeParamChange(w io.Writer, v ParamChange) error {
 } //End of EncodeParamChange
 
 func DecodeParamChange(bz []byte) (ParamChange, int, error) {
+	return decodeParamChange(bz, -1)
+}
+
+func decodeParamChange(bz []byte, limit int) (ParamChange, int, error) {
 	// codon version: 1
 	var err error
 	var v ParamChange
@@ -1452,13 +1676,17 @@ func EncodeInput(w io.Writer, v Input) error {
 } //End of EncodeInput
 
 func DecodeInput(bz []byte) (Input, int, error) {
+	return decodeInput(bz, -1)
+}
+
+func decodeInput(bz []byte, limit int) (Input, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v Input
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1470,7 +1698,7 @@ func DecodeInput(bz []byte) (Input, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1478,7 +1706,7 @@ func DecodeInput(bz []byte) (Input, int, error) {
 	total += n
 	v.Coins = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Coins[_0], n, err = DecodeCoin(bz)
+		v.Coins[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -1529,13 +1757,17 @@ func EncodeOutput(w io.Writer, v Output) error {
 } //End of EncodeOutput
 
 func DecodeOutput(bz []byte) (Output, int, error) {
+	return decodeOutput(bz, -1)
+}
+
+func decodeOutput(bz []byte, limit int) (Output, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v Output
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1547,7 +1779,7 @@ func DecodeOutput(bz []byte) (Output, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1555,7 +1787,7 @@ func DecodeOutput(bz []byte) (Output, int, error) {
 	total += n
 	v.Coins = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Coins[_0], n, err = DecodeCoin(bz)
+		v.Coins[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -1591,13 +1823,17 @@ func EncodeAccAddress(w io.Writer, v AccAddress) error {
 } //End of EncodeAccAddress
 
 func DecodeAccAddress(bz []byte) (AccAddress, int, error) {
+	return decodeAccAddress(bz, -1)
+}
+
+func decodeAccAddress(bz []byte, limit int) (AccAddress, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v AccAddress
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1655,6 +1891,10 @@ func EncodeCommentRef(w io.Writer, v CommentRef) error {
 } //End of EncodeCommentRef
 
 func DecodeCommentRef(bz []byte) (CommentRef, int, error) {
+	return decodeCommentRef(bz, -1)
+}
+
+func decodeCommentRef(bz []byte, limit int) (CommentRef, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -1667,7 +1907,7 @@ func DecodeCommentRef(bz []byte) (CommentRef, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1691,7 +1931,7 @@ func DecodeCommentRef(bz []byte) (CommentRef, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1726,6 +1966,80 @@ func RandCommentRef(r RandSrc) CommentRef {
 	return v
 } //End of RandCommentRef
 
+// Non-Interface
+func EncodePeriod(w io.Writer, v Period) error {
+	// codon version: 1
+	var err error
+	err = codonEncodeVarint(w, int64(v.Length))
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(len(v.Amount)))
+	if err != nil {
+		return err
+	}
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		err = codonEncodeString(w, v.Amount[_0].Denom)
+		if err != nil {
+			return err
+		}
+		err = EncodeInt(w, v.Amount[_0].Amount)
+		if err != nil {
+			return err
+		}
+		// end of v.Amount[_0]
+	}
+	return nil
+} //End of EncodePeriod
+
+func DecodePeriod(bz []byte) (Period, int, error) {
+	return decodePeriod(bz, -1)
+}
+
+func decodePeriod(bz []byte, limit int) (Period, int, error) {
+	// codon version: 1
+	var err error
+	var length int
+	var v Period
+	var n int
+	var total int
+	v.Length = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Amount = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.Amount[_0], n, err = decodeCoin(bz, limit)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+	}
+	return v, total, nil
+} //End of DecodePeriod
+
+func RandPeriod(r RandSrc) Period {
+	// codon version: 1
+	var length int
+	var v Period
+	v.Length = r.GetInt64()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Amount = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.Amount[_0] = RandCoin(r)
+	}
+	return v
+} //End of RandPeriod
+
 // Non-Interface
 func EncodeBaseAccount(w io.Writer, v BaseAccount) error {
 	// codon version: 1
@@ -1765,13 +2079,17 @@ func EncodeBaseAccount(w io.Writer, v BaseAccount) error {
 } //End of EncodeBaseAccount
 
 func DecodeBaseAccount(bz []byte) (BaseAccount, int, error) {
+	return decodeBaseAccount(bz, -1)
+}
+
+func decodeBaseAccount(bz []byte, limit int) (BaseAccount, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v BaseAccount
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1783,7 +2101,7 @@ func DecodeBaseAccount(bz []byte) (BaseAccount, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1791,14 +2109,14 @@ func DecodeBaseAccount(bz []byte) (BaseAccount, int, error) {
 	total += n
 	v.Coins = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Coins[_0], n, err = DecodeCoin(bz)
+		v.Coins[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	v.PubKey, n, err = DecodePubKey(bz)
+	v.PubKey, n, err = decodePubKey(bz, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1925,6 +2243,10 @@ func EncodeBaseVestingAccount(w io.Writer, v BaseVestingAccount) error {
 } //End of EncodeBaseVestingAccount
 
 func DecodeBaseVestingAccount(bz []byte) (BaseVestingAccount, int, error) {
+	return decodeBaseVestingAccount(bz, -1)
+}
+
+func decodeBaseVestingAccount(bz []byte, limit int) (BaseVestingAccount, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -1932,7 +2254,7 @@ func DecodeBaseVestingAccount(bz []byte) (BaseVestingAccount, int, error) {
 	var n int
 	var total int
 	v.BaseAccount = &BaseAccount{}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1944,7 +2266,7 @@ func DecodeBaseVestingAccount(bz []byte) (BaseVestingAccount, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1952,14 +2274,14 @@ func DecodeBaseVestingAccount(bz []byte) (BaseVestingAccount, int, error) {
 	total += n
 	v.BaseAccount.Coins = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseAccount.Coins[_0], n, err = DecodeCoin(bz)
+		v.BaseAccount.Coins[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	v.BaseAccount.PubKey, n, err = DecodePubKey(bz)
+	v.BaseAccount.PubKey, n, err = decodePubKey(bz, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1978,7 +2300,7 @@ func DecodeBaseVestingAccount(bz []byte) (BaseVestingAccount, int, error) {
 	bz = bz[n:]
 	total += n
 	// end of v.BaseAccount
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -1986,14 +2308,14 @@ func DecodeBaseVestingAccount(bz []byte) (BaseVestingAccount, int, error) {
 	total += n
 	v.OriginalVesting = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.OriginalVesting[_0], n, err = DecodeCoin(bz)
+		v.OriginalVesting[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2001,14 +2323,14 @@ func DecodeBaseVestingAccount(bz []byte) (BaseVestingAccount, int, error) {
 	total += n
 	v.DelegatedFree = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.DelegatedFree[_0], n, err = DecodeCoin(bz)
+		v.DelegatedFree[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2016,7 +2338,7 @@ func DecodeBaseVestingAccount(bz []byte) (BaseVestingAccount, int, error) {
 	total += n
 	v.DelegatedVesting = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.DelegatedVesting[_0], n, err = DecodeCoin(bz)
+		v.DelegatedVesting[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -2161,6 +2483,10 @@ func EncodeContinuousVestingAccount(w io.Writer, v ContinuousVestingAccount) err
 } //End of EncodeContinuousVestingAccount
 
 func DecodeContinuousVestingAccount(bz []byte) (ContinuousVestingAccount, int, error) {
+	return decodeContinuousVestingAccount(bz, -1)
+}
+
+func decodeContinuousVestingAccount(bz []byte, limit int) (ContinuousVestingAccount, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -2169,7 +2495,7 @@ func DecodeContinuousVestingAccount(bz []byte) (ContinuousVestingAccount, int, e
 	var total int
 	v.BaseVestingAccount = &BaseVestingAccount{}
 	v.BaseVestingAccount.BaseAccount = &BaseAccount{}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2181,7 +2507,7 @@ func DecodeContinuousVestingAccount(bz []byte) (ContinuousVestingAccount, int, e
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2189,14 +2515,14 @@ func DecodeContinuousVestingAccount(bz []byte) (ContinuousVestingAccount, int, e
 	total += n
 	v.BaseVestingAccount.BaseAccount.Coins = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseVestingAccount.BaseAccount.Coins[_0], n, err = DecodeCoin(bz)
+		v.BaseVestingAccount.BaseAccount.Coins[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	v.BaseVestingAccount.BaseAccount.PubKey, n, err = DecodePubKey(bz)
+	v.BaseVestingAccount.BaseAccount.PubKey, n, err = decodePubKey(bz, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2215,7 +2541,7 @@ func DecodeContinuousVestingAccount(bz []byte) (ContinuousVestingAccount, int, e
 	bz = bz[n:]
 	total += n
 	// end of v.BaseVestingAccount.BaseAccount
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2223,14 +2549,14 @@ func DecodeContinuousVestingAccount(bz []byte) (ContinuousVestingAccount, int, e
 	total += n
 	v.BaseVestingAccount.OriginalVesting = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseVestingAccount.OriginalVesting[_0], n, err = DecodeCoin(bz)
+		v.BaseVestingAccount.OriginalVesting[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2238,14 +2564,14 @@ func DecodeContinuousVestingAccount(bz []byte) (ContinuousVestingAccount, int, e
 	total += n
 	v.BaseVestingAccount.DelegatedFree = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseVestingAccount.DelegatedFree[_0], n, err = DecodeCoin(bz)
+		v.BaseVestingAccount.DelegatedFree[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2253,7 +2579,7 @@ func DecodeContinuousVestingAccount(bz []byte) (ContinuousVestingAccount, int, e
 	total += n
 	v.BaseVestingAccount.DelegatedVesting = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseVestingAccount.DelegatedVesting[_0], n, err = DecodeCoin(bz)
+		v.BaseVestingAccount.DelegatedVesting[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -2404,6 +2730,10 @@ func EncodeDelayedVestingAccount(w io.Writer, v DelayedVestingAccount) error {
 } //End of EncodeDelayedVestingAccount
 
 func DecodeDelayedVestingAccount(bz []byte) (DelayedVestingAccount, int, error) {
+	return decodeDelayedVestingAccount(bz, -1)
+}
+
+func decodeDelayedVestingAccount(bz []byte, limit int) (DelayedVestingAccount, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -2412,7 +2742,7 @@ func DecodeDelayedVestingAccount(bz []byte) (DelayedVestingAccount, int, error)
 	var total int
 	v.BaseVestingAccount = &BaseVestingAccount{}
 	v.BaseVestingAccount.BaseAccount = &BaseAccount{}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2424,7 +2754,7 @@ func DecodeDelayedVestingAccount(bz []byte) (DelayedVestingAccount, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2432,14 +2762,14 @@ func DecodeDelayedVestingAccount(bz []byte) (DelayedVestingAccount, int, error)
 	total += n
 	v.BaseVestingAccount.BaseAccount.Coins = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseVestingAccount.BaseAccount.Coins[_0], n, err = DecodeCoin(bz)
+		v.BaseVestingAccount.BaseAccount.Coins[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	v.BaseVestingAccount.BaseAccount.PubKey, n, err = DecodePubKey(bz)
+	v.BaseVestingAccount.BaseAccount.PubKey, n, err = decodePubKey(bz, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2458,7 +2788,7 @@ func DecodeDelayedVestingAccount(bz []byte) (DelayedVestingAccount, int, error)
 	bz = bz[n:]
 	total += n
 	// end of v.BaseVestingAccount.BaseAccount
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2466,14 +2796,14 @@ func DecodeDelayedVestingAccount(bz []byte) (DelayedVestingAccount, int, error)
 	total += n
 	v.BaseVestingAccount.OriginalVesting = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseVestingAccount.OriginalVesting[_0], n, err = DecodeCoin(bz)
+		v.BaseVestingAccount.OriginalVesting[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2481,14 +2811,14 @@ func DecodeDelayedVestingAccount(bz []byte) (DelayedVestingAccount, int, error)
 	total += n
 	v.BaseVestingAccount.DelegatedFree = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseVestingAccount.DelegatedFree[_0], n, err = DecodeCoin(bz)
+		v.BaseVestingAccount.DelegatedFree[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2496,7 +2826,7 @@ func DecodeDelayedVestingAccount(bz []byte) (DelayedVestingAccount, int, error)
 	total += n
 	v.BaseVestingAccount.DelegatedVesting = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseVestingAccount.DelegatedVesting[_0], n, err = DecodeCoin(bz)
+		v.BaseVestingAccount.DelegatedVesting[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -2551,94 +2881,395 @@ func RandDelayedVestingAccount(r RandSrc) DelayedVestingAccount {
 } //End of RandDelayedVestingAccount
 
 // Non-Interface
-func EncodeModuleAccount(w io.Writer, v ModuleAccount) error {
+func EncodePeriodicVestingAccount(w io.Writer, v PeriodicVestingAccount) error {
 	// codon version: 1
 	var err error
-	err = codonEncodeByteSlice(w, v.BaseAccount.Address[:])
+	err = codonEncodeByteSlice(w, v.BaseVestingAccount.BaseAccount.Address[:])
 	if err != nil {
 		return err
 	}
-	err = codonEncodeVarint(w, int64(len(v.BaseAccount.Coins)))
+	err = codonEncodeVarint(w, int64(len(v.BaseVestingAccount.BaseAccount.Coins)))
 	if err != nil {
 		return err
 	}
-	for _0 := 0; _0 < len(v.BaseAccount.Coins); _0++ {
-		err = codonEncodeString(w, v.BaseAccount.Coins[_0].Denom)
+	for _0 := 0; _0 < len(v.BaseVestingAccount.BaseAccount.Coins); _0++ {
+		err = codonEncodeString(w, v.BaseVestingAccount.BaseAccount.Coins[_0].Denom)
 		if err != nil {
 			return err
 		}
-		err = EncodeInt(w, v.BaseAccount.Coins[_0].Amount)
+		err = EncodeInt(w, v.BaseVestingAccount.BaseAccount.Coins[_0].Amount)
 		if err != nil {
 			return err
 		}
-		// end of v.BaseAccount.Coins[_0]
+		// end of v.BaseVestingAccount.BaseAccount.Coins[_0]
 	}
-	err = EncodePubKey(w, v.BaseAccount.PubKey)
+	err = EncodePubKey(w, v.BaseVestingAccount.BaseAccount.PubKey)
 	if err != nil {
 		return err
 	} // interface_encode
-	err = codonEncodeUvarint(w, uint64(v.BaseAccount.AccountNumber))
+	err = codonEncodeUvarint(w, uint64(v.BaseVestingAccount.BaseAccount.AccountNumber))
 	if err != nil {
 		return err
 	}
-	err = codonEncodeUvarint(w, uint64(v.BaseAccount.Sequence))
+	err = codonEncodeUvarint(w, uint64(v.BaseVestingAccount.BaseAccount.Sequence))
 	if err != nil {
 		return err
 	}
-	// end of v.BaseAccount
-	err = codonEncodeString(w, v.Name)
+	// end of v.BaseVestingAccount.BaseAccount
+	err = codonEncodeVarint(w, int64(len(v.BaseVestingAccount.OriginalVesting)))
 	if err != nil {
 		return err
 	}
-	err = codonEncodeVarint(w, int64(len(v.Permissions)))
+	for _0 := 0; _0 < len(v.BaseVestingAccount.OriginalVesting); _0++ {
+		err = codonEncodeString(w, v.BaseVestingAccount.OriginalVesting[_0].Denom)
+		if err != nil {
+			return err
+		}
+		err = EncodeInt(w, v.BaseVestingAccount.OriginalVesting[_0].Amount)
+		if err != nil {
+			return err
+		}
+		// end of v.BaseVestingAccount.OriginalVesting[_0]
+	}
+	err = codonEncodeVarint(w, int64(len(v.BaseVestingAccount.DelegatedFree)))
 	if err != nil {
 		return err
 	}
-	for _0 := 0; _0 < len(v.Permissions); _0++ {
-		err = codonEncodeString(w, v.Permissions[_0])
+	for _0 := 0; _0 < len(v.BaseVestingAccount.DelegatedFree); _0++ {
+		err = codonEncodeString(w, v.BaseVestingAccount.DelegatedFree[_0].Denom)
+		if err != nil {
+			return err
+		}
+		err = EncodeInt(w, v.BaseVestingAccount.DelegatedFree[_0].Amount)
 		if err != nil {
 			return err
 		}
+		// end of v.BaseVestingAccount.DelegatedFree[_0]
 	}
-	return nil
-} //End of EncodeModuleAccount
-
-func DecodeModuleAccount(bz []byte) (ModuleAccount, int, error) {
-	// codon version: 1
-	var err error
-	var length int
-	var v ModuleAccount
-	var n int
-	var total int
-	v.BaseAccount = &BaseAccount{}
-	length = codonDecodeInt(bz, &n, &err)
+	err = codonEncodeVarint(w, int64(len(v.BaseVestingAccount.DelegatedVesting)))
 	if err != nil {
-		return v, total, err
+		return err
 	}
-	bz = bz[n:]
-	total += n
-	v.BaseAccount.Address, n, err = codonGetByteSlice(bz, length)
+	for _0 := 0; _0 < len(v.BaseVestingAccount.DelegatedVesting); _0++ {
+		err = codonEncodeString(w, v.BaseVestingAccount.DelegatedVesting[_0].Denom)
+		if err != nil {
+			return err
+		}
+		err = EncodeInt(w, v.BaseVestingAccount.DelegatedVesting[_0].Amount)
+		if err != nil {
+			return err
+		}
+		// end of v.BaseVestingAccount.DelegatedVesting[_0]
+	}
+	err = codonEncodeVarint(w, int64(v.BaseVestingAccount.EndTime))
 	if err != nil {
-		return v, total, err
+		return err
 	}
-	bz = bz[n:]
-	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	// end of v.BaseVestingAccount
+	err = codonEncodeVarint(w, int64(v.StartTime))
 	if err != nil {
-		return v, total, err
+		return err
 	}
-	bz = bz[n:]
-	total += n
-	v.BaseAccount.Coins = make([]Coin, length)
-	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.BaseAccount.Coins[_0], n, err = DecodeCoin(bz)
+	err = codonEncodeVarint(w, int64(len(v.VestingPeriods)))
+	if err != nil {
+		return err
+	}
+	for _0 := 0; _0 < len(v.VestingPeriods); _0++ {
+		err = codonEncodeVarint(w, int64(v.VestingPeriods[_0].Length))
 		if err != nil {
-			return v, total, err
+			return err
 		}
-		bz = bz[n:]
+		err = codonEncodeVarint(w, int64(len(v.VestingPeriods[_0].Amount)))
+		if err != nil {
+			return err
+		}
+		for _1 := 0; _1 < len(v.VestingPeriods[_0].Amount); _1++ {
+			err = codonEncodeString(w, v.VestingPeriods[_0].Amount[_1].Denom)
+			if err != nil {
+				return err
+			}
+			err = EncodeInt(w, v.VestingPeriods[_0].Amount[_1].Amount)
+			if err != nil {
+				return err
+			}
+			// end of v.VestingPeriods[_0].Amount[_1]
+		}
+		// end of v.VestingPeriods[_0]
+	}
+	return nil
+} //End of EncodePeriodicVestingAccount
+
+func DecodePeriodicVestingAccount(bz []byte) (PeriodicVestingAccount, int, error) {
+	return decodePeriodicVestingAccount(bz, -1)
+}
+
+func decodePeriodicVestingAccount(bz []byte, limit int) (PeriodicVestingAccount, int, error) {
+	// codon version: 1
+	var err error
+	var length int
+	var v PeriodicVestingAccount
+	var n int
+	var total int
+	v.BaseVestingAccount = &BaseVestingAccount{}
+	v.BaseVestingAccount.BaseAccount = &BaseAccount{}
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.BaseVestingAccount.BaseAccount.Address, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.BaseVestingAccount.BaseAccount.Coins = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.BaseVestingAccount.BaseAccount.Coins[_0], n, err = decodeCoin(bz, limit)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+	}
+	v.BaseVestingAccount.BaseAccount.PubKey, n, err = decodePubKey(bz, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n // interface_decode
+	v.BaseVestingAccount.BaseAccount.AccountNumber = uint64(codonDecodeUint64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.BaseVestingAccount.BaseAccount.Sequence = uint64(codonDecodeUint64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	// end of v.BaseVestingAccount.BaseAccount
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.BaseVestingAccount.OriginalVesting = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.BaseVestingAccount.OriginalVesting[_0], n, err = decodeCoin(bz, limit)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+	}
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.BaseVestingAccount.DelegatedFree = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.BaseVestingAccount.DelegatedFree[_0], n, err = decodeCoin(bz, limit)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+	}
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.BaseVestingAccount.DelegatedVesting = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.BaseVestingAccount.DelegatedVesting[_0], n, err = decodeCoin(bz, limit)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+	}
+	v.BaseVestingAccount.EndTime = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	// end of v.BaseVestingAccount
+	v.StartTime = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.VestingPeriods = make([]Period, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.VestingPeriods[_0], n, err = decodePeriod(bz, limit)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+	}
+	return v, total, nil
+} //End of DecodePeriodicVestingAccount
+
+func RandPeriodicVestingAccount(r RandSrc) PeriodicVestingAccount {
+	// codon version: 1
+	var length int
+	var v PeriodicVestingAccount
+	v.BaseVestingAccount = &BaseVestingAccount{}
+	v.BaseVestingAccount.BaseAccount = &BaseAccount{}
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.BaseVestingAccount.BaseAccount.Address = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.BaseVestingAccount.BaseAccount.Coins = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.BaseVestingAccount.BaseAccount.Coins[_0] = RandCoin(r)
+	}
+	v.BaseVestingAccount.BaseAccount.PubKey = RandPubKey(r) // interface_decode
+	v.BaseVestingAccount.BaseAccount.AccountNumber = r.GetUint64()
+	v.BaseVestingAccount.BaseAccount.Sequence = r.GetUint64()
+	// end of v.BaseVestingAccount.BaseAccount
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.BaseVestingAccount.OriginalVesting = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.BaseVestingAccount.OriginalVesting[_0] = RandCoin(r)
+	}
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.BaseVestingAccount.DelegatedFree = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.BaseVestingAccount.DelegatedFree[_0] = RandCoin(r)
+	}
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.BaseVestingAccount.DelegatedVesting = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.BaseVestingAccount.DelegatedVesting[_0] = RandCoin(r)
+	}
+	v.BaseVestingAccount.EndTime = r.GetInt64()
+	// end of v.BaseVestingAccount
+	v.StartTime = r.GetInt64()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.VestingPeriods = make([]Period, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.VestingPeriods[_0] = RandPeriod(r)
+	}
+	return v
+} //End of RandPeriodicVestingAccount
+
+// Non-Interface
+func EncodeModuleAccount(w io.Writer, v ModuleAccount) error {
+	// codon version: 1
+	var err error
+	err = codonEncodeByteSlice(w, v.BaseAccount.Address[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(len(v.BaseAccount.Coins)))
+	if err != nil {
+		return err
+	}
+	for _0 := 0; _0 < len(v.BaseAccount.Coins); _0++ {
+		err = codonEncodeString(w, v.BaseAccount.Coins[_0].Denom)
+		if err != nil {
+			return err
+		}
+		err = EncodeInt(w, v.BaseAccount.Coins[_0].Amount)
+		if err != nil {
+			return err
+		}
+		// end of v.BaseAccount.Coins[_0]
+	}
+	err = EncodePubKey(w, v.BaseAccount.PubKey)
+	if err != nil {
+		return err
+	} // interface_encode
+	err = codonEncodeUvarint(w, uint64(v.BaseAccount.AccountNumber))
+	if err != nil {
+		return err
+	}
+	err = codonEncodeUvarint(w, uint64(v.BaseAccount.Sequence))
+	if err != nil {
+		return err
+	}
+	// end of v.BaseAccount
+	err = codonEncodeString(w, v.Name)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(len(v.Permissions)))
+	if err != nil {
+		return err
+	}
+	for _0 := 0; _0 < len(v.Permissions); _0++ {
+		err = codonEncodeString(w, v.Permissions[_0])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+} //End of EncodeModuleAccount
+
+func DecodeModuleAccount(bz []byte) (ModuleAccount, int, error) {
+	return decodeModuleAccount(bz, -1)
+}
+
+func decodeModuleAccount(bz []byte, limit int) (ModuleAccount, int, error) {
+	// codon version: 1
+	var err error
+	var length int
+	var v ModuleAccount
+	var n int
+	var total int
+	v.BaseAccount = &BaseAccount{}
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.BaseAccount.Address, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.BaseAccount.Coins = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.BaseAccount.Coins[_0], n, err = decodeCoin(bz, limit)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
 		total += n
 	}
-	v.BaseAccount.PubKey, n, err = DecodePubKey(bz)
+	v.BaseAccount.PubKey, n, err = decodePubKey(bz, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2663,7 +3294,7 @@ func DecodeModuleAccount(bz []byte) (ModuleAccount, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2763,13 +3394,17 @@ func EncodeStdTx(w io.Writer, v StdTx) error {
 } //End of EncodeStdTx
 
 func DecodeStdTx(bz []byte) (StdTx, int, error) {
+	return decodeStdTx(bz, -1)
+}
+
+func decodeStdTx(bz []byte, limit int) (StdTx, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v StdTx
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2777,14 +3412,14 @@ func DecodeStdTx(bz []byte) (StdTx, int, error) {
 	total += n
 	v.Msgs = make([]Msg, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of interface
-		v.Msgs[_0], n, err = DecodeMsg(bz)
+		v.Msgs[_0], n, err = decodeMsg(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2792,7 +3427,7 @@ func DecodeStdTx(bz []byte) (StdTx, int, error) {
 	total += n
 	v.Fee.Amount = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Fee.Amount[_0], n, err = DecodeCoin(bz)
+		v.Fee.Amount[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -2806,7 +3441,7 @@ func DecodeStdTx(bz []byte) (StdTx, int, error) {
 	bz = bz[n:]
 	total += n
 	// end of v.Fee
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2814,7 +3449,7 @@ func DecodeStdTx(bz []byte) (StdTx, int, error) {
 	total += n
 	v.Signatures = make([]StdSignature, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Signatures[_0], n, err = DecodeStdSignature(bz)
+		v.Signatures[_0], n, err = decodeStdSignature(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -2884,13 +3519,17 @@ func EncodeMsgBeginRedelegate(w io.Writer, v MsgBeginRedelegate) error {
 } //End of EncodeMsgBeginRedelegate
 
 func DecodeMsgBeginRedelegate(bz []byte) (MsgBeginRedelegate, int, error) {
-	// codon version: 1
+	return decodeMsgBeginRedelegate(bz, -1)
+}
+
+func decodeMsgBeginRedelegate(bz []byte, limit int) (MsgBeginRedelegate, int, error) {
+	// codon version: 1
 	var err error
 	var length int
 	var v MsgBeginRedelegate
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2902,7 +3541,7 @@ func DecodeMsgBeginRedelegate(bz []byte) (MsgBeginRedelegate, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -2914,7 +3553,7 @@ func DecodeMsgBeginRedelegate(bz []byte) (MsgBeginRedelegate, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3021,6 +3660,10 @@ func EncodeMsgCreateValidator(w io.Writer, v MsgCreateValidator) error {
 } //End of EncodeMsgCreateValidator
 
 func DecodeMsgCreateValidator(bz []byte) (MsgCreateValidator, int, error) {
+	return decodeMsgCreateValidator(bz, -1)
+}
+
+func decodeMsgCreateValidator(bz []byte, limit int) (MsgCreateValidator, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -3077,7 +3720,7 @@ func DecodeMsgCreateValidator(bz []byte) (MsgCreateValidator, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3089,7 +3732,7 @@ func DecodeMsgCreateValidator(bz []byte) (MsgCreateValidator, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3101,7 +3744,7 @@ func DecodeMsgCreateValidator(bz []byte) (MsgCreateValidator, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	v.PubKey, n, err = DecodePubKey(bz)
+	v.PubKey, n, err = decodePubKey(bz, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3173,13 +3816,17 @@ func EncodeMsgDelegate(w io.Writer, v MsgDelegate) error {
 } //End of EncodeMsgDelegate
 
 func DecodeMsgDelegate(bz []byte) (MsgDelegate, int, error) {
+	return decodeMsgDelegate(bz, -1)
+}
+
+func decodeMsgDelegate(bz []byte, limit int) (MsgDelegate, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgDelegate
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3191,7 +3838,7 @@ func DecodeMsgDelegate(bz []byte) (MsgDelegate, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3270,6 +3917,10 @@ func EncodeMsgEditValidator(w io.Writer, v MsgEditValidator) error {
 } //End of EncodeMsgEditValidator
 
 func DecodeMsgEditValidator(bz []byte) (MsgEditValidator, int, error) {
+	return decodeMsgEditValidator(bz, -1)
+}
+
+func decodeMsgEditValidator(bz []byte, limit int) (MsgEditValidator, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -3301,7 +3952,7 @@ func DecodeMsgEditValidator(bz []byte) (MsgEditValidator, int, error) {
 	bz = bz[n:]
 	total += n
 	// end of v.Description
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3364,13 +4015,17 @@ func EncodeMsgSetWithdrawAddress(w io.Writer, v MsgSetWithdrawAddress) error {
 } //End of EncodeMsgSetWithdrawAddress
 
 func DecodeMsgSetWithdrawAddress(bz []byte) (MsgSetWithdrawAddress, int, error) {
+	return decodeMsgSetWithdrawAddress(bz, -1)
+}
+
+func decodeMsgSetWithdrawAddress(bz []byte, limit int) (MsgSetWithdrawAddress, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgSetWithdrawAddress
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3382,7 +4037,7 @@ func DecodeMsgSetWithdrawAddress(bz []byte) (MsgSetWithdrawAddress, int, error)
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3433,13 +4088,17 @@ func EncodeMsgUndelegate(w io.Writer, v MsgUndelegate) error {
 } //End of EncodeMsgUndelegate
 
 func DecodeMsgUndelegate(bz []byte) (MsgUndelegate, int, error) {
+	return decodeMsgUndelegate(bz, -1)
+}
+
+func decodeMsgUndelegate(bz []byte, limit int) (MsgUndelegate, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgUndelegate
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3451,7 +4110,7 @@ func DecodeMsgUndelegate(bz []byte) (MsgUndelegate, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3505,13 +4164,17 @@ func EncodeMsgUnjail(w io.Writer, v MsgUnjail) error {
 } //End of EncodeMsgUnjail
 
 func DecodeMsgUnjail(bz []byte) (MsgUnjail, int, error) {
+	return decodeMsgUnjail(bz, -1)
+}
+
+func decodeMsgUnjail(bz []byte, limit int) (MsgUnjail, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgUnjail
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3551,13 +4214,17 @@ func EncodeMsgWithdrawDelegatorReward(w io.Writer, v MsgWithdrawDelegatorReward)
 } //End of EncodeMsgWithdrawDelegatorReward
 
 func DecodeMsgWithdrawDelegatorReward(bz []byte) (MsgWithdrawDelegatorReward, int, error) {
+	return decodeMsgWithdrawDelegatorReward(bz, -1)
+}
+
+func decodeMsgWithdrawDelegatorReward(bz []byte, limit int) (MsgWithdrawDelegatorReward, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgWithdrawDelegatorReward
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3569,7 +4236,7 @@ func DecodeMsgWithdrawDelegatorReward(bz []byte) (MsgWithdrawDelegatorReward, in
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3607,13 +4274,17 @@ func EncodeMsgWithdrawValidatorCommission(w io.Writer, v MsgWithdrawValidatorCom
 } //End of EncodeMsgWithdrawValidatorCommission
 
 func DecodeMsgWithdrawValidatorCommission(bz []byte) (MsgWithdrawValidatorCommission, int, error) {
+	return decodeMsgWithdrawValidatorCommission(bz, -1)
+}
+
+func decodeMsgWithdrawValidatorCommission(bz []byte, limit int) (MsgWithdrawValidatorCommission, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgWithdrawValidatorCommission
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3668,6 +4339,10 @@ func EncodeMsgDeposit(w io.Writer, v MsgDeposit) error {
 } //End of EncodeMsgDeposit
 
 func DecodeMsgDeposit(bz []byte) (MsgDeposit, int, error) {
+	return decodeMsgDeposit(bz, -1)
+}
+
+func decodeMsgDeposit(bz []byte, limit int) (MsgDeposit, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -3680,7 +4355,7 @@ func DecodeMsgDeposit(bz []byte) (MsgDeposit, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3692,7 +4367,7 @@ func DecodeMsgDeposit(bz []byte) (MsgDeposit, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3700,7 +4375,7 @@ func DecodeMsgDeposit(bz []byte) (MsgDeposit, int, error) {
 	total += n
 	v.Amount = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Amount[_0], n, err = DecodeCoin(bz)
+		v.Amount[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -3756,19 +4431,23 @@ func EncodeMsgSubmitProposal(w io.Writer, v MsgSubmitProposal) error {
 } //End of EncodeMsgSubmitProposal
 
 func DecodeMsgSubmitProposal(bz []byte) (MsgSubmitProposal, int, error) {
+	return decodeMsgSubmitProposal(bz, -1)
+}
+
+func decodeMsgSubmitProposal(bz []byte, limit int) (MsgSubmitProposal, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgSubmitProposal
 	var n int
 	var total int
-	v.Content, n, err = DecodeContent(bz)
+	v.Content, n, err = decodeContent(bz, limit)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n // interface_decode
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3776,14 +4455,14 @@ func DecodeMsgSubmitProposal(bz []byte) (MsgSubmitProposal, int, error) {
 	total += n
 	v.InitialDeposit = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.InitialDeposit[_0], n, err = DecodeCoin(bz)
+		v.InitialDeposit[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3833,6 +4512,10 @@ func EncodeMsgVote(w io.Writer, v MsgVote) error {
 } //End of EncodeMsgVote
 
 func DecodeMsgVote(bz []byte) (MsgVote, int, error) {
+	return decodeMsgVote(bz, -1)
+}
+
+func decodeMsgVote(bz []byte, limit int) (MsgVote, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -3845,7 +4528,7 @@ func DecodeMsgVote(bz []byte) (MsgVote, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3916,6 +4599,10 @@ func EncodeParameterChangeProposal(w io.Writer, v ParameterChangeProposal) error
 } //End of EncodeParameterChangeProposal
 
 func DecodeParameterChangeProposal(bz []byte) (ParameterChangeProposal, int, error) {
+	return decodeParameterChangeProposal(bz, -1)
+}
+
+func decodeParameterChangeProposal(bz []byte, limit int) (ParameterChangeProposal, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -3934,7 +4621,7 @@ func DecodeParameterChangeProposal(bz []byte) (ParameterChangeProposal, int, err
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -3942,7 +4629,7 @@ func DecodeParameterChangeProposal(bz []byte) (ParameterChangeProposal, int, err
 	total += n
 	v.Changes = make([]ParamChange, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Changes[_0], n, err = DecodeParamChange(bz)
+		v.Changes[_0], n, err = decodeParamChange(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -3982,6 +4669,10 @@ func EncodeSoftwareUpgradeProposal(w io.Writer, v SoftwareUpgradeProposal) error
 } //End of EncodeSoftwareUpgradeProposal
 
 func DecodeSoftwareUpgradeProposal(bz []byte) (SoftwareUpgradeProposal, int, error) {
+	return decodeSoftwareUpgradeProposal(bz, -1)
+}
+
+func decodeSoftwareUpgradeProposal(bz []byte, limit int) (SoftwareUpgradeProposal, int, error) {
 	// codon version: 1
 	var err error
 	var v SoftwareUpgradeProposal
@@ -4026,6 +4717,10 @@ func EncodeTextProposal(w io.Writer, v TextProposal) error {
 } //End of EncodeTextProposal
 
 func DecodeTextProposal(bz []byte) (TextProposal, int, error) {
+	return decodeTextProposal(bz, -1)
+}
+
+func decodeTextProposal(bz []byte, limit int) (TextProposal, int, error) {
 	// codon version: 1
 	var err error
 	var v TextProposal
@@ -4089,6 +4784,10 @@ func EncodeCommunityPoolSpendProposal(w io.Writer, v CommunityPoolSpendProposal)
 } //End of EncodeCommunityPoolSpendProposal
 
 func DecodeCommunityPoolSpendProposal(bz []byte) (CommunityPoolSpendProposal, int, error) {
+	return decodeCommunityPoolSpendProposal(bz, -1)
+}
+
+func decodeCommunityPoolSpendProposal(bz []byte, limit int) (CommunityPoolSpendProposal, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -4107,7 +4806,7 @@ func DecodeCommunityPoolSpendProposal(bz []byte) (CommunityPoolSpendProposal, in
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4119,7 +4818,7 @@ func DecodeCommunityPoolSpendProposal(bz []byte) (CommunityPoolSpendProposal, in
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4127,7 +4826,7 @@ func DecodeCommunityPoolSpendProposal(bz []byte) (CommunityPoolSpendProposal, in
 	total += n
 	v.Amount = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Amount[_0], n, err = DecodeCoin(bz)
+		v.Amount[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -4213,13 +4912,17 @@ func EncodeMsgMultiSend(w io.Writer, v MsgMultiSend) error {
 } //End of EncodeMsgMultiSend
 
 func DecodeMsgMultiSend(bz []byte) (MsgMultiSend, int, error) {
+	return decodeMsgMultiSend(bz, -1)
+}
+
+func decodeMsgMultiSend(bz []byte, limit int) (MsgMultiSend, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgMultiSend
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4227,14 +4930,14 @@ func DecodeMsgMultiSend(bz []byte) (MsgMultiSend, int, error) {
 	total += n
 	v.Inputs = make([]Input, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Inputs[_0], n, err = DecodeInput(bz)
+		v.Inputs[_0], n, err = decodeInput(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4242,7 +4945,7 @@ func DecodeMsgMultiSend(bz []byte) (MsgMultiSend, int, error) {
 	total += n
 	v.Outputs = make([]Output, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Outputs[_0], n, err = DecodeOutput(bz)
+		v.Outputs[_0], n, err = decodeOutput(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -4300,13 +5003,17 @@ func EncodeMsgSend(w io.Writer, v MsgSend) error {
 } //End of EncodeMsgSend
 
 func DecodeMsgSend(bz []byte) (MsgSend, int, error) {
+	return decodeMsgSend(bz, -1)
+}
+
+func decodeMsgSend(bz []byte, limit int) (MsgSend, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgSend
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4318,7 +5025,7 @@ func DecodeMsgSend(bz []byte) (MsgSend, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4330,7 +5037,7 @@ func DecodeMsgSend(bz []byte) (MsgSend, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4338,7 +5045,7 @@ func DecodeMsgSend(bz []byte) (MsgSend, int, error) {
 	total += n
 	v.Amount = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Amount[_0], n, err = DecodeCoin(bz)
+		v.Amount[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -4384,13 +5091,17 @@ func EncodeMsgVerifyInvariant(w io.Writer, v MsgVerifyInvariant) error {
 } //End of EncodeMsgVerifyInvariant
 
 func DecodeMsgVerifyInvariant(bz []byte) (MsgVerifyInvariant, int, error) {
+	return decodeMsgVerifyInvariant(bz, -1)
+}
+
+func decodeMsgVerifyInvariant(bz []byte, limit int) (MsgVerifyInvariant, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgVerifyInvariant
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4451,13 +5162,17 @@ func EncodeSupply(w io.Writer, v Supply) error {
 } //End of EncodeSupply
 
 func DecodeSupply(bz []byte) (Supply, int, error) {
+	return decodeSupply(bz, -1)
+}
+
+func decodeSupply(bz []byte, limit int) (Supply, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v Supply
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4465,7 +5180,7 @@ func DecodeSupply(bz []byte) (Supply, int, error) {
 	total += n
 	v.Total = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Total[_0], n, err = DecodeCoin(bz)
+		v.Total[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -4546,17 +5261,29 @@ func EncodeAccountX(w io.Writer, v AccountX) error {
 		}
 		// end of v.FrozenCoins[_0]
 	}
+	err = codonEncodeByteSlice(w, v.Referee[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(v.RefereeChangeTime))
+	if err != nil {
+		return err
+	}
 	return nil
 } //End of EncodeAccountX
 
 func DecodeAccountX(bz []byte) (AccountX, int, error) {
+	return decodeAccountX(bz, -1)
+}
+
+func decodeAccountX(bz []byte, limit int) (AccountX, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v AccountX
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4574,7 +5301,7 @@ func DecodeAccountX(bz []byte) (AccountX, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4582,14 +5309,14 @@ func DecodeAccountX(bz []byte) (AccountX, int, error) {
 	total += n
 	v.LockedCoins = make([]LockedCoin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.LockedCoins[_0], n, err = DecodeLockedCoin(bz)
+		v.LockedCoins[_0], n, err = decodeLockedCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4597,13 +5324,31 @@ func DecodeAccountX(bz []byte) (AccountX, int, error) {
 	total += n
 	v.FrozenCoins = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.FrozenCoins[_0], n, err = DecodeCoin(bz)
+		v.FrozenCoins[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Referee, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.RefereeChangeTime = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
 	return v, total, nil
 } //End of DecodeAccountX
 
@@ -4624,9 +5369,76 @@ func RandAccountX(r RandSrc) AccountX {
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
 		v.FrozenCoins[_0] = RandCoin(r)
 	}
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Referee = r.GetBytes(length)
+	v.RefereeChangeTime = r.GetInt64()
 	return v
 } //End of RandAccountX
 
+// Non-Interface
+func EncodeMsgSetReferee(w io.Writer, v MsgSetReferee) error {
+	// codon version: 1
+	var err error
+	err = codonEncodeByteSlice(w, v.Sender[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeByteSlice(w, v.Referee[:])
+	if err != nil {
+		return err
+	}
+	return nil
+} //End of EncodeMsgSetReferee
+
+func DecodeMsgSetReferee(bz []byte) (MsgSetReferee, int, error) {
+	return decodeMsgSetReferee(bz, -1)
+}
+
+func decodeMsgSetReferee(bz []byte, limit int) (MsgSetReferee, int, error) {
+	// codon version: 1
+	var err error
+	var length int
+	var v MsgSetReferee
+	var n int
+	var total int
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Sender, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Referee, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	return v, total, nil
+} //End of DecodeMsgSetReferee
+
+func RandMsgSetReferee(r RandSrc) MsgSetReferee {
+	// codon version: 1
+	var length int
+	var v MsgSetReferee
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Sender = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Referee = r.GetBytes(length)
+	return v
+} //End of RandMsgSetReferee
+
 // Non-Interface
 func EncodeMsgMultiSendX(w io.Writer, v MsgMultiSendX) error {
 	// codon version: 1
@@ -4687,13 +5499,17 @@ func EncodeMsgMultiSendX(w io.Writer, v MsgMultiSendX) error {
 } //End of EncodeMsgMultiSendX
 
 func DecodeMsgMultiSendX(bz []byte) (MsgMultiSendX, int, error) {
+	return decodeMsgMultiSendX(bz, -1)
+}
+
+func decodeMsgMultiSendX(bz []byte, limit int) (MsgMultiSendX, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgMultiSendX
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4701,14 +5517,14 @@ func DecodeMsgMultiSendX(bz []byte) (MsgMultiSendX, int, error) {
 	total += n
 	v.Inputs = make([]Input, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Inputs[_0], n, err = DecodeInput(bz)
+		v.Inputs[_0], n, err = decodeInput(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
 		bz = bz[n:]
 		total += n
 	}
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4716,7 +5532,7 @@ func DecodeMsgMultiSendX(bz []byte) (MsgMultiSendX, int, error) {
 	total += n
 	v.Outputs = make([]Output, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Outputs[_0], n, err = DecodeOutput(bz)
+		v.Outputs[_0], n, err = decodeOutput(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -4778,13 +5594,17 @@ func EncodeMsgSendX(w io.Writer, v MsgSendX) error {
 } //End of EncodeMsgSendX
 
 func DecodeMsgSendX(bz []byte) (MsgSendX, int, error) {
+	return decodeMsgSendX(bz, -1)
+}
+
+func decodeMsgSendX(bz []byte, limit int) (MsgSendX, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgSendX
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4796,7 +5616,7 @@ func DecodeMsgSendX(bz []byte) (MsgSendX, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4808,7 +5628,7 @@ func DecodeMsgSendX(bz []byte) (MsgSendX, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4816,7 +5636,7 @@ func DecodeMsgSendX(bz []byte) (MsgSendX, int, error) {
 	total += n
 	v.Amount = make([]Coin, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Amount[_0], n, err = DecodeCoin(bz)
+		v.Amount[_0], n, err = decodeCoin(bz, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -4865,13 +5685,17 @@ func EncodeMsgSetMemoRequired(w io.Writer, v MsgSetMemoRequired) error {
 } //End of EncodeMsgSetMemoRequired
 
 func DecodeMsgSetMemoRequired(bz []byte) (MsgSetMemoRequired, int, error) {
+	return decodeMsgSetMemoRequired(bz, -1)
+}
+
+func decodeMsgSetMemoRequired(bz []byte, limit int) (MsgSetMemoRequired, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgSetMemoRequired
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -4902,6 +5726,146 @@ func RandMsgSetMemoRequired(r RandSrc) MsgSetMemoRequired {
 	return v
 } //End of RandMsgSetMemoRequired
 
+// Non-Interface
+func EncodeMsgSupervisedSend(w io.Writer, v MsgSupervisedSend) error {
+	// codon version: 1
+	var err error
+	err = codonEncodeByteSlice(w, v.FromAddress[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeByteSlice(w, v.Supervisor[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeByteSlice(w, v.ToAddress[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.Amount.Denom)
+	if err != nil {
+		return err
+	}
+	err = EncodeInt(w, v.Amount.Amount)
+	if err != nil {
+		return err
+	}
+	// end of v.Amount
+	err = codonEncodeVarint(w, int64(v.UnlockTime))
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(v.Reward))
+	if err != nil {
+		return err
+	}
+	err = codonEncodeUint8(w, v.Operation)
+	if err != nil {
+		return err
+	}
+	return nil
+} //End of EncodeMsgSupervisedSend
+
+func DecodeMsgSupervisedSend(bz []byte) (MsgSupervisedSend, int, error) {
+	return decodeMsgSupervisedSend(bz, -1)
+}
+
+func decodeMsgSupervisedSend(bz []byte, limit int) (MsgSupervisedSend, int, error) {
+	// codon version: 1
+	var err error
+	var length int
+	var v MsgSupervisedSend
+	var n int
+	var total int
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.FromAddress, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Supervisor, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.ToAddress, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Amount.Denom = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Amount.Amount, n, err = DecodeInt(bz)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	// end of v.Amount
+	v.UnlockTime = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Reward = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Operation = uint8(codonDecodeUint8(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	return v, total, nil
+} //End of DecodeMsgSupervisedSend
+
+func RandMsgSupervisedSend(r RandSrc) MsgSupervisedSend {
+	// codon version: 1
+	var length int
+	var v MsgSupervisedSend
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.FromAddress = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Supervisor = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.ToAddress = r.GetBytes(length)
+	v.Amount.Denom = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Amount.Amount = RandInt(r)
+	// end of v.Amount
+	v.UnlockTime = r.GetInt64()
+	v.Reward = r.GetInt64()
+	v.Operation = r.GetUint8()
+	return v
+} //End of RandMsgSupervisedSend
+
 // Non-Interface
 func EncodeBaseToken(w io.Writer, v BaseToken) error {
 	// codon version: 1
@@ -4970,6 +5934,10 @@ func EncodeBaseToken(w io.Writer, v BaseToken) error {
 } //End of EncodeBaseToken
 
 func DecodeBaseToken(bz []byte) (BaseToken, int, error) {
+	return decodeBaseToken(bz, -1)
+}
+
+func decodeBaseToken(bz []byte, limit int) (BaseToken, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5000,7 +5968,7 @@ func DecodeBaseToken(bz []byte) (BaseToken, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5124,6 +6092,10 @@ func EncodeMsgAddTokenWhitelist(w io.Writer, v MsgAddTokenWhitelist) error {
 } //End of EncodeMsgAddTokenWhitelist
 
 func DecodeMsgAddTokenWhitelist(bz []byte) (MsgAddTokenWhitelist, int, error) {
+	return decodeMsgAddTokenWhitelist(bz, -1)
+}
+
+func decodeMsgAddTokenWhitelist(bz []byte, limit int) (MsgAddTokenWhitelist, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5136,7 +6108,7 @@ func DecodeMsgAddTokenWhitelist(bz []byte) (MsgAddTokenWhitelist, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5148,7 +6120,7 @@ func DecodeMsgAddTokenWhitelist(bz []byte) (MsgAddTokenWhitelist, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5156,7 +6128,7 @@ func DecodeMsgAddTokenWhitelist(bz []byte) (MsgAddTokenWhitelist, int, error) {
 	total += n
 	v.Whitelist = make([]AccAddress, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of slice
-		length = codonDecodeInt(bz, &n, &err)
+		length = codonDecodeLength(bz, &n, &err, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -5208,6 +6180,10 @@ func EncodeMsgBurnToken(w io.Writer, v MsgBurnToken) error {
 } //End of EncodeMsgBurnToken
 
 func DecodeMsgBurnToken(bz []byte) (MsgBurnToken, int, error) {
+	return decodeMsgBurnToken(bz, -1)
+}
+
+func decodeMsgBurnToken(bz []byte, limit int) (MsgBurnToken, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5226,7 +6202,7 @@ func DecodeMsgBurnToken(bz []byte) (MsgBurnToken, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5278,6 +6254,10 @@ func EncodeMsgForbidAddr(w io.Writer, v MsgForbidAddr) error {
 } //End of EncodeMsgForbidAddr
 
 func DecodeMsgForbidAddr(bz []byte) (MsgForbidAddr, int, error) {
+	return decodeMsgForbidAddr(bz, -1)
+}
+
+func decodeMsgForbidAddr(bz []byte, limit int) (MsgForbidAddr, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5290,7 +6270,7 @@ func DecodeMsgForbidAddr(bz []byte) (MsgForbidAddr, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5302,7 +6282,7 @@ func DecodeMsgForbidAddr(bz []byte) (MsgForbidAddr, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5310,7 +6290,7 @@ func DecodeMsgForbidAddr(bz []byte) (MsgForbidAddr, int, error) {
 	total += n
 	v.Addresses = make([]AccAddress, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of slice
-		length = codonDecodeInt(bz, &n, &err)
+		length = codonDecodeLength(bz, &n, &err, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -5358,6 +6338,10 @@ func EncodeMsgForbidToken(w io.Writer, v MsgForbidToken) error {
 } //End of EncodeMsgForbidToken
 
 func DecodeMsgForbidToken(bz []byte) (MsgForbidToken, int, error) {
+	return decodeMsgForbidToken(bz, -1)
+}
+
+func decodeMsgForbidToken(bz []byte, limit int) (MsgForbidToken, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5370,7 +6354,7 @@ func DecodeMsgForbidToken(bz []byte) (MsgForbidToken, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5447,6 +6431,10 @@ func EncodeMsgIssueToken(w io.Writer, v MsgIssueToken) error {
 } //End of EncodeMsgIssueToken
 
 func DecodeMsgIssueToken(bz []byte) (MsgIssueToken, int, error) {
+	return decodeMsgIssueToken(bz, -1)
+}
+
+func decodeMsgIssueToken(bz []byte, limit int) (MsgIssueToken, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5471,7 +6459,7 @@ func DecodeMsgIssueToken(bz []byte) (MsgIssueToken, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5567,6 +6555,10 @@ func EncodeMsgMintToken(w io.Writer, v MsgMintToken) error {
 } //End of EncodeMsgMintToken
 
 func DecodeMsgMintToken(bz []byte) (MsgMintToken, int, error) {
+	return decodeMsgMintToken(bz, -1)
+}
+
+func decodeMsgMintToken(bz []byte, limit int) (MsgMintToken, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5585,7 +6577,7 @@ func DecodeMsgMintToken(bz []byte) (MsgMintToken, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5619,6 +6611,10 @@ func EncodeMsgModifyTokenInfo(w io.Writer, v MsgModifyTokenInfo) error {
 	if err != nil {
 		return err
 	}
+	err = codonEncodeByteSlice(w, v.OwnerAddress[:])
+	if err != nil {
+		return err
+	}
 	err = codonEncodeString(w, v.URL)
 	if err != nil {
 		return err
@@ -5631,7 +6627,27 @@ func EncodeMsgModifyTokenInfo(w io.Writer, v MsgModifyTokenInfo) error {
 	if err != nil {
 		return err
 	}
-	err = codonEncodeByteSlice(w, v.OwnerAddress[:])
+	err = codonEncodeString(w, v.Name)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.TotalSupply)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.Mintable)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.Burnable)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.AddrForbiddable)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.TokenForbiddable)
 	if err != nil {
 		return err
 	}
@@ -5639,6 +6655,10 @@ func EncodeMsgModifyTokenInfo(w io.Writer, v MsgModifyTokenInfo) error {
 } //End of EncodeMsgModifyTokenInfo
 
 func DecodeMsgModifyTokenInfo(bz []byte) (MsgModifyTokenInfo, int, error) {
+	return decodeMsgModifyTokenInfo(bz, -1)
+}
+
+func decodeMsgModifyTokenInfo(bz []byte, limit int) (MsgModifyTokenInfo, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5651,6 +6671,18 @@ func DecodeMsgModifyTokenInfo(bz []byte) (MsgModifyTokenInfo, int, error) {
 	}
 	bz = bz[n:]
 	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.OwnerAddress, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
 	v.URL = string(codonDecodeString(bz, &n, &err))
 	if err != nil {
 		return v, total, err
@@ -5669,13 +6701,37 @@ func DecodeMsgModifyTokenInfo(bz []byte) (MsgModifyTokenInfo, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	v.Name = string(codonDecodeString(bz, &n, &err))
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.OwnerAddress, n, err = codonGetByteSlice(bz, length)
+	v.TotalSupply = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Mintable = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Burnable = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.AddrForbiddable = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.TokenForbiddable = string(codonDecodeString(bz, &n, &err))
 	if err != nil {
 		return v, total, err
 	}
@@ -5689,11 +6745,17 @@ func RandMsgModifyTokenInfo(r RandSrc) MsgModifyTokenInfo {
 	var length int
 	var v MsgModifyTokenInfo
 	v.Symbol = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.OwnerAddress = r.GetBytes(length)
 	v.URL = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
 	v.Description = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
 	v.Identity = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
-	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
-	v.OwnerAddress = r.GetBytes(length)
+	v.Name = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.TotalSupply = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Mintable = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Burnable = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.AddrForbiddable = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.TokenForbiddable = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
 	return v
 } //End of RandMsgModifyTokenInfo
 
@@ -5723,6 +6785,10 @@ func EncodeMsgRemoveTokenWhitelist(w io.Writer, v MsgRemoveTokenWhitelist) error
 } //End of EncodeMsgRemoveTokenWhitelist
 
 func DecodeMsgRemoveTokenWhitelist(bz []byte) (MsgRemoveTokenWhitelist, int, error) {
+	return decodeMsgRemoveTokenWhitelist(bz, -1)
+}
+
+func decodeMsgRemoveTokenWhitelist(bz []byte, limit int) (MsgRemoveTokenWhitelist, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5735,7 +6801,7 @@ func DecodeMsgRemoveTokenWhitelist(bz []byte) (MsgRemoveTokenWhitelist, int, err
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5747,7 +6813,7 @@ func DecodeMsgRemoveTokenWhitelist(bz []byte) (MsgRemoveTokenWhitelist, int, err
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5755,7 +6821,7 @@ func DecodeMsgRemoveTokenWhitelist(bz []byte) (MsgRemoveTokenWhitelist, int, err
 	total += n
 	v.Whitelist = make([]AccAddress, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of slice
-		length = codonDecodeInt(bz, &n, &err)
+		length = codonDecodeLength(bz, &n, &err, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -5807,6 +6873,10 @@ func EncodeMsgTransferOwnership(w io.Writer, v MsgTransferOwnership) error {
 } //End of EncodeMsgTransferOwnership
 
 func DecodeMsgTransferOwnership(bz []byte) (MsgTransferOwnership, int, error) {
+	return decodeMsgTransferOwnership(bz, -1)
+}
+
+func decodeMsgTransferOwnership(bz []byte, limit int) (MsgTransferOwnership, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5819,7 +6889,7 @@ func DecodeMsgTransferOwnership(bz []byte) (MsgTransferOwnership, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5831,7 +6901,7 @@ func DecodeMsgTransferOwnership(bz []byte) (MsgTransferOwnership, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5884,6 +6954,10 @@ func EncodeMsgUnForbidAddr(w io.Writer, v MsgUnForbidAddr) error {
 } //End of EncodeMsgUnForbidAddr
 
 func DecodeMsgUnForbidAddr(bz []byte) (MsgUnForbidAddr, int, error) {
+	return decodeMsgUnForbidAddr(bz, -1)
+}
+
+func decodeMsgUnForbidAddr(bz []byte, limit int) (MsgUnForbidAddr, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5896,7 +6970,7 @@ func DecodeMsgUnForbidAddr(bz []byte) (MsgUnForbidAddr, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5908,7 +6982,7 @@ func DecodeMsgUnForbidAddr(bz []byte) (MsgUnForbidAddr, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -5916,7 +6990,7 @@ func DecodeMsgUnForbidAddr(bz []byte) (MsgUnForbidAddr, int, error) {
 	total += n
 	v.Addresses = make([]AccAddress, length)
 	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of slice
-		length = codonDecodeInt(bz, &n, &err)
+		length = codonDecodeLength(bz, &n, &err, limit)
 		if err != nil {
 			return v, total, err
 		}
@@ -5964,6 +7038,10 @@ func EncodeMsgUnForbidToken(w io.Writer, v MsgUnForbidToken) error {
 } //End of EncodeMsgUnForbidToken
 
 func DecodeMsgUnForbidToken(bz []byte) (MsgUnForbidToken, int, error) {
+	return decodeMsgUnForbidToken(bz, -1)
+}
+
+func decodeMsgUnForbidToken(bz []byte, limit int) (MsgUnForbidToken, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -5976,7 +7054,7 @@ func DecodeMsgUnForbidToken(bz []byte) (MsgUnForbidToken, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6021,13 +7099,17 @@ func EncodeMsgBancorCancel(w io.Writer, v MsgBancorCancel) error {
 } //End of EncodeMsgBancorCancel
 
 func DecodeMsgBancorCancel(bz []byte) (MsgBancorCancel, int, error) {
+	return decodeMsgBancorCancel(bz, -1)
+}
+
+func decodeMsgBancorCancel(bz []byte, limit int) (MsgBancorCancel, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgBancorCancel
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6093,6 +7175,10 @@ func EncodeMsgBancorInit(w io.Writer, v MsgBancorInit) error {
 	if err != nil {
 		return err
 	}
+	err = EncodeInt(w, v.MaxMoney)
+	if err != nil {
+		return err
+	}
 	err = codonEncodeUint8(w, v.StockPrecision)
 	if err != nil {
 		return err
@@ -6105,13 +7191,17 @@ func EncodeMsgBancorInit(w io.Writer, v MsgBancorInit) error {
 } //End of EncodeMsgBancorInit
 
 func DecodeMsgBancorInit(bz []byte) (MsgBancorInit, int, error) {
+	return decodeMsgBancorInit(bz, -1)
+}
+
+func decodeMsgBancorInit(bz []byte, limit int) (MsgBancorInit, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgBancorInit
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6153,6 +7243,12 @@ func DecodeMsgBancorInit(bz []byte) (MsgBancorInit, int, error) {
 	}
 	bz = bz[n:]
 	total += n
+	v.MaxMoney, n, err = DecodeInt(bz)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
 	v.StockPrecision = uint8(codonDecodeUint8(bz, &n, &err))
 	if err != nil {
 		return v, total, err
@@ -6179,6 +7275,7 @@ func RandMsgBancorInit(r RandSrc) MsgBancorInit {
 	v.InitPrice = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
 	v.MaxSupply = RandInt(r)
 	v.MaxPrice = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.MaxMoney = RandInt(r)
 	v.StockPrecision = r.GetUint8()
 	v.EarliestCancelTime = r.GetInt64()
 	return v
@@ -6216,13 +7313,17 @@ func EncodeMsgBancorTrade(w io.Writer, v MsgBancorTrade) error {
 } //End of EncodeMsgBancorTrade
 
 func DecodeMsgBancorTrade(bz []byte) (MsgBancorTrade, int, error) {
+	return decodeMsgBancorTrade(bz, -1)
+}
+
+func decodeMsgBancorTrade(bz []byte, limit int) (MsgBancorTrade, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgBancorTrade
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6297,13 +7398,17 @@ func EncodeMsgCancelOrder(w io.Writer, v MsgCancelOrder) error {
 } //End of EncodeMsgCancelOrder
 
 func DecodeMsgCancelOrder(bz []byte) (MsgCancelOrder, int, error) {
+	return decodeMsgCancelOrder(bz, -1)
+}
+
+func decodeMsgCancelOrder(bz []byte, limit int) (MsgCancelOrder, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgCancelOrder
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6354,13 +7459,17 @@ func EncodeMsgCancelTradingPair(w io.Writer, v MsgCancelTradingPair) error {
 } //End of EncodeMsgCancelTradingPair
 
 func DecodeMsgCancelTradingPair(bz []byte) (MsgCancelTradingPair, int, error) {
+	return decodeMsgCancelTradingPair(bz, -1)
+}
+
+func decodeMsgCancelTradingPair(bz []byte, limit int) (MsgCancelTradingPair, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgCancelTradingPair
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6446,13 +7555,17 @@ func EncodeMsgCreateOrder(w io.Writer, v MsgCreateOrder) error {
 } //End of EncodeMsgCreateOrder
 
 func DecodeMsgCreateOrder(bz []byte) (MsgCreateOrder, int, error) {
+	return decodeMsgCreateOrder(bz, -1)
+}
+
+func decodeMsgCreateOrder(bz []byte, limit int) (MsgCreateOrder, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgCreateOrder
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6567,6 +7680,10 @@ func EncodeMsgCreateTradingPair(w io.Writer, v MsgCreateTradingPair) error {
 } //End of EncodeMsgCreateTradingPair
 
 func DecodeMsgCreateTradingPair(bz []byte) (MsgCreateTradingPair, int, error) {
+	return decodeMsgCreateTradingPair(bz, -1)
+}
+
+func decodeMsgCreateTradingPair(bz []byte, limit int) (MsgCreateTradingPair, int, error) {
 	// codon version: 1
 	var err error
 	var length int
@@ -6585,7 +7702,7 @@ func DecodeMsgCreateTradingPair(bz []byte) (MsgCreateTradingPair, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6645,13 +7762,17 @@ func EncodeMsgModifyPricePrecision(w io.Writer, v MsgModifyPricePrecision) error
 } //End of EncodeMsgModifyPricePrecision
 
 func DecodeMsgModifyPricePrecision(bz []byte) (MsgModifyPricePrecision, int, error) {
+	return decodeMsgModifyPricePrecision(bz, -1)
+}
+
+func decodeMsgModifyPricePrecision(bz []byte, limit int) (MsgModifyPricePrecision, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v MsgModifyPricePrecision
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6745,6 +7866,10 @@ func EncodeOrder(w io.Writer, v Order) error {
 	if err != nil {
 		return err
 	}
+	err = codonEncodeVarint(w, int64(v.FrozenFee))
+	if err != nil {
+		return err
+	}
 	err = codonEncodeVarint(w, int64(v.LeftStock))
 	if err != nil {
 		return err
@@ -6765,13 +7890,17 @@ func EncodeOrder(w io.Writer, v Order) error {
 } //End of EncodeOrder
 
 func DecodeOrder(bz []byte) (Order, int, error) {
+	return decodeOrder(bz, -1)
+}
+
+func decodeOrder(bz []byte, limit int) (Order, int, error) {
 	// codon version: 1
 	var err error
 	var length int
 	var v Order
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -6855,6 +7984,12 @@ func DecodeOrder(bz []byte) (Order, int, error) {
 	}
 	bz = bz[n:]
 	total += n
+	v.FrozenFee = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
 	v.LeftStock = int64(codonDecodeInt64(bz, &n, &err))
 	if err != nil {
 		return v, total, err
@@ -6900,6 +8035,7 @@ func RandOrder(r RandSrc) Order {
 	v.FrozenCommission = r.GetInt64()
 	v.ExistBlocks = r.GetInt64()
 	v.FrozenFeatureFee = r.GetInt64()
+	v.FrozenFee = r.GetInt64()
 	v.LeftStock = r.GetInt64()
 	v.Freeze = r.GetInt64()
 	v.DealStock = r.GetInt64()
@@ -6935,6 +8071,10 @@ func EncodeMarketInfo(w io.Writer, v MarketInfo) error {
 } //End of EncodeMarketInfo
 
 func DecodeMarketInfo(bz []byte) (MarketInfo, int, error) {
+	return decodeMarketInfo(bz, -1)
+}
+
+func decodeMarketInfo(bz []byte, limit int) (MarketInfo, int, error) {
 	// codon version: 1
 	var err error
 	var v MarketInfo
@@ -6985,154 +8125,152 @@ func RandMarketInfo(r RandSrc) MarketInfo {
 } //End of RandMarketInfo
 
 // Non-Interface
-func EncodeMsgDonateToCommunityPool(w io.Writer, v MsgDonateToCommunityPool) error {
+func EncodeMsgAddLiquidity(w io.Writer, v MsgAddLiquidity) error {
 	// codon version: 1
 	var err error
-	err = codonEncodeByteSlice(w, v.FromAddr[:])
+	err = codonEncodeByteSlice(w, v.Sender[:])
 	if err != nil {
 		return err
 	}
-	err = codonEncodeVarint(w, int64(len(v.Amount)))
+	err = codonEncodeString(w, v.Stock)
 	if err != nil {
 		return err
 	}
-	for _0 := 0; _0 < len(v.Amount); _0++ {
-		err = codonEncodeString(w, v.Amount[_0].Denom)
-		if err != nil {
-			return err
-		}
-		err = EncodeInt(w, v.Amount[_0].Amount)
-		if err != nil {
-			return err
-		}
-		// end of v.Amount[_0]
+	err = codonEncodeString(w, v.Money)
+	if err != nil {
+		return err
+	}
+	err = EncodeInt(w, v.StockIn)
+	if err != nil {
+		return err
+	}
+	err = EncodeInt(w, v.MoneyIn)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeByteSlice(w, v.To[:])
+	if err != nil {
+		return err
 	}
 	return nil
-} //End of EncodeMsgDonateToCommunityPool
+} //End of EncodeMsgAddLiquidity
 
-func DecodeMsgDonateToCommunityPool(bz []byte) (MsgDonateToCommunityPool, int, error) {
+func DecodeMsgAddLiquidity(bz []byte) (MsgAddLiquidity, int, error) {
+	return decodeMsgAddLiquidity(bz, -1)
+}
+
+func decodeMsgAddLiquidity(bz []byte, limit int) (MsgAddLiquidity, int, error) {
 	// codon version: 1
 	var err error
 	var length int
-	var v MsgDonateToCommunityPool
+	var v MsgAddLiquidity
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.FromAddr, n, err = codonGetByteSlice(bz, length)
+	v.Sender, n, err = codonGetByteSlice(bz, length)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	v.Stock = string(codonDecodeString(bz, &n, &err))
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.Amount = make([]Coin, length)
-	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Amount[_0], n, err = DecodeCoin(bz)
-		if err != nil {
-			return v, total, err
-		}
-		bz = bz[n:]
-		total += n
+	v.Money = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.StockIn, n, err = DecodeInt(bz)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.MoneyIn, n, err = DecodeInt(bz)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.To, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
 	}
+	bz = bz[n:]
+	total += n
 	return v, total, nil
-} //End of DecodeMsgDonateToCommunityPool
+} //End of DecodeMsgAddLiquidity
 
-func RandMsgDonateToCommunityPool(r RandSrc) MsgDonateToCommunityPool {
+func RandMsgAddLiquidity(r RandSrc) MsgAddLiquidity {
 	// codon version: 1
 	var length int
-	var v MsgDonateToCommunityPool
+	var v MsgAddLiquidity
 	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
-	v.FromAddr = r.GetBytes(length)
+	v.Sender = r.GetBytes(length)
+	v.Stock = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Money = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.StockIn = RandInt(r)
+	v.MoneyIn = RandInt(r)
 	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
-	v.Amount = make([]Coin, length)
-	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.Amount[_0] = RandCoin(r)
-	}
+	v.To = r.GetBytes(length)
 	return v
-} //End of RandMsgDonateToCommunityPool
+} //End of RandMsgAddLiquidity
 
 // Non-Interface
-func EncodeMsgCommentToken(w io.Writer, v MsgCommentToken) error {
+func EncodeMsgRemoveLiquidity(w io.Writer, v MsgRemoveLiquidity) error {
 	// codon version: 1
 	var err error
 	err = codonEncodeByteSlice(w, v.Sender[:])
 	if err != nil {
 		return err
 	}
-	err = codonEncodeString(w, v.Token)
-	if err != nil {
-		return err
-	}
-	err = codonEncodeVarint(w, int64(v.Donation))
-	if err != nil {
-		return err
-	}
-	err = codonEncodeString(w, v.Title)
+	err = codonEncodeString(w, v.Stock)
 	if err != nil {
 		return err
 	}
-	err = codonEncodeByteSlice(w, v.Content[:])
+	err = codonEncodeString(w, v.Money)
 	if err != nil {
 		return err
 	}
-	err = codonEncodeInt8(w, v.ContentType)
+	err = EncodeInt(w, v.Amount)
 	if err != nil {
 		return err
 	}
-	err = codonEncodeVarint(w, int64(len(v.References)))
+	err = codonEncodeByteSlice(w, v.To[:])
 	if err != nil {
 		return err
 	}
-	for _0 := 0; _0 < len(v.References); _0++ {
-		err = codonEncodeUvarint(w, uint64(v.References[_0].ID))
-		if err != nil {
-			return err
-		}
-		err = codonEncodeByteSlice(w, v.References[_0].RewardTarget[:])
-		if err != nil {
-			return err
-		}
-		err = codonEncodeString(w, v.References[_0].RewardToken)
-		if err != nil {
-			return err
-		}
-		err = codonEncodeVarint(w, int64(v.References[_0].RewardAmount))
-		if err != nil {
-			return err
-		}
-		err = codonEncodeVarint(w, int64(len(v.References[_0].Attitudes)))
-		if err != nil {
-			return err
-		}
-		for _1 := 0; _1 < len(v.References[_0].Attitudes); _1++ {
-			err = codonEncodeVarint(w, int64(v.References[_0].Attitudes[_1]))
-			if err != nil {
-				return err
-			}
-		}
-		// end of v.References[_0]
-	}
 	return nil
-} //End of EncodeMsgCommentToken
+} //End of EncodeMsgRemoveLiquidity
 
-func DecodeMsgCommentToken(bz []byte) (MsgCommentToken, int, error) {
+func DecodeMsgRemoveLiquidity(bz []byte) (MsgRemoveLiquidity, int, error) {
+	return decodeMsgRemoveLiquidity(bz, -1)
+}
+
+func decodeMsgRemoveLiquidity(bz []byte, limit int) (MsgRemoveLiquidity, int, error) {
 	// codon version: 1
 	var err error
 	var length int
-	var v MsgCommentToken
+	var v MsgRemoveLiquidity
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
@@ -7144,2370 +8282,11807 @@ func DecodeMsgCommentToken(bz []byte) (MsgCommentToken, int, error) {
 	}
 	bz = bz[n:]
 	total += n
-	v.Token = string(codonDecodeString(bz, &n, &err))
-	if err != nil {
-		return v, total, err
-	}
-	bz = bz[n:]
-	total += n
-	v.Donation = int64(codonDecodeInt64(bz, &n, &err))
-	if err != nil {
-		return v, total, err
-	}
-	bz = bz[n:]
-	total += n
-	v.Title = string(codonDecodeString(bz, &n, &err))
+	v.Stock = string(codonDecodeString(bz, &n, &err))
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	v.Money = string(codonDecodeString(bz, &n, &err))
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.Content, n, err = codonGetByteSlice(bz, length)
+	v.Amount, n, err = DecodeInt(bz)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.ContentType = int8(codonDecodeInt8(bz, &n, &err))
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	length = codonDecodeInt(bz, &n, &err)
+	v.To, n, err = codonGetByteSlice(bz, length)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.References = make([]CommentRef, length)
-	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.References[_0], n, err = DecodeCommentRef(bz)
-		if err != nil {
-			return v, total, err
-		}
-		bz = bz[n:]
-		total += n
-	}
 	return v, total, nil
-} //End of DecodeMsgCommentToken
+} //End of DecodeMsgRemoveLiquidity
 
-func RandMsgCommentToken(r RandSrc) MsgCommentToken {
+func RandMsgRemoveLiquidity(r RandSrc) MsgRemoveLiquidity {
 	// codon version: 1
 	var length int
-	var v MsgCommentToken
+	var v MsgRemoveLiquidity
 	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
 	v.Sender = r.GetBytes(length)
-	v.Token = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
-	v.Donation = r.GetInt64()
-	v.Title = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
-	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
-	v.Content = r.GetBytes(length)
-	v.ContentType = r.GetInt8()
+	v.Stock = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Money = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Amount = RandInt(r)
 	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
-	v.References = make([]CommentRef, length)
-	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
-		v.References[_0] = RandCommentRef(r)
-	}
+	v.To = r.GetBytes(length)
 	return v
-} //End of RandMsgCommentToken
+} //End of RandMsgRemoveLiquidity
 
 // Non-Interface
-func EncodeState(w io.Writer, v State) error {
+func EncodeMsgAutoSwapCreateOrder(w io.Writer, v MsgAutoSwapCreateOrder) error {
 	// codon version: 1
 	var err error
-	err = codonEncodeVarint(w, int64(v.HeightAdjustment))
+	err = codonEncodeByteSlice(w, v.Sender[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeUint8(w, v.Identify)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.TradingPair)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeUint8(w, v.PricePrecision)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(v.Price))
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(v.Quantity))
+	if err != nil {
+		return err
+	}
+	err = codonEncodeUint8(w, v.Side)
 	if err != nil {
 		return err
 	}
 	return nil
-} //End of EncodeState
+} //End of EncodeMsgAutoSwapCreateOrder
 
-func DecodeState(bz []byte) (State, int, error) {
+func DecodeMsgAutoSwapCreateOrder(bz []byte) (MsgAutoSwapCreateOrder, int, error) {
+	return decodeMsgAutoSwapCreateOrder(bz, -1)
+}
+
+func decodeMsgAutoSwapCreateOrder(bz []byte, limit int) (MsgAutoSwapCreateOrder, int, error) {
 	// codon version: 1
 	var err error
-	var v State
+	var length int
+	var v MsgAutoSwapCreateOrder
 	var n int
 	var total int
-	v.HeightAdjustment = int64(codonDecodeInt64(bz, &n, &err))
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Sender, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Identify = uint8(codonDecodeUint8(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.TradingPair = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.PricePrecision = uint8(codonDecodeUint8(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Price = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Quantity = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Side = uint8(codonDecodeUint8(bz, &n, &err))
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
 	return v, total, nil
-} //End of DecodeState
+} //End of DecodeMsgAutoSwapCreateOrder
 
-func RandState(r RandSrc) State {
+func RandMsgAutoSwapCreateOrder(r RandSrc) MsgAutoSwapCreateOrder {
 	// codon version: 1
-	var v State
-	v.HeightAdjustment = r.GetInt64()
+	var length int
+	var v MsgAutoSwapCreateOrder
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Sender = r.GetBytes(length)
+	v.Identify = r.GetUint8()
+	v.TradingPair = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.PricePrecision = r.GetUint8()
+	v.Price = r.GetInt64()
+	v.Quantity = r.GetInt64()
+	v.Side = r.GetUint8()
 	return v
-} //End of RandState
+} //End of RandMsgAutoSwapCreateOrder
 
 // Non-Interface
-func EncodeMsgAliasUpdate(w io.Writer, v MsgAliasUpdate) error {
+func EncodeMsgAutoSwapCancelOrder(w io.Writer, v MsgAutoSwapCancelOrder) error {
 	// codon version: 1
 	var err error
-	err = codonEncodeByteSlice(w, v.Owner[:])
-	if err != nil {
-		return err
-	}
-	err = codonEncodeString(w, v.Alias)
-	if err != nil {
-		return err
-	}
-	err = codonEncodeBool(w, v.IsAdd)
+	err = codonEncodeByteSlice(w, v.Sender[:])
 	if err != nil {
 		return err
 	}
-	err = codonEncodeBool(w, v.AsDefault)
+	err = codonEncodeString(w, v.OrderID)
 	if err != nil {
 		return err
 	}
 	return nil
-} //End of EncodeMsgAliasUpdate
+} //End of EncodeMsgAutoSwapCancelOrder
 
-func DecodeMsgAliasUpdate(bz []byte) (MsgAliasUpdate, int, error) {
+func DecodeMsgAutoSwapCancelOrder(bz []byte) (MsgAutoSwapCancelOrder, int, error) {
+	return decodeMsgAutoSwapCancelOrder(bz, -1)
+}
+
+func decodeMsgAutoSwapCancelOrder(bz []byte, limit int) (MsgAutoSwapCancelOrder, int, error) {
 	// codon version: 1
 	var err error
 	var length int
-	var v MsgAliasUpdate
+	var v MsgAutoSwapCancelOrder
 	var n int
 	var total int
-	length = codonDecodeInt(bz, &n, &err)
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.Owner, n, err = codonGetByteSlice(bz, length)
+	v.Sender, n, err = codonGetByteSlice(bz, length)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.Alias = string(codonDecodeString(bz, &n, &err))
+	v.OrderID = string(codonDecodeString(bz, &n, &err))
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.IsAdd = bool(codonDecodeBool(bz, &n, &err))
+	return v, total, nil
+} //End of DecodeMsgAutoSwapCancelOrder
+
+func RandMsgAutoSwapCancelOrder(r RandSrc) MsgAutoSwapCancelOrder {
+	// codon version: 1
+	var length int
+	var v MsgAutoSwapCancelOrder
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Sender = r.GetBytes(length)
+	v.OrderID = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	return v
+} //End of RandMsgAutoSwapCancelOrder
+
+// Non-Interface
+func EncodeMsgDonateToCommunityPool(w io.Writer, v MsgDonateToCommunityPool) error {
+	// codon version: 1
+	var err error
+	err = codonEncodeByteSlice(w, v.FromAddr[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(len(v.Amount)))
+	if err != nil {
+		return err
+	}
+	for _0 := 0; _0 < len(v.Amount); _0++ {
+		err = codonEncodeString(w, v.Amount[_0].Denom)
+		if err != nil {
+			return err
+		}
+		err = EncodeInt(w, v.Amount[_0].Amount)
+		if err != nil {
+			return err
+		}
+		// end of v.Amount[_0]
+	}
+	return nil
+} //End of EncodeMsgDonateToCommunityPool
+
+func DecodeMsgDonateToCommunityPool(bz []byte) (MsgDonateToCommunityPool, int, error) {
+	return decodeMsgDonateToCommunityPool(bz, -1)
+}
+
+func decodeMsgDonateToCommunityPool(bz []byte, limit int) (MsgDonateToCommunityPool, int, error) {
+	// codon version: 1
+	var err error
+	var length int
+	var v MsgDonateToCommunityPool
+	var n int
+	var total int
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
-	v.AsDefault = bool(codonDecodeBool(bz, &n, &err))
+	v.FromAddr, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
 	if err != nil {
 		return v, total, err
 	}
 	bz = bz[n:]
 	total += n
+	v.Amount = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.Amount[_0], n, err = decodeCoin(bz, limit)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+	}
 	return v, total, nil
-} //End of DecodeMsgAliasUpdate
+} //End of DecodeMsgDonateToCommunityPool
 
-func RandMsgAliasUpdate(r RandSrc) MsgAliasUpdate {
+func RandMsgDonateToCommunityPool(r RandSrc) MsgDonateToCommunityPool {
 	// codon version: 1
 	var length int
-	var v MsgAliasUpdate
+	var v MsgDonateToCommunityPool
 	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
-	v.Owner = r.GetBytes(length)
-	v.Alias = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
-	v.IsAdd = r.GetBool()
-	v.AsDefault = r.GetBool()
+	v.FromAddr = r.GetBytes(length)
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Amount = make([]Coin, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.Amount[_0] = RandCoin(r)
+	}
 	return v
-} //End of RandMsgAliasUpdate
+} //End of RandMsgDonateToCommunityPool
 
-// Interface
-func EncodePubKey(w io.Writer, x interface{}) error {
-	switch v := x.(type) {
-	case PubKeyEd25519:
-		w.Write(getMagicBytes("PubKeyEd25519"))
-		return EncodePubKeyEd25519(w, v)
-	case *PubKeyEd25519:
-		w.Write(getMagicBytes("PubKeyEd25519"))
-		return EncodePubKeyEd25519(w, *v)
-	case PubKeyMultisigThreshold:
-		w.Write(getMagicBytes("PubKeyMultisigThreshold"))
-		return EncodePubKeyMultisigThreshold(w, v)
-	case *PubKeyMultisigThreshold:
-		w.Write(getMagicBytes("PubKeyMultisigThreshold"))
-		return EncodePubKeyMultisigThreshold(w, *v)
-	case PubKeySecp256k1:
-		w.Write(getMagicBytes("PubKeySecp256k1"))
-		return EncodePubKeySecp256k1(w, v)
-	case *PubKeySecp256k1:
-		w.Write(getMagicBytes("PubKeySecp256k1"))
-		return EncodePubKeySecp256k1(w, *v)
-	case StdSignature:
-		w.Write(getMagicBytes("StdSignature"))
-		return EncodeStdSignature(w, v)
-	case *StdSignature:
-		w.Write(getMagicBytes("StdSignature"))
-		return EncodeStdSignature(w, *v)
-	default:
-		panic("Unknown Type.")
-	} // end of switch
-} // end of func
-func DecodePubKey(bz []byte) (PubKey, int, error) {
-	var v PubKey
-	var magicBytes [4]byte
-	var n int
-	for i := 0; i < 4; i++ {
-		magicBytes[i] = bz[i]
-	}
-	switch magicBytes {
-	case [4]byte{108, 143, 2, 48}:
-		v, n, err := DecodePubKeyEd25519(bz[4:])
-		return v, n + 4, err
-	case [4]byte{131, 227, 102, 173}:
-		v, n, err := DecodePubKeyMultisigThreshold(bz[4:])
-		return v, n + 4, err
+// Non-Interface
+func EncodeMsgCommentToken(w io.Writer, v MsgCommentToken) error {
+	// codon version: 1
+	var err error
+	err = codonEncodeByteSlice(w, v.Sender[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.Token)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(v.Donation))
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.Title)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeByteSlice(w, v.Content[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeInt8(w, v.ContentType)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeVarint(w, int64(len(v.References)))
+	if err != nil {
+		return err
+	}
+	for _0 := 0; _0 < len(v.References); _0++ {
+		err = codonEncodeUvarint(w, uint64(v.References[_0].ID))
+		if err != nil {
+			return err
+		}
+		err = codonEncodeByteSlice(w, v.References[_0].RewardTarget[:])
+		if err != nil {
+			return err
+		}
+		err = codonEncodeString(w, v.References[_0].RewardToken)
+		if err != nil {
+			return err
+		}
+		err = codonEncodeVarint(w, int64(v.References[_0].RewardAmount))
+		if err != nil {
+			return err
+		}
+		err = codonEncodeVarint(w, int64(len(v.References[_0].Attitudes)))
+		if err != nil {
+			return err
+		}
+		for _1 := 0; _1 < len(v.References[_0].Attitudes); _1++ {
+			err = codonEncodeVarint(w, int64(v.References[_0].Attitudes[_1]))
+			if err != nil {
+				return err
+			}
+		}
+		// end of v.References[_0]
+	}
+	return nil
+} //End of EncodeMsgCommentToken
+
+func DecodeMsgCommentToken(bz []byte) (MsgCommentToken, int, error) {
+	return decodeMsgCommentToken(bz, -1)
+}
+
+func decodeMsgCommentToken(bz []byte, limit int) (MsgCommentToken, int, error) {
+	// codon version: 1
+	var err error
+	var length int
+	var v MsgCommentToken
+	var n int
+	var total int
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Sender, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Token = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Donation = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Title = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Content, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.ContentType = int8(codonDecodeInt8(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.References = make([]CommentRef, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.References[_0], n, err = decodeCommentRef(bz, limit)
+		if err != nil {
+			return v, total, err
+		}
+		bz = bz[n:]
+		total += n
+	}
+	return v, total, nil
+} //End of DecodeMsgCommentToken
+
+func RandMsgCommentToken(r RandSrc) MsgCommentToken {
+	// codon version: 1
+	var length int
+	var v MsgCommentToken
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Sender = r.GetBytes(length)
+	v.Token = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.Donation = r.GetInt64()
+	v.Title = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Content = r.GetBytes(length)
+	v.ContentType = r.GetInt8()
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.References = make([]CommentRef, length)
+	for _0, length_0 := 0, length; _0 < length_0; _0++ { //slice of struct
+		v.References[_0] = RandCommentRef(r)
+	}
+	return v
+} //End of RandMsgCommentToken
+
+// Non-Interface
+func EncodeState(w io.Writer, v State) error {
+	// codon version: 1
+	var err error
+	err = codonEncodeVarint(w, int64(v.HeightAdjustment))
+	if err != nil {
+		return err
+	}
+	return nil
+} //End of EncodeState
+
+func DecodeState(bz []byte) (State, int, error) {
+	return decodeState(bz, -1)
+}
+
+func decodeState(bz []byte, limit int) (State, int, error) {
+	// codon version: 1
+	var err error
+	var v State
+	var n int
+	var total int
+	v.HeightAdjustment = int64(codonDecodeInt64(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	return v, total, nil
+} //End of DecodeState
+
+func RandState(r RandSrc) State {
+	// codon version: 1
+	var v State
+	v.HeightAdjustment = r.GetInt64()
+	return v
+} //End of RandState
+
+// Non-Interface
+func EncodeMsgAliasUpdate(w io.Writer, v MsgAliasUpdate) error {
+	// codon version: 1
+	var err error
+	err = codonEncodeByteSlice(w, v.Owner[:])
+	if err != nil {
+		return err
+	}
+	err = codonEncodeString(w, v.Alias)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeBool(w, v.IsAdd)
+	if err != nil {
+		return err
+	}
+	err = codonEncodeBool(w, v.AsDefault)
+	if err != nil {
+		return err
+	}
+	return nil
+} //End of EncodeMsgAliasUpdate
+
+func DecodeMsgAliasUpdate(bz []byte) (MsgAliasUpdate, int, error) {
+	return decodeMsgAliasUpdate(bz, -1)
+}
+
+func decodeMsgAliasUpdate(bz []byte, limit int) (MsgAliasUpdate, int, error) {
+	// codon version: 1
+	var err error
+	var length int
+	var v MsgAliasUpdate
+	var n int
+	var total int
+	length = codonDecodeLength(bz, &n, &err, limit)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Owner, n, err = codonGetByteSlice(bz, length)
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.Alias = string(codonDecodeString(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.IsAdd = bool(codonDecodeBool(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	v.AsDefault = bool(codonDecodeBool(bz, &n, &err))
+	if err != nil {
+		return v, total, err
+	}
+	bz = bz[n:]
+	total += n
+	return v, total, nil
+} //End of DecodeMsgAliasUpdate
+
+func RandMsgAliasUpdate(r RandSrc) MsgAliasUpdate {
+	// codon version: 1
+	var length int
+	var v MsgAliasUpdate
+	length = 1 + int(r.GetUint()%(MaxSliceLength-1))
+	v.Owner = r.GetBytes(length)
+	v.Alias = r.GetString(1 + int(r.GetUint()%(MaxStringLength-1)))
+	v.IsAdd = r.GetBool()
+	v.AsDefault = r.GetBool()
+	return v
+} //End of RandMsgAliasUpdate
+
+// Interface
+func EncodePubKey(w io.Writer, x interface{}) error {
+	switch v := x.(type) {
+	case PubKeyEd25519:
+		w.Write(getMagicBytes("PubKeyEd25519"))
+		return EncodePubKeyEd25519(w, v)
+	case *PubKeyEd25519:
+		w.Write(getMagicBytes("PubKeyEd25519"))
+		return EncodePubKeyEd25519(w, *v)
+	case PubKeyMultisigThreshold:
+		w.Write(getMagicBytes("PubKeyMultisigThreshold"))
+		return EncodePubKeyMultisigThreshold(w, v)
+	case *PubKeyMultisigThreshold:
+		w.Write(getMagicBytes("PubKeyMultisigThreshold"))
+		return EncodePubKeyMultisigThreshold(w, *v)
+	case PubKeySecp256k1:
+		w.Write(getMagicBytes("PubKeySecp256k1"))
+		return EncodePubKeySecp256k1(w, v)
+	case *PubKeySecp256k1:
+		w.Write(getMagicBytes("PubKeySecp256k1"))
+		return EncodePubKeySecp256k1(w, *v)
+	case StdSignature:
+		w.Write(getMagicBytes("StdSignature"))
+		return EncodeStdSignature(w, v)
+	case *StdSignature:
+		w.Write(getMagicBytes("StdSignature"))
+		return EncodeStdSignature(w, *v)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func DecodePubKey(bz []byte) (PubKey, int, error) {
+	return decodePubKey(bz, -1)
+}
+
+func decodePubKey(bz []byte, limit int) (PubKey, int, error) {
+	var v PubKey
+	var magicBytes [4]byte
+	var n int
+	if len(bz) < 4 {
+		return v, 0, ErrNotEnoughBytes
+	}
+	for i := 0; i < 4; i++ {
+		magicBytes[i] = bz[i]
+	}
+	switch magicBytes {
+	case [4]byte{108, 143, 2, 48}:
+		v, n, err := decodePubKeyEd25519(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{131, 227, 102, 173}:
+		v, n, err := decodePubKeyMultisigThreshold(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{10, 126, 85, 105}:
+		v, n, err := decodePubKeySecp256k1(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{88, 244, 106, 18}:
+		v, n, err := decodeStdSignature(bz[4:], limit)
+		return v, n + 4, err
+	default:
+		return v, n, errors.New("Unknown type")
+	} // end of switch
+} // end of DecodePubKey
+func RandPubKey(r RandSrc) PubKey {
+	switch r.GetUint() % 2 {
+	case 0:
+		return RandPubKeyEd25519(r)
+	case 1:
+		return RandPubKeySecp256k1(r)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+// Interface
+func EncodeMsg(w io.Writer, x interface{}) error {
+	switch v := x.(type) {
+	case MsgAddLiquidity:
+		w.Write(getMagicBytes("MsgAddLiquidity"))
+		return EncodeMsgAddLiquidity(w, v)
+	case *MsgAddLiquidity:
+		w.Write(getMagicBytes("MsgAddLiquidity"))
+		return EncodeMsgAddLiquidity(w, *v)
+	case MsgAddTokenWhitelist:
+		w.Write(getMagicBytes("MsgAddTokenWhitelist"))
+		return EncodeMsgAddTokenWhitelist(w, v)
+	case *MsgAddTokenWhitelist:
+		w.Write(getMagicBytes("MsgAddTokenWhitelist"))
+		return EncodeMsgAddTokenWhitelist(w, *v)
+	case MsgAliasUpdate:
+		w.Write(getMagicBytes("MsgAliasUpdate"))
+		return EncodeMsgAliasUpdate(w, v)
+	case *MsgAliasUpdate:
+		w.Write(getMagicBytes("MsgAliasUpdate"))
+		return EncodeMsgAliasUpdate(w, *v)
+	case MsgAutoSwapCancelOrder:
+		w.Write(getMagicBytes("MsgAutoSwapCancelOrder"))
+		return EncodeMsgAutoSwapCancelOrder(w, v)
+	case *MsgAutoSwapCancelOrder:
+		w.Write(getMagicBytes("MsgAutoSwapCancelOrder"))
+		return EncodeMsgAutoSwapCancelOrder(w, *v)
+	case MsgAutoSwapCreateOrder:
+		w.Write(getMagicBytes("MsgAutoSwapCreateOrder"))
+		return EncodeMsgAutoSwapCreateOrder(w, v)
+	case *MsgAutoSwapCreateOrder:
+		w.Write(getMagicBytes("MsgAutoSwapCreateOrder"))
+		return EncodeMsgAutoSwapCreateOrder(w, *v)
+	case MsgBancorCancel:
+		w.Write(getMagicBytes("MsgBancorCancel"))
+		return EncodeMsgBancorCancel(w, v)
+	case *MsgBancorCancel:
+		w.Write(getMagicBytes("MsgBancorCancel"))
+		return EncodeMsgBancorCancel(w, *v)
+	case MsgBancorInit:
+		w.Write(getMagicBytes("MsgBancorInit"))
+		return EncodeMsgBancorInit(w, v)
+	case *MsgBancorInit:
+		w.Write(getMagicBytes("MsgBancorInit"))
+		return EncodeMsgBancorInit(w, *v)
+	case MsgBancorTrade:
+		w.Write(getMagicBytes("MsgBancorTrade"))
+		return EncodeMsgBancorTrade(w, v)
+	case *MsgBancorTrade:
+		w.Write(getMagicBytes("MsgBancorTrade"))
+		return EncodeMsgBancorTrade(w, *v)
+	case MsgBeginRedelegate:
+		w.Write(getMagicBytes("MsgBeginRedelegate"))
+		return EncodeMsgBeginRedelegate(w, v)
+	case *MsgBeginRedelegate:
+		w.Write(getMagicBytes("MsgBeginRedelegate"))
+		return EncodeMsgBeginRedelegate(w, *v)
+	case MsgBurnToken:
+		w.Write(getMagicBytes("MsgBurnToken"))
+		return EncodeMsgBurnToken(w, v)
+	case *MsgBurnToken:
+		w.Write(getMagicBytes("MsgBurnToken"))
+		return EncodeMsgBurnToken(w, *v)
+	case MsgCancelOrder:
+		w.Write(getMagicBytes("MsgCancelOrder"))
+		return EncodeMsgCancelOrder(w, v)
+	case *MsgCancelOrder:
+		w.Write(getMagicBytes("MsgCancelOrder"))
+		return EncodeMsgCancelOrder(w, *v)
+	case MsgCancelTradingPair:
+		w.Write(getMagicBytes("MsgCancelTradingPair"))
+		return EncodeMsgCancelTradingPair(w, v)
+	case *MsgCancelTradingPair:
+		w.Write(getMagicBytes("MsgCancelTradingPair"))
+		return EncodeMsgCancelTradingPair(w, *v)
+	case MsgCommentToken:
+		w.Write(getMagicBytes("MsgCommentToken"))
+		return EncodeMsgCommentToken(w, v)
+	case *MsgCommentToken:
+		w.Write(getMagicBytes("MsgCommentToken"))
+		return EncodeMsgCommentToken(w, *v)
+	case MsgCreateOrder:
+		w.Write(getMagicBytes("MsgCreateOrder"))
+		return EncodeMsgCreateOrder(w, v)
+	case *MsgCreateOrder:
+		w.Write(getMagicBytes("MsgCreateOrder"))
+		return EncodeMsgCreateOrder(w, *v)
+	case MsgCreateTradingPair:
+		w.Write(getMagicBytes("MsgCreateTradingPair"))
+		return EncodeMsgCreateTradingPair(w, v)
+	case *MsgCreateTradingPair:
+		w.Write(getMagicBytes("MsgCreateTradingPair"))
+		return EncodeMsgCreateTradingPair(w, *v)
+	case MsgCreateValidator:
+		w.Write(getMagicBytes("MsgCreateValidator"))
+		return EncodeMsgCreateValidator(w, v)
+	case *MsgCreateValidator:
+		w.Write(getMagicBytes("MsgCreateValidator"))
+		return EncodeMsgCreateValidator(w, *v)
+	case MsgDelegate:
+		w.Write(getMagicBytes("MsgDelegate"))
+		return EncodeMsgDelegate(w, v)
+	case *MsgDelegate:
+		w.Write(getMagicBytes("MsgDelegate"))
+		return EncodeMsgDelegate(w, *v)
+	case MsgDeposit:
+		w.Write(getMagicBytes("MsgDeposit"))
+		return EncodeMsgDeposit(w, v)
+	case *MsgDeposit:
+		w.Write(getMagicBytes("MsgDeposit"))
+		return EncodeMsgDeposit(w, *v)
+	case MsgDonateToCommunityPool:
+		w.Write(getMagicBytes("MsgDonateToCommunityPool"))
+		return EncodeMsgDonateToCommunityPool(w, v)
+	case *MsgDonateToCommunityPool:
+		w.Write(getMagicBytes("MsgDonateToCommunityPool"))
+		return EncodeMsgDonateToCommunityPool(w, *v)
+	case MsgEditValidator:
+		w.Write(getMagicBytes("MsgEditValidator"))
+		return EncodeMsgEditValidator(w, v)
+	case *MsgEditValidator:
+		w.Write(getMagicBytes("MsgEditValidator"))
+		return EncodeMsgEditValidator(w, *v)
+	case MsgForbidAddr:
+		w.Write(getMagicBytes("MsgForbidAddr"))
+		return EncodeMsgForbidAddr(w, v)
+	case *MsgForbidAddr:
+		w.Write(getMagicBytes("MsgForbidAddr"))
+		return EncodeMsgForbidAddr(w, *v)
+	case MsgForbidToken:
+		w.Write(getMagicBytes("MsgForbidToken"))
+		return EncodeMsgForbidToken(w, v)
+	case *MsgForbidToken:
+		w.Write(getMagicBytes("MsgForbidToken"))
+		return EncodeMsgForbidToken(w, *v)
+	case MsgIssueToken:
+		w.Write(getMagicBytes("MsgIssueToken"))
+		return EncodeMsgIssueToken(w, v)
+	case *MsgIssueToken:
+		w.Write(getMagicBytes("MsgIssueToken"))
+		return EncodeMsgIssueToken(w, *v)
+	case MsgMintToken:
+		w.Write(getMagicBytes("MsgMintToken"))
+		return EncodeMsgMintToken(w, v)
+	case *MsgMintToken:
+		w.Write(getMagicBytes("MsgMintToken"))
+		return EncodeMsgMintToken(w, *v)
+	case MsgModifyPricePrecision:
+		w.Write(getMagicBytes("MsgModifyPricePrecision"))
+		return EncodeMsgModifyPricePrecision(w, v)
+	case *MsgModifyPricePrecision:
+		w.Write(getMagicBytes("MsgModifyPricePrecision"))
+		return EncodeMsgModifyPricePrecision(w, *v)
+	case MsgModifyTokenInfo:
+		w.Write(getMagicBytes("MsgModifyTokenInfo"))
+		return EncodeMsgModifyTokenInfo(w, v)
+	case *MsgModifyTokenInfo:
+		w.Write(getMagicBytes("MsgModifyTokenInfo"))
+		return EncodeMsgModifyTokenInfo(w, *v)
+	case MsgMultiSend:
+		w.Write(getMagicBytes("MsgMultiSend"))
+		return EncodeMsgMultiSend(w, v)
+	case *MsgMultiSend:
+		w.Write(getMagicBytes("MsgMultiSend"))
+		return EncodeMsgMultiSend(w, *v)
+	case MsgMultiSendX:
+		w.Write(getMagicBytes("MsgMultiSendX"))
+		return EncodeMsgMultiSendX(w, v)
+	case *MsgMultiSendX:
+		w.Write(getMagicBytes("MsgMultiSendX"))
+		return EncodeMsgMultiSendX(w, *v)
+	case MsgRemoveLiquidity:
+		w.Write(getMagicBytes("MsgRemoveLiquidity"))
+		return EncodeMsgRemoveLiquidity(w, v)
+	case *MsgRemoveLiquidity:
+		w.Write(getMagicBytes("MsgRemoveLiquidity"))
+		return EncodeMsgRemoveLiquidity(w, *v)
+	case MsgRemoveTokenWhitelist:
+		w.Write(getMagicBytes("MsgRemoveTokenWhitelist"))
+		return EncodeMsgRemoveTokenWhitelist(w, v)
+	case *MsgRemoveTokenWhitelist:
+		w.Write(getMagicBytes("MsgRemoveTokenWhitelist"))
+		return EncodeMsgRemoveTokenWhitelist(w, *v)
+	case MsgSend:
+		w.Write(getMagicBytes("MsgSend"))
+		return EncodeMsgSend(w, v)
+	case *MsgSend:
+		w.Write(getMagicBytes("MsgSend"))
+		return EncodeMsgSend(w, *v)
+	case MsgSendX:
+		w.Write(getMagicBytes("MsgSendX"))
+		return EncodeMsgSendX(w, v)
+	case *MsgSendX:
+		w.Write(getMagicBytes("MsgSendX"))
+		return EncodeMsgSendX(w, *v)
+	case MsgSetMemoRequired:
+		w.Write(getMagicBytes("MsgSetMemoRequired"))
+		return EncodeMsgSetMemoRequired(w, v)
+	case *MsgSetMemoRequired:
+		w.Write(getMagicBytes("MsgSetMemoRequired"))
+		return EncodeMsgSetMemoRequired(w, *v)
+	case MsgSetReferee:
+		w.Write(getMagicBytes("MsgSetReferee"))
+		return EncodeMsgSetReferee(w, v)
+	case *MsgSetReferee:
+		w.Write(getMagicBytes("MsgSetReferee"))
+		return EncodeMsgSetReferee(w, *v)
+	case MsgSetWithdrawAddress:
+		w.Write(getMagicBytes("MsgSetWithdrawAddress"))
+		return EncodeMsgSetWithdrawAddress(w, v)
+	case *MsgSetWithdrawAddress:
+		w.Write(getMagicBytes("MsgSetWithdrawAddress"))
+		return EncodeMsgSetWithdrawAddress(w, *v)
+	case MsgSubmitProposal:
+		w.Write(getMagicBytes("MsgSubmitProposal"))
+		return EncodeMsgSubmitProposal(w, v)
+	case *MsgSubmitProposal:
+		w.Write(getMagicBytes("MsgSubmitProposal"))
+		return EncodeMsgSubmitProposal(w, *v)
+	case MsgSupervisedSend:
+		w.Write(getMagicBytes("MsgSupervisedSend"))
+		return EncodeMsgSupervisedSend(w, v)
+	case *MsgSupervisedSend:
+		w.Write(getMagicBytes("MsgSupervisedSend"))
+		return EncodeMsgSupervisedSend(w, *v)
+	case MsgTransferOwnership:
+		w.Write(getMagicBytes("MsgTransferOwnership"))
+		return EncodeMsgTransferOwnership(w, v)
+	case *MsgTransferOwnership:
+		w.Write(getMagicBytes("MsgTransferOwnership"))
+		return EncodeMsgTransferOwnership(w, *v)
+	case MsgUnForbidAddr:
+		w.Write(getMagicBytes("MsgUnForbidAddr"))
+		return EncodeMsgUnForbidAddr(w, v)
+	case *MsgUnForbidAddr:
+		w.Write(getMagicBytes("MsgUnForbidAddr"))
+		return EncodeMsgUnForbidAddr(w, *v)
+	case MsgUnForbidToken:
+		w.Write(getMagicBytes("MsgUnForbidToken"))
+		return EncodeMsgUnForbidToken(w, v)
+	case *MsgUnForbidToken:
+		w.Write(getMagicBytes("MsgUnForbidToken"))
+		return EncodeMsgUnForbidToken(w, *v)
+	case MsgUndelegate:
+		w.Write(getMagicBytes("MsgUndelegate"))
+		return EncodeMsgUndelegate(w, v)
+	case *MsgUndelegate:
+		w.Write(getMagicBytes("MsgUndelegate"))
+		return EncodeMsgUndelegate(w, *v)
+	case MsgUnjail:
+		w.Write(getMagicBytes("MsgUnjail"))
+		return EncodeMsgUnjail(w, v)
+	case *MsgUnjail:
+		w.Write(getMagicBytes("MsgUnjail"))
+		return EncodeMsgUnjail(w, *v)
+	case MsgVerifyInvariant:
+		w.Write(getMagicBytes("MsgVerifyInvariant"))
+		return EncodeMsgVerifyInvariant(w, v)
+	case *MsgVerifyInvariant:
+		w.Write(getMagicBytes("MsgVerifyInvariant"))
+		return EncodeMsgVerifyInvariant(w, *v)
+	case MsgVote:
+		w.Write(getMagicBytes("MsgVote"))
+		return EncodeMsgVote(w, v)
+	case *MsgVote:
+		w.Write(getMagicBytes("MsgVote"))
+		return EncodeMsgVote(w, *v)
+	case MsgWithdrawDelegatorReward:
+		w.Write(getMagicBytes("MsgWithdrawDelegatorReward"))
+		return EncodeMsgWithdrawDelegatorReward(w, v)
+	case *MsgWithdrawDelegatorReward:
+		w.Write(getMagicBytes("MsgWithdrawDelegatorReward"))
+		return EncodeMsgWithdrawDelegatorReward(w, *v)
+	case MsgWithdrawValidatorCommission:
+		w.Write(getMagicBytes("MsgWithdrawValidatorCommission"))
+		return EncodeMsgWithdrawValidatorCommission(w, v)
+	case *MsgWithdrawValidatorCommission:
+		w.Write(getMagicBytes("MsgWithdrawValidatorCommission"))
+		return EncodeMsgWithdrawValidatorCommission(w, *v)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func DecodeMsg(bz []byte) (Msg, int, error) {
+	return decodeMsg(bz, -1)
+}
+
+func decodeMsg(bz []byte, limit int) (Msg, int, error) {
+	var v Msg
+	var magicBytes [4]byte
+	var n int
+	if len(bz) < 4 {
+		return v, 0, ErrNotEnoughBytes
+	}
+	for i := 0; i < 4; i++ {
+		magicBytes[i] = bz[i]
+	}
+	switch magicBytes {
+	case [4]byte{53, 70, 1, 235}:
+		v, n, err := decodeMsgAddLiquidity(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{147, 136, 220, 215}:
+		v, n, err := decodeMsgAddTokenWhitelist(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{173, 181, 17, 162}:
+		v, n, err := decodeMsgAliasUpdate(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{72, 43, 95, 106}:
+		v, n, err := decodeMsgAutoSwapCancelOrder(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{13, 89, 105, 117}:
+		v, n, err := decodeMsgAutoSwapCreateOrder(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{187, 190, 104, 91}:
+		v, n, err := decodeMsgBancorCancel(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{171, 83, 147, 104}:
+		v, n, err := decodeMsgBancorInit(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{225, 122, 18, 80}:
+		v, n, err := decodeMsgBancorTrade(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{247, 3, 0, 105}:
+		v, n, err := decodeMsgBeginRedelegate(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{228, 0, 236, 212}:
+		v, n, err := decodeMsgBurnToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{106, 229, 80, 141}:
+		v, n, err := decodeMsgCancelOrder(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{13, 177, 95, 127}:
+		v, n, err := decodeMsgCancelTradingPair(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{79, 125, 235, 121}:
+		v, n, err := decodeMsgCommentToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{246, 238, 7, 164}:
+		v, n, err := decodeMsgCreateOrder(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{130, 221, 55, 57}:
+		v, n, err := decodeMsgCreateTradingPair(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{58, 78, 252, 114}:
+		v, n, err := decodeMsgCreateValidator(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{1, 82, 140, 71}:
+		v, n, err := decodeMsgDelegate(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{205, 134, 140, 190}:
+		v, n, err := decodeMsgDeposit(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{20, 250, 115, 197}:
+		v, n, err := decodeMsgDonateToCommunityPool(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{202, 62, 140, 8}:
+		v, n, err := decodeMsgEditValidator(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{105, 235, 112, 10}:
+		v, n, err := decodeMsgForbidAddr(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{36, 174, 203, 238}:
+		v, n, err := decodeMsgForbidToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{233, 180, 92, 129}:
+		v, n, err := decodeMsgIssueToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{66, 148, 56, 203}:
+		v, n, err := decodeMsgMintToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{76, 91, 156, 199}:
+		v, n, err := decodeMsgModifyPricePrecision(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{248, 60, 175, 175}:
+		v, n, err := decodeMsgModifyTokenInfo(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{207, 152, 156, 90}:
+		v, n, err := decodeMsgMultiSend(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{61, 117, 88, 200}:
+		v, n, err := decodeMsgMultiSendX(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{235, 230, 144, 194}:
+		v, n, err := decodeMsgRemoveLiquidity(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{44, 154, 68, 83}:
+		v, n, err := decodeMsgRemoveTokenWhitelist(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{100, 168, 39, 140}:
+		v, n, err := decodeMsgSend(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{198, 76, 8, 81}:
+		v, n, err := decodeMsgSendX(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{184, 238, 253, 154}:
+		v, n, err := decodeMsgSetMemoRequired(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{189, 36, 194, 183}:
+		v, n, err := decodeMsgSetReferee(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{190, 178, 173, 144}:
+		v, n, err := decodeMsgSetWithdrawAddress(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{115, 119, 137, 48}:
+		v, n, err := decodeMsgSubmitProposal(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{247, 207, 81, 239}:
+		v, n, err := decodeMsgSupervisedSend(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{200, 224, 118, 175}:
+		v, n, err := decodeMsgTransferOwnership(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{167, 165, 166, 227}:
+		v, n, err := decodeMsgUnForbidAddr(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{78, 83, 156, 139}:
+		v, n, err := decodeMsgUnForbidToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{122, 66, 160, 76}:
+		v, n, err := decodeMsgUndelegate(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{216, 247, 180, 46}:
+		v, n, err := decodeMsgUnjail(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{84, 44, 219, 65}:
+		v, n, err := decodeMsgVerifyInvariant(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{238, 246, 67, 141}:
+		v, n, err := decodeMsgVote(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{94, 251, 176, 152}:
+		v, n, err := decodeMsgWithdrawDelegatorReward(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{18, 172, 190, 152}:
+		v, n, err := decodeMsgWithdrawValidatorCommission(bz[4:], limit)
+		return v, n + 4, err
+	default:
+		return v, n, errors.New("Unknown type")
+	} // end of switch
+} // end of DecodeMsg
+func RandMsg(r RandSrc) Msg {
+	switch r.GetUint() % 46 {
+	case 0:
+		return RandMsgAddLiquidity(r)
+	case 1:
+		return RandMsgAddTokenWhitelist(r)
+	case 2:
+		return RandMsgAliasUpdate(r)
+	case 3:
+		return RandMsgAutoSwapCancelOrder(r)
+	case 4:
+		return RandMsgAutoSwapCreateOrder(r)
+	case 5:
+		return RandMsgBancorCancel(r)
+	case 6:
+		return RandMsgBancorInit(r)
+	case 7:
+		return RandMsgBancorTrade(r)
+	case 8:
+		return RandMsgBeginRedelegate(r)
+	case 9:
+		return RandMsgBurnToken(r)
+	case 10:
+		return RandMsgCancelOrder(r)
+	case 11:
+		return RandMsgCancelTradingPair(r)
+	case 12:
+		return RandMsgCommentToken(r)
+	case 13:
+		return RandMsgCreateOrder(r)
+	case 14:
+		return RandMsgCreateTradingPair(r)
+	case 15:
+		return RandMsgCreateValidator(r)
+	case 16:
+		return RandMsgDelegate(r)
+	case 17:
+		return RandMsgDeposit(r)
+	case 18:
+		return RandMsgDonateToCommunityPool(r)
+	case 19:
+		return RandMsgEditValidator(r)
+	case 20:
+		return RandMsgForbidAddr(r)
+	case 21:
+		return RandMsgForbidToken(r)
+	case 22:
+		return RandMsgIssueToken(r)
+	case 23:
+		return RandMsgMintToken(r)
+	case 24:
+		return RandMsgModifyPricePrecision(r)
+	case 25:
+		return RandMsgModifyTokenInfo(r)
+	case 26:
+		return RandMsgMultiSend(r)
+	case 27:
+		return RandMsgMultiSendX(r)
+	case 28:
+		return RandMsgRemoveLiquidity(r)
+	case 29:
+		return RandMsgRemoveTokenWhitelist(r)
+	case 30:
+		return RandMsgSend(r)
+	case 31:
+		return RandMsgSendX(r)
+	case 32:
+		return RandMsgSetMemoRequired(r)
+	case 33:
+		return RandMsgSetReferee(r)
+	case 34:
+		return RandMsgSetWithdrawAddress(r)
+	case 35:
+		return RandMsgSubmitProposal(r)
+	case 36:
+		return RandMsgSupervisedSend(r)
+	case 37:
+		return RandMsgTransferOwnership(r)
+	case 38:
+		return RandMsgUnForbidAddr(r)
+	case 39:
+		return RandMsgUnForbidToken(r)
+	case 40:
+		return RandMsgUndelegate(r)
+	case 41:
+		return RandMsgUnjail(r)
+	case 42:
+		return RandMsgVerifyInvariant(r)
+	case 43:
+		return RandMsgVote(r)
+	case 44:
+		return RandMsgWithdrawDelegatorReward(r)
+	case 45:
+		return RandMsgWithdrawValidatorCommission(r)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+// Interface
+func EncodeAccount(w io.Writer, x interface{}) error {
+	switch v := x.(type) {
+	case BaseVestingAccount:
+		w.Write(getMagicBytes("BaseVestingAccount"))
+		return EncodeBaseVestingAccount(w, v)
+	case *BaseVestingAccount:
+		w.Write(getMagicBytes("BaseVestingAccount"))
+		return EncodeBaseVestingAccount(w, *v)
+	case ContinuousVestingAccount:
+		w.Write(getMagicBytes("ContinuousVestingAccount"))
+		return EncodeContinuousVestingAccount(w, v)
+	case *ContinuousVestingAccount:
+		w.Write(getMagicBytes("ContinuousVestingAccount"))
+		return EncodeContinuousVestingAccount(w, *v)
+	case DelayedVestingAccount:
+		w.Write(getMagicBytes("DelayedVestingAccount"))
+		return EncodeDelayedVestingAccount(w, v)
+	case *DelayedVestingAccount:
+		w.Write(getMagicBytes("DelayedVestingAccount"))
+		return EncodeDelayedVestingAccount(w, *v)
+	case ModuleAccount:
+		w.Write(getMagicBytes("ModuleAccount"))
+		return EncodeModuleAccount(w, v)
+	case *ModuleAccount:
+		w.Write(getMagicBytes("ModuleAccount"))
+		return EncodeModuleAccount(w, *v)
+	case PeriodicVestingAccount:
+		w.Write(getMagicBytes("PeriodicVestingAccount"))
+		return EncodePeriodicVestingAccount(w, v)
+	case *PeriodicVestingAccount:
+		w.Write(getMagicBytes("PeriodicVestingAccount"))
+		return EncodePeriodicVestingAccount(w, *v)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func DecodeAccount(bz []byte) (Account, int, error) {
+	return decodeAccount(bz, -1)
+}
+
+func decodeAccount(bz []byte, limit int) (Account, int, error) {
+	var v Account
+	var magicBytes [4]byte
+	var n int
+	if len(bz) < 4 {
+		return v, 0, ErrNotEnoughBytes
+	}
+	for i := 0; i < 4; i++ {
+		magicBytes[i] = bz[i]
+	}
+	switch magicBytes {
+	case [4]byte{178, 47, 121, 129}:
+		v, n, err := decodeBaseVestingAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{95, 96, 75, 0}:
+		v, n, err := decodeContinuousVestingAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{187, 71, 224, 1}:
+		v, n, err := decodeDelayedVestingAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{190, 107, 1, 124}:
+		v, n, err := decodeModuleAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{18, 134, 62, 186}:
+		v, n, err := decodePeriodicVestingAccount(bz[4:], limit)
+		return v, n + 4, err
+	default:
+		return v, n, errors.New("Unknown type")
+	} // end of switch
+} // end of DecodeAccount
+func RandAccount(r RandSrc) Account {
+	switch r.GetUint() % 5 {
+	case 0:
+		return RandBaseVestingAccount(r)
+	case 1:
+		return RandContinuousVestingAccount(r)
+	case 2:
+		return RandDelayedVestingAccount(r)
+	case 3:
+		return RandModuleAccount(r)
+	case 4:
+		return RandPeriodicVestingAccount(r)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+// Interface
+func EncodeContent(w io.Writer, x interface{}) error {
+	switch v := x.(type) {
+	case CommunityPoolSpendProposal:
+		w.Write(getMagicBytes("CommunityPoolSpendProposal"))
+		return EncodeCommunityPoolSpendProposal(w, v)
+	case *CommunityPoolSpendProposal:
+		w.Write(getMagicBytes("CommunityPoolSpendProposal"))
+		return EncodeCommunityPoolSpendProposal(w, *v)
+	case ParameterChangeProposal:
+		w.Write(getMagicBytes("ParameterChangeProposal"))
+		return EncodeParameterChangeProposal(w, v)
+	case *ParameterChangeProposal:
+		w.Write(getMagicBytes("ParameterChangeProposal"))
+		return EncodeParameterChangeProposal(w, *v)
+	case SoftwareUpgradeProposal:
+		w.Write(getMagicBytes("SoftwareUpgradeProposal"))
+		return EncodeSoftwareUpgradeProposal(w, v)
+	case *SoftwareUpgradeProposal:
+		w.Write(getMagicBytes("SoftwareUpgradeProposal"))
+		return EncodeSoftwareUpgradeProposal(w, *v)
+	case TextProposal:
+		w.Write(getMagicBytes("TextProposal"))
+		return EncodeTextProposal(w, v)
+	case *TextProposal:
+		w.Write(getMagicBytes("TextProposal"))
+		return EncodeTextProposal(w, *v)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func DecodeContent(bz []byte) (Content, int, error) {
+	return decodeContent(bz, -1)
+}
+
+func decodeContent(bz []byte, limit int) (Content, int, error) {
+	var v Content
+	var magicBytes [4]byte
+	var n int
+	if len(bz) < 4 {
+		return v, 0, ErrNotEnoughBytes
+	}
+	for i := 0; i < 4; i++ {
+		magicBytes[i] = bz[i]
+	}
+	switch magicBytes {
+	case [4]byte{37, 214, 119, 170}:
+		v, n, err := decodeCommunityPoolSpendProposal(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{166, 63, 172, 210}:
+		v, n, err := decodeParameterChangeProposal(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{37, 100, 208, 251}:
+		v, n, err := decodeSoftwareUpgradeProposal(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{169, 32, 176, 245}:
+		v, n, err := decodeTextProposal(bz[4:], limit)
+		return v, n + 4, err
+	default:
+		return v, n, errors.New("Unknown type")
+	} // end of switch
+} // end of DecodeContent
+func RandContent(r RandSrc) Content {
+	switch r.GetUint() % 4 {
+	case 0:
+		return RandCommunityPoolSpendProposal(r)
+	case 1:
+		return RandParameterChangeProposal(r)
+	case 2:
+		return RandSoftwareUpgradeProposal(r)
+	case 3:
+		return RandTextProposal(r)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func getMagicBytes(name string) []byte {
+	switch name {
+	case "AccAddress":
+		return []byte{37, 50, 37, 208}
+	case "AccountX":
+		return []byte{148, 255, 29, 190}
+	case "BaseAccount":
+		return []byte{100, 94, 81, 72}
+	case "BaseToken":
+		return []byte{34, 178, 244, 51}
+	case "BaseVestingAccount":
+		return []byte{178, 47, 121, 129}
+	case "Coin":
+		return []byte{141, 140, 97, 80}
+	case "CommentRef":
+		return []byte{17, 162, 164, 235}
+	case "CommunityPoolSpendProposal":
+		return []byte{37, 214, 119, 170}
+	case "ContinuousVestingAccount":
+		return []byte{95, 96, 75, 0}
+	case "DelayedVestingAccount":
+		return []byte{187, 71, 224, 1}
+	case "DuplicateVoteEvidence":
+		return []byte{130, 76, 198, 17}
+	case "Input":
+		return []byte{165, 152, 189, 47}
+	case "LockedCoin":
+		return []byte{227, 236, 168, 93}
+	case "MarketInfo":
+		return []byte{174, 117, 167, 230}
+	case "ModuleAccount":
+		return []byte{190, 107, 1, 124}
+	case "MsgAddLiquidity":
+		return []byte{53, 70, 1, 235}
+	case "MsgAddTokenWhitelist":
+		return []byte{147, 136, 220, 215}
+	case "MsgAliasUpdate":
+		return []byte{173, 181, 17, 162}
+	case "MsgAutoSwapCancelOrder":
+		return []byte{72, 43, 95, 106}
+	case "MsgAutoSwapCreateOrder":
+		return []byte{13, 89, 105, 117}
+	case "MsgBancorCancel":
+		return []byte{187, 190, 104, 91}
+	case "MsgBancorInit":
+		return []byte{171, 83, 147, 104}
+	case "MsgBancorTrade":
+		return []byte{225, 122, 18, 80}
+	case "MsgBeginRedelegate":
+		return []byte{247, 3, 0, 105}
+	case "MsgBurnToken":
+		return []byte{228, 0, 236, 212}
+	case "MsgCancelOrder":
+		return []byte{106, 229, 80, 141}
+	case "MsgCancelTradingPair":
+		return []byte{13, 177, 95, 127}
+	case "MsgCommentToken":
+		return []byte{79, 125, 235, 121}
+	case "MsgCreateOrder":
+		return []byte{246, 238, 7, 164}
+	case "MsgCreateTradingPair":
+		return []byte{130, 221, 55, 57}
+	case "MsgCreateValidator":
+		return []byte{58, 78, 252, 114}
+	case "MsgDelegate":
+		return []byte{1, 82, 140, 71}
+	case "MsgDeposit":
+		return []byte{205, 134, 140, 190}
+	case "MsgDonateToCommunityPool":
+		return []byte{20, 250, 115, 197}
+	case "MsgEditValidator":
+		return []byte{202, 62, 140, 8}
+	case "MsgForbidAddr":
+		return []byte{105, 235, 112, 10}
+	case "MsgForbidToken":
+		return []byte{36, 174, 203, 238}
+	case "MsgIssueToken":
+		return []byte{233, 180, 92, 129}
+	case "MsgMintToken":
+		return []byte{66, 148, 56, 203}
+	case "MsgModifyPricePrecision":
+		return []byte{76, 91, 156, 199}
+	case "MsgModifyTokenInfo":
+		return []byte{248, 60, 175, 175}
+	case "MsgMultiSend":
+		return []byte{207, 152, 156, 90}
+	case "MsgMultiSendX":
+		return []byte{61, 117, 88, 200}
+	case "MsgRemoveLiquidity":
+		return []byte{235, 230, 144, 194}
+	case "MsgRemoveTokenWhitelist":
+		return []byte{44, 154, 68, 83}
+	case "MsgSend":
+		return []byte{100, 168, 39, 140}
+	case "MsgSendX":
+		return []byte{198, 76, 8, 81}
+	case "MsgSetMemoRequired":
+		return []byte{184, 238, 253, 154}
+	case "MsgSetReferee":
+		return []byte{189, 36, 194, 183}
+	case "MsgSetWithdrawAddress":
+		return []byte{190, 178, 173, 144}
+	case "MsgSubmitProposal":
+		return []byte{115, 119, 137, 48}
+	case "MsgSupervisedSend":
+		return []byte{247, 207, 81, 239}
+	case "MsgTransferOwnership":
+		return []byte{200, 224, 118, 175}
+	case "MsgUnForbidAddr":
+		return []byte{167, 165, 166, 227}
+	case "MsgUnForbidToken":
+		return []byte{78, 83, 156, 139}
+	case "MsgUndelegate":
+		return []byte{122, 66, 160, 76}
+	case "MsgUnjail":
+		return []byte{216, 247, 180, 46}
+	case "MsgVerifyInvariant":
+		return []byte{84, 44, 219, 65}
+	case "MsgVote":
+		return []byte{238, 246, 67, 141}
+	case "MsgWithdrawDelegatorReward":
+		return []byte{94, 251, 176, 152}
+	case "MsgWithdrawValidatorCommission":
+		return []byte{18, 172, 190, 152}
+	case "Order":
+		return []byte{40, 166, 231, 227}
+	case "Output":
+		return []byte{251, 0, 54, 127}
+	case "ParamChange":
+		return []byte{234, 101, 49, 27}
+	case "ParameterChangeProposal":
+		return []byte{166, 63, 172, 210}
+	case "Period":
+		return []byte{22, 115, 239, 93}
+	case "PeriodicVestingAccount":
+		return []byte{18, 134, 62, 186}
+	case "PrivKeyEd25519":
+		return []byte{93, 160, 108, 51}
+	case "PrivKeySecp256k1":
+		return []byte{209, 107, 141, 98}
+	case "PubKeyEd25519":
+		return []byte{108, 143, 2, 48}
+	case "PubKeyMultisigThreshold":
+		return []byte{131, 227, 102, 173}
+	case "PubKeySecp256k1":
+		return []byte{10, 126, 85, 105}
+	case "SignedMsgType":
+		return []byte{169, 174, 252, 87}
+	case "SoftwareUpgradeProposal":
+		return []byte{37, 100, 208, 251}
+	case "State":
+		return []byte{186, 223, 120, 4}
+	case "StdSignature":
+		return []byte{88, 244, 106, 18}
+	case "StdTx":
+		return []byte{71, 175, 179, 184}
+	case "Supply":
+		return []byte{233, 209, 209, 86}
+	case "TextProposal":
+		return []byte{169, 32, 176, 245}
+	case "Vote":
+		return []byte{113, 227, 24, 224}
+	case "VoteOption":
+		return []byte{56, 159, 20, 227}
+	} // end of switch
+	panic("Should not reach here")
+} // end of getMagicBytes
+func EncodeAny(w io.Writer, x interface{}) error {
+	switch v := x.(type) {
+	case AccAddress:
+		w.Write(getMagicBytes("AccAddress"))
+		return EncodeAccAddress(w, v)
+	case *AccAddress:
+		w.Write(getMagicBytes("AccAddress"))
+		return EncodeAccAddress(w, *v)
+	case AccountX:
+		w.Write(getMagicBytes("AccountX"))
+		return EncodeAccountX(w, v)
+	case *AccountX:
+		w.Write(getMagicBytes("AccountX"))
+		return EncodeAccountX(w, *v)
+	case BaseAccount:
+		w.Write(getMagicBytes("BaseAccount"))
+		return EncodeBaseAccount(w, v)
+	case *BaseAccount:
+		w.Write(getMagicBytes("BaseAccount"))
+		return EncodeBaseAccount(w, *v)
+	case BaseToken:
+		w.Write(getMagicBytes("BaseToken"))
+		return EncodeBaseToken(w, v)
+	case *BaseToken:
+		w.Write(getMagicBytes("BaseToken"))
+		return EncodeBaseToken(w, *v)
+	case BaseVestingAccount:
+		w.Write(getMagicBytes("BaseVestingAccount"))
+		return EncodeBaseVestingAccount(w, v)
+	case *BaseVestingAccount:
+		w.Write(getMagicBytes("BaseVestingAccount"))
+		return EncodeBaseVestingAccount(w, *v)
+	case Coin:
+		w.Write(getMagicBytes("Coin"))
+		return EncodeCoin(w, v)
+	case *Coin:
+		w.Write(getMagicBytes("Coin"))
+		return EncodeCoin(w, *v)
+	case CommentRef:
+		w.Write(getMagicBytes("CommentRef"))
+		return EncodeCommentRef(w, v)
+	case *CommentRef:
+		w.Write(getMagicBytes("CommentRef"))
+		return EncodeCommentRef(w, *v)
+	case CommunityPoolSpendProposal:
+		w.Write(getMagicBytes("CommunityPoolSpendProposal"))
+		return EncodeCommunityPoolSpendProposal(w, v)
+	case *CommunityPoolSpendProposal:
+		w.Write(getMagicBytes("CommunityPoolSpendProposal"))
+		return EncodeCommunityPoolSpendProposal(w, *v)
+	case ContinuousVestingAccount:
+		w.Write(getMagicBytes("ContinuousVestingAccount"))
+		return EncodeContinuousVestingAccount(w, v)
+	case *ContinuousVestingAccount:
+		w.Write(getMagicBytes("ContinuousVestingAccount"))
+		return EncodeContinuousVestingAccount(w, *v)
+	case DelayedVestingAccount:
+		w.Write(getMagicBytes("DelayedVestingAccount"))
+		return EncodeDelayedVestingAccount(w, v)
+	case *DelayedVestingAccount:
+		w.Write(getMagicBytes("DelayedVestingAccount"))
+		return EncodeDelayedVestingAccount(w, *v)
+	case DuplicateVoteEvidence:
+		w.Write(getMagicBytes("DuplicateVoteEvidence"))
+		return EncodeDuplicateVoteEvidence(w, v)
+	case *DuplicateVoteEvidence:
+		w.Write(getMagicBytes("DuplicateVoteEvidence"))
+		return EncodeDuplicateVoteEvidence(w, *v)
+	case Input:
+		w.Write(getMagicBytes("Input"))
+		return EncodeInput(w, v)
+	case *Input:
+		w.Write(getMagicBytes("Input"))
+		return EncodeInput(w, *v)
+	case LockedCoin:
+		w.Write(getMagicBytes("LockedCoin"))
+		return EncodeLockedCoin(w, v)
+	case *LockedCoin:
+		w.Write(getMagicBytes("LockedCoin"))
+		return EncodeLockedCoin(w, *v)
+	case MarketInfo:
+		w.Write(getMagicBytes("MarketInfo"))
+		return EncodeMarketInfo(w, v)
+	case *MarketInfo:
+		w.Write(getMagicBytes("MarketInfo"))
+		return EncodeMarketInfo(w, *v)
+	case ModuleAccount:
+		w.Write(getMagicBytes("ModuleAccount"))
+		return EncodeModuleAccount(w, v)
+	case *ModuleAccount:
+		w.Write(getMagicBytes("ModuleAccount"))
+		return EncodeModuleAccount(w, *v)
+	case MsgAddLiquidity:
+		w.Write(getMagicBytes("MsgAddLiquidity"))
+		return EncodeMsgAddLiquidity(w, v)
+	case *MsgAddLiquidity:
+		w.Write(getMagicBytes("MsgAddLiquidity"))
+		return EncodeMsgAddLiquidity(w, *v)
+	case MsgAddTokenWhitelist:
+		w.Write(getMagicBytes("MsgAddTokenWhitelist"))
+		return EncodeMsgAddTokenWhitelist(w, v)
+	case *MsgAddTokenWhitelist:
+		w.Write(getMagicBytes("MsgAddTokenWhitelist"))
+		return EncodeMsgAddTokenWhitelist(w, *v)
+	case MsgAliasUpdate:
+		w.Write(getMagicBytes("MsgAliasUpdate"))
+		return EncodeMsgAliasUpdate(w, v)
+	case *MsgAliasUpdate:
+		w.Write(getMagicBytes("MsgAliasUpdate"))
+		return EncodeMsgAliasUpdate(w, *v)
+	case MsgAutoSwapCancelOrder:
+		w.Write(getMagicBytes("MsgAutoSwapCancelOrder"))
+		return EncodeMsgAutoSwapCancelOrder(w, v)
+	case *MsgAutoSwapCancelOrder:
+		w.Write(getMagicBytes("MsgAutoSwapCancelOrder"))
+		return EncodeMsgAutoSwapCancelOrder(w, *v)
+	case MsgAutoSwapCreateOrder:
+		w.Write(getMagicBytes("MsgAutoSwapCreateOrder"))
+		return EncodeMsgAutoSwapCreateOrder(w, v)
+	case *MsgAutoSwapCreateOrder:
+		w.Write(getMagicBytes("MsgAutoSwapCreateOrder"))
+		return EncodeMsgAutoSwapCreateOrder(w, *v)
+	case MsgBancorCancel:
+		w.Write(getMagicBytes("MsgBancorCancel"))
+		return EncodeMsgBancorCancel(w, v)
+	case *MsgBancorCancel:
+		w.Write(getMagicBytes("MsgBancorCancel"))
+		return EncodeMsgBancorCancel(w, *v)
+	case MsgBancorInit:
+		w.Write(getMagicBytes("MsgBancorInit"))
+		return EncodeMsgBancorInit(w, v)
+	case *MsgBancorInit:
+		w.Write(getMagicBytes("MsgBancorInit"))
+		return EncodeMsgBancorInit(w, *v)
+	case MsgBancorTrade:
+		w.Write(getMagicBytes("MsgBancorTrade"))
+		return EncodeMsgBancorTrade(w, v)
+	case *MsgBancorTrade:
+		w.Write(getMagicBytes("MsgBancorTrade"))
+		return EncodeMsgBancorTrade(w, *v)
+	case MsgBeginRedelegate:
+		w.Write(getMagicBytes("MsgBeginRedelegate"))
+		return EncodeMsgBeginRedelegate(w, v)
+	case *MsgBeginRedelegate:
+		w.Write(getMagicBytes("MsgBeginRedelegate"))
+		return EncodeMsgBeginRedelegate(w, *v)
+	case MsgBurnToken:
+		w.Write(getMagicBytes("MsgBurnToken"))
+		return EncodeMsgBurnToken(w, v)
+	case *MsgBurnToken:
+		w.Write(getMagicBytes("MsgBurnToken"))
+		return EncodeMsgBurnToken(w, *v)
+	case MsgCancelOrder:
+		w.Write(getMagicBytes("MsgCancelOrder"))
+		return EncodeMsgCancelOrder(w, v)
+	case *MsgCancelOrder:
+		w.Write(getMagicBytes("MsgCancelOrder"))
+		return EncodeMsgCancelOrder(w, *v)
+	case MsgCancelTradingPair:
+		w.Write(getMagicBytes("MsgCancelTradingPair"))
+		return EncodeMsgCancelTradingPair(w, v)
+	case *MsgCancelTradingPair:
+		w.Write(getMagicBytes("MsgCancelTradingPair"))
+		return EncodeMsgCancelTradingPair(w, *v)
+	case MsgCommentToken:
+		w.Write(getMagicBytes("MsgCommentToken"))
+		return EncodeMsgCommentToken(w, v)
+	case *MsgCommentToken:
+		w.Write(getMagicBytes("MsgCommentToken"))
+		return EncodeMsgCommentToken(w, *v)
+	case MsgCreateOrder:
+		w.Write(getMagicBytes("MsgCreateOrder"))
+		return EncodeMsgCreateOrder(w, v)
+	case *MsgCreateOrder:
+		w.Write(getMagicBytes("MsgCreateOrder"))
+		return EncodeMsgCreateOrder(w, *v)
+	case MsgCreateTradingPair:
+		w.Write(getMagicBytes("MsgCreateTradingPair"))
+		return EncodeMsgCreateTradingPair(w, v)
+	case *MsgCreateTradingPair:
+		w.Write(getMagicBytes("MsgCreateTradingPair"))
+		return EncodeMsgCreateTradingPair(w, *v)
+	case MsgCreateValidator:
+		w.Write(getMagicBytes("MsgCreateValidator"))
+		return EncodeMsgCreateValidator(w, v)
+	case *MsgCreateValidator:
+		w.Write(getMagicBytes("MsgCreateValidator"))
+		return EncodeMsgCreateValidator(w, *v)
+	case MsgDelegate:
+		w.Write(getMagicBytes("MsgDelegate"))
+		return EncodeMsgDelegate(w, v)
+	case *MsgDelegate:
+		w.Write(getMagicBytes("MsgDelegate"))
+		return EncodeMsgDelegate(w, *v)
+	case MsgDeposit:
+		w.Write(getMagicBytes("MsgDeposit"))
+		return EncodeMsgDeposit(w, v)
+	case *MsgDeposit:
+		w.Write(getMagicBytes("MsgDeposit"))
+		return EncodeMsgDeposit(w, *v)
+	case MsgDonateToCommunityPool:
+		w.Write(getMagicBytes("MsgDonateToCommunityPool"))
+		return EncodeMsgDonateToCommunityPool(w, v)
+	case *MsgDonateToCommunityPool:
+		w.Write(getMagicBytes("MsgDonateToCommunityPool"))
+		return EncodeMsgDonateToCommunityPool(w, *v)
+	case MsgEditValidator:
+		w.Write(getMagicBytes("MsgEditValidator"))
+		return EncodeMsgEditValidator(w, v)
+	case *MsgEditValidator:
+		w.Write(getMagicBytes("MsgEditValidator"))
+		return EncodeMsgEditValidator(w, *v)
+	case MsgForbidAddr:
+		w.Write(getMagicBytes("MsgForbidAddr"))
+		return EncodeMsgForbidAddr(w, v)
+	case *MsgForbidAddr:
+		w.Write(getMagicBytes("MsgForbidAddr"))
+		return EncodeMsgForbidAddr(w, *v)
+	case MsgForbidToken:
+		w.Write(getMagicBytes("MsgForbidToken"))
+		return EncodeMsgForbidToken(w, v)
+	case *MsgForbidToken:
+		w.Write(getMagicBytes("MsgForbidToken"))
+		return EncodeMsgForbidToken(w, *v)
+	case MsgIssueToken:
+		w.Write(getMagicBytes("MsgIssueToken"))
+		return EncodeMsgIssueToken(w, v)
+	case *MsgIssueToken:
+		w.Write(getMagicBytes("MsgIssueToken"))
+		return EncodeMsgIssueToken(w, *v)
+	case MsgMintToken:
+		w.Write(getMagicBytes("MsgMintToken"))
+		return EncodeMsgMintToken(w, v)
+	case *MsgMintToken:
+		w.Write(getMagicBytes("MsgMintToken"))
+		return EncodeMsgMintToken(w, *v)
+	case MsgModifyPricePrecision:
+		w.Write(getMagicBytes("MsgModifyPricePrecision"))
+		return EncodeMsgModifyPricePrecision(w, v)
+	case *MsgModifyPricePrecision:
+		w.Write(getMagicBytes("MsgModifyPricePrecision"))
+		return EncodeMsgModifyPricePrecision(w, *v)
+	case MsgModifyTokenInfo:
+		w.Write(getMagicBytes("MsgModifyTokenInfo"))
+		return EncodeMsgModifyTokenInfo(w, v)
+	case *MsgModifyTokenInfo:
+		w.Write(getMagicBytes("MsgModifyTokenInfo"))
+		return EncodeMsgModifyTokenInfo(w, *v)
+	case MsgMultiSend:
+		w.Write(getMagicBytes("MsgMultiSend"))
+		return EncodeMsgMultiSend(w, v)
+	case *MsgMultiSend:
+		w.Write(getMagicBytes("MsgMultiSend"))
+		return EncodeMsgMultiSend(w, *v)
+	case MsgMultiSendX:
+		w.Write(getMagicBytes("MsgMultiSendX"))
+		return EncodeMsgMultiSendX(w, v)
+	case *MsgMultiSendX:
+		w.Write(getMagicBytes("MsgMultiSendX"))
+		return EncodeMsgMultiSendX(w, *v)
+	case MsgRemoveLiquidity:
+		w.Write(getMagicBytes("MsgRemoveLiquidity"))
+		return EncodeMsgRemoveLiquidity(w, v)
+	case *MsgRemoveLiquidity:
+		w.Write(getMagicBytes("MsgRemoveLiquidity"))
+		return EncodeMsgRemoveLiquidity(w, *v)
+	case MsgRemoveTokenWhitelist:
+		w.Write(getMagicBytes("MsgRemoveTokenWhitelist"))
+		return EncodeMsgRemoveTokenWhitelist(w, v)
+	case *MsgRemoveTokenWhitelist:
+		w.Write(getMagicBytes("MsgRemoveTokenWhitelist"))
+		return EncodeMsgRemoveTokenWhitelist(w, *v)
+	case MsgSend:
+		w.Write(getMagicBytes("MsgSend"))
+		return EncodeMsgSend(w, v)
+	case *MsgSend:
+		w.Write(getMagicBytes("MsgSend"))
+		return EncodeMsgSend(w, *v)
+	case MsgSendX:
+		w.Write(getMagicBytes("MsgSendX"))
+		return EncodeMsgSendX(w, v)
+	case *MsgSendX:
+		w.Write(getMagicBytes("MsgSendX"))
+		return EncodeMsgSendX(w, *v)
+	case MsgSetMemoRequired:
+		w.Write(getMagicBytes("MsgSetMemoRequired"))
+		return EncodeMsgSetMemoRequired(w, v)
+	case *MsgSetMemoRequired:
+		w.Write(getMagicBytes("MsgSetMemoRequired"))
+		return EncodeMsgSetMemoRequired(w, *v)
+	case MsgSetReferee:
+		w.Write(getMagicBytes("MsgSetReferee"))
+		return EncodeMsgSetReferee(w, v)
+	case *MsgSetReferee:
+		w.Write(getMagicBytes("MsgSetReferee"))
+		return EncodeMsgSetReferee(w, *v)
+	case MsgSetWithdrawAddress:
+		w.Write(getMagicBytes("MsgSetWithdrawAddress"))
+		return EncodeMsgSetWithdrawAddress(w, v)
+	case *MsgSetWithdrawAddress:
+		w.Write(getMagicBytes("MsgSetWithdrawAddress"))
+		return EncodeMsgSetWithdrawAddress(w, *v)
+	case MsgSubmitProposal:
+		w.Write(getMagicBytes("MsgSubmitProposal"))
+		return EncodeMsgSubmitProposal(w, v)
+	case *MsgSubmitProposal:
+		w.Write(getMagicBytes("MsgSubmitProposal"))
+		return EncodeMsgSubmitProposal(w, *v)
+	case MsgSupervisedSend:
+		w.Write(getMagicBytes("MsgSupervisedSend"))
+		return EncodeMsgSupervisedSend(w, v)
+	case *MsgSupervisedSend:
+		w.Write(getMagicBytes("MsgSupervisedSend"))
+		return EncodeMsgSupervisedSend(w, *v)
+	case MsgTransferOwnership:
+		w.Write(getMagicBytes("MsgTransferOwnership"))
+		return EncodeMsgTransferOwnership(w, v)
+	case *MsgTransferOwnership:
+		w.Write(getMagicBytes("MsgTransferOwnership"))
+		return EncodeMsgTransferOwnership(w, *v)
+	case MsgUnForbidAddr:
+		w.Write(getMagicBytes("MsgUnForbidAddr"))
+		return EncodeMsgUnForbidAddr(w, v)
+	case *MsgUnForbidAddr:
+		w.Write(getMagicBytes("MsgUnForbidAddr"))
+		return EncodeMsgUnForbidAddr(w, *v)
+	case MsgUnForbidToken:
+		w.Write(getMagicBytes("MsgUnForbidToken"))
+		return EncodeMsgUnForbidToken(w, v)
+	case *MsgUnForbidToken:
+		w.Write(getMagicBytes("MsgUnForbidToken"))
+		return EncodeMsgUnForbidToken(w, *v)
+	case MsgUndelegate:
+		w.Write(getMagicBytes("MsgUndelegate"))
+		return EncodeMsgUndelegate(w, v)
+	case *MsgUndelegate:
+		w.Write(getMagicBytes("MsgUndelegate"))
+		return EncodeMsgUndelegate(w, *v)
+	case MsgUnjail:
+		w.Write(getMagicBytes("MsgUnjail"))
+		return EncodeMsgUnjail(w, v)
+	case *MsgUnjail:
+		w.Write(getMagicBytes("MsgUnjail"))
+		return EncodeMsgUnjail(w, *v)
+	case MsgVerifyInvariant:
+		w.Write(getMagicBytes("MsgVerifyInvariant"))
+		return EncodeMsgVerifyInvariant(w, v)
+	case *MsgVerifyInvariant:
+		w.Write(getMagicBytes("MsgVerifyInvariant"))
+		return EncodeMsgVerifyInvariant(w, *v)
+	case MsgVote:
+		w.Write(getMagicBytes("MsgVote"))
+		return EncodeMsgVote(w, v)
+	case *MsgVote:
+		w.Write(getMagicBytes("MsgVote"))
+		return EncodeMsgVote(w, *v)
+	case MsgWithdrawDelegatorReward:
+		w.Write(getMagicBytes("MsgWithdrawDelegatorReward"))
+		return EncodeMsgWithdrawDelegatorReward(w, v)
+	case *MsgWithdrawDelegatorReward:
+		w.Write(getMagicBytes("MsgWithdrawDelegatorReward"))
+		return EncodeMsgWithdrawDelegatorReward(w, *v)
+	case MsgWithdrawValidatorCommission:
+		w.Write(getMagicBytes("MsgWithdrawValidatorCommission"))
+		return EncodeMsgWithdrawValidatorCommission(w, v)
+	case *MsgWithdrawValidatorCommission:
+		w.Write(getMagicBytes("MsgWithdrawValidatorCommission"))
+		return EncodeMsgWithdrawValidatorCommission(w, *v)
+	case Order:
+		w.Write(getMagicBytes("Order"))
+		return EncodeOrder(w, v)
+	case *Order:
+		w.Write(getMagicBytes("Order"))
+		return EncodeOrder(w, *v)
+	case Output:
+		w.Write(getMagicBytes("Output"))
+		return EncodeOutput(w, v)
+	case *Output:
+		w.Write(getMagicBytes("Output"))
+		return EncodeOutput(w, *v)
+	case ParamChange:
+		w.Write(getMagicBytes("ParamChange"))
+		return EncodeParamChange(w, v)
+	case *ParamChange:
+		w.Write(getMagicBytes("ParamChange"))
+		return EncodeParamChange(w, *v)
+	case ParameterChangeProposal:
+		w.Write(getMagicBytes("ParameterChangeProposal"))
+		return EncodeParameterChangeProposal(w, v)
+	case *ParameterChangeProposal:
+		w.Write(getMagicBytes("ParameterChangeProposal"))
+		return EncodeParameterChangeProposal(w, *v)
+	case Period:
+		w.Write(getMagicBytes("Period"))
+		return EncodePeriod(w, v)
+	case *Period:
+		w.Write(getMagicBytes("Period"))
+		return EncodePeriod(w, *v)
+	case PeriodicVestingAccount:
+		w.Write(getMagicBytes("PeriodicVestingAccount"))
+		return EncodePeriodicVestingAccount(w, v)
+	case *PeriodicVestingAccount:
+		w.Write(getMagicBytes("PeriodicVestingAccount"))
+		return EncodePeriodicVestingAccount(w, *v)
+	case PrivKeyEd25519:
+		w.Write(getMagicBytes("PrivKeyEd25519"))
+		return EncodePrivKeyEd25519(w, v)
+	case *PrivKeyEd25519:
+		w.Write(getMagicBytes("PrivKeyEd25519"))
+		return EncodePrivKeyEd25519(w, *v)
+	case PrivKeySecp256k1:
+		w.Write(getMagicBytes("PrivKeySecp256k1"))
+		return EncodePrivKeySecp256k1(w, v)
+	case *PrivKeySecp256k1:
+		w.Write(getMagicBytes("PrivKeySecp256k1"))
+		return EncodePrivKeySecp256k1(w, *v)
+	case PubKeyEd25519:
+		w.Write(getMagicBytes("PubKeyEd25519"))
+		return EncodePubKeyEd25519(w, v)
+	case *PubKeyEd25519:
+		w.Write(getMagicBytes("PubKeyEd25519"))
+		return EncodePubKeyEd25519(w, *v)
+	case PubKeyMultisigThreshold:
+		w.Write(getMagicBytes("PubKeyMultisigThreshold"))
+		return EncodePubKeyMultisigThreshold(w, v)
+	case *PubKeyMultisigThreshold:
+		w.Write(getMagicBytes("PubKeyMultisigThreshold"))
+		return EncodePubKeyMultisigThreshold(w, *v)
+	case PubKeySecp256k1:
+		w.Write(getMagicBytes("PubKeySecp256k1"))
+		return EncodePubKeySecp256k1(w, v)
+	case *PubKeySecp256k1:
+		w.Write(getMagicBytes("PubKeySecp256k1"))
+		return EncodePubKeySecp256k1(w, *v)
+	case SignedMsgType:
+		w.Write(getMagicBytes("SignedMsgType"))
+		return EncodeSignedMsgType(w, v)
+	case *SignedMsgType:
+		w.Write(getMagicBytes("SignedMsgType"))
+		return EncodeSignedMsgType(w, *v)
+	case SoftwareUpgradeProposal:
+		w.Write(getMagicBytes("SoftwareUpgradeProposal"))
+		return EncodeSoftwareUpgradeProposal(w, v)
+	case *SoftwareUpgradeProposal:
+		w.Write(getMagicBytes("SoftwareUpgradeProposal"))
+		return EncodeSoftwareUpgradeProposal(w, *v)
+	case State:
+		w.Write(getMagicBytes("State"))
+		return EncodeState(w, v)
+	case *State:
+		w.Write(getMagicBytes("State"))
+		return EncodeState(w, *v)
+	case StdSignature:
+		w.Write(getMagicBytes("StdSignature"))
+		return EncodeStdSignature(w, v)
+	case *StdSignature:
+		w.Write(getMagicBytes("StdSignature"))
+		return EncodeStdSignature(w, *v)
+	case StdTx:
+		w.Write(getMagicBytes("StdTx"))
+		return EncodeStdTx(w, v)
+	case *StdTx:
+		w.Write(getMagicBytes("StdTx"))
+		return EncodeStdTx(w, *v)
+	case Supply:
+		w.Write(getMagicBytes("Supply"))
+		return EncodeSupply(w, v)
+	case *Supply:
+		w.Write(getMagicBytes("Supply"))
+		return EncodeSupply(w, *v)
+	case TextProposal:
+		w.Write(getMagicBytes("TextProposal"))
+		return EncodeTextProposal(w, v)
+	case *TextProposal:
+		w.Write(getMagicBytes("TextProposal"))
+		return EncodeTextProposal(w, *v)
+	case Vote:
+		w.Write(getMagicBytes("Vote"))
+		return EncodeVote(w, v)
+	case *Vote:
+		w.Write(getMagicBytes("Vote"))
+		return EncodeVote(w, *v)
+	case VoteOption:
+		w.Write(getMagicBytes("VoteOption"))
+		return EncodeVoteOption(w, v)
+	case *VoteOption:
+		w.Write(getMagicBytes("VoteOption"))
+		return EncodeVoteOption(w, *v)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func BareEncodeAny(w io.Writer, x interface{}) error {
+	switch v := x.(type) {
+	case AccAddress:
+		return EncodeAccAddress(w, v)
+	case *AccAddress:
+		return EncodeAccAddress(w, *v)
+	case AccountX:
+		return EncodeAccountX(w, v)
+	case *AccountX:
+		return EncodeAccountX(w, *v)
+	case BaseAccount:
+		return EncodeBaseAccount(w, v)
+	case *BaseAccount:
+		return EncodeBaseAccount(w, *v)
+	case BaseToken:
+		return EncodeBaseToken(w, v)
+	case *BaseToken:
+		return EncodeBaseToken(w, *v)
+	case BaseVestingAccount:
+		return EncodeBaseVestingAccount(w, v)
+	case *BaseVestingAccount:
+		return EncodeBaseVestingAccount(w, *v)
+	case Coin:
+		return EncodeCoin(w, v)
+	case *Coin:
+		return EncodeCoin(w, *v)
+	case CommentRef:
+		return EncodeCommentRef(w, v)
+	case *CommentRef:
+		return EncodeCommentRef(w, *v)
+	case CommunityPoolSpendProposal:
+		return EncodeCommunityPoolSpendProposal(w, v)
+	case *CommunityPoolSpendProposal:
+		return EncodeCommunityPoolSpendProposal(w, *v)
+	case ContinuousVestingAccount:
+		return EncodeContinuousVestingAccount(w, v)
+	case *ContinuousVestingAccount:
+		return EncodeContinuousVestingAccount(w, *v)
+	case DelayedVestingAccount:
+		return EncodeDelayedVestingAccount(w, v)
+	case *DelayedVestingAccount:
+		return EncodeDelayedVestingAccount(w, *v)
+	case DuplicateVoteEvidence:
+		return EncodeDuplicateVoteEvidence(w, v)
+	case *DuplicateVoteEvidence:
+		return EncodeDuplicateVoteEvidence(w, *v)
+	case Input:
+		return EncodeInput(w, v)
+	case *Input:
+		return EncodeInput(w, *v)
+	case LockedCoin:
+		return EncodeLockedCoin(w, v)
+	case *LockedCoin:
+		return EncodeLockedCoin(w, *v)
+	case MarketInfo:
+		return EncodeMarketInfo(w, v)
+	case *MarketInfo:
+		return EncodeMarketInfo(w, *v)
+	case ModuleAccount:
+		return EncodeModuleAccount(w, v)
+	case *ModuleAccount:
+		return EncodeModuleAccount(w, *v)
+	case MsgAddLiquidity:
+		return EncodeMsgAddLiquidity(w, v)
+	case *MsgAddLiquidity:
+		return EncodeMsgAddLiquidity(w, *v)
+	case MsgAddTokenWhitelist:
+		return EncodeMsgAddTokenWhitelist(w, v)
+	case *MsgAddTokenWhitelist:
+		return EncodeMsgAddTokenWhitelist(w, *v)
+	case MsgAliasUpdate:
+		return EncodeMsgAliasUpdate(w, v)
+	case *MsgAliasUpdate:
+		return EncodeMsgAliasUpdate(w, *v)
+	case MsgAutoSwapCancelOrder:
+		return EncodeMsgAutoSwapCancelOrder(w, v)
+	case *MsgAutoSwapCancelOrder:
+		return EncodeMsgAutoSwapCancelOrder(w, *v)
+	case MsgAutoSwapCreateOrder:
+		return EncodeMsgAutoSwapCreateOrder(w, v)
+	case *MsgAutoSwapCreateOrder:
+		return EncodeMsgAutoSwapCreateOrder(w, *v)
+	case MsgBancorCancel:
+		return EncodeMsgBancorCancel(w, v)
+	case *MsgBancorCancel:
+		return EncodeMsgBancorCancel(w, *v)
+	case MsgBancorInit:
+		return EncodeMsgBancorInit(w, v)
+	case *MsgBancorInit:
+		return EncodeMsgBancorInit(w, *v)
+	case MsgBancorTrade:
+		return EncodeMsgBancorTrade(w, v)
+	case *MsgBancorTrade:
+		return EncodeMsgBancorTrade(w, *v)
+	case MsgBeginRedelegate:
+		return EncodeMsgBeginRedelegate(w, v)
+	case *MsgBeginRedelegate:
+		return EncodeMsgBeginRedelegate(w, *v)
+	case MsgBurnToken:
+		return EncodeMsgBurnToken(w, v)
+	case *MsgBurnToken:
+		return EncodeMsgBurnToken(w, *v)
+	case MsgCancelOrder:
+		return EncodeMsgCancelOrder(w, v)
+	case *MsgCancelOrder:
+		return EncodeMsgCancelOrder(w, *v)
+	case MsgCancelTradingPair:
+		return EncodeMsgCancelTradingPair(w, v)
+	case *MsgCancelTradingPair:
+		return EncodeMsgCancelTradingPair(w, *v)
+	case MsgCommentToken:
+		return EncodeMsgCommentToken(w, v)
+	case *MsgCommentToken:
+		return EncodeMsgCommentToken(w, *v)
+	case MsgCreateOrder:
+		return EncodeMsgCreateOrder(w, v)
+	case *MsgCreateOrder:
+		return EncodeMsgCreateOrder(w, *v)
+	case MsgCreateTradingPair:
+		return EncodeMsgCreateTradingPair(w, v)
+	case *MsgCreateTradingPair:
+		return EncodeMsgCreateTradingPair(w, *v)
+	case MsgCreateValidator:
+		return EncodeMsgCreateValidator(w, v)
+	case *MsgCreateValidator:
+		return EncodeMsgCreateValidator(w, *v)
+	case MsgDelegate:
+		return EncodeMsgDelegate(w, v)
+	case *MsgDelegate:
+		return EncodeMsgDelegate(w, *v)
+	case MsgDeposit:
+		return EncodeMsgDeposit(w, v)
+	case *MsgDeposit:
+		return EncodeMsgDeposit(w, *v)
+	case MsgDonateToCommunityPool:
+		return EncodeMsgDonateToCommunityPool(w, v)
+	case *MsgDonateToCommunityPool:
+		return EncodeMsgDonateToCommunityPool(w, *v)
+	case MsgEditValidator:
+		return EncodeMsgEditValidator(w, v)
+	case *MsgEditValidator:
+		return EncodeMsgEditValidator(w, *v)
+	case MsgForbidAddr:
+		return EncodeMsgForbidAddr(w, v)
+	case *MsgForbidAddr:
+		return EncodeMsgForbidAddr(w, *v)
+	case MsgForbidToken:
+		return EncodeMsgForbidToken(w, v)
+	case *MsgForbidToken:
+		return EncodeMsgForbidToken(w, *v)
+	case MsgIssueToken:
+		return EncodeMsgIssueToken(w, v)
+	case *MsgIssueToken:
+		return EncodeMsgIssueToken(w, *v)
+	case MsgMintToken:
+		return EncodeMsgMintToken(w, v)
+	case *MsgMintToken:
+		return EncodeMsgMintToken(w, *v)
+	case MsgModifyPricePrecision:
+		return EncodeMsgModifyPricePrecision(w, v)
+	case *MsgModifyPricePrecision:
+		return EncodeMsgModifyPricePrecision(w, *v)
+	case MsgModifyTokenInfo:
+		return EncodeMsgModifyTokenInfo(w, v)
+	case *MsgModifyTokenInfo:
+		return EncodeMsgModifyTokenInfo(w, *v)
+	case MsgMultiSend:
+		return EncodeMsgMultiSend(w, v)
+	case *MsgMultiSend:
+		return EncodeMsgMultiSend(w, *v)
+	case MsgMultiSendX:
+		return EncodeMsgMultiSendX(w, v)
+	case *MsgMultiSendX:
+		return EncodeMsgMultiSendX(w, *v)
+	case MsgRemoveLiquidity:
+		return EncodeMsgRemoveLiquidity(w, v)
+	case *MsgRemoveLiquidity:
+		return EncodeMsgRemoveLiquidity(w, *v)
+	case MsgRemoveTokenWhitelist:
+		return EncodeMsgRemoveTokenWhitelist(w, v)
+	case *MsgRemoveTokenWhitelist:
+		return EncodeMsgRemoveTokenWhitelist(w, *v)
+	case MsgSend:
+		return EncodeMsgSend(w, v)
+	case *MsgSend:
+		return EncodeMsgSend(w, *v)
+	case MsgSendX:
+		return EncodeMsgSendX(w, v)
+	case *MsgSendX:
+		return EncodeMsgSendX(w, *v)
+	case MsgSetMemoRequired:
+		return EncodeMsgSetMemoRequired(w, v)
+	case *MsgSetMemoRequired:
+		return EncodeMsgSetMemoRequired(w, *v)
+	case MsgSetReferee:
+		return EncodeMsgSetReferee(w, v)
+	case *MsgSetReferee:
+		return EncodeMsgSetReferee(w, *v)
+	case MsgSetWithdrawAddress:
+		return EncodeMsgSetWithdrawAddress(w, v)
+	case *MsgSetWithdrawAddress:
+		return EncodeMsgSetWithdrawAddress(w, *v)
+	case MsgSubmitProposal:
+		return EncodeMsgSubmitProposal(w, v)
+	case *MsgSubmitProposal:
+		return EncodeMsgSubmitProposal(w, *v)
+	case MsgSupervisedSend:
+		return EncodeMsgSupervisedSend(w, v)
+	case *MsgSupervisedSend:
+		return EncodeMsgSupervisedSend(w, *v)
+	case MsgTransferOwnership:
+		return EncodeMsgTransferOwnership(w, v)
+	case *MsgTransferOwnership:
+		return EncodeMsgTransferOwnership(w, *v)
+	case MsgUnForbidAddr:
+		return EncodeMsgUnForbidAddr(w, v)
+	case *MsgUnForbidAddr:
+		return EncodeMsgUnForbidAddr(w, *v)
+	case MsgUnForbidToken:
+		return EncodeMsgUnForbidToken(w, v)
+	case *MsgUnForbidToken:
+		return EncodeMsgUnForbidToken(w, *v)
+	case MsgUndelegate:
+		return EncodeMsgUndelegate(w, v)
+	case *MsgUndelegate:
+		return EncodeMsgUndelegate(w, *v)
+	case MsgUnjail:
+		return EncodeMsgUnjail(w, v)
+	case *MsgUnjail:
+		return EncodeMsgUnjail(w, *v)
+	case MsgVerifyInvariant:
+		return EncodeMsgVerifyInvariant(w, v)
+	case *MsgVerifyInvariant:
+		return EncodeMsgVerifyInvariant(w, *v)
+	case MsgVote:
+		return EncodeMsgVote(w, v)
+	case *MsgVote:
+		return EncodeMsgVote(w, *v)
+	case MsgWithdrawDelegatorReward:
+		return EncodeMsgWithdrawDelegatorReward(w, v)
+	case *MsgWithdrawDelegatorReward:
+		return EncodeMsgWithdrawDelegatorReward(w, *v)
+	case MsgWithdrawValidatorCommission:
+		return EncodeMsgWithdrawValidatorCommission(w, v)
+	case *MsgWithdrawValidatorCommission:
+		return EncodeMsgWithdrawValidatorCommission(w, *v)
+	case Order:
+		return EncodeOrder(w, v)
+	case *Order:
+		return EncodeOrder(w, *v)
+	case Output:
+		return EncodeOutput(w, v)
+	case *Output:
+		return EncodeOutput(w, *v)
+	case ParamChange:
+		return EncodeParamChange(w, v)
+	case *ParamChange:
+		return EncodeParamChange(w, *v)
+	case ParameterChangeProposal:
+		return EncodeParameterChangeProposal(w, v)
+	case *ParameterChangeProposal:
+		return EncodeParameterChangeProposal(w, *v)
+	case Period:
+		return EncodePeriod(w, v)
+	case *Period:
+		return EncodePeriod(w, *v)
+	case PeriodicVestingAccount:
+		return EncodePeriodicVestingAccount(w, v)
+	case *PeriodicVestingAccount:
+		return EncodePeriodicVestingAccount(w, *v)
+	case PrivKeyEd25519:
+		return EncodePrivKeyEd25519(w, v)
+	case *PrivKeyEd25519:
+		return EncodePrivKeyEd25519(w, *v)
+	case PrivKeySecp256k1:
+		return EncodePrivKeySecp256k1(w, v)
+	case *PrivKeySecp256k1:
+		return EncodePrivKeySecp256k1(w, *v)
+	case PubKeyEd25519:
+		return EncodePubKeyEd25519(w, v)
+	case *PubKeyEd25519:
+		return EncodePubKeyEd25519(w, *v)
+	case PubKeyMultisigThreshold:
+		return EncodePubKeyMultisigThreshold(w, v)
+	case *PubKeyMultisigThreshold:
+		return EncodePubKeyMultisigThreshold(w, *v)
+	case PubKeySecp256k1:
+		return EncodePubKeySecp256k1(w, v)
+	case *PubKeySecp256k1:
+		return EncodePubKeySecp256k1(w, *v)
+	case SignedMsgType:
+		return EncodeSignedMsgType(w, v)
+	case *SignedMsgType:
+		return EncodeSignedMsgType(w, *v)
+	case SoftwareUpgradeProposal:
+		return EncodeSoftwareUpgradeProposal(w, v)
+	case *SoftwareUpgradeProposal:
+		return EncodeSoftwareUpgradeProposal(w, *v)
+	case State:
+		return EncodeState(w, v)
+	case *State:
+		return EncodeState(w, *v)
+	case StdSignature:
+		return EncodeStdSignature(w, v)
+	case *StdSignature:
+		return EncodeStdSignature(w, *v)
+	case StdTx:
+		return EncodeStdTx(w, v)
+	case *StdTx:
+		return EncodeStdTx(w, *v)
+	case Supply:
+		return EncodeSupply(w, v)
+	case *Supply:
+		return EncodeSupply(w, *v)
+	case TextProposal:
+		return EncodeTextProposal(w, v)
+	case *TextProposal:
+		return EncodeTextProposal(w, *v)
+	case Vote:
+		return EncodeVote(w, v)
+	case *Vote:
+		return EncodeVote(w, *v)
+	case VoteOption:
+		return EncodeVoteOption(w, v)
+	case *VoteOption:
+		return EncodeVoteOption(w, *v)
+	default:
+		panic("Unknown Type.")
+	} // end of switch
+} // end of func
+func DecodeAny(bz []byte) (interface{}, int, error) {
+	return decodeAny(bz, -1)
+}
+
+func decodeAny(bz []byte, limit int) (interface{}, int, error) {
+	var v interface{}
+	var magicBytes [4]byte
+	var n int
+	if len(bz) < 4 {
+		return v, 0, ErrNotEnoughBytes
+	}
+	for i := 0; i < 4; i++ {
+		magicBytes[i] = bz[i]
+	}
+	switch magicBytes {
+	case [4]byte{37, 50, 37, 208}:
+		v, n, err := decodeAccAddress(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{148, 255, 29, 190}:
+		v, n, err := decodeAccountX(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{100, 94, 81, 72}:
+		v, n, err := decodeBaseAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{34, 178, 244, 51}:
+		v, n, err := decodeBaseToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{178, 47, 121, 129}:
+		v, n, err := decodeBaseVestingAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{141, 140, 97, 80}:
+		v, n, err := decodeCoin(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{17, 162, 164, 235}:
+		v, n, err := decodeCommentRef(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{37, 214, 119, 170}:
+		v, n, err := decodeCommunityPoolSpendProposal(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{95, 96, 75, 0}:
+		v, n, err := decodeContinuousVestingAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{187, 71, 224, 1}:
+		v, n, err := decodeDelayedVestingAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{130, 76, 198, 17}:
+		v, n, err := decodeDuplicateVoteEvidence(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{165, 152, 189, 47}:
+		v, n, err := decodeInput(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{227, 236, 168, 93}:
+		v, n, err := decodeLockedCoin(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{174, 117, 167, 230}:
+		v, n, err := decodeMarketInfo(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{190, 107, 1, 124}:
+		v, n, err := decodeModuleAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{53, 70, 1, 235}:
+		v, n, err := decodeMsgAddLiquidity(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{147, 136, 220, 215}:
+		v, n, err := decodeMsgAddTokenWhitelist(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{173, 181, 17, 162}:
+		v, n, err := decodeMsgAliasUpdate(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{72, 43, 95, 106}:
+		v, n, err := decodeMsgAutoSwapCancelOrder(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{13, 89, 105, 117}:
+		v, n, err := decodeMsgAutoSwapCreateOrder(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{187, 190, 104, 91}:
+		v, n, err := decodeMsgBancorCancel(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{171, 83, 147, 104}:
+		v, n, err := decodeMsgBancorInit(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{225, 122, 18, 80}:
+		v, n, err := decodeMsgBancorTrade(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{247, 3, 0, 105}:
+		v, n, err := decodeMsgBeginRedelegate(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{228, 0, 236, 212}:
+		v, n, err := decodeMsgBurnToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{106, 229, 80, 141}:
+		v, n, err := decodeMsgCancelOrder(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{13, 177, 95, 127}:
+		v, n, err := decodeMsgCancelTradingPair(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{79, 125, 235, 121}:
+		v, n, err := decodeMsgCommentToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{246, 238, 7, 164}:
+		v, n, err := decodeMsgCreateOrder(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{130, 221, 55, 57}:
+		v, n, err := decodeMsgCreateTradingPair(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{58, 78, 252, 114}:
+		v, n, err := decodeMsgCreateValidator(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{1, 82, 140, 71}:
+		v, n, err := decodeMsgDelegate(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{205, 134, 140, 190}:
+		v, n, err := decodeMsgDeposit(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{20, 250, 115, 197}:
+		v, n, err := decodeMsgDonateToCommunityPool(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{202, 62, 140, 8}:
+		v, n, err := decodeMsgEditValidator(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{105, 235, 112, 10}:
+		v, n, err := decodeMsgForbidAddr(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{36, 174, 203, 238}:
+		v, n, err := decodeMsgForbidToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{233, 180, 92, 129}:
+		v, n, err := decodeMsgIssueToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{66, 148, 56, 203}:
+		v, n, err := decodeMsgMintToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{76, 91, 156, 199}:
+		v, n, err := decodeMsgModifyPricePrecision(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{248, 60, 175, 175}:
+		v, n, err := decodeMsgModifyTokenInfo(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{207, 152, 156, 90}:
+		v, n, err := decodeMsgMultiSend(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{61, 117, 88, 200}:
+		v, n, err := decodeMsgMultiSendX(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{235, 230, 144, 194}:
+		v, n, err := decodeMsgRemoveLiquidity(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{44, 154, 68, 83}:
+		v, n, err := decodeMsgRemoveTokenWhitelist(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{100, 168, 39, 140}:
+		v, n, err := decodeMsgSend(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{198, 76, 8, 81}:
+		v, n, err := decodeMsgSendX(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{184, 238, 253, 154}:
+		v, n, err := decodeMsgSetMemoRequired(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{189, 36, 194, 183}:
+		v, n, err := decodeMsgSetReferee(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{190, 178, 173, 144}:
+		v, n, err := decodeMsgSetWithdrawAddress(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{115, 119, 137, 48}:
+		v, n, err := decodeMsgSubmitProposal(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{247, 207, 81, 239}:
+		v, n, err := decodeMsgSupervisedSend(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{200, 224, 118, 175}:
+		v, n, err := decodeMsgTransferOwnership(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{167, 165, 166, 227}:
+		v, n, err := decodeMsgUnForbidAddr(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{78, 83, 156, 139}:
+		v, n, err := decodeMsgUnForbidToken(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{122, 66, 160, 76}:
+		v, n, err := decodeMsgUndelegate(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{216, 247, 180, 46}:
+		v, n, err := decodeMsgUnjail(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{84, 44, 219, 65}:
+		v, n, err := decodeMsgVerifyInvariant(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{238, 246, 67, 141}:
+		v, n, err := decodeMsgVote(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{94, 251, 176, 152}:
+		v, n, err := decodeMsgWithdrawDelegatorReward(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{18, 172, 190, 152}:
+		v, n, err := decodeMsgWithdrawValidatorCommission(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{40, 166, 231, 227}:
+		v, n, err := decodeOrder(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{251, 0, 54, 127}:
+		v, n, err := decodeOutput(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{234, 101, 49, 27}:
+		v, n, err := decodeParamChange(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{166, 63, 172, 210}:
+		v, n, err := decodeParameterChangeProposal(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{22, 115, 239, 93}:
+		v, n, err := decodePeriod(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{18, 134, 62, 186}:
+		v, n, err := decodePeriodicVestingAccount(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{93, 160, 108, 51}:
+		v, n, err := decodePrivKeyEd25519(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{209, 107, 141, 98}:
+		v, n, err := decodePrivKeySecp256k1(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{108, 143, 2, 48}:
+		v, n, err := decodePubKeyEd25519(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{131, 227, 102, 173}:
+		v, n, err := decodePubKeyMultisigThreshold(bz[4:], limit)
+		return v, n + 4, err
 	case [4]byte{10, 126, 85, 105}:
-		v, n, err := DecodePubKeySecp256k1(bz[4:])
+		v, n, err := decodePubKeySecp256k1(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{169, 174, 252, 87}:
+		v, n, err := decodeSignedMsgType(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{37, 100, 208, 251}:
+		v, n, err := decodeSoftwareUpgradeProposal(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{186, 223, 120, 4}:
+		v, n, err := decodeState(bz[4:], limit)
 		return v, n + 4, err
 	case [4]byte{88, 244, 106, 18}:
-		v, n, err := DecodeStdSignature(bz[4:])
+		v, n, err := decodeStdSignature(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{71, 175, 179, 184}:
+		v, n, err := decodeStdTx(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{233, 209, 209, 86}:
+		v, n, err := decodeSupply(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{169, 32, 176, 245}:
+		v, n, err := decodeTextProposal(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{113, 227, 24, 224}:
+		v, n, err := decodeVote(bz[4:], limit)
+		return v, n + 4, err
+	case [4]byte{56, 159, 20, 227}:
+		v, n, err := decodeVoteOption(bz[4:], limit)
 		return v, n + 4, err
 	default:
-		panic("Unknown type")
-	} // end of switch
-	return v, n, nil
-} // end of DecodePubKey
-func RandPubKey(r RandSrc) PubKey {
-	switch r.GetUint() % 2 {
-	case 0:
-		return RandPubKeyEd25519(r)
-	case 1:
-		return RandPubKeySecp256k1(r)
-	default:
-		panic("Unknown Type.")
+		return v, n, errors.New("Unknown type")
 	} // end of switch
-} // end of func
-// Interface
-func EncodeMsg(w io.Writer, x interface{}) error {
+} // end of DecodeAny
+func BareDecodeAny(bz []byte, x interface{}) (n int, err error) {
+	return bareDecodeAny(bz, x, -1)
+}
+
+func bareDecodeAny(bz []byte, x interface{}, limit int) (n int, err error) {
 	switch v := x.(type) {
-	case MsgAddTokenWhitelist:
-		w.Write(getMagicBytes("MsgAddTokenWhitelist"))
-		return EncodeMsgAddTokenWhitelist(w, v)
+	case *AccAddress:
+		*v, n, err = decodeAccAddress(bz, limit)
+	case *AccountX:
+		*v, n, err = decodeAccountX(bz, limit)
+	case *BaseAccount:
+		*v, n, err = decodeBaseAccount(bz, limit)
+	case *BaseToken:
+		*v, n, err = decodeBaseToken(bz, limit)
+	case *BaseVestingAccount:
+		*v, n, err = decodeBaseVestingAccount(bz, limit)
+	case *Coin:
+		*v, n, err = decodeCoin(bz, limit)
+	case *CommentRef:
+		*v, n, err = decodeCommentRef(bz, limit)
+	case *CommunityPoolSpendProposal:
+		*v, n, err = decodeCommunityPoolSpendProposal(bz, limit)
+	case *ContinuousVestingAccount:
+		*v, n, err = decodeContinuousVestingAccount(bz, limit)
+	case *DelayedVestingAccount:
+		*v, n, err = decodeDelayedVestingAccount(bz, limit)
+	case *DuplicateVoteEvidence:
+		*v, n, err = decodeDuplicateVoteEvidence(bz, limit)
+	case *Input:
+		*v, n, err = decodeInput(bz, limit)
+	case *LockedCoin:
+		*v, n, err = decodeLockedCoin(bz, limit)
+	case *MarketInfo:
+		*v, n, err = decodeMarketInfo(bz, limit)
+	case *ModuleAccount:
+		*v, n, err = decodeModuleAccount(bz, limit)
+	case *MsgAddLiquidity:
+		*v, n, err = decodeMsgAddLiquidity(bz, limit)
 	case *MsgAddTokenWhitelist:
-		w.Write(getMagicBytes("MsgAddTokenWhitelist"))
-		return EncodeMsgAddTokenWhitelist(w, *v)
-	case MsgAliasUpdate:
-		w.Write(getMagicBytes("MsgAliasUpdate"))
-		return EncodeMsgAliasUpdate(w, v)
+		*v, n, err = decodeMsgAddTokenWhitelist(bz, limit)
 	case *MsgAliasUpdate:
-		w.Write(getMagicBytes("MsgAliasUpdate"))
-		return EncodeMsgAliasUpdate(w, *v)
-	case MsgBancorCancel:
-		w.Write(getMagicBytes("MsgBancorCancel"))
-		return EncodeMsgBancorCancel(w, v)
+		*v, n, err = decodeMsgAliasUpdate(bz, limit)
+	case *MsgAutoSwapCancelOrder:
+		*v, n, err = decodeMsgAutoSwapCancelOrder(bz, limit)
+	case *MsgAutoSwapCreateOrder:
+		*v, n, err = decodeMsgAutoSwapCreateOrder(bz, limit)
 	case *MsgBancorCancel:
-		w.Write(getMagicBytes("MsgBancorCancel"))
-		return EncodeMsgBancorCancel(w, *v)
-	case MsgBancorInit:
-		w.Write(getMagicBytes("MsgBancorInit"))
-		return EncodeMsgBancorInit(w, v)
+		*v, n, err = decodeMsgBancorCancel(bz, limit)
 	case *MsgBancorInit:
-		w.Write(getMagicBytes("MsgBancorInit"))
-		return EncodeMsgBancorInit(w, *v)
-	case MsgBancorTrade:
-		w.Write(getMagicBytes("MsgBancorTrade"))
-		return EncodeMsgBancorTrade(w, v)
+		*v, n, err = decodeMsgBancorInit(bz, limit)
 	case *MsgBancorTrade:
-		w.Write(getMagicBytes("MsgBancorTrade"))
-		return EncodeMsgBancorTrade(w, *v)
-	case MsgBeginRedelegate:
-		w.Write(getMagicBytes("MsgBeginRedelegate"))
-		return EncodeMsgBeginRedelegate(w, v)
+		*v, n, err = decodeMsgBancorTrade(bz, limit)
 	case *MsgBeginRedelegate:
-		w.Write(getMagicBytes("MsgBeginRedelegate"))
-		return EncodeMsgBeginRedelegate(w, *v)
-	case MsgBurnToken:
-		w.Write(getMagicBytes("MsgBurnToken"))
-		return EncodeMsgBurnToken(w, v)
+		*v, n, err = decodeMsgBeginRedelegate(bz, limit)
 	case *MsgBurnToken:
-		w.Write(getMagicBytes("MsgBurnToken"))
-		return EncodeMsgBurnToken(w, *v)
-	case MsgCancelOrder:
-		w.Write(getMagicBytes("MsgCancelOrder"))
-		return EncodeMsgCancelOrder(w, v)
+		*v, n, err = decodeMsgBurnToken(bz, limit)
 	case *MsgCancelOrder:
-		w.Write(getMagicBytes("MsgCancelOrder"))
-		return EncodeMsgCancelOrder(w, *v)
-	case MsgCancelTradingPair:
-		w.Write(getMagicBytes("MsgCancelTradingPair"))
-		return EncodeMsgCancelTradingPair(w, v)
+		*v, n, err = decodeMsgCancelOrder(bz, limit)
 	case *MsgCancelTradingPair:
-		w.Write(getMagicBytes("MsgCancelTradingPair"))
-		return EncodeMsgCancelTradingPair(w, *v)
-	case MsgCommentToken:
-		w.Write(getMagicBytes("MsgCommentToken"))
-		return EncodeMsgCommentToken(w, v)
+		*v, n, err = decodeMsgCancelTradingPair(bz, limit)
 	case *MsgCommentToken:
-		w.Write(getMagicBytes("MsgCommentToken"))
-		return EncodeMsgCommentToken(w, *v)
-	case MsgCreateOrder:
-		w.Write(getMagicBytes("MsgCreateOrder"))
-		return EncodeMsgCreateOrder(w, v)
+		*v, n, err = decodeMsgCommentToken(bz, limit)
 	case *MsgCreateOrder:
-		w.Write(getMagicBytes("MsgCreateOrder"))
-		return EncodeMsgCreateOrder(w, *v)
-	case MsgCreateTradingPair:
-		w.Write(getMagicBytes("MsgCreateTradingPair"))
-		return EncodeMsgCreateTradingPair(w, v)
+		*v, n, err = decodeMsgCreateOrder(bz, limit)
 	case *MsgCreateTradingPair:
-		w.Write(getMagicBytes("MsgCreateTradingPair"))
-		return EncodeMsgCreateTradingPair(w, *v)
-	case MsgCreateValidator:
-		w.Write(getMagicBytes("MsgCreateValidator"))
-		return EncodeMsgCreateValidator(w, v)
+		*v, n, err = decodeMsgCreateTradingPair(bz, limit)
 	case *MsgCreateValidator:
-		w.Write(getMagicBytes("MsgCreateValidator"))
-		return EncodeMsgCreateValidator(w, *v)
-	case MsgDelegate:
-		w.Write(getMagicBytes("MsgDelegate"))
-		return EncodeMsgDelegate(w, v)
+		*v, n, err = decodeMsgCreateValidator(bz, limit)
 	case *MsgDelegate:
-		w.Write(getMagicBytes("MsgDelegate"))
-		return EncodeMsgDelegate(w, *v)
-	case MsgDeposit:
-		w.Write(getMagicBytes("MsgDeposit"))
-		return EncodeMsgDeposit(w, v)
+		*v, n, err = decodeMsgDelegate(bz, limit)
 	case *MsgDeposit:
-		w.Write(getMagicBytes("MsgDeposit"))
-		return EncodeMsgDeposit(w, *v)
-	case MsgDonateToCommunityPool:
-		w.Write(getMagicBytes("MsgDonateToCommunityPool"))
-		return EncodeMsgDonateToCommunityPool(w, v)
+		*v, n, err = decodeMsgDeposit(bz, limit)
 	case *MsgDonateToCommunityPool:
-		w.Write(getMagicBytes("MsgDonateToCommunityPool"))
-		return EncodeMsgDonateToCommunityPool(w, *v)
-	case MsgEditValidator:
-		w.Write(getMagicBytes("MsgEditValidator"))
-		return EncodeMsgEditValidator(w, v)
+		*v, n, err = decodeMsgDonateToCommunityPool(bz, limit)
 	case *MsgEditValidator:
-		w.Write(getMagicBytes("MsgEditValidator"))
-		return EncodeMsgEditValidator(w, *v)
-	case MsgForbidAddr:
-		w.Write(getMagicBytes("MsgForbidAddr"))
-		return EncodeMsgForbidAddr(w, v)
+		*v, n, err = decodeMsgEditValidator(bz, limit)
 	case *MsgForbidAddr:
-		w.Write(getMagicBytes("MsgForbidAddr"))
-		return EncodeMsgForbidAddr(w, *v)
-	case MsgForbidToken:
-		w.Write(getMagicBytes("MsgForbidToken"))
-		return EncodeMsgForbidToken(w, v)
+		*v, n, err = decodeMsgForbidAddr(bz, limit)
 	case *MsgForbidToken:
-		w.Write(getMagicBytes("MsgForbidToken"))
-		return EncodeMsgForbidToken(w, *v)
-	case MsgIssueToken:
-		w.Write(getMagicBytes("MsgIssueToken"))
-		return EncodeMsgIssueToken(w, v)
+		*v, n, err = decodeMsgForbidToken(bz, limit)
 	case *MsgIssueToken:
-		w.Write(getMagicBytes("MsgIssueToken"))
-		return EncodeMsgIssueToken(w, *v)
-	case MsgMintToken:
-		w.Write(getMagicBytes("MsgMintToken"))
-		return EncodeMsgMintToken(w, v)
+		*v, n, err = decodeMsgIssueToken(bz, limit)
 	case *MsgMintToken:
-		w.Write(getMagicBytes("MsgMintToken"))
-		return EncodeMsgMintToken(w, *v)
-	case MsgModifyPricePrecision:
-		w.Write(getMagicBytes("MsgModifyPricePrecision"))
-		return EncodeMsgModifyPricePrecision(w, v)
+		*v, n, err = decodeMsgMintToken(bz, limit)
 	case *MsgModifyPricePrecision:
-		w.Write(getMagicBytes("MsgModifyPricePrecision"))
-		return EncodeMsgModifyPricePrecision(w, *v)
-	case MsgModifyTokenInfo:
-		w.Write(getMagicBytes("MsgModifyTokenInfo"))
-		return EncodeMsgModifyTokenInfo(w, v)
+		*v, n, err = decodeMsgModifyPricePrecision(bz, limit)
 	case *MsgModifyTokenInfo:
-		w.Write(getMagicBytes("MsgModifyTokenInfo"))
-		return EncodeMsgModifyTokenInfo(w, *v)
-	case MsgMultiSend:
-		w.Write(getMagicBytes("MsgMultiSend"))
-		return EncodeMsgMultiSend(w, v)
+		*v, n, err = decodeMsgModifyTokenInfo(bz, limit)
 	case *MsgMultiSend:
-		w.Write(getMagicBytes("MsgMultiSend"))
-		return EncodeMsgMultiSend(w, *v)
-	case MsgMultiSendX:
-		w.Write(getMagicBytes("MsgMultiSendX"))
-		return EncodeMsgMultiSendX(w, v)
+		*v, n, err = decodeMsgMultiSend(bz, limit)
 	case *MsgMultiSendX:
-		w.Write(getMagicBytes("MsgMultiSendX"))
-		return EncodeMsgMultiSendX(w, *v)
-	case MsgRemoveTokenWhitelist:
-		w.Write(getMagicBytes("MsgRemoveTokenWhitelist"))
-		return EncodeMsgRemoveTokenWhitelist(w, v)
+		*v, n, err = decodeMsgMultiSendX(bz, limit)
+	case *MsgRemoveLiquidity:
+		*v, n, err = decodeMsgRemoveLiquidity(bz, limit)
 	case *MsgRemoveTokenWhitelist:
-		w.Write(getMagicBytes("MsgRemoveTokenWhitelist"))
-		return EncodeMsgRemoveTokenWhitelist(w, *v)
-	case MsgSend:
-		w.Write(getMagicBytes("MsgSend"))
-		return EncodeMsgSend(w, v)
+		*v, n, err = decodeMsgRemoveTokenWhitelist(bz, limit)
 	case *MsgSend:
-		w.Write(getMagicBytes("MsgSend"))
-		return EncodeMsgSend(w, *v)
-	case MsgSendX:
-		w.Write(getMagicBytes("MsgSendX"))
-		return EncodeMsgSendX(w, v)
+		*v, n, err = decodeMsgSend(bz, limit)
 	case *MsgSendX:
-		w.Write(getMagicBytes("MsgSendX"))
-		return EncodeMsgSendX(w, *v)
-	case MsgSetMemoRequired:
-		w.Write(getMagicBytes("MsgSetMemoRequired"))
-		return EncodeMsgSetMemoRequired(w, v)
+		*v, n, err = decodeMsgSendX(bz, limit)
 	case *MsgSetMemoRequired:
-		w.Write(getMagicBytes("MsgSetMemoRequired"))
-		return EncodeMsgSetMemoRequired(w, *v)
-	case MsgSetWithdrawAddress:
-		w.Write(getMagicBytes("MsgSetWithdrawAddress"))
-		return EncodeMsgSetWithdrawAddress(w, v)
+		*v, n, err = decodeMsgSetMemoRequired(bz, limit)
+	case *MsgSetReferee:
+		*v, n, err = decodeMsgSetReferee(bz, limit)
 	case *MsgSetWithdrawAddress:
-		w.Write(getMagicBytes("MsgSetWithdrawAddress"))
-		return EncodeMsgSetWithdrawAddress(w, *v)
-	case MsgSubmitProposal:
-		w.Write(getMagicBytes("MsgSubmitProposal"))
-		return EncodeMsgSubmitProposal(w, v)
+		*v, n, err = decodeMsgSetWithdrawAddress(bz, limit)
 	case *MsgSubmitProposal:
-		w.Write(getMagicBytes("MsgSubmitProposal"))
-		return EncodeMsgSubmitProposal(w, *v)
-	case MsgTransferOwnership:
-		w.Write(getMagicBytes("MsgTransferOwnership"))
-		return EncodeMsgTransferOwnership(w, v)
+		*v, n, err = decodeMsgSubmitProposal(bz, limit)
+	case *MsgSupervisedSend:
+		*v, n, err = decodeMsgSupervisedSend(bz, limit)
 	case *MsgTransferOwnership:
-		w.Write(getMagicBytes("MsgTransferOwnership"))
-		return EncodeMsgTransferOwnership(w, *v)
-	case MsgUnForbidAddr:
-		w.Write(getMagicBytes("MsgUnForbidAddr"))
-		return EncodeMsgUnForbidAddr(w, v)
+		*v, n, err = decodeMsgTransferOwnership(bz, limit)
 	case *MsgUnForbidAddr:
-		w.Write(getMagicBytes("MsgUnForbidAddr"))
-		return EncodeMsgUnForbidAddr(w, *v)
-	case MsgUnForbidToken:
-		w.Write(getMagicBytes("MsgUnForbidToken"))
-		return EncodeMsgUnForbidToken(w, v)
+		*v, n, err = decodeMsgUnForbidAddr(bz, limit)
 	case *MsgUnForbidToken:
-		w.Write(getMagicBytes("MsgUnForbidToken"))
-		return EncodeMsgUnForbidToken(w, *v)
-	case MsgUndelegate:
-		w.Write(getMagicBytes("MsgUndelegate"))
-		return EncodeMsgUndelegate(w, v)
+		*v, n, err = decodeMsgUnForbidToken(bz, limit)
 	case *MsgUndelegate:
-		w.Write(getMagicBytes("MsgUndelegate"))
-		return EncodeMsgUndelegate(w, *v)
-	case MsgUnjail:
-		w.Write(getMagicBytes("MsgUnjail"))
-		return EncodeMsgUnjail(w, v)
+		*v, n, err = decodeMsgUndelegate(bz, limit)
 	case *MsgUnjail:
-		w.Write(getMagicBytes("MsgUnjail"))
-		return EncodeMsgUnjail(w, *v)
-	case MsgVerifyInvariant:
-		w.Write(getMagicBytes("MsgVerifyInvariant"))
-		return EncodeMsgVerifyInvariant(w, v)
+		*v, n, err = decodeMsgUnjail(bz, limit)
 	case *MsgVerifyInvariant:
-		w.Write(getMagicBytes("MsgVerifyInvariant"))
-		return EncodeMsgVerifyInvariant(w, *v)
-	case MsgVote:
-		w.Write(getMagicBytes("MsgVote"))
-		return EncodeMsgVote(w, v)
+		*v, n, err = decodeMsgVerifyInvariant(bz, limit)
 	case *MsgVote:
-		w.Write(getMagicBytes("MsgVote"))
-		return EncodeMsgVote(w, *v)
-	case MsgWithdrawDelegatorReward:
-		w.Write(getMagicBytes("MsgWithdrawDelegatorReward"))
-		return EncodeMsgWithdrawDelegatorReward(w, v)
+		*v, n, err = decodeMsgVote(bz, limit)
 	case *MsgWithdrawDelegatorReward:
-		w.Write(getMagicBytes("MsgWithdrawDelegatorReward"))
-		return EncodeMsgWithdrawDelegatorReward(w, *v)
-	case MsgWithdrawValidatorCommission:
-		w.Write(getMagicBytes("MsgWithdrawValidatorCommission"))
-		return EncodeMsgWithdrawValidatorCommission(w, v)
+		*v, n, err = decodeMsgWithdrawDelegatorReward(bz, limit)
 	case *MsgWithdrawValidatorCommission:
-		w.Write(getMagicBytes("MsgWithdrawValidatorCommission"))
-		return EncodeMsgWithdrawValidatorCommission(w, *v)
+		*v, n, err = decodeMsgWithdrawValidatorCommission(bz, limit)
+	case *Order:
+		*v, n, err = decodeOrder(bz, limit)
+	case *Output:
+		*v, n, err = decodeOutput(bz, limit)
+	case *ParamChange:
+		*v, n, err = decodeParamChange(bz, limit)
+	case *ParameterChangeProposal:
+		*v, n, err = decodeParameterChangeProposal(bz, limit)
+	case *Period:
+		*v, n, err = decodePeriod(bz, limit)
+	case *PeriodicVestingAccount:
+		*v, n, err = decodePeriodicVestingAccount(bz, limit)
+	case *PrivKeyEd25519:
+		*v, n, err = decodePrivKeyEd25519(bz, limit)
+	case *PrivKeySecp256k1:
+		*v, n, err = decodePrivKeySecp256k1(bz, limit)
+	case *PubKeyEd25519:
+		*v, n, err = decodePubKeyEd25519(bz, limit)
+	case *PubKeyMultisigThreshold:
+		*v, n, err = decodePubKeyMultisigThreshold(bz, limit)
+	case *PubKeySecp256k1:
+		*v, n, err = decodePubKeySecp256k1(bz, limit)
+	case *SignedMsgType:
+		*v, n, err = decodeSignedMsgType(bz, limit)
+	case *SoftwareUpgradeProposal:
+		*v, n, err = decodeSoftwareUpgradeProposal(bz, limit)
+	case *State:
+		*v, n, err = decodeState(bz, limit)
+	case *StdSignature:
+		*v, n, err = decodeStdSignature(bz, limit)
+	case *StdTx:
+		*v, n, err = decodeStdTx(bz, limit)
+	case *Supply:
+		*v, n, err = decodeSupply(bz, limit)
+	case *TextProposal:
+		*v, n, err = decodeTextProposal(bz, limit)
+	case *Vote:
+		*v, n, err = decodeVote(bz, limit)
+	case *VoteOption:
+		*v, n, err = decodeVoteOption(bz, limit)
+	default:
+		err = errors.New("Unknown type")
+	} // end of switch
+	return
+} // end of DecodeVar
+func RandAny(r RandSrc) interface{} {
+	switch r.GetUint() % 81 {
+	case 0:
+		return RandAccAddress(r)
+	case 1:
+		return RandAccountX(r)
+	case 2:
+		return RandBaseAccount(r)
+	case 3:
+		return RandBaseToken(r)
+	case 4:
+		return RandBaseVestingAccount(r)
+	case 5:
+		return RandCoin(r)
+	case 6:
+		return RandCommentRef(r)
+	case 7:
+		return RandCommunityPoolSpendProposal(r)
+	case 8:
+		return RandContinuousVestingAccount(r)
+	case 9:
+		return RandDelayedVestingAccount(r)
+	case 10:
+		return RandDuplicateVoteEvidence(r)
+	case 11:
+		return RandInput(r)
+	case 12:
+		return RandLockedCoin(r)
+	case 13:
+		return RandMarketInfo(r)
+	case 14:
+		return RandModuleAccount(r)
+	case 15:
+		return RandMsgAddLiquidity(r)
+	case 16:
+		return RandMsgAddTokenWhitelist(r)
+	case 17:
+		return RandMsgAliasUpdate(r)
+	case 18:
+		return RandMsgAutoSwapCancelOrder(r)
+	case 19:
+		return RandMsgAutoSwapCreateOrder(r)
+	case 20:
+		return RandMsgBancorCancel(r)
+	case 21:
+		return RandMsgBancorInit(r)
+	case 22:
+		return RandMsgBancorTrade(r)
+	case 23:
+		return RandMsgBeginRedelegate(r)
+	case 24:
+		return RandMsgBurnToken(r)
+	case 25:
+		return RandMsgCancelOrder(r)
+	case 26:
+		return RandMsgCancelTradingPair(r)
+	case 27:
+		return RandMsgCommentToken(r)
+	case 28:
+		return RandMsgCreateOrder(r)
+	case 29:
+		return RandMsgCreateTradingPair(r)
+	case 30:
+		return RandMsgCreateValidator(r)
+	case 31:
+		return RandMsgDelegate(r)
+	case 32:
+		return RandMsgDeposit(r)
+	case 33:
+		return RandMsgDonateToCommunityPool(r)
+	case 34:
+		return RandMsgEditValidator(r)
+	case 35:
+		return RandMsgForbidAddr(r)
+	case 36:
+		return RandMsgForbidToken(r)
+	case 37:
+		return RandMsgIssueToken(r)
+	case 38:
+		return RandMsgMintToken(r)
+	case 39:
+		return RandMsgModifyPricePrecision(r)
+	case 40:
+		return RandMsgModifyTokenInfo(r)
+	case 41:
+		return RandMsgMultiSend(r)
+	case 42:
+		return RandMsgMultiSendX(r)
+	case 43:
+		return RandMsgRemoveLiquidity(r)
+	case 44:
+		return RandMsgRemoveTokenWhitelist(r)
+	case 45:
+		return RandMsgSend(r)
+	case 46:
+		return RandMsgSendX(r)
+	case 47:
+		return RandMsgSetMemoRequired(r)
+	case 48:
+		return RandMsgSetReferee(r)
+	case 49:
+		return RandMsgSetWithdrawAddress(r)
+	case 50:
+		return RandMsgSubmitProposal(r)
+	case 51:
+		return RandMsgSupervisedSend(r)
+	case 52:
+		return RandMsgTransferOwnership(r)
+	case 53:
+		return RandMsgUnForbidAddr(r)
+	case 54:
+		return RandMsgUnForbidToken(r)
+	case 55:
+		return RandMsgUndelegate(r)
+	case 56:
+		return RandMsgUnjail(r)
+	case 57:
+		return RandMsgVerifyInvariant(r)
+	case 58:
+		return RandMsgVote(r)
+	case 59:
+		return RandMsgWithdrawDelegatorReward(r)
+	case 60:
+		return RandMsgWithdrawValidatorCommission(r)
+	case 61:
+		return RandOrder(r)
+	case 62:
+		return RandOutput(r)
+	case 63:
+		return RandParamChange(r)
+	case 64:
+		return RandParameterChangeProposal(r)
+	case 65:
+		return RandPeriod(r)
+	case 66:
+		return RandPeriodicVestingAccount(r)
+	case 67:
+		return RandPrivKeyEd25519(r)
+	case 68:
+		return RandPrivKeySecp256k1(r)
+	case 69:
+		return RandPubKeyEd25519(r)
+	case 70:
+		return RandPubKeyMultisigThreshold(r)
+	case 71:
+		return RandPubKeySecp256k1(r)
+	case 72:
+		return RandSignedMsgType(r)
+	case 73:
+		return RandSoftwareUpgradeProposal(r)
+	case 74:
+		return RandState(r)
+	case 75:
+		return RandStdSignature(r)
+	case 76:
+		return RandStdTx(r)
+	case 77:
+		return RandSupply(r)
+	case 78:
+		return RandTextProposal(r)
+	case 79:
+		return RandVote(r)
+	case 80:
+		return RandVoteOption(r)
 	default:
 		panic("Unknown Type.")
 	} // end of switch
 } // end of func
-func DecodeMsg(bz []byte) (Msg, int, error) {
-	var v Msg
-	var magicBytes [4]byte
-	var n int
-	for i := 0; i < 4; i++ {
-		magicBytes[i] = bz[i]
+func GetSupportList() []string {
+	return []string{
+		"github.com/coinexchain/cet-sdk/modules/alias/internal/types.MsgAliasUpdate",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.BaseToken",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgAddTokenWhitelist",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgBurnToken",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgForbidAddr",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgForbidToken",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgIssueToken",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgMintToken",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgModifyTokenInfo",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgRemoveTokenWhitelist",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgTransferOwnership",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgUnForbidAddr",
+		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgUnForbidToken",
+		"github.com/coinexchain/cet-sdk/modules/authx/internal/types.AccountX",
+		"github.com/coinexchain/cet-sdk/modules/authx/internal/types.LockedCoin",
+		"github.com/coinexchain/cet-sdk/modules/authx/internal/types.MsgSetReferee",
+		"github.com/coinexchain/cet-sdk/modules/autoswap/internal/types.MsgAddLiquidity",
+		"github.com/coinexchain/cet-sdk/modules/autoswap/internal/types.MsgAutoSwapCancelOrder",
+		"github.com/coinexchain/cet-sdk/modules/autoswap/internal/types.MsgAutoSwapCreateOrder",
+		"github.com/coinexchain/cet-sdk/modules/autoswap/internal/types.MsgRemoveLiquidity",
+		"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types.MsgBancorCancel",
+		"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types.MsgBancorInit",
+		"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types.MsgBancorTrade",
+		"github.com/coinexchain/cet-sdk/modules/bankx/internal/types.MsgMultiSend",
+		"github.com/coinexchain/cet-sdk/modules/bankx/internal/types.MsgSend",
+		"github.com/coinexchain/cet-sdk/modules/bankx/internal/types.MsgSetMemoRequired",
+		"github.com/coinexchain/cet-sdk/modules/bankx/internal/types.MsgSupervisedSend",
+		"github.com/coinexchain/cet-sdk/modules/comment/internal/types.CommentRef",
+		"github.com/coinexchain/cet-sdk/modules/comment/internal/types.MsgCommentToken",
+		"github.com/coinexchain/cet-sdk/modules/distributionx/types.MsgDonateToCommunityPool",
+		"github.com/coinexchain/cet-sdk/modules/incentive/internal/types.State",
+		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MarketInfo",
+		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgCancelOrder",
+		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgCancelTradingPair",
+		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgCreateOrder",
+		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgCreateTradingPair",
+		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgModifyPricePrecision",
+		"github.com/coinexchain/cet-sdk/modules/market/internal/types.Order",
+		"github.com/coinexchain/dex/app/vesting.Period",
+		"github.com/coinexchain/dex/app/vesting.PeriodicVestingAccount",
+		"github.com/cosmos/cosmos-sdk/types.AccAddress",
+		"github.com/cosmos/cosmos-sdk/types.Coin",
+		"github.com/cosmos/cosmos-sdk/types.Msg",
+		"github.com/cosmos/cosmos-sdk/x/auth/exported.Account",
+		"github.com/cosmos/cosmos-sdk/x/auth/types.BaseAccount",
+		"github.com/cosmos/cosmos-sdk/x/auth/types.BaseVestingAccount",
+		"github.com/cosmos/cosmos-sdk/x/auth/types.ContinuousVestingAccount",
+		"github.com/cosmos/cosmos-sdk/x/auth/types.DelayedVestingAccount",
+		"github.com/cosmos/cosmos-sdk/x/auth/types.StdSignature",
+		"github.com/cosmos/cosmos-sdk/x/auth/types.StdTx",
+		"github.com/cosmos/cosmos-sdk/x/bank/internal/types.Input",
+		"github.com/cosmos/cosmos-sdk/x/bank/internal/types.MsgMultiSend",
+		"github.com/cosmos/cosmos-sdk/x/bank/internal/types.MsgSend",
+		"github.com/cosmos/cosmos-sdk/x/bank/internal/types.Output",
+		"github.com/cosmos/cosmos-sdk/x/crisis/internal/types.MsgVerifyInvariant",
+		"github.com/cosmos/cosmos-sdk/x/distribution/types.CommunityPoolSpendProposal",
+		"github.com/cosmos/cosmos-sdk/x/distribution/types.MsgSetWithdrawAddress",
+		"github.com/cosmos/cosmos-sdk/x/distribution/types.MsgWithdrawDelegatorReward",
+		"github.com/cosmos/cosmos-sdk/x/distribution/types.MsgWithdrawValidatorCommission",
+		"github.com/cosmos/cosmos-sdk/x/gov/types.Content",
+		"github.com/cosmos/cosmos-sdk/x/gov/types.MsgDeposit",
+		"github.com/cosmos/cosmos-sdk/x/gov/types.MsgSubmitProposal",
+		"github.com/cosmos/cosmos-sdk/x/gov/types.MsgVote",
+		"github.com/cosmos/cosmos-sdk/x/gov/types.SoftwareUpgradeProposal",
+		"github.com/cosmos/cosmos-sdk/x/gov/types.TextProposal",
+		"github.com/cosmos/cosmos-sdk/x/gov/types.VoteOption",
+		"github.com/cosmos/cosmos-sdk/x/params/types.ParamChange",
+		"github.com/cosmos/cosmos-sdk/x/params/types.ParameterChangeProposal",
+		"github.com/cosmos/cosmos-sdk/x/slashing/types.MsgUnjail",
+		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgBeginRedelegate",
+		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgCreateValidator",
+		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgDelegate",
+		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgEditValidator",
+		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgUndelegate",
+		"github.com/cosmos/cosmos-sdk/x/supply/internal/types.ModuleAccount",
+		"github.com/cosmos/cosmos-sdk/x/supply/internal/types.Supply",
+		"github.com/tendermint/tendermint/crypto.PubKey",
+		"github.com/tendermint/tendermint/crypto/ed25519.PrivKeyEd25519",
+		"github.com/tendermint/tendermint/crypto/ed25519.PubKeyEd25519",
+		"github.com/tendermint/tendermint/crypto/multisig.PubKeyMultisigThreshold",
+		"github.com/tendermint/tendermint/crypto/secp256k1.PrivKeySecp256k1",
+		"github.com/tendermint/tendermint/crypto/secp256k1.PubKeySecp256k1",
+		"github.com/tendermint/tendermint/types.DuplicateVoteEvidence",
+		"github.com/tendermint/tendermint/types.SignedMsgType",
+		"github.com/tendermint/tendermint/types.Vote",
+	}
+} // end of GetSupportList
+func DeepCopyAccAddress(v AccAddress) AccAddress {
+	var out AccAddress
+	if v != nil {
+		out = make([]uint8, len(v))
+		copy(out, v)
+	}
+	return out
+} //End of DeepCopyAccAddress
+
+func EqualAccAddress(a, b AccAddress) bool {
+	if !bytes.Equal(a, b) {
+		return false
+	}
+	return true
+} //End of EqualAccAddress
+
+func DeepCopyAccountX(v AccountX) AccountX {
+	var out AccountX
+	out.Address = DeepCopyAccAddress(v.Address)
+	out.MemoRequired = v.MemoRequired
+	if v.LockedCoins != nil {
+		out.LockedCoins = make([]LockedCoin, len(v.LockedCoins))
+		for _0 := 0; _0 < len(v.LockedCoins); _0++ {
+			out.LockedCoins[_0] = DeepCopyLockedCoin(v.LockedCoins[_0])
+		}
+	}
+	if v.FrozenCoins != nil {
+		out.FrozenCoins = make([]Coin, len(v.FrozenCoins))
+		for _0 := 0; _0 < len(v.FrozenCoins); _0++ {
+			out.FrozenCoins[_0] = DeepCopyCoin(v.FrozenCoins[_0])
+		}
+	}
+	out.Referee = DeepCopyAccAddress(v.Referee)
+	out.RefereeChangeTime = v.RefereeChangeTime
+	return out
+} //End of DeepCopyAccountX
+
+func EqualAccountX(a, b AccountX) bool {
+	if !EqualAccAddress(a.Address, b.Address) {
+		return false
+	}
+	if a.MemoRequired != b.MemoRequired {
+		return false
+	}
+	if len(a.LockedCoins) != len(b.LockedCoins) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.LockedCoins); _0++ {
+		if !EqualLockedCoin(a.LockedCoins[_0], b.LockedCoins[_0]) {
+			return false
+		}
+	}
+	if len(a.FrozenCoins) != len(b.FrozenCoins) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.FrozenCoins); _0++ {
+		if !EqualCoin(a.FrozenCoins[_0], b.FrozenCoins[_0]) {
+			return false
+		}
+	}
+	if !EqualAccAddress(a.Referee, b.Referee) {
+		return false
+	}
+	if a.RefereeChangeTime != b.RefereeChangeTime {
+		return false
+	}
+	return true
+} //End of EqualAccountX
+
+func DeepCopyBaseAccount(v BaseAccount) BaseAccount {
+	var out BaseAccount
+	out.Address = DeepCopyAccAddress(v.Address)
+	if v.Coins != nil {
+		out.Coins = make([]Coin, len(v.Coins))
+		for _0 := 0; _0 < len(v.Coins); _0++ {
+			out.Coins[_0] = DeepCopyCoin(v.Coins[_0])
+		}
+	}
+	out.PubKey = DeepCopyPubKey(v.PubKey)
+	out.AccountNumber = v.AccountNumber
+	out.Sequence = v.Sequence
+	return out
+} //End of DeepCopyBaseAccount
+
+func EqualBaseAccount(a, b BaseAccount) bool {
+	if !EqualAccAddress(a.Address, b.Address) {
+		return false
+	}
+	if len(a.Coins) != len(b.Coins) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Coins); _0++ {
+		if !EqualCoin(a.Coins[_0], b.Coins[_0]) {
+			return false
+		}
+	}
+	if !EqualPubKey(a.PubKey, b.PubKey) {
+		return false
+	}
+	if a.AccountNumber != b.AccountNumber {
+		return false
+	}
+	if a.Sequence != b.Sequence {
+		return false
+	}
+	return true
+} //End of EqualBaseAccount
+
+func DeepCopyBaseToken(v BaseToken) BaseToken {
+	var out BaseToken
+	out.Name = v.Name
+	out.Symbol = v.Symbol
+	out.TotalSupply = DeepCopyInt(v.TotalSupply)
+	out.SendLock = DeepCopyInt(v.SendLock)
+	out.Owner = DeepCopyAccAddress(v.Owner)
+	out.Mintable = v.Mintable
+	out.Burnable = v.Burnable
+	out.AddrForbiddable = v.AddrForbiddable
+	out.TokenForbiddable = v.TokenForbiddable
+	out.TotalBurn = DeepCopyInt(v.TotalBurn)
+	out.TotalMint = DeepCopyInt(v.TotalMint)
+	out.IsForbidden = v.IsForbidden
+	out.URL = v.URL
+	out.Description = v.Description
+	out.Identity = v.Identity
+	return out
+} //End of DeepCopyBaseToken
+
+func EqualBaseToken(a, b BaseToken) bool {
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualInt(a.TotalSupply, b.TotalSupply) {
+		return false
+	}
+	if !EqualInt(a.SendLock, b.SendLock) {
+		return false
+	}
+	if !EqualAccAddress(a.Owner, b.Owner) {
+		return false
+	}
+	if a.Mintable != b.Mintable {
+		return false
+	}
+	if a.Burnable != b.Burnable {
+		return false
+	}
+	if a.AddrForbiddable != b.AddrForbiddable {
+		return false
+	}
+	if a.TokenForbiddable != b.TokenForbiddable {
+		return false
+	}
+	if !EqualInt(a.TotalBurn, b.TotalBurn) {
+		return false
+	}
+	if !EqualInt(a.TotalMint, b.TotalMint) {
+		return false
+	}
+	if a.IsForbidden != b.IsForbidden {
+		return false
+	}
+	if a.URL != b.URL {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	if a.Identity != b.Identity {
+		return false
+	}
+	return true
+} //End of EqualBaseToken
+
+func DeepCopyBaseVestingAccount(v BaseVestingAccount) BaseVestingAccount {
+	var out BaseVestingAccount
+	if v.BaseAccount != nil {
+		out.BaseAccount = new(BaseAccount)
+		(*out.BaseAccount) = DeepCopyBaseAccount((*v.BaseAccount))
+	}
+	if v.OriginalVesting != nil {
+		out.OriginalVesting = make([]Coin, len(v.OriginalVesting))
+		for _0 := 0; _0 < len(v.OriginalVesting); _0++ {
+			out.OriginalVesting[_0] = DeepCopyCoin(v.OriginalVesting[_0])
+		}
+	}
+	if v.DelegatedFree != nil {
+		out.DelegatedFree = make([]Coin, len(v.DelegatedFree))
+		for _0 := 0; _0 < len(v.DelegatedFree); _0++ {
+			out.DelegatedFree[_0] = DeepCopyCoin(v.DelegatedFree[_0])
+		}
+	}
+	if v.DelegatedVesting != nil {
+		out.DelegatedVesting = make([]Coin, len(v.DelegatedVesting))
+		for _0 := 0; _0 < len(v.DelegatedVesting); _0++ {
+			out.DelegatedVesting[_0] = DeepCopyCoin(v.DelegatedVesting[_0])
+		}
+	}
+	out.EndTime = v.EndTime
+	return out
+} //End of DeepCopyBaseVestingAccount
+
+func EqualBaseVestingAccount(a, b BaseVestingAccount) bool {
+	if (a.BaseAccount == nil) != (b.BaseAccount == nil) {
+		return false
+	}
+	if a.BaseAccount != nil {
+		if !EqualBaseAccount((*a.BaseAccount), (*b.BaseAccount)) {
+			return false
+		}
+	}
+	if len(a.OriginalVesting) != len(b.OriginalVesting) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.OriginalVesting); _0++ {
+		if !EqualCoin(a.OriginalVesting[_0], b.OriginalVesting[_0]) {
+			return false
+		}
+	}
+	if len(a.DelegatedFree) != len(b.DelegatedFree) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.DelegatedFree); _0++ {
+		if !EqualCoin(a.DelegatedFree[_0], b.DelegatedFree[_0]) {
+			return false
+		}
+	}
+	if len(a.DelegatedVesting) != len(b.DelegatedVesting) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.DelegatedVesting); _0++ {
+		if !EqualCoin(a.DelegatedVesting[_0], b.DelegatedVesting[_0]) {
+			return false
+		}
+	}
+	if a.EndTime != b.EndTime {
+		return false
+	}
+	return true
+} //End of EqualBaseVestingAccount
+
+func DeepCopyCoin(v Coin) Coin {
+	var out Coin
+	out.Denom = v.Denom
+	out.Amount = DeepCopyInt(v.Amount)
+	return out
+} //End of DeepCopyCoin
+
+func EqualCoin(a, b Coin) bool {
+	if a.Denom != b.Denom {
+		return false
+	}
+	if !EqualInt(a.Amount, b.Amount) {
+		return false
+	}
+	return true
+} //End of EqualCoin
+
+func DeepCopyCommentRef(v CommentRef) CommentRef {
+	var out CommentRef
+	out.ID = v.ID
+	out.RewardTarget = DeepCopyAccAddress(v.RewardTarget)
+	out.RewardToken = v.RewardToken
+	out.RewardAmount = v.RewardAmount
+	if v.Attitudes != nil {
+		out.Attitudes = make([]int32, len(v.Attitudes))
+		copy(out.Attitudes, v.Attitudes)
+	}
+	return out
+} //End of DeepCopyCommentRef
+
+func EqualCommentRef(a, b CommentRef) bool {
+	if a.ID != b.ID {
+		return false
+	}
+	if !EqualAccAddress(a.RewardTarget, b.RewardTarget) {
+		return false
+	}
+	if a.RewardToken != b.RewardToken {
+		return false
+	}
+	if a.RewardAmount != b.RewardAmount {
+		return false
+	}
+	if len(a.Attitudes) != len(b.Attitudes) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Attitudes); _0++ {
+		if a.Attitudes[_0] != b.Attitudes[_0] {
+			return false
+		}
+	}
+	return true
+} //End of EqualCommentRef
+
+func DeepCopyCommunityPoolSpendProposal(v CommunityPoolSpendProposal) CommunityPoolSpendProposal {
+	var out CommunityPoolSpendProposal
+	out.Title = v.Title
+	out.Description = v.Description
+	out.Recipient = DeepCopyAccAddress(v.Recipient)
+	if v.Amount != nil {
+		out.Amount = make([]Coin, len(v.Amount))
+		for _0 := 0; _0 < len(v.Amount); _0++ {
+			out.Amount[_0] = DeepCopyCoin(v.Amount[_0])
+		}
+	}
+	return out
+} //End of DeepCopyCommunityPoolSpendProposal
+
+func EqualCommunityPoolSpendProposal(a, b CommunityPoolSpendProposal) bool {
+	if a.Title != b.Title {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	if !EqualAccAddress(a.Recipient, b.Recipient) {
+		return false
+	}
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Amount); _0++ {
+		if !EqualCoin(a.Amount[_0], b.Amount[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualCommunityPoolSpendProposal
+
+func DeepCopyContinuousVestingAccount(v ContinuousVestingAccount) ContinuousVestingAccount {
+	var out ContinuousVestingAccount
+	if v.BaseVestingAccount != nil {
+		out.BaseVestingAccount = new(BaseVestingAccount)
+		(*out.BaseVestingAccount) = DeepCopyBaseVestingAccount((*v.BaseVestingAccount))
+	}
+	out.StartTime = v.StartTime
+	return out
+} //End of DeepCopyContinuousVestingAccount
+
+func EqualContinuousVestingAccount(a, b ContinuousVestingAccount) bool {
+	if (a.BaseVestingAccount == nil) != (b.BaseVestingAccount == nil) {
+		return false
+	}
+	if a.BaseVestingAccount != nil {
+		if !EqualBaseVestingAccount((*a.BaseVestingAccount), (*b.BaseVestingAccount)) {
+			return false
+		}
+	}
+	if a.StartTime != b.StartTime {
+		return false
+	}
+	return true
+} //End of EqualContinuousVestingAccount
+
+func DeepCopyDelayedVestingAccount(v DelayedVestingAccount) DelayedVestingAccount {
+	var out DelayedVestingAccount
+	if v.BaseVestingAccount != nil {
+		out.BaseVestingAccount = new(BaseVestingAccount)
+		(*out.BaseVestingAccount) = DeepCopyBaseVestingAccount((*v.BaseVestingAccount))
+	}
+	return out
+} //End of DeepCopyDelayedVestingAccount
+
+func EqualDelayedVestingAccount(a, b DelayedVestingAccount) bool {
+	if (a.BaseVestingAccount == nil) != (b.BaseVestingAccount == nil) {
+		return false
+	}
+	if a.BaseVestingAccount != nil {
+		if !EqualBaseVestingAccount((*a.BaseVestingAccount), (*b.BaseVestingAccount)) {
+			return false
+		}
+	}
+	return true
+} //End of EqualDelayedVestingAccount
+
+func DeepCopyDuplicateVoteEvidence(v DuplicateVoteEvidence) DuplicateVoteEvidence {
+	var out DuplicateVoteEvidence
+	out.PubKey = DeepCopyPubKey(v.PubKey)
+	if v.VoteA != nil {
+		out.VoteA = new(Vote)
+		(*out.VoteA) = DeepCopyVote((*v.VoteA))
+	}
+	if v.VoteB != nil {
+		out.VoteB = new(Vote)
+		(*out.VoteB) = DeepCopyVote((*v.VoteB))
+	}
+	return out
+} //End of DeepCopyDuplicateVoteEvidence
+
+func EqualDuplicateVoteEvidence(a, b DuplicateVoteEvidence) bool {
+	if !EqualPubKey(a.PubKey, b.PubKey) {
+		return false
+	}
+	if (a.VoteA == nil) != (b.VoteA == nil) {
+		return false
+	}
+	if a.VoteA != nil {
+		if !EqualVote((*a.VoteA), (*b.VoteA)) {
+			return false
+		}
+	}
+	if (a.VoteB == nil) != (b.VoteB == nil) {
+		return false
+	}
+	if a.VoteB != nil {
+		if !EqualVote((*a.VoteB), (*b.VoteB)) {
+			return false
+		}
+	}
+	return true
+} //End of EqualDuplicateVoteEvidence
+
+func DeepCopyInput(v Input) Input {
+	var out Input
+	out.Address = DeepCopyAccAddress(v.Address)
+	if v.Coins != nil {
+		out.Coins = make([]Coin, len(v.Coins))
+		for _0 := 0; _0 < len(v.Coins); _0++ {
+			out.Coins[_0] = DeepCopyCoin(v.Coins[_0])
+		}
+	}
+	return out
+} //End of DeepCopyInput
+
+func EqualInput(a, b Input) bool {
+	if !EqualAccAddress(a.Address, b.Address) {
+		return false
+	}
+	if len(a.Coins) != len(b.Coins) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Coins); _0++ {
+		if !EqualCoin(a.Coins[_0], b.Coins[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualInput
+
+func DeepCopyLockedCoin(v LockedCoin) LockedCoin {
+	var out LockedCoin
+	out.Coin = DeepCopyCoin(v.Coin)
+	out.UnlockTime = v.UnlockTime
+	out.FromAddress = DeepCopyAccAddress(v.FromAddress)
+	out.Supervisor = DeepCopyAccAddress(v.Supervisor)
+	out.Reward = v.Reward
+	return out
+} //End of DeepCopyLockedCoin
+
+func EqualLockedCoin(a, b LockedCoin) bool {
+	if !EqualCoin(a.Coin, b.Coin) {
+		return false
+	}
+	if a.UnlockTime != b.UnlockTime {
+		return false
+	}
+	if !EqualAccAddress(a.FromAddress, b.FromAddress) {
+		return false
+	}
+	if !EqualAccAddress(a.Supervisor, b.Supervisor) {
+		return false
+	}
+	if a.Reward != b.Reward {
+		return false
+	}
+	return true
+} //End of EqualLockedCoin
+
+func DeepCopyMarketInfo(v MarketInfo) MarketInfo {
+	var out MarketInfo
+	out.Stock = v.Stock
+	out.Money = v.Money
+	out.PricePrecision = v.PricePrecision
+	out.LastExecutedPrice = DeepCopyDec(v.LastExecutedPrice)
+	out.OrderPrecision = v.OrderPrecision
+	return out
+} //End of DeepCopyMarketInfo
+
+func EqualMarketInfo(a, b MarketInfo) bool {
+	if a.Stock != b.Stock {
+		return false
+	}
+	if a.Money != b.Money {
+		return false
+	}
+	if a.PricePrecision != b.PricePrecision {
+		return false
+	}
+	if !EqualDec(a.LastExecutedPrice, b.LastExecutedPrice) {
+		return false
+	}
+	if a.OrderPrecision != b.OrderPrecision {
+		return false
+	}
+	return true
+} //End of EqualMarketInfo
+
+func DeepCopyModuleAccount(v ModuleAccount) ModuleAccount {
+	var out ModuleAccount
+	if v.BaseAccount != nil {
+		out.BaseAccount = new(BaseAccount)
+		(*out.BaseAccount) = DeepCopyBaseAccount((*v.BaseAccount))
+	}
+	out.Name = v.Name
+	if v.Permissions != nil {
+		out.Permissions = make([]string, len(v.Permissions))
+		copy(out.Permissions, v.Permissions)
+	}
+	return out
+} //End of DeepCopyModuleAccount
+
+func EqualModuleAccount(a, b ModuleAccount) bool {
+	if (a.BaseAccount == nil) != (b.BaseAccount == nil) {
+		return false
+	}
+	if a.BaseAccount != nil {
+		if !EqualBaseAccount((*a.BaseAccount), (*b.BaseAccount)) {
+			return false
+		}
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if len(a.Permissions) != len(b.Permissions) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Permissions); _0++ {
+		if a.Permissions[_0] != b.Permissions[_0] {
+			return false
+		}
+	}
+	return true
+} //End of EqualModuleAccount
+
+func DeepCopyMsgAddLiquidity(v MsgAddLiquidity) MsgAddLiquidity {
+	var out MsgAddLiquidity
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.Stock = v.Stock
+	out.Money = v.Money
+	out.StockIn = DeepCopyInt(v.StockIn)
+	out.MoneyIn = DeepCopyInt(v.MoneyIn)
+	out.To = DeepCopyAccAddress(v.To)
+	return out
+} //End of DeepCopyMsgAddLiquidity
+
+func EqualMsgAddLiquidity(a, b MsgAddLiquidity) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.Stock != b.Stock {
+		return false
+	}
+	if a.Money != b.Money {
+		return false
+	}
+	if !EqualInt(a.StockIn, b.StockIn) {
+		return false
+	}
+	if !EqualInt(a.MoneyIn, b.MoneyIn) {
+		return false
+	}
+	if !EqualAccAddress(a.To, b.To) {
+		return false
+	}
+	return true
+} //End of EqualMsgAddLiquidity
+
+func DeepCopyMsgAddTokenWhitelist(v MsgAddTokenWhitelist) MsgAddTokenWhitelist {
+	var out MsgAddTokenWhitelist
+	out.Symbol = v.Symbol
+	out.OwnerAddress = DeepCopyAccAddress(v.OwnerAddress)
+	if v.Whitelist != nil {
+		out.Whitelist = make([]AccAddress, len(v.Whitelist))
+		for _0 := 0; _0 < len(v.Whitelist); _0++ {
+			out.Whitelist[_0] = DeepCopyAccAddress(v.Whitelist[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgAddTokenWhitelist
+
+func EqualMsgAddTokenWhitelist(a, b MsgAddTokenWhitelist) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualAccAddress(a.OwnerAddress, b.OwnerAddress) {
+		return false
+	}
+	if len(a.Whitelist) != len(b.Whitelist) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Whitelist); _0++ {
+		if !EqualAccAddress(a.Whitelist[_0], b.Whitelist[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgAddTokenWhitelist
+
+func DeepCopyMsgAliasUpdate(v MsgAliasUpdate) MsgAliasUpdate {
+	var out MsgAliasUpdate
+	out.Owner = DeepCopyAccAddress(v.Owner)
+	out.Alias = v.Alias
+	out.IsAdd = v.IsAdd
+	out.AsDefault = v.AsDefault
+	return out
+} //End of DeepCopyMsgAliasUpdate
+
+func EqualMsgAliasUpdate(a, b MsgAliasUpdate) bool {
+	if !EqualAccAddress(a.Owner, b.Owner) {
+		return false
+	}
+	if a.Alias != b.Alias {
+		return false
+	}
+	if a.IsAdd != b.IsAdd {
+		return false
+	}
+	if a.AsDefault != b.AsDefault {
+		return false
+	}
+	return true
+} //End of EqualMsgAliasUpdate
+
+func DeepCopyMsgAutoSwapCancelOrder(v MsgAutoSwapCancelOrder) MsgAutoSwapCancelOrder {
+	var out MsgAutoSwapCancelOrder
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.OrderID = v.OrderID
+	return out
+} //End of DeepCopyMsgAutoSwapCancelOrder
+
+func EqualMsgAutoSwapCancelOrder(a, b MsgAutoSwapCancelOrder) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.OrderID != b.OrderID {
+		return false
+	}
+	return true
+} //End of EqualMsgAutoSwapCancelOrder
+
+func DeepCopyMsgAutoSwapCreateOrder(v MsgAutoSwapCreateOrder) MsgAutoSwapCreateOrder {
+	var out MsgAutoSwapCreateOrder
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.Identify = v.Identify
+	out.TradingPair = v.TradingPair
+	out.PricePrecision = v.PricePrecision
+	out.Price = v.Price
+	out.Quantity = v.Quantity
+	out.Side = v.Side
+	return out
+} //End of DeepCopyMsgAutoSwapCreateOrder
+
+func EqualMsgAutoSwapCreateOrder(a, b MsgAutoSwapCreateOrder) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.Identify != b.Identify {
+		return false
+	}
+	if a.TradingPair != b.TradingPair {
+		return false
+	}
+	if a.PricePrecision != b.PricePrecision {
+		return false
+	}
+	if a.Price != b.Price {
+		return false
+	}
+	if a.Quantity != b.Quantity {
+		return false
+	}
+	if a.Side != b.Side {
+		return false
+	}
+	return true
+} //End of EqualMsgAutoSwapCreateOrder
+
+func DeepCopyMsgBancorCancel(v MsgBancorCancel) MsgBancorCancel {
+	var out MsgBancorCancel
+	out.Owner = DeepCopyAccAddress(v.Owner)
+	out.Stock = v.Stock
+	out.Money = v.Money
+	return out
+} //End of DeepCopyMsgBancorCancel
+
+func EqualMsgBancorCancel(a, b MsgBancorCancel) bool {
+	if !EqualAccAddress(a.Owner, b.Owner) {
+		return false
+	}
+	if a.Stock != b.Stock {
+		return false
+	}
+	if a.Money != b.Money {
+		return false
+	}
+	return true
+} //End of EqualMsgBancorCancel
+
+func DeepCopyMsgBancorInit(v MsgBancorInit) MsgBancorInit {
+	var out MsgBancorInit
+	out.Owner = DeepCopyAccAddress(v.Owner)
+	out.Stock = v.Stock
+	out.Money = v.Money
+	out.InitPrice = v.InitPrice
+	out.MaxSupply = DeepCopyInt(v.MaxSupply)
+	out.MaxPrice = v.MaxPrice
+	out.MaxMoney = DeepCopyInt(v.MaxMoney)
+	out.StockPrecision = v.StockPrecision
+	out.EarliestCancelTime = v.EarliestCancelTime
+	return out
+} //End of DeepCopyMsgBancorInit
+
+func EqualMsgBancorInit(a, b MsgBancorInit) bool {
+	if !EqualAccAddress(a.Owner, b.Owner) {
+		return false
+	}
+	if a.Stock != b.Stock {
+		return false
+	}
+	if a.Money != b.Money {
+		return false
+	}
+	if a.InitPrice != b.InitPrice {
+		return false
+	}
+	if !EqualInt(a.MaxSupply, b.MaxSupply) {
+		return false
+	}
+	if a.MaxPrice != b.MaxPrice {
+		return false
+	}
+	if !EqualInt(a.MaxMoney, b.MaxMoney) {
+		return false
+	}
+	if a.StockPrecision != b.StockPrecision {
+		return false
+	}
+	if a.EarliestCancelTime != b.EarliestCancelTime {
+		return false
+	}
+	return true
+} //End of EqualMsgBancorInit
+
+func DeepCopyMsgBancorTrade(v MsgBancorTrade) MsgBancorTrade {
+	var out MsgBancorTrade
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.Stock = v.Stock
+	out.Money = v.Money
+	out.Amount = v.Amount
+	out.IsBuy = v.IsBuy
+	out.MoneyLimit = v.MoneyLimit
+	return out
+} //End of DeepCopyMsgBancorTrade
+
+func EqualMsgBancorTrade(a, b MsgBancorTrade) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.Stock != b.Stock {
+		return false
+	}
+	if a.Money != b.Money {
+		return false
+	}
+	if a.Amount != b.Amount {
+		return false
+	}
+	if a.IsBuy != b.IsBuy {
+		return false
+	}
+	if a.MoneyLimit != b.MoneyLimit {
+		return false
+	}
+	return true
+} //End of EqualMsgBancorTrade
+
+func DeepCopyMsgBeginRedelegate(v MsgBeginRedelegate) MsgBeginRedelegate {
+	var out MsgBeginRedelegate
+	out.DelegatorAddress = DeepCopyAccAddress(v.DelegatorAddress)
+	if v.ValidatorSrcAddress != nil {
+		out.ValidatorSrcAddress = make([]uint8, len(v.ValidatorSrcAddress))
+		copy(out.ValidatorSrcAddress, v.ValidatorSrcAddress)
+	}
+	if v.ValidatorDstAddress != nil {
+		out.ValidatorDstAddress = make([]uint8, len(v.ValidatorDstAddress))
+		copy(out.ValidatorDstAddress, v.ValidatorDstAddress)
+	}
+	out.Amount = DeepCopyCoin(v.Amount)
+	return out
+} //End of DeepCopyMsgBeginRedelegate
+
+func EqualMsgBeginRedelegate(a, b MsgBeginRedelegate) bool {
+	if !EqualAccAddress(a.DelegatorAddress, b.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(a.ValidatorSrcAddress, b.ValidatorSrcAddress) {
+		return false
+	}
+	if !bytes.Equal(a.ValidatorDstAddress, b.ValidatorDstAddress) {
+		return false
+	}
+	if !EqualCoin(a.Amount, b.Amount) {
+		return false
+	}
+	return true
+} //End of EqualMsgBeginRedelegate
+
+func DeepCopyMsgBurnToken(v MsgBurnToken) MsgBurnToken {
+	var out MsgBurnToken
+	out.Symbol = v.Symbol
+	out.Amount = DeepCopyInt(v.Amount)
+	out.OwnerAddress = DeepCopyAccAddress(v.OwnerAddress)
+	return out
+} //End of DeepCopyMsgBurnToken
+
+func EqualMsgBurnToken(a, b MsgBurnToken) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualInt(a.Amount, b.Amount) {
+		return false
+	}
+	if !EqualAccAddress(a.OwnerAddress, b.OwnerAddress) {
+		return false
+	}
+	return true
+} //End of EqualMsgBurnToken
+
+func DeepCopyMsgCancelOrder(v MsgCancelOrder) MsgCancelOrder {
+	var out MsgCancelOrder
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.OrderID = v.OrderID
+	return out
+} //End of DeepCopyMsgCancelOrder
+
+func EqualMsgCancelOrder(a, b MsgCancelOrder) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.OrderID != b.OrderID {
+		return false
+	}
+	return true
+} //End of EqualMsgCancelOrder
+
+func DeepCopyMsgCancelTradingPair(v MsgCancelTradingPair) MsgCancelTradingPair {
+	var out MsgCancelTradingPair
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.TradingPair = v.TradingPair
+	out.EffectiveTime = v.EffectiveTime
+	return out
+} //End of DeepCopyMsgCancelTradingPair
+
+func EqualMsgCancelTradingPair(a, b MsgCancelTradingPair) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.TradingPair != b.TradingPair {
+		return false
+	}
+	if a.EffectiveTime != b.EffectiveTime {
+		return false
+	}
+	return true
+} //End of EqualMsgCancelTradingPair
+
+func DeepCopyMsgCommentToken(v MsgCommentToken) MsgCommentToken {
+	var out MsgCommentToken
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.Token = v.Token
+	out.Donation = v.Donation
+	out.Title = v.Title
+	if v.Content != nil {
+		out.Content = make([]uint8, len(v.Content))
+		copy(out.Content, v.Content)
+	}
+	out.ContentType = v.ContentType
+	if v.References != nil {
+		out.References = make([]CommentRef, len(v.References))
+		for _0 := 0; _0 < len(v.References); _0++ {
+			out.References[_0] = DeepCopyCommentRef(v.References[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgCommentToken
+
+func EqualMsgCommentToken(a, b MsgCommentToken) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.Token != b.Token {
+		return false
+	}
+	if a.Donation != b.Donation {
+		return false
+	}
+	if a.Title != b.Title {
+		return false
+	}
+	if !bytes.Equal(a.Content, b.Content) {
+		return false
+	}
+	if a.ContentType != b.ContentType {
+		return false
+	}
+	if len(a.References) != len(b.References) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.References); _0++ {
+		if !EqualCommentRef(a.References[_0], b.References[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgCommentToken
+
+func DeepCopyMsgCreateOrder(v MsgCreateOrder) MsgCreateOrder {
+	var out MsgCreateOrder
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.Identify = v.Identify
+	out.TradingPair = v.TradingPair
+	out.OrderType = v.OrderType
+	out.PricePrecision = v.PricePrecision
+	out.Price = v.Price
+	out.Quantity = v.Quantity
+	out.Side = v.Side
+	out.TimeInForce = v.TimeInForce
+	out.ExistBlocks = v.ExistBlocks
+	return out
+} //End of DeepCopyMsgCreateOrder
+
+func EqualMsgCreateOrder(a, b MsgCreateOrder) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.Identify != b.Identify {
+		return false
+	}
+	if a.TradingPair != b.TradingPair {
+		return false
+	}
+	if a.OrderType != b.OrderType {
+		return false
+	}
+	if a.PricePrecision != b.PricePrecision {
+		return false
+	}
+	if a.Price != b.Price {
+		return false
+	}
+	if a.Quantity != b.Quantity {
+		return false
+	}
+	if a.Side != b.Side {
+		return false
+	}
+	if a.TimeInForce != b.TimeInForce {
+		return false
+	}
+	if a.ExistBlocks != b.ExistBlocks {
+		return false
+	}
+	return true
+} //End of EqualMsgCreateOrder
+
+func DeepCopyMsgCreateTradingPair(v MsgCreateTradingPair) MsgCreateTradingPair {
+	var out MsgCreateTradingPair
+	out.Stock = v.Stock
+	out.Money = v.Money
+	out.Creator = DeepCopyAccAddress(v.Creator)
+	out.PricePrecision = v.PricePrecision
+	out.OrderPrecision = v.OrderPrecision
+	return out
+} //End of DeepCopyMsgCreateTradingPair
+
+func EqualMsgCreateTradingPair(a, b MsgCreateTradingPair) bool {
+	if a.Stock != b.Stock {
+		return false
+	}
+	if a.Money != b.Money {
+		return false
+	}
+	if !EqualAccAddress(a.Creator, b.Creator) {
+		return false
+	}
+	if a.PricePrecision != b.PricePrecision {
+		return false
+	}
+	if a.OrderPrecision != b.OrderPrecision {
+		return false
+	}
+	return true
+} //End of EqualMsgCreateTradingPair
+
+func DeepCopyMsgCreateValidator(v MsgCreateValidator) MsgCreateValidator {
+	var out MsgCreateValidator
+	out.Description.Moniker = v.Description.Moniker
+	out.Description.Identity = v.Description.Identity
+	out.Description.Website = v.Description.Website
+	out.Description.Details = v.Description.Details
+	out.Commission.Rate = DeepCopyDec(v.Commission.Rate)
+	out.Commission.MaxRate = DeepCopyDec(v.Commission.MaxRate)
+	out.Commission.MaxChangeRate = DeepCopyDec(v.Commission.MaxChangeRate)
+	out.MinSelfDelegation = DeepCopyInt(v.MinSelfDelegation)
+	out.DelegatorAddress = DeepCopyAccAddress(v.DelegatorAddress)
+	if v.ValidatorAddress != nil {
+		out.ValidatorAddress = make([]uint8, len(v.ValidatorAddress))
+		copy(out.ValidatorAddress, v.ValidatorAddress)
+	}
+	out.PubKey = DeepCopyPubKey(v.PubKey)
+	out.Value = DeepCopyCoin(v.Value)
+	return out
+} //End of DeepCopyMsgCreateValidator
+
+func EqualMsgCreateValidator(a, b MsgCreateValidator) bool {
+	if a.Description.Moniker != b.Description.Moniker {
+		return false
+	}
+	if a.Description.Identity != b.Description.Identity {
+		return false
+	}
+	if a.Description.Website != b.Description.Website {
+		return false
+	}
+	if a.Description.Details != b.Description.Details {
+		return false
+	}
+	if !EqualDec(a.Commission.Rate, b.Commission.Rate) {
+		return false
+	}
+	if !EqualDec(a.Commission.MaxRate, b.Commission.MaxRate) {
+		return false
+	}
+	if !EqualDec(a.Commission.MaxChangeRate, b.Commission.MaxChangeRate) {
+		return false
+	}
+	if !EqualInt(a.MinSelfDelegation, b.MinSelfDelegation) {
+		return false
+	}
+	if !EqualAccAddress(a.DelegatorAddress, b.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(a.ValidatorAddress, b.ValidatorAddress) {
+		return false
+	}
+	if !EqualPubKey(a.PubKey, b.PubKey) {
+		return false
+	}
+	if !EqualCoin(a.Value, b.Value) {
+		return false
+	}
+	return true
+} //End of EqualMsgCreateValidator
+
+func DeepCopyMsgDelegate(v MsgDelegate) MsgDelegate {
+	var out MsgDelegate
+	out.DelegatorAddress = DeepCopyAccAddress(v.DelegatorAddress)
+	if v.ValidatorAddress != nil {
+		out.ValidatorAddress = make([]uint8, len(v.ValidatorAddress))
+		copy(out.ValidatorAddress, v.ValidatorAddress)
+	}
+	out.Amount = DeepCopyCoin(v.Amount)
+	return out
+} //End of DeepCopyMsgDelegate
+
+func EqualMsgDelegate(a, b MsgDelegate) bool {
+	if !EqualAccAddress(a.DelegatorAddress, b.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(a.ValidatorAddress, b.ValidatorAddress) {
+		return false
+	}
+	if !EqualCoin(a.Amount, b.Amount) {
+		return false
+	}
+	return true
+} //End of EqualMsgDelegate
+
+func DeepCopyMsgDeposit(v MsgDeposit) MsgDeposit {
+	var out MsgDeposit
+	out.ProposalID = v.ProposalID
+	out.Depositor = DeepCopyAccAddress(v.Depositor)
+	if v.Amount != nil {
+		out.Amount = make([]Coin, len(v.Amount))
+		for _0 := 0; _0 < len(v.Amount); _0++ {
+			out.Amount[_0] = DeepCopyCoin(v.Amount[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgDeposit
+
+func EqualMsgDeposit(a, b MsgDeposit) bool {
+	if a.ProposalID != b.ProposalID {
+		return false
+	}
+	if !EqualAccAddress(a.Depositor, b.Depositor) {
+		return false
+	}
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Amount); _0++ {
+		if !EqualCoin(a.Amount[_0], b.Amount[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgDeposit
+
+func DeepCopyMsgDonateToCommunityPool(v MsgDonateToCommunityPool) MsgDonateToCommunityPool {
+	var out MsgDonateToCommunityPool
+	out.FromAddr = DeepCopyAccAddress(v.FromAddr)
+	if v.Amount != nil {
+		out.Amount = make([]Coin, len(v.Amount))
+		for _0 := 0; _0 < len(v.Amount); _0++ {
+			out.Amount[_0] = DeepCopyCoin(v.Amount[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgDonateToCommunityPool
+
+func EqualMsgDonateToCommunityPool(a, b MsgDonateToCommunityPool) bool {
+	if !EqualAccAddress(a.FromAddr, b.FromAddr) {
+		return false
+	}
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Amount); _0++ {
+		if !EqualCoin(a.Amount[_0], b.Amount[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgDonateToCommunityPool
+
+func DeepCopyMsgEditValidator(v MsgEditValidator) MsgEditValidator {
+	var out MsgEditValidator
+	out.Description.Moniker = v.Description.Moniker
+	out.Description.Identity = v.Description.Identity
+	out.Description.Website = v.Description.Website
+	out.Description.Details = v.Description.Details
+	if v.ValidatorAddress != nil {
+		out.ValidatorAddress = make([]uint8, len(v.ValidatorAddress))
+		copy(out.ValidatorAddress, v.ValidatorAddress)
+	}
+	if v.CommissionRate != nil {
+		out.CommissionRate = new(sdk.Dec)
+		(*out.CommissionRate) = DeepCopyDec((*v.CommissionRate))
+	}
+	if v.MinSelfDelegation != nil {
+		out.MinSelfDelegation = new(sdk.Int)
+		(*out.MinSelfDelegation) = DeepCopyInt((*v.MinSelfDelegation))
+	}
+	return out
+} //End of DeepCopyMsgEditValidator
+
+func EqualMsgEditValidator(a, b MsgEditValidator) bool {
+	if a.Description.Moniker != b.Description.Moniker {
+		return false
+	}
+	if a.Description.Identity != b.Description.Identity {
+		return false
+	}
+	if a.Description.Website != b.Description.Website {
+		return false
+	}
+	if a.Description.Details != b.Description.Details {
+		return false
+	}
+	if !bytes.Equal(a.ValidatorAddress, b.ValidatorAddress) {
+		return false
+	}
+	if (a.CommissionRate == nil) != (b.CommissionRate == nil) {
+		return false
+	}
+	if a.CommissionRate != nil {
+		if !EqualDec((*a.CommissionRate), (*b.CommissionRate)) {
+			return false
+		}
+	}
+	if (a.MinSelfDelegation == nil) != (b.MinSelfDelegation == nil) {
+		return false
+	}
+	if a.MinSelfDelegation != nil {
+		if !EqualInt((*a.MinSelfDelegation), (*b.MinSelfDelegation)) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgEditValidator
+
+func DeepCopyMsgForbidAddr(v MsgForbidAddr) MsgForbidAddr {
+	var out MsgForbidAddr
+	out.Symbol = v.Symbol
+	out.OwnerAddr = DeepCopyAccAddress(v.OwnerAddr)
+	if v.Addresses != nil {
+		out.Addresses = make([]AccAddress, len(v.Addresses))
+		for _0 := 0; _0 < len(v.Addresses); _0++ {
+			out.Addresses[_0] = DeepCopyAccAddress(v.Addresses[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgForbidAddr
+
+func EqualMsgForbidAddr(a, b MsgForbidAddr) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualAccAddress(a.OwnerAddr, b.OwnerAddr) {
+		return false
+	}
+	if len(a.Addresses) != len(b.Addresses) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Addresses); _0++ {
+		if !EqualAccAddress(a.Addresses[_0], b.Addresses[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgForbidAddr
+
+func DeepCopyMsgForbidToken(v MsgForbidToken) MsgForbidToken {
+	var out MsgForbidToken
+	out.Symbol = v.Symbol
+	out.OwnerAddress = DeepCopyAccAddress(v.OwnerAddress)
+	return out
+} //End of DeepCopyMsgForbidToken
+
+func EqualMsgForbidToken(a, b MsgForbidToken) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualAccAddress(a.OwnerAddress, b.OwnerAddress) {
+		return false
+	}
+	return true
+} //End of EqualMsgForbidToken
+
+func DeepCopyMsgIssueToken(v MsgIssueToken) MsgIssueToken {
+	var out MsgIssueToken
+	out.Name = v.Name
+	out.Symbol = v.Symbol
+	out.TotalSupply = DeepCopyInt(v.TotalSupply)
+	out.Owner = DeepCopyAccAddress(v.Owner)
+	out.Mintable = v.Mintable
+	out.Burnable = v.Burnable
+	out.AddrForbiddable = v.AddrForbiddable
+	out.TokenForbiddable = v.TokenForbiddable
+	out.URL = v.URL
+	out.Description = v.Description
+	out.Identity = v.Identity
+	return out
+} //End of DeepCopyMsgIssueToken
+
+func EqualMsgIssueToken(a, b MsgIssueToken) bool {
+	if a.Name != b.Name {
+		return false
+	}
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualInt(a.TotalSupply, b.TotalSupply) {
+		return false
+	}
+	if !EqualAccAddress(a.Owner, b.Owner) {
+		return false
+	}
+	if a.Mintable != b.Mintable {
+		return false
+	}
+	if a.Burnable != b.Burnable {
+		return false
+	}
+	if a.AddrForbiddable != b.AddrForbiddable {
+		return false
+	}
+	if a.TokenForbiddable != b.TokenForbiddable {
+		return false
+	}
+	if a.URL != b.URL {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	if a.Identity != b.Identity {
+		return false
+	}
+	return true
+} //End of EqualMsgIssueToken
+
+func DeepCopyMsgMintToken(v MsgMintToken) MsgMintToken {
+	var out MsgMintToken
+	out.Symbol = v.Symbol
+	out.Amount = DeepCopyInt(v.Amount)
+	out.OwnerAddress = DeepCopyAccAddress(v.OwnerAddress)
+	return out
+} //End of DeepCopyMsgMintToken
+
+func EqualMsgMintToken(a, b MsgMintToken) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualInt(a.Amount, b.Amount) {
+		return false
+	}
+	if !EqualAccAddress(a.OwnerAddress, b.OwnerAddress) {
+		return false
+	}
+	return true
+} //End of EqualMsgMintToken
+
+func DeepCopyMsgModifyPricePrecision(v MsgModifyPricePrecision) MsgModifyPricePrecision {
+	var out MsgModifyPricePrecision
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.TradingPair = v.TradingPair
+	out.PricePrecision = v.PricePrecision
+	return out
+} //End of DeepCopyMsgModifyPricePrecision
+
+func EqualMsgModifyPricePrecision(a, b MsgModifyPricePrecision) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.TradingPair != b.TradingPair {
+		return false
+	}
+	if a.PricePrecision != b.PricePrecision {
+		return false
+	}
+	return true
+} //End of EqualMsgModifyPricePrecision
+
+func DeepCopyMsgModifyTokenInfo(v MsgModifyTokenInfo) MsgModifyTokenInfo {
+	var out MsgModifyTokenInfo
+	out.Symbol = v.Symbol
+	out.OwnerAddress = DeepCopyAccAddress(v.OwnerAddress)
+	out.URL = v.URL
+	out.Description = v.Description
+	out.Identity = v.Identity
+	out.Name = v.Name
+	out.TotalSupply = v.TotalSupply
+	out.Mintable = v.Mintable
+	out.Burnable = v.Burnable
+	out.AddrForbiddable = v.AddrForbiddable
+	out.TokenForbiddable = v.TokenForbiddable
+	return out
+} //End of DeepCopyMsgModifyTokenInfo
+
+func EqualMsgModifyTokenInfo(a, b MsgModifyTokenInfo) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualAccAddress(a.OwnerAddress, b.OwnerAddress) {
+		return false
+	}
+	if a.URL != b.URL {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	if a.Identity != b.Identity {
+		return false
+	}
+	if a.Name != b.Name {
+		return false
+	}
+	if a.TotalSupply != b.TotalSupply {
+		return false
+	}
+	if a.Mintable != b.Mintable {
+		return false
+	}
+	if a.Burnable != b.Burnable {
+		return false
+	}
+	if a.AddrForbiddable != b.AddrForbiddable {
+		return false
+	}
+	if a.TokenForbiddable != b.TokenForbiddable {
+		return false
+	}
+	return true
+} //End of EqualMsgModifyTokenInfo
+
+func DeepCopyMsgMultiSend(v MsgMultiSend) MsgMultiSend {
+	var out MsgMultiSend
+	if v.Inputs != nil {
+		out.Inputs = make([]Input, len(v.Inputs))
+		for _0 := 0; _0 < len(v.Inputs); _0++ {
+			out.Inputs[_0] = DeepCopyInput(v.Inputs[_0])
+		}
+	}
+	if v.Outputs != nil {
+		out.Outputs = make([]Output, len(v.Outputs))
+		for _0 := 0; _0 < len(v.Outputs); _0++ {
+			out.Outputs[_0] = DeepCopyOutput(v.Outputs[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgMultiSend
+
+func EqualMsgMultiSend(a, b MsgMultiSend) bool {
+	if len(a.Inputs) != len(b.Inputs) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Inputs); _0++ {
+		if !EqualInput(a.Inputs[_0], b.Inputs[_0]) {
+			return false
+		}
+	}
+	if len(a.Outputs) != len(b.Outputs) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Outputs); _0++ {
+		if !EqualOutput(a.Outputs[_0], b.Outputs[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgMultiSend
+
+func DeepCopyMsgMultiSendX(v MsgMultiSendX) MsgMultiSendX {
+	var out MsgMultiSendX
+	if v.Inputs != nil {
+		out.Inputs = make([]Input, len(v.Inputs))
+		for _0 := 0; _0 < len(v.Inputs); _0++ {
+			out.Inputs[_0] = DeepCopyInput(v.Inputs[_0])
+		}
+	}
+	if v.Outputs != nil {
+		out.Outputs = make([]Output, len(v.Outputs))
+		for _0 := 0; _0 < len(v.Outputs); _0++ {
+			out.Outputs[_0] = DeepCopyOutput(v.Outputs[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgMultiSendX
+
+func EqualMsgMultiSendX(a, b MsgMultiSendX) bool {
+	if len(a.Inputs) != len(b.Inputs) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Inputs); _0++ {
+		if !EqualInput(a.Inputs[_0], b.Inputs[_0]) {
+			return false
+		}
+	}
+	if len(a.Outputs) != len(b.Outputs) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Outputs); _0++ {
+		if !EqualOutput(a.Outputs[_0], b.Outputs[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgMultiSendX
+
+func DeepCopyMsgRemoveLiquidity(v MsgRemoveLiquidity) MsgRemoveLiquidity {
+	var out MsgRemoveLiquidity
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.Stock = v.Stock
+	out.Money = v.Money
+	out.Amount = DeepCopyInt(v.Amount)
+	out.To = DeepCopyAccAddress(v.To)
+	return out
+} //End of DeepCopyMsgRemoveLiquidity
+
+func EqualMsgRemoveLiquidity(a, b MsgRemoveLiquidity) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.Stock != b.Stock {
+		return false
+	}
+	if a.Money != b.Money {
+		return false
+	}
+	if !EqualInt(a.Amount, b.Amount) {
+		return false
+	}
+	if !EqualAccAddress(a.To, b.To) {
+		return false
+	}
+	return true
+} //End of EqualMsgRemoveLiquidity
+
+func DeepCopyMsgRemoveTokenWhitelist(v MsgRemoveTokenWhitelist) MsgRemoveTokenWhitelist {
+	var out MsgRemoveTokenWhitelist
+	out.Symbol = v.Symbol
+	out.OwnerAddress = DeepCopyAccAddress(v.OwnerAddress)
+	if v.Whitelist != nil {
+		out.Whitelist = make([]AccAddress, len(v.Whitelist))
+		for _0 := 0; _0 < len(v.Whitelist); _0++ {
+			out.Whitelist[_0] = DeepCopyAccAddress(v.Whitelist[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgRemoveTokenWhitelist
+
+func EqualMsgRemoveTokenWhitelist(a, b MsgRemoveTokenWhitelist) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualAccAddress(a.OwnerAddress, b.OwnerAddress) {
+		return false
+	}
+	if len(a.Whitelist) != len(b.Whitelist) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Whitelist); _0++ {
+		if !EqualAccAddress(a.Whitelist[_0], b.Whitelist[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgRemoveTokenWhitelist
+
+func DeepCopyMsgSend(v MsgSend) MsgSend {
+	var out MsgSend
+	out.FromAddress = DeepCopyAccAddress(v.FromAddress)
+	out.ToAddress = DeepCopyAccAddress(v.ToAddress)
+	if v.Amount != nil {
+		out.Amount = make([]Coin, len(v.Amount))
+		for _0 := 0; _0 < len(v.Amount); _0++ {
+			out.Amount[_0] = DeepCopyCoin(v.Amount[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgSend
+
+func EqualMsgSend(a, b MsgSend) bool {
+	if !EqualAccAddress(a.FromAddress, b.FromAddress) {
+		return false
+	}
+	if !EqualAccAddress(a.ToAddress, b.ToAddress) {
+		return false
+	}
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Amount); _0++ {
+		if !EqualCoin(a.Amount[_0], b.Amount[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgSend
+
+func DeepCopyMsgSendX(v MsgSendX) MsgSendX {
+	var out MsgSendX
+	out.FromAddress = DeepCopyAccAddress(v.FromAddress)
+	out.ToAddress = DeepCopyAccAddress(v.ToAddress)
+	if v.Amount != nil {
+		out.Amount = make([]Coin, len(v.Amount))
+		for _0 := 0; _0 < len(v.Amount); _0++ {
+			out.Amount[_0] = DeepCopyCoin(v.Amount[_0])
+		}
+	}
+	out.UnlockTime = v.UnlockTime
+	return out
+} //End of DeepCopyMsgSendX
+
+func EqualMsgSendX(a, b MsgSendX) bool {
+	if !EqualAccAddress(a.FromAddress, b.FromAddress) {
+		return false
+	}
+	if !EqualAccAddress(a.ToAddress, b.ToAddress) {
+		return false
+	}
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Amount); _0++ {
+		if !EqualCoin(a.Amount[_0], b.Amount[_0]) {
+			return false
+		}
+	}
+	if a.UnlockTime != b.UnlockTime {
+		return false
+	}
+	return true
+} //End of EqualMsgSendX
+
+func DeepCopyMsgSetMemoRequired(v MsgSetMemoRequired) MsgSetMemoRequired {
+	var out MsgSetMemoRequired
+	out.Address = DeepCopyAccAddress(v.Address)
+	out.Required = v.Required
+	return out
+} //End of DeepCopyMsgSetMemoRequired
+
+func EqualMsgSetMemoRequired(a, b MsgSetMemoRequired) bool {
+	if !EqualAccAddress(a.Address, b.Address) {
+		return false
+	}
+	if a.Required != b.Required {
+		return false
+	}
+	return true
+} //End of EqualMsgSetMemoRequired
+
+func DeepCopyMsgSetReferee(v MsgSetReferee) MsgSetReferee {
+	var out MsgSetReferee
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.Referee = DeepCopyAccAddress(v.Referee)
+	return out
+} //End of DeepCopyMsgSetReferee
+
+func EqualMsgSetReferee(a, b MsgSetReferee) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if !EqualAccAddress(a.Referee, b.Referee) {
+		return false
+	}
+	return true
+} //End of EqualMsgSetReferee
+
+func DeepCopyMsgSetWithdrawAddress(v MsgSetWithdrawAddress) MsgSetWithdrawAddress {
+	var out MsgSetWithdrawAddress
+	out.DelegatorAddress = DeepCopyAccAddress(v.DelegatorAddress)
+	out.WithdrawAddress = DeepCopyAccAddress(v.WithdrawAddress)
+	return out
+} //End of DeepCopyMsgSetWithdrawAddress
+
+func EqualMsgSetWithdrawAddress(a, b MsgSetWithdrawAddress) bool {
+	if !EqualAccAddress(a.DelegatorAddress, b.DelegatorAddress) {
+		return false
+	}
+	if !EqualAccAddress(a.WithdrawAddress, b.WithdrawAddress) {
+		return false
+	}
+	return true
+} //End of EqualMsgSetWithdrawAddress
+
+func DeepCopyMsgSubmitProposal(v MsgSubmitProposal) MsgSubmitProposal {
+	var out MsgSubmitProposal
+	out.Content = DeepCopyContent(v.Content)
+	if v.InitialDeposit != nil {
+		out.InitialDeposit = make([]Coin, len(v.InitialDeposit))
+		for _0 := 0; _0 < len(v.InitialDeposit); _0++ {
+			out.InitialDeposit[_0] = DeepCopyCoin(v.InitialDeposit[_0])
+		}
+	}
+	out.Proposer = DeepCopyAccAddress(v.Proposer)
+	return out
+} //End of DeepCopyMsgSubmitProposal
+
+func EqualMsgSubmitProposal(a, b MsgSubmitProposal) bool {
+	if !EqualContent(a.Content, b.Content) {
+		return false
+	}
+	if len(a.InitialDeposit) != len(b.InitialDeposit) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.InitialDeposit); _0++ {
+		if !EqualCoin(a.InitialDeposit[_0], b.InitialDeposit[_0]) {
+			return false
+		}
+	}
+	if !EqualAccAddress(a.Proposer, b.Proposer) {
+		return false
+	}
+	return true
+} //End of EqualMsgSubmitProposal
+
+func DeepCopyMsgSupervisedSend(v MsgSupervisedSend) MsgSupervisedSend {
+	var out MsgSupervisedSend
+	out.FromAddress = DeepCopyAccAddress(v.FromAddress)
+	out.Supervisor = DeepCopyAccAddress(v.Supervisor)
+	out.ToAddress = DeepCopyAccAddress(v.ToAddress)
+	out.Amount = DeepCopyCoin(v.Amount)
+	out.UnlockTime = v.UnlockTime
+	out.Reward = v.Reward
+	out.Operation = v.Operation
+	return out
+} //End of DeepCopyMsgSupervisedSend
+
+func EqualMsgSupervisedSend(a, b MsgSupervisedSend) bool {
+	if !EqualAccAddress(a.FromAddress, b.FromAddress) {
+		return false
+	}
+	if !EqualAccAddress(a.Supervisor, b.Supervisor) {
+		return false
+	}
+	if !EqualAccAddress(a.ToAddress, b.ToAddress) {
+		return false
+	}
+	if !EqualCoin(a.Amount, b.Amount) {
+		return false
+	}
+	if a.UnlockTime != b.UnlockTime {
+		return false
+	}
+	if a.Reward != b.Reward {
+		return false
+	}
+	if a.Operation != b.Operation {
+		return false
+	}
+	return true
+} //End of EqualMsgSupervisedSend
+
+func DeepCopyMsgTransferOwnership(v MsgTransferOwnership) MsgTransferOwnership {
+	var out MsgTransferOwnership
+	out.Symbol = v.Symbol
+	out.OriginalOwner = DeepCopyAccAddress(v.OriginalOwner)
+	out.NewOwner = DeepCopyAccAddress(v.NewOwner)
+	return out
+} //End of DeepCopyMsgTransferOwnership
+
+func EqualMsgTransferOwnership(a, b MsgTransferOwnership) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualAccAddress(a.OriginalOwner, b.OriginalOwner) {
+		return false
+	}
+	if !EqualAccAddress(a.NewOwner, b.NewOwner) {
+		return false
+	}
+	return true
+} //End of EqualMsgTransferOwnership
+
+func DeepCopyMsgUnForbidAddr(v MsgUnForbidAddr) MsgUnForbidAddr {
+	var out MsgUnForbidAddr
+	out.Symbol = v.Symbol
+	out.OwnerAddr = DeepCopyAccAddress(v.OwnerAddr)
+	if v.Addresses != nil {
+		out.Addresses = make([]AccAddress, len(v.Addresses))
+		for _0 := 0; _0 < len(v.Addresses); _0++ {
+			out.Addresses[_0] = DeepCopyAccAddress(v.Addresses[_0])
+		}
+	}
+	return out
+} //End of DeepCopyMsgUnForbidAddr
+
+func EqualMsgUnForbidAddr(a, b MsgUnForbidAddr) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualAccAddress(a.OwnerAddr, b.OwnerAddr) {
+		return false
+	}
+	if len(a.Addresses) != len(b.Addresses) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Addresses); _0++ {
+		if !EqualAccAddress(a.Addresses[_0], b.Addresses[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualMsgUnForbidAddr
+
+func DeepCopyMsgUnForbidToken(v MsgUnForbidToken) MsgUnForbidToken {
+	var out MsgUnForbidToken
+	out.Symbol = v.Symbol
+	out.OwnerAddress = DeepCopyAccAddress(v.OwnerAddress)
+	return out
+} //End of DeepCopyMsgUnForbidToken
+
+func EqualMsgUnForbidToken(a, b MsgUnForbidToken) bool {
+	if a.Symbol != b.Symbol {
+		return false
+	}
+	if !EqualAccAddress(a.OwnerAddress, b.OwnerAddress) {
+		return false
+	}
+	return true
+} //End of EqualMsgUnForbidToken
+
+func DeepCopyMsgUndelegate(v MsgUndelegate) MsgUndelegate {
+	var out MsgUndelegate
+	out.DelegatorAddress = DeepCopyAccAddress(v.DelegatorAddress)
+	if v.ValidatorAddress != nil {
+		out.ValidatorAddress = make([]uint8, len(v.ValidatorAddress))
+		copy(out.ValidatorAddress, v.ValidatorAddress)
+	}
+	out.Amount = DeepCopyCoin(v.Amount)
+	return out
+} //End of DeepCopyMsgUndelegate
+
+func EqualMsgUndelegate(a, b MsgUndelegate) bool {
+	if !EqualAccAddress(a.DelegatorAddress, b.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(a.ValidatorAddress, b.ValidatorAddress) {
+		return false
+	}
+	if !EqualCoin(a.Amount, b.Amount) {
+		return false
+	}
+	return true
+} //End of EqualMsgUndelegate
+
+func DeepCopyMsgUnjail(v MsgUnjail) MsgUnjail {
+	var out MsgUnjail
+	if v.ValidatorAddr != nil {
+		out.ValidatorAddr = make([]uint8, len(v.ValidatorAddr))
+		copy(out.ValidatorAddr, v.ValidatorAddr)
+	}
+	return out
+} //End of DeepCopyMsgUnjail
+
+func EqualMsgUnjail(a, b MsgUnjail) bool {
+	if !bytes.Equal(a.ValidatorAddr, b.ValidatorAddr) {
+		return false
+	}
+	return true
+} //End of EqualMsgUnjail
+
+func DeepCopyMsgVerifyInvariant(v MsgVerifyInvariant) MsgVerifyInvariant {
+	var out MsgVerifyInvariant
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.InvariantModuleName = v.InvariantModuleName
+	out.InvariantRoute = v.InvariantRoute
+	return out
+} //End of DeepCopyMsgVerifyInvariant
+
+func EqualMsgVerifyInvariant(a, b MsgVerifyInvariant) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.InvariantModuleName != b.InvariantModuleName {
+		return false
+	}
+	if a.InvariantRoute != b.InvariantRoute {
+		return false
+	}
+	return true
+} //End of EqualMsgVerifyInvariant
+
+func DeepCopyMsgVote(v MsgVote) MsgVote {
+	var out MsgVote
+	out.ProposalID = v.ProposalID
+	out.Voter = DeepCopyAccAddress(v.Voter)
+	out.Option = v.Option
+	return out
+} //End of DeepCopyMsgVote
+
+func EqualMsgVote(a, b MsgVote) bool {
+	if a.ProposalID != b.ProposalID {
+		return false
+	}
+	if !EqualAccAddress(a.Voter, b.Voter) {
+		return false
+	}
+	if a.Option != b.Option {
+		return false
 	}
-	switch magicBytes {
-	case [4]byte{147, 136, 220, 215}:
-		v, n, err := DecodeMsgAddTokenWhitelist(bz[4:])
-		return v, n + 4, err
-	case [4]byte{173, 181, 17, 162}:
-		v, n, err := DecodeMsgAliasUpdate(bz[4:])
-		return v, n + 4, err
-	case [4]byte{187, 190, 104, 91}:
-		v, n, err := DecodeMsgBancorCancel(bz[4:])
-		return v, n + 4, err
-	case [4]byte{177, 152, 232, 60}:
-		v, n, err := DecodeMsgBancorInit(bz[4:])
-		return v, n + 4, err
-	case [4]byte{225, 122, 18, 80}:
-		v, n, err := DecodeMsgBancorTrade(bz[4:])
-		return v, n + 4, err
-	case [4]byte{247, 3, 0, 105}:
-		v, n, err := DecodeMsgBeginRedelegate(bz[4:])
-		return v, n + 4, err
-	case [4]byte{228, 0, 236, 212}:
-		v, n, err := DecodeMsgBurnToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{106, 229, 80, 141}:
-		v, n, err := DecodeMsgCancelOrder(bz[4:])
-		return v, n + 4, err
-	case [4]byte{13, 177, 95, 127}:
-		v, n, err := DecodeMsgCancelTradingPair(bz[4:])
-		return v, n + 4, err
-	case [4]byte{79, 125, 235, 121}:
-		v, n, err := DecodeMsgCommentToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{246, 238, 7, 164}:
-		v, n, err := DecodeMsgCreateOrder(bz[4:])
-		return v, n + 4, err
-	case [4]byte{130, 221, 55, 57}:
-		v, n, err := DecodeMsgCreateTradingPair(bz[4:])
-		return v, n + 4, err
-	case [4]byte{58, 78, 252, 114}:
-		v, n, err := DecodeMsgCreateValidator(bz[4:])
-		return v, n + 4, err
-	case [4]byte{1, 82, 140, 71}:
-		v, n, err := DecodeMsgDelegate(bz[4:])
-		return v, n + 4, err
-	case [4]byte{205, 134, 140, 190}:
-		v, n, err := DecodeMsgDeposit(bz[4:])
-		return v, n + 4, err
-	case [4]byte{20, 250, 115, 197}:
-		v, n, err := DecodeMsgDonateToCommunityPool(bz[4:])
-		return v, n + 4, err
-	case [4]byte{202, 62, 140, 8}:
-		v, n, err := DecodeMsgEditValidator(bz[4:])
-		return v, n + 4, err
-	case [4]byte{105, 235, 112, 10}:
-		v, n, err := DecodeMsgForbidAddr(bz[4:])
-		return v, n + 4, err
-	case [4]byte{36, 174, 203, 238}:
-		v, n, err := DecodeMsgForbidToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{233, 180, 92, 129}:
-		v, n, err := DecodeMsgIssueToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{66, 148, 56, 203}:
-		v, n, err := DecodeMsgMintToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{76, 91, 156, 199}:
-		v, n, err := DecodeMsgModifyPricePrecision(bz[4:])
-		return v, n + 4, err
-	case [4]byte{40, 158, 40, 18}:
-		v, n, err := DecodeMsgModifyTokenInfo(bz[4:])
-		return v, n + 4, err
-	case [4]byte{207, 152, 156, 90}:
-		v, n, err := DecodeMsgMultiSend(bz[4:])
-		return v, n + 4, err
-	case [4]byte{61, 117, 88, 200}:
-		v, n, err := DecodeMsgMultiSendX(bz[4:])
-		return v, n + 4, err
-	case [4]byte{44, 154, 68, 83}:
-		v, n, err := DecodeMsgRemoveTokenWhitelist(bz[4:])
-		return v, n + 4, err
-	case [4]byte{100, 168, 39, 140}:
-		v, n, err := DecodeMsgSend(bz[4:])
-		return v, n + 4, err
-	case [4]byte{198, 76, 8, 81}:
-		v, n, err := DecodeMsgSendX(bz[4:])
-		return v, n + 4, err
-	case [4]byte{184, 238, 253, 154}:
-		v, n, err := DecodeMsgSetMemoRequired(bz[4:])
-		return v, n + 4, err
-	case [4]byte{190, 178, 173, 144}:
-		v, n, err := DecodeMsgSetWithdrawAddress(bz[4:])
-		return v, n + 4, err
-	case [4]byte{115, 119, 137, 48}:
-		v, n, err := DecodeMsgSubmitProposal(bz[4:])
-		return v, n + 4, err
-	case [4]byte{200, 224, 118, 175}:
-		v, n, err := DecodeMsgTransferOwnership(bz[4:])
-		return v, n + 4, err
-	case [4]byte{167, 165, 166, 227}:
-		v, n, err := DecodeMsgUnForbidAddr(bz[4:])
-		return v, n + 4, err
-	case [4]byte{78, 83, 156, 139}:
-		v, n, err := DecodeMsgUnForbidToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{122, 66, 160, 76}:
-		v, n, err := DecodeMsgUndelegate(bz[4:])
-		return v, n + 4, err
-	case [4]byte{216, 247, 180, 46}:
-		v, n, err := DecodeMsgUnjail(bz[4:])
-		return v, n + 4, err
-	case [4]byte{84, 44, 219, 65}:
-		v, n, err := DecodeMsgVerifyInvariant(bz[4:])
-		return v, n + 4, err
-	case [4]byte{238, 246, 67, 141}:
-		v, n, err := DecodeMsgVote(bz[4:])
-		return v, n + 4, err
-	case [4]byte{94, 251, 176, 152}:
-		v, n, err := DecodeMsgWithdrawDelegatorReward(bz[4:])
-		return v, n + 4, err
-	case [4]byte{18, 172, 190, 152}:
-		v, n, err := DecodeMsgWithdrawValidatorCommission(bz[4:])
-		return v, n + 4, err
+	return true
+} //End of EqualMsgVote
+
+func DeepCopyMsgWithdrawDelegatorReward(v MsgWithdrawDelegatorReward) MsgWithdrawDelegatorReward {
+	var out MsgWithdrawDelegatorReward
+	out.DelegatorAddress = DeepCopyAccAddress(v.DelegatorAddress)
+	if v.ValidatorAddress != nil {
+		out.ValidatorAddress = make([]uint8, len(v.ValidatorAddress))
+		copy(out.ValidatorAddress, v.ValidatorAddress)
+	}
+	return out
+} //End of DeepCopyMsgWithdrawDelegatorReward
+
+func EqualMsgWithdrawDelegatorReward(a, b MsgWithdrawDelegatorReward) bool {
+	if !EqualAccAddress(a.DelegatorAddress, b.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(a.ValidatorAddress, b.ValidatorAddress) {
+		return false
+	}
+	return true
+} //End of EqualMsgWithdrawDelegatorReward
+
+func DeepCopyMsgWithdrawValidatorCommission(v MsgWithdrawValidatorCommission) MsgWithdrawValidatorCommission {
+	var out MsgWithdrawValidatorCommission
+	if v.ValidatorAddress != nil {
+		out.ValidatorAddress = make([]uint8, len(v.ValidatorAddress))
+		copy(out.ValidatorAddress, v.ValidatorAddress)
+	}
+	return out
+} //End of DeepCopyMsgWithdrawValidatorCommission
+
+func EqualMsgWithdrawValidatorCommission(a, b MsgWithdrawValidatorCommission) bool {
+	if !bytes.Equal(a.ValidatorAddress, b.ValidatorAddress) {
+		return false
+	}
+	return true
+} //End of EqualMsgWithdrawValidatorCommission
+
+func DeepCopyOrder(v Order) Order {
+	var out Order
+	out.Sender = DeepCopyAccAddress(v.Sender)
+	out.Sequence = v.Sequence
+	out.Identify = v.Identify
+	out.TradingPair = v.TradingPair
+	out.OrderType = v.OrderType
+	out.Price = DeepCopyDec(v.Price)
+	out.Quantity = v.Quantity
+	out.Side = v.Side
+	out.TimeInForce = v.TimeInForce
+	out.Height = v.Height
+	out.FrozenCommission = v.FrozenCommission
+	out.ExistBlocks = v.ExistBlocks
+	out.FrozenFeatureFee = v.FrozenFeatureFee
+	out.FrozenFee = v.FrozenFee
+	out.LeftStock = v.LeftStock
+	out.Freeze = v.Freeze
+	out.DealStock = v.DealStock
+	out.DealMoney = v.DealMoney
+	return out
+} //End of DeepCopyOrder
+
+func EqualOrder(a, b Order) bool {
+	if !EqualAccAddress(a.Sender, b.Sender) {
+		return false
+	}
+	if a.Sequence != b.Sequence {
+		return false
+	}
+	if a.Identify != b.Identify {
+		return false
+	}
+	if a.TradingPair != b.TradingPair {
+		return false
+	}
+	if a.OrderType != b.OrderType {
+		return false
+	}
+	if !EqualDec(a.Price, b.Price) {
+		return false
+	}
+	if a.Quantity != b.Quantity {
+		return false
+	}
+	if a.Side != b.Side {
+		return false
+	}
+	if a.TimeInForce != b.TimeInForce {
+		return false
+	}
+	if a.Height != b.Height {
+		return false
+	}
+	if a.FrozenCommission != b.FrozenCommission {
+		return false
+	}
+	if a.ExistBlocks != b.ExistBlocks {
+		return false
+	}
+	if a.FrozenFeatureFee != b.FrozenFeatureFee {
+		return false
+	}
+	if a.FrozenFee != b.FrozenFee {
+		return false
+	}
+	if a.LeftStock != b.LeftStock {
+		return false
+	}
+	if a.Freeze != b.Freeze {
+		return false
+	}
+	if a.DealStock != b.DealStock {
+		return false
+	}
+	if a.DealMoney != b.DealMoney {
+		return false
+	}
+	return true
+} //End of EqualOrder
+
+func DeepCopyOutput(v Output) Output {
+	var out Output
+	out.Address = DeepCopyAccAddress(v.Address)
+	if v.Coins != nil {
+		out.Coins = make([]Coin, len(v.Coins))
+		for _0 := 0; _0 < len(v.Coins); _0++ {
+			out.Coins[_0] = DeepCopyCoin(v.Coins[_0])
+		}
+	}
+	return out
+} //End of DeepCopyOutput
+
+func EqualOutput(a, b Output) bool {
+	if !EqualAccAddress(a.Address, b.Address) {
+		return false
+	}
+	if len(a.Coins) != len(b.Coins) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Coins); _0++ {
+		if !EqualCoin(a.Coins[_0], b.Coins[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualOutput
+
+func DeepCopyParamChange(v ParamChange) ParamChange {
+	var out ParamChange
+	out.Subspace = v.Subspace
+	out.Key = v.Key
+	out.Subkey = v.Subkey
+	out.Value = v.Value
+	return out
+} //End of DeepCopyParamChange
+
+func EqualParamChange(a, b ParamChange) bool {
+	if a.Subspace != b.Subspace {
+		return false
+	}
+	if a.Key != b.Key {
+		return false
+	}
+	if a.Subkey != b.Subkey {
+		return false
+	}
+	if a.Value != b.Value {
+		return false
+	}
+	return true
+} //End of EqualParamChange
+
+func DeepCopyParameterChangeProposal(v ParameterChangeProposal) ParameterChangeProposal {
+	var out ParameterChangeProposal
+	out.Title = v.Title
+	out.Description = v.Description
+	if v.Changes != nil {
+		out.Changes = make([]ParamChange, len(v.Changes))
+		for _0 := 0; _0 < len(v.Changes); _0++ {
+			out.Changes[_0] = DeepCopyParamChange(v.Changes[_0])
+		}
+	}
+	return out
+} //End of DeepCopyParameterChangeProposal
+
+func EqualParameterChangeProposal(a, b ParameterChangeProposal) bool {
+	if a.Title != b.Title {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	if len(a.Changes) != len(b.Changes) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Changes); _0++ {
+		if !EqualParamChange(a.Changes[_0], b.Changes[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualParameterChangeProposal
+
+func DeepCopyPeriod(v Period) Period {
+	var out Period
+	out.Length = v.Length
+	if v.Amount != nil {
+		out.Amount = make([]Coin, len(v.Amount))
+		for _0 := 0; _0 < len(v.Amount); _0++ {
+			out.Amount[_0] = DeepCopyCoin(v.Amount[_0])
+		}
+	}
+	return out
+} //End of DeepCopyPeriod
+
+func EqualPeriod(a, b Period) bool {
+	if a.Length != b.Length {
+		return false
+	}
+	if len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Amount); _0++ {
+		if !EqualCoin(a.Amount[_0], b.Amount[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualPeriod
+
+func DeepCopyPeriodicVestingAccount(v PeriodicVestingAccount) PeriodicVestingAccount {
+	var out PeriodicVestingAccount
+	if v.BaseVestingAccount != nil {
+		out.BaseVestingAccount = new(BaseVestingAccount)
+		(*out.BaseVestingAccount) = DeepCopyBaseVestingAccount((*v.BaseVestingAccount))
+	}
+	out.StartTime = v.StartTime
+	if v.VestingPeriods != nil {
+		out.VestingPeriods = make([]Period, len(v.VestingPeriods))
+		for _0 := 0; _0 < len(v.VestingPeriods); _0++ {
+			out.VestingPeriods[_0] = DeepCopyPeriod(v.VestingPeriods[_0])
+		}
+	}
+	return out
+} //End of DeepCopyPeriodicVestingAccount
+
+func EqualPeriodicVestingAccount(a, b PeriodicVestingAccount) bool {
+	if (a.BaseVestingAccount == nil) != (b.BaseVestingAccount == nil) {
+		return false
+	}
+	if a.BaseVestingAccount != nil {
+		if !EqualBaseVestingAccount((*a.BaseVestingAccount), (*b.BaseVestingAccount)) {
+			return false
+		}
+	}
+	if a.StartTime != b.StartTime {
+		return false
+	}
+	if len(a.VestingPeriods) != len(b.VestingPeriods) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.VestingPeriods); _0++ {
+		if !EqualPeriod(a.VestingPeriods[_0], b.VestingPeriods[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualPeriodicVestingAccount
+
+func DeepCopyPrivKeyEd25519(v PrivKeyEd25519) PrivKeyEd25519 {
+	var out PrivKeyEd25519
+	out = v
+	return out
+} //End of DeepCopyPrivKeyEd25519
+
+func EqualPrivKeyEd25519(a, b PrivKeyEd25519) bool {
+	if a != b {
+		return false
+	}
+	return true
+} //End of EqualPrivKeyEd25519
+
+func DeepCopyPrivKeySecp256k1(v PrivKeySecp256k1) PrivKeySecp256k1 {
+	var out PrivKeySecp256k1
+	out = v
+	return out
+} //End of DeepCopyPrivKeySecp256k1
+
+func EqualPrivKeySecp256k1(a, b PrivKeySecp256k1) bool {
+	if a != b {
+		return false
+	}
+	return true
+} //End of EqualPrivKeySecp256k1
+
+func DeepCopyPubKeyEd25519(v PubKeyEd25519) PubKeyEd25519 {
+	var out PubKeyEd25519
+	out = v
+	return out
+} //End of DeepCopyPubKeyEd25519
+
+func EqualPubKeyEd25519(a, b PubKeyEd25519) bool {
+	if a != b {
+		return false
+	}
+	return true
+} //End of EqualPubKeyEd25519
+
+func DeepCopyPubKeyMultisigThreshold(v PubKeyMultisigThreshold) PubKeyMultisigThreshold {
+	var out PubKeyMultisigThreshold
+	out.K = v.K
+	if v.PubKeys != nil {
+		out.PubKeys = make([]PubKey, len(v.PubKeys))
+		for _0 := 0; _0 < len(v.PubKeys); _0++ {
+			out.PubKeys[_0] = DeepCopyPubKey(v.PubKeys[_0])
+		}
+	}
+	return out
+} //End of DeepCopyPubKeyMultisigThreshold
+
+func EqualPubKeyMultisigThreshold(a, b PubKeyMultisigThreshold) bool {
+	if a.K != b.K {
+		return false
+	}
+	if len(a.PubKeys) != len(b.PubKeys) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.PubKeys); _0++ {
+		if !EqualPubKey(a.PubKeys[_0], b.PubKeys[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualPubKeyMultisigThreshold
+
+func DeepCopyPubKeySecp256k1(v PubKeySecp256k1) PubKeySecp256k1 {
+	var out PubKeySecp256k1
+	out = v
+	return out
+} //End of DeepCopyPubKeySecp256k1
+
+func EqualPubKeySecp256k1(a, b PubKeySecp256k1) bool {
+	if a != b {
+		return false
+	}
+	return true
+} //End of EqualPubKeySecp256k1
+
+func DeepCopySignedMsgType(v SignedMsgType) SignedMsgType {
+	var out SignedMsgType
+	out = v
+	return out
+} //End of DeepCopySignedMsgType
+
+func EqualSignedMsgType(a, b SignedMsgType) bool {
+	if a != b {
+		return false
+	}
+	return true
+} //End of EqualSignedMsgType
+
+func DeepCopySoftwareUpgradeProposal(v SoftwareUpgradeProposal) SoftwareUpgradeProposal {
+	var out SoftwareUpgradeProposal
+	out.Title = v.Title
+	out.Description = v.Description
+	return out
+} //End of DeepCopySoftwareUpgradeProposal
+
+func EqualSoftwareUpgradeProposal(a, b SoftwareUpgradeProposal) bool {
+	if a.Title != b.Title {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	return true
+} //End of EqualSoftwareUpgradeProposal
+
+func DeepCopyState(v State) State {
+	var out State
+	out.HeightAdjustment = v.HeightAdjustment
+	return out
+} //End of DeepCopyState
+
+func EqualState(a, b State) bool {
+	if a.HeightAdjustment != b.HeightAdjustment {
+		return false
+	}
+	return true
+} //End of EqualState
+
+func DeepCopyStdSignature(v StdSignature) StdSignature {
+	var out StdSignature
+	out.PubKey = DeepCopyPubKey(v.PubKey)
+	if v.Signature != nil {
+		out.Signature = make([]uint8, len(v.Signature))
+		copy(out.Signature, v.Signature)
+	}
+	return out
+} //End of DeepCopyStdSignature
+
+func EqualStdSignature(a, b StdSignature) bool {
+	if !EqualPubKey(a.PubKey, b.PubKey) {
+		return false
+	}
+	if !bytes.Equal(a.Signature, b.Signature) {
+		return false
+	}
+	return true
+} //End of EqualStdSignature
+
+func DeepCopyStdTx(v StdTx) StdTx {
+	var out StdTx
+	if v.Msgs != nil {
+		out.Msgs = make([]Msg, len(v.Msgs))
+		for _0 := 0; _0 < len(v.Msgs); _0++ {
+			out.Msgs[_0] = DeepCopyMsg(v.Msgs[_0])
+		}
+	}
+	if v.Fee.Amount != nil {
+		out.Fee.Amount = make([]Coin, len(v.Fee.Amount))
+		for _0 := 0; _0 < len(v.Fee.Amount); _0++ {
+			out.Fee.Amount[_0] = DeepCopyCoin(v.Fee.Amount[_0])
+		}
+	}
+	out.Fee.Gas = v.Fee.Gas
+	if v.Signatures != nil {
+		out.Signatures = make([]StdSignature, len(v.Signatures))
+		for _0 := 0; _0 < len(v.Signatures); _0++ {
+			out.Signatures[_0] = DeepCopyStdSignature(v.Signatures[_0])
+		}
+	}
+	out.Memo = v.Memo
+	return out
+} //End of DeepCopyStdTx
+
+func EqualStdTx(a, b StdTx) bool {
+	if len(a.Msgs) != len(b.Msgs) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Msgs); _0++ {
+		if !EqualMsg(a.Msgs[_0], b.Msgs[_0]) {
+			return false
+		}
+	}
+	if len(a.Fee.Amount) != len(b.Fee.Amount) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Fee.Amount); _0++ {
+		if !EqualCoin(a.Fee.Amount[_0], b.Fee.Amount[_0]) {
+			return false
+		}
+	}
+	if a.Fee.Gas != b.Fee.Gas {
+		return false
+	}
+	if len(a.Signatures) != len(b.Signatures) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Signatures); _0++ {
+		if !EqualStdSignature(a.Signatures[_0], b.Signatures[_0]) {
+			return false
+		}
+	}
+	if a.Memo != b.Memo {
+		return false
+	}
+	return true
+} //End of EqualStdTx
+
+func DeepCopySupply(v Supply) Supply {
+	var out Supply
+	if v.Total != nil {
+		out.Total = make([]Coin, len(v.Total))
+		for _0 := 0; _0 < len(v.Total); _0++ {
+			out.Total[_0] = DeepCopyCoin(v.Total[_0])
+		}
+	}
+	return out
+} //End of DeepCopySupply
+
+func EqualSupply(a, b Supply) bool {
+	if len(a.Total) != len(b.Total) {
+		return false
+	}
+	for _0 := 0; _0 < len(a.Total); _0++ {
+		if !EqualCoin(a.Total[_0], b.Total[_0]) {
+			return false
+		}
+	}
+	return true
+} //End of EqualSupply
+
+func DeepCopyTextProposal(v TextProposal) TextProposal {
+	var out TextProposal
+	out.Title = v.Title
+	out.Description = v.Description
+	return out
+} //End of DeepCopyTextProposal
+
+func EqualTextProposal(a, b TextProposal) bool {
+	if a.Title != b.Title {
+		return false
+	}
+	if a.Description != b.Description {
+		return false
+	}
+	return true
+} //End of EqualTextProposal
+
+func DeepCopyVote(v Vote) Vote {
+	var out Vote
+	out.Type = v.Type
+	out.Height = v.Height
+	out.Round = v.Round
+	if v.BlockID.Hash != nil {
+		out.BlockID.Hash = make([]uint8, len(v.BlockID.Hash))
+		copy(out.BlockID.Hash, v.BlockID.Hash)
+	}
+	out.BlockID.PartsHeader.Total = v.BlockID.PartsHeader.Total
+	if v.BlockID.PartsHeader.Hash != nil {
+		out.BlockID.PartsHeader.Hash = make([]uint8, len(v.BlockID.PartsHeader.Hash))
+		copy(out.BlockID.PartsHeader.Hash, v.BlockID.PartsHeader.Hash)
+	}
+	out.Timestamp = DeepCopyTime(v.Timestamp)
+	if v.ValidatorAddress != nil {
+		out.ValidatorAddress = make([]uint8, len(v.ValidatorAddress))
+		copy(out.ValidatorAddress, v.ValidatorAddress)
+	}
+	out.ValidatorIndex = v.ValidatorIndex
+	if v.Signature != nil {
+		out.Signature = make([]uint8, len(v.Signature))
+		copy(out.Signature, v.Signature)
+	}
+	return out
+} //End of DeepCopyVote
+
+func EqualVote(a, b Vote) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	if a.Height != b.Height {
+		return false
+	}
+	if a.Round != b.Round {
+		return false
+	}
+	if !bytes.Equal(a.BlockID.Hash, b.BlockID.Hash) {
+		return false
+	}
+	if a.BlockID.PartsHeader.Total != b.BlockID.PartsHeader.Total {
+		return false
+	}
+	if !bytes.Equal(a.BlockID.PartsHeader.Hash, b.BlockID.PartsHeader.Hash) {
+		return false
+	}
+	if !EqualTime(a.Timestamp, b.Timestamp) {
+		return false
+	}
+	if !bytes.Equal(a.ValidatorAddress, b.ValidatorAddress) {
+		return false
+	}
+	if a.ValidatorIndex != b.ValidatorIndex {
+		return false
+	}
+	if !bytes.Equal(a.Signature, b.Signature) {
+		return false
+	}
+	return true
+} //End of EqualVote
+
+func DeepCopyVoteOption(v VoteOption) VoteOption {
+	var out VoteOption
+	out = v
+	return out
+} //End of DeepCopyVoteOption
+
+func EqualVoteOption(a, b VoteOption) bool {
+	if a != b {
+		return false
+	}
+	return true
+} //End of EqualVoteOption
+
+func DeepCopyAccount(x Account) Account {
+	switch v := x.(type) {
+	case nil:
+		return nil
+	case BaseVestingAccount:
+		return DeepCopyBaseVestingAccount(v)
+	case *BaseVestingAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyBaseVestingAccount(*v)
+		return &res
+	case ContinuousVestingAccount:
+		return DeepCopyContinuousVestingAccount(v)
+	case *ContinuousVestingAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyContinuousVestingAccount(*v)
+		return &res
+	case DelayedVestingAccount:
+		return DeepCopyDelayedVestingAccount(v)
+	case *DelayedVestingAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyDelayedVestingAccount(*v)
+		return &res
+	case ModuleAccount:
+		return DeepCopyModuleAccount(v)
+	case *ModuleAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyModuleAccount(*v)
+		return &res
+	case PeriodicVestingAccount:
+		return DeepCopyPeriodicVestingAccount(v)
+	case *PeriodicVestingAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPeriodicVestingAccount(*v)
+		return &res
 	default:
 		panic("Unknown type")
 	} // end of switch
-	return v, n, nil
-} // end of DecodeMsg
-func RandMsg(r RandSrc) Msg {
-	switch r.GetUint() % 40 {
-	case 0:
-		return RandMsgAddTokenWhitelist(r)
-	case 1:
-		return RandMsgAliasUpdate(r)
-	case 2:
-		return RandMsgBancorCancel(r)
-	case 3:
-		return RandMsgBancorInit(r)
-	case 4:
-		return RandMsgBancorTrade(r)
-	case 5:
-		return RandMsgBeginRedelegate(r)
-	case 6:
-		return RandMsgBurnToken(r)
-	case 7:
-		return RandMsgCancelOrder(r)
-	case 8:
-		return RandMsgCancelTradingPair(r)
-	case 9:
-		return RandMsgCommentToken(r)
-	case 10:
-		return RandMsgCreateOrder(r)
-	case 11:
-		return RandMsgCreateTradingPair(r)
-	case 12:
-		return RandMsgCreateValidator(r)
-	case 13:
-		return RandMsgDelegate(r)
-	case 14:
-		return RandMsgDeposit(r)
-	case 15:
-		return RandMsgDonateToCommunityPool(r)
-	case 16:
-		return RandMsgEditValidator(r)
-	case 17:
-		return RandMsgForbidAddr(r)
-	case 18:
-		return RandMsgForbidToken(r)
-	case 19:
-		return RandMsgIssueToken(r)
-	case 20:
-		return RandMsgMintToken(r)
-	case 21:
-		return RandMsgModifyPricePrecision(r)
-	case 22:
-		return RandMsgModifyTokenInfo(r)
-	case 23:
-		return RandMsgMultiSend(r)
-	case 24:
-		return RandMsgMultiSendX(r)
-	case 25:
-		return RandMsgRemoveTokenWhitelist(r)
-	case 26:
-		return RandMsgSend(r)
-	case 27:
-		return RandMsgSendX(r)
-	case 28:
-		return RandMsgSetMemoRequired(r)
-	case 29:
-		return RandMsgSetWithdrawAddress(r)
-	case 30:
-		return RandMsgSubmitProposal(r)
-	case 31:
-		return RandMsgTransferOwnership(r)
-	case 32:
-		return RandMsgUnForbidAddr(r)
-	case 33:
-		return RandMsgUnForbidToken(r)
-	case 34:
-		return RandMsgUndelegate(r)
-	case 35:
-		return RandMsgUnjail(r)
-	case 36:
-		return RandMsgVerifyInvariant(r)
-	case 37:
-		return RandMsgVote(r)
-	case 38:
-		return RandMsgWithdrawDelegatorReward(r)
-	case 39:
-		return RandMsgWithdrawValidatorCommission(r)
-	default:
-		panic("Unknown Type.")
-	} // end of switch
-} // end of func
-// Interface
-func EncodeAccount(w io.Writer, x interface{}) error {
+} //End of DeepCopyAccount
+
+func EqualAccount(x, y Account) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
 	switch v := x.(type) {
 	case BaseVestingAccount:
-		w.Write(getMagicBytes("BaseVestingAccount"))
-		return EncodeBaseVestingAccount(w, v)
+		w, ok := y.(BaseVestingAccount)
+		return ok && EqualBaseVestingAccount(v, w)
 	case *BaseVestingAccount:
-		w.Write(getMagicBytes("BaseVestingAccount"))
-		return EncodeBaseVestingAccount(w, *v)
+		w, ok := y.(*BaseVestingAccount)
+		return ok && (v == w || v != nil && w != nil && EqualBaseVestingAccount(*v, *w))
 	case ContinuousVestingAccount:
-		w.Write(getMagicBytes("ContinuousVestingAccount"))
-		return EncodeContinuousVestingAccount(w, v)
+		w, ok := y.(ContinuousVestingAccount)
+		return ok && EqualContinuousVestingAccount(v, w)
 	case *ContinuousVestingAccount:
-		w.Write(getMagicBytes("ContinuousVestingAccount"))
-		return EncodeContinuousVestingAccount(w, *v)
+		w, ok := y.(*ContinuousVestingAccount)
+		return ok && (v == w || v != nil && w != nil && EqualContinuousVestingAccount(*v, *w))
 	case DelayedVestingAccount:
-		w.Write(getMagicBytes("DelayedVestingAccount"))
-		return EncodeDelayedVestingAccount(w, v)
+		w, ok := y.(DelayedVestingAccount)
+		return ok && EqualDelayedVestingAccount(v, w)
 	case *DelayedVestingAccount:
-		w.Write(getMagicBytes("DelayedVestingAccount"))
-		return EncodeDelayedVestingAccount(w, *v)
+		w, ok := y.(*DelayedVestingAccount)
+		return ok && (v == w || v != nil && w != nil && EqualDelayedVestingAccount(*v, *w))
 	case ModuleAccount:
-		w.Write(getMagicBytes("ModuleAccount"))
-		return EncodeModuleAccount(w, v)
+		w, ok := y.(ModuleAccount)
+		return ok && EqualModuleAccount(v, w)
 	case *ModuleAccount:
-		w.Write(getMagicBytes("ModuleAccount"))
-		return EncodeModuleAccount(w, *v)
+		w, ok := y.(*ModuleAccount)
+		return ok && (v == w || v != nil && w != nil && EqualModuleAccount(*v, *w))
+	case PeriodicVestingAccount:
+		w, ok := y.(PeriodicVestingAccount)
+		return ok && EqualPeriodicVestingAccount(v, w)
+	case *PeriodicVestingAccount:
+		w, ok := y.(*PeriodicVestingAccount)
+		return ok && (v == w || v != nil && w != nil && EqualPeriodicVestingAccount(*v, *w))
+	default:
+		panic("Unknown type")
+	} // end of switch
+} //End of EqualAccount
+
+func DeepCopyContent(x Content) Content {
+	switch v := x.(type) {
+	case nil:
+		return nil
+	case CommunityPoolSpendProposal:
+		return DeepCopyCommunityPoolSpendProposal(v)
+	case *CommunityPoolSpendProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyCommunityPoolSpendProposal(*v)
+		return &res
+	case ParameterChangeProposal:
+		return DeepCopyParameterChangeProposal(v)
+	case *ParameterChangeProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyParameterChangeProposal(*v)
+		return &res
+	case SoftwareUpgradeProposal:
+		return DeepCopySoftwareUpgradeProposal(v)
+	case *SoftwareUpgradeProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopySoftwareUpgradeProposal(*v)
+		return &res
+	case TextProposal:
+		return DeepCopyTextProposal(v)
+	case *TextProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyTextProposal(*v)
+		return &res
+	default:
+		panic("Unknown type")
+	} // end of switch
+} //End of DeepCopyContent
+
+func EqualContent(x, y Content) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	switch v := x.(type) {
+	case CommunityPoolSpendProposal:
+		w, ok := y.(CommunityPoolSpendProposal)
+		return ok && EqualCommunityPoolSpendProposal(v, w)
+	case *CommunityPoolSpendProposal:
+		w, ok := y.(*CommunityPoolSpendProposal)
+		return ok && (v == w || v != nil && w != nil && EqualCommunityPoolSpendProposal(*v, *w))
+	case ParameterChangeProposal:
+		w, ok := y.(ParameterChangeProposal)
+		return ok && EqualParameterChangeProposal(v, w)
+	case *ParameterChangeProposal:
+		w, ok := y.(*ParameterChangeProposal)
+		return ok && (v == w || v != nil && w != nil && EqualParameterChangeProposal(*v, *w))
+	case SoftwareUpgradeProposal:
+		w, ok := y.(SoftwareUpgradeProposal)
+		return ok && EqualSoftwareUpgradeProposal(v, w)
+	case *SoftwareUpgradeProposal:
+		w, ok := y.(*SoftwareUpgradeProposal)
+		return ok && (v == w || v != nil && w != nil && EqualSoftwareUpgradeProposal(*v, *w))
+	case TextProposal:
+		w, ok := y.(TextProposal)
+		return ok && EqualTextProposal(v, w)
+	case *TextProposal:
+		w, ok := y.(*TextProposal)
+		return ok && (v == w || v != nil && w != nil && EqualTextProposal(*v, *w))
+	default:
+		panic("Unknown type")
+	} // end of switch
+} //End of EqualContent
+
+func DeepCopyMsg(x Msg) Msg {
+	switch v := x.(type) {
+	case nil:
+		return nil
+	case MsgAddLiquidity:
+		return DeepCopyMsgAddLiquidity(v)
+	case *MsgAddLiquidity:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAddLiquidity(*v)
+		return &res
+	case MsgAddTokenWhitelist:
+		return DeepCopyMsgAddTokenWhitelist(v)
+	case *MsgAddTokenWhitelist:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAddTokenWhitelist(*v)
+		return &res
+	case MsgAliasUpdate:
+		return DeepCopyMsgAliasUpdate(v)
+	case *MsgAliasUpdate:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAliasUpdate(*v)
+		return &res
+	case MsgAutoSwapCancelOrder:
+		return DeepCopyMsgAutoSwapCancelOrder(v)
+	case *MsgAutoSwapCancelOrder:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAutoSwapCancelOrder(*v)
+		return &res
+	case MsgAutoSwapCreateOrder:
+		return DeepCopyMsgAutoSwapCreateOrder(v)
+	case *MsgAutoSwapCreateOrder:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAutoSwapCreateOrder(*v)
+		return &res
+	case MsgBancorCancel:
+		return DeepCopyMsgBancorCancel(v)
+	case *MsgBancorCancel:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBancorCancel(*v)
+		return &res
+	case MsgBancorInit:
+		return DeepCopyMsgBancorInit(v)
+	case *MsgBancorInit:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBancorInit(*v)
+		return &res
+	case MsgBancorTrade:
+		return DeepCopyMsgBancorTrade(v)
+	case *MsgBancorTrade:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBancorTrade(*v)
+		return &res
+	case MsgBeginRedelegate:
+		return DeepCopyMsgBeginRedelegate(v)
+	case *MsgBeginRedelegate:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBeginRedelegate(*v)
+		return &res
+	case MsgBurnToken:
+		return DeepCopyMsgBurnToken(v)
+	case *MsgBurnToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBurnToken(*v)
+		return &res
+	case MsgCancelOrder:
+		return DeepCopyMsgCancelOrder(v)
+	case *MsgCancelOrder:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCancelOrder(*v)
+		return &res
+	case MsgCancelTradingPair:
+		return DeepCopyMsgCancelTradingPair(v)
+	case *MsgCancelTradingPair:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCancelTradingPair(*v)
+		return &res
+	case MsgCommentToken:
+		return DeepCopyMsgCommentToken(v)
+	case *MsgCommentToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCommentToken(*v)
+		return &res
+	case MsgCreateOrder:
+		return DeepCopyMsgCreateOrder(v)
+	case *MsgCreateOrder:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCreateOrder(*v)
+		return &res
+	case MsgCreateTradingPair:
+		return DeepCopyMsgCreateTradingPair(v)
+	case *MsgCreateTradingPair:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCreateTradingPair(*v)
+		return &res
+	case MsgCreateValidator:
+		return DeepCopyMsgCreateValidator(v)
+	case *MsgCreateValidator:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCreateValidator(*v)
+		return &res
+	case MsgDelegate:
+		return DeepCopyMsgDelegate(v)
+	case *MsgDelegate:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgDelegate(*v)
+		return &res
+	case MsgDeposit:
+		return DeepCopyMsgDeposit(v)
+	case *MsgDeposit:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgDeposit(*v)
+		return &res
+	case MsgDonateToCommunityPool:
+		return DeepCopyMsgDonateToCommunityPool(v)
+	case *MsgDonateToCommunityPool:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgDonateToCommunityPool(*v)
+		return &res
+	case MsgEditValidator:
+		return DeepCopyMsgEditValidator(v)
+	case *MsgEditValidator:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgEditValidator(*v)
+		return &res
+	case MsgForbidAddr:
+		return DeepCopyMsgForbidAddr(v)
+	case *MsgForbidAddr:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgForbidAddr(*v)
+		return &res
+	case MsgForbidToken:
+		return DeepCopyMsgForbidToken(v)
+	case *MsgForbidToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgForbidToken(*v)
+		return &res
+	case MsgIssueToken:
+		return DeepCopyMsgIssueToken(v)
+	case *MsgIssueToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgIssueToken(*v)
+		return &res
+	case MsgMintToken:
+		return DeepCopyMsgMintToken(v)
+	case *MsgMintToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgMintToken(*v)
+		return &res
+	case MsgModifyPricePrecision:
+		return DeepCopyMsgModifyPricePrecision(v)
+	case *MsgModifyPricePrecision:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgModifyPricePrecision(*v)
+		return &res
+	case MsgModifyTokenInfo:
+		return DeepCopyMsgModifyTokenInfo(v)
+	case *MsgModifyTokenInfo:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgModifyTokenInfo(*v)
+		return &res
+	case MsgMultiSend:
+		return DeepCopyMsgMultiSend(v)
+	case *MsgMultiSend:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgMultiSend(*v)
+		return &res
+	case MsgMultiSendX:
+		return DeepCopyMsgMultiSendX(v)
+	case *MsgMultiSendX:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgMultiSendX(*v)
+		return &res
+	case MsgRemoveLiquidity:
+		return DeepCopyMsgRemoveLiquidity(v)
+	case *MsgRemoveLiquidity:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgRemoveLiquidity(*v)
+		return &res
+	case MsgRemoveTokenWhitelist:
+		return DeepCopyMsgRemoveTokenWhitelist(v)
+	case *MsgRemoveTokenWhitelist:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgRemoveTokenWhitelist(*v)
+		return &res
+	case MsgSend:
+		return DeepCopyMsgSend(v)
+	case *MsgSend:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSend(*v)
+		return &res
+	case MsgSendX:
+		return DeepCopyMsgSendX(v)
+	case *MsgSendX:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSendX(*v)
+		return &res
+	case MsgSetMemoRequired:
+		return DeepCopyMsgSetMemoRequired(v)
+	case *MsgSetMemoRequired:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSetMemoRequired(*v)
+		return &res
+	case MsgSetReferee:
+		return DeepCopyMsgSetReferee(v)
+	case *MsgSetReferee:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSetReferee(*v)
+		return &res
+	case MsgSetWithdrawAddress:
+		return DeepCopyMsgSetWithdrawAddress(v)
+	case *MsgSetWithdrawAddress:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSetWithdrawAddress(*v)
+		return &res
+	case MsgSubmitProposal:
+		return DeepCopyMsgSubmitProposal(v)
+	case *MsgSubmitProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSubmitProposal(*v)
+		return &res
+	case MsgSupervisedSend:
+		return DeepCopyMsgSupervisedSend(v)
+	case *MsgSupervisedSend:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSupervisedSend(*v)
+		return &res
+	case MsgTransferOwnership:
+		return DeepCopyMsgTransferOwnership(v)
+	case *MsgTransferOwnership:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgTransferOwnership(*v)
+		return &res
+	case MsgUnForbidAddr:
+		return DeepCopyMsgUnForbidAddr(v)
+	case *MsgUnForbidAddr:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgUnForbidAddr(*v)
+		return &res
+	case MsgUnForbidToken:
+		return DeepCopyMsgUnForbidToken(v)
+	case *MsgUnForbidToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgUnForbidToken(*v)
+		return &res
+	case MsgUndelegate:
+		return DeepCopyMsgUndelegate(v)
+	case *MsgUndelegate:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgUndelegate(*v)
+		return &res
+	case MsgUnjail:
+		return DeepCopyMsgUnjail(v)
+	case *MsgUnjail:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgUnjail(*v)
+		return &res
+	case MsgVerifyInvariant:
+		return DeepCopyMsgVerifyInvariant(v)
+	case *MsgVerifyInvariant:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgVerifyInvariant(*v)
+		return &res
+	case MsgVote:
+		return DeepCopyMsgVote(v)
+	case *MsgVote:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgVote(*v)
+		return &res
+	case MsgWithdrawDelegatorReward:
+		return DeepCopyMsgWithdrawDelegatorReward(v)
+	case *MsgWithdrawDelegatorReward:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgWithdrawDelegatorReward(*v)
+		return &res
+	case MsgWithdrawValidatorCommission:
+		return DeepCopyMsgWithdrawValidatorCommission(v)
+	case *MsgWithdrawValidatorCommission:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgWithdrawValidatorCommission(*v)
+		return &res
 	default:
-		panic("Unknown Type.")
+		panic("Unknown type")
 	} // end of switch
-} // end of func
-func DecodeAccount(bz []byte) (Account, int, error) {
-	var v Account
-	var magicBytes [4]byte
-	var n int
-	for i := 0; i < 4; i++ {
-		magicBytes[i] = bz[i]
+} //End of DeepCopyMsg
+
+func EqualMsg(x, y Msg) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
 	}
-	switch magicBytes {
-	case [4]byte{178, 47, 121, 129}:
-		v, n, err := DecodeBaseVestingAccount(bz[4:])
-		return v, n + 4, err
-	case [4]byte{95, 96, 75, 0}:
-		v, n, err := DecodeContinuousVestingAccount(bz[4:])
-		return v, n + 4, err
-	case [4]byte{187, 71, 224, 1}:
-		v, n, err := DecodeDelayedVestingAccount(bz[4:])
-		return v, n + 4, err
-	case [4]byte{190, 107, 1, 124}:
-		v, n, err := DecodeModuleAccount(bz[4:])
-		return v, n + 4, err
+	switch v := x.(type) {
+	case MsgAddLiquidity:
+		w, ok := y.(MsgAddLiquidity)
+		return ok && EqualMsgAddLiquidity(v, w)
+	case *MsgAddLiquidity:
+		w, ok := y.(*MsgAddLiquidity)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAddLiquidity(*v, *w))
+	case MsgAddTokenWhitelist:
+		w, ok := y.(MsgAddTokenWhitelist)
+		return ok && EqualMsgAddTokenWhitelist(v, w)
+	case *MsgAddTokenWhitelist:
+		w, ok := y.(*MsgAddTokenWhitelist)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAddTokenWhitelist(*v, *w))
+	case MsgAliasUpdate:
+		w, ok := y.(MsgAliasUpdate)
+		return ok && EqualMsgAliasUpdate(v, w)
+	case *MsgAliasUpdate:
+		w, ok := y.(*MsgAliasUpdate)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAliasUpdate(*v, *w))
+	case MsgAutoSwapCancelOrder:
+		w, ok := y.(MsgAutoSwapCancelOrder)
+		return ok && EqualMsgAutoSwapCancelOrder(v, w)
+	case *MsgAutoSwapCancelOrder:
+		w, ok := y.(*MsgAutoSwapCancelOrder)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAutoSwapCancelOrder(*v, *w))
+	case MsgAutoSwapCreateOrder:
+		w, ok := y.(MsgAutoSwapCreateOrder)
+		return ok && EqualMsgAutoSwapCreateOrder(v, w)
+	case *MsgAutoSwapCreateOrder:
+		w, ok := y.(*MsgAutoSwapCreateOrder)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAutoSwapCreateOrder(*v, *w))
+	case MsgBancorCancel:
+		w, ok := y.(MsgBancorCancel)
+		return ok && EqualMsgBancorCancel(v, w)
+	case *MsgBancorCancel:
+		w, ok := y.(*MsgBancorCancel)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBancorCancel(*v, *w))
+	case MsgBancorInit:
+		w, ok := y.(MsgBancorInit)
+		return ok && EqualMsgBancorInit(v, w)
+	case *MsgBancorInit:
+		w, ok := y.(*MsgBancorInit)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBancorInit(*v, *w))
+	case MsgBancorTrade:
+		w, ok := y.(MsgBancorTrade)
+		return ok && EqualMsgBancorTrade(v, w)
+	case *MsgBancorTrade:
+		w, ok := y.(*MsgBancorTrade)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBancorTrade(*v, *w))
+	case MsgBeginRedelegate:
+		w, ok := y.(MsgBeginRedelegate)
+		return ok && EqualMsgBeginRedelegate(v, w)
+	case *MsgBeginRedelegate:
+		w, ok := y.(*MsgBeginRedelegate)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBeginRedelegate(*v, *w))
+	case MsgBurnToken:
+		w, ok := y.(MsgBurnToken)
+		return ok && EqualMsgBurnToken(v, w)
+	case *MsgBurnToken:
+		w, ok := y.(*MsgBurnToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBurnToken(*v, *w))
+	case MsgCancelOrder:
+		w, ok := y.(MsgCancelOrder)
+		return ok && EqualMsgCancelOrder(v, w)
+	case *MsgCancelOrder:
+		w, ok := y.(*MsgCancelOrder)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCancelOrder(*v, *w))
+	case MsgCancelTradingPair:
+		w, ok := y.(MsgCancelTradingPair)
+		return ok && EqualMsgCancelTradingPair(v, w)
+	case *MsgCancelTradingPair:
+		w, ok := y.(*MsgCancelTradingPair)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCancelTradingPair(*v, *w))
+	case MsgCommentToken:
+		w, ok := y.(MsgCommentToken)
+		return ok && EqualMsgCommentToken(v, w)
+	case *MsgCommentToken:
+		w, ok := y.(*MsgCommentToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCommentToken(*v, *w))
+	case MsgCreateOrder:
+		w, ok := y.(MsgCreateOrder)
+		return ok && EqualMsgCreateOrder(v, w)
+	case *MsgCreateOrder:
+		w, ok := y.(*MsgCreateOrder)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCreateOrder(*v, *w))
+	case MsgCreateTradingPair:
+		w, ok := y.(MsgCreateTradingPair)
+		return ok && EqualMsgCreateTradingPair(v, w)
+	case *MsgCreateTradingPair:
+		w, ok := y.(*MsgCreateTradingPair)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCreateTradingPair(*v, *w))
+	case MsgCreateValidator:
+		w, ok := y.(MsgCreateValidator)
+		return ok && EqualMsgCreateValidator(v, w)
+	case *MsgCreateValidator:
+		w, ok := y.(*MsgCreateValidator)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCreateValidator(*v, *w))
+	case MsgDelegate:
+		w, ok := y.(MsgDelegate)
+		return ok && EqualMsgDelegate(v, w)
+	case *MsgDelegate:
+		w, ok := y.(*MsgDelegate)
+		return ok && (v == w || v != nil && w != nil && EqualMsgDelegate(*v, *w))
+	case MsgDeposit:
+		w, ok := y.(MsgDeposit)
+		return ok && EqualMsgDeposit(v, w)
+	case *MsgDeposit:
+		w, ok := y.(*MsgDeposit)
+		return ok && (v == w || v != nil && w != nil && EqualMsgDeposit(*v, *w))
+	case MsgDonateToCommunityPool:
+		w, ok := y.(MsgDonateToCommunityPool)
+		return ok && EqualMsgDonateToCommunityPool(v, w)
+	case *MsgDonateToCommunityPool:
+		w, ok := y.(*MsgDonateToCommunityPool)
+		return ok && (v == w || v != nil && w != nil && EqualMsgDonateToCommunityPool(*v, *w))
+	case MsgEditValidator:
+		w, ok := y.(MsgEditValidator)
+		return ok && EqualMsgEditValidator(v, w)
+	case *MsgEditValidator:
+		w, ok := y.(*MsgEditValidator)
+		return ok && (v == w || v != nil && w != nil && EqualMsgEditValidator(*v, *w))
+	case MsgForbidAddr:
+		w, ok := y.(MsgForbidAddr)
+		return ok && EqualMsgForbidAddr(v, w)
+	case *MsgForbidAddr:
+		w, ok := y.(*MsgForbidAddr)
+		return ok && (v == w || v != nil && w != nil && EqualMsgForbidAddr(*v, *w))
+	case MsgForbidToken:
+		w, ok := y.(MsgForbidToken)
+		return ok && EqualMsgForbidToken(v, w)
+	case *MsgForbidToken:
+		w, ok := y.(*MsgForbidToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgForbidToken(*v, *w))
+	case MsgIssueToken:
+		w, ok := y.(MsgIssueToken)
+		return ok && EqualMsgIssueToken(v, w)
+	case *MsgIssueToken:
+		w, ok := y.(*MsgIssueToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgIssueToken(*v, *w))
+	case MsgMintToken:
+		w, ok := y.(MsgMintToken)
+		return ok && EqualMsgMintToken(v, w)
+	case *MsgMintToken:
+		w, ok := y.(*MsgMintToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgMintToken(*v, *w))
+	case MsgModifyPricePrecision:
+		w, ok := y.(MsgModifyPricePrecision)
+		return ok && EqualMsgModifyPricePrecision(v, w)
+	case *MsgModifyPricePrecision:
+		w, ok := y.(*MsgModifyPricePrecision)
+		return ok && (v == w || v != nil && w != nil && EqualMsgModifyPricePrecision(*v, *w))
+	case MsgModifyTokenInfo:
+		w, ok := y.(MsgModifyTokenInfo)
+		return ok && EqualMsgModifyTokenInfo(v, w)
+	case *MsgModifyTokenInfo:
+		w, ok := y.(*MsgModifyTokenInfo)
+		return ok && (v == w || v != nil && w != nil && EqualMsgModifyTokenInfo(*v, *w))
+	case MsgMultiSend:
+		w, ok := y.(MsgMultiSend)
+		return ok && EqualMsgMultiSend(v, w)
+	case *MsgMultiSend:
+		w, ok := y.(*MsgMultiSend)
+		return ok && (v == w || v != nil && w != nil && EqualMsgMultiSend(*v, *w))
+	case MsgMultiSendX:
+		w, ok := y.(MsgMultiSendX)
+		return ok && EqualMsgMultiSendX(v, w)
+	case *MsgMultiSendX:
+		w, ok := y.(*MsgMultiSendX)
+		return ok && (v == w || v != nil && w != nil && EqualMsgMultiSendX(*v, *w))
+	case MsgRemoveLiquidity:
+		w, ok := y.(MsgRemoveLiquidity)
+		return ok && EqualMsgRemoveLiquidity(v, w)
+	case *MsgRemoveLiquidity:
+		w, ok := y.(*MsgRemoveLiquidity)
+		return ok && (v == w || v != nil && w != nil && EqualMsgRemoveLiquidity(*v, *w))
+	case MsgRemoveTokenWhitelist:
+		w, ok := y.(MsgRemoveTokenWhitelist)
+		return ok && EqualMsgRemoveTokenWhitelist(v, w)
+	case *MsgRemoveTokenWhitelist:
+		w, ok := y.(*MsgRemoveTokenWhitelist)
+		return ok && (v == w || v != nil && w != nil && EqualMsgRemoveTokenWhitelist(*v, *w))
+	case MsgSend:
+		w, ok := y.(MsgSend)
+		return ok && EqualMsgSend(v, w)
+	case *MsgSend:
+		w, ok := y.(*MsgSend)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSend(*v, *w))
+	case MsgSendX:
+		w, ok := y.(MsgSendX)
+		return ok && EqualMsgSendX(v, w)
+	case *MsgSendX:
+		w, ok := y.(*MsgSendX)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSendX(*v, *w))
+	case MsgSetMemoRequired:
+		w, ok := y.(MsgSetMemoRequired)
+		return ok && EqualMsgSetMemoRequired(v, w)
+	case *MsgSetMemoRequired:
+		w, ok := y.(*MsgSetMemoRequired)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSetMemoRequired(*v, *w))
+	case MsgSetReferee:
+		w, ok := y.(MsgSetReferee)
+		return ok && EqualMsgSetReferee(v, w)
+	case *MsgSetReferee:
+		w, ok := y.(*MsgSetReferee)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSetReferee(*v, *w))
+	case MsgSetWithdrawAddress:
+		w, ok := y.(MsgSetWithdrawAddress)
+		return ok && EqualMsgSetWithdrawAddress(v, w)
+	case *MsgSetWithdrawAddress:
+		w, ok := y.(*MsgSetWithdrawAddress)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSetWithdrawAddress(*v, *w))
+	case MsgSubmitProposal:
+		w, ok := y.(MsgSubmitProposal)
+		return ok && EqualMsgSubmitProposal(v, w)
+	case *MsgSubmitProposal:
+		w, ok := y.(*MsgSubmitProposal)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSubmitProposal(*v, *w))
+	case MsgSupervisedSend:
+		w, ok := y.(MsgSupervisedSend)
+		return ok && EqualMsgSupervisedSend(v, w)
+	case *MsgSupervisedSend:
+		w, ok := y.(*MsgSupervisedSend)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSupervisedSend(*v, *w))
+	case MsgTransferOwnership:
+		w, ok := y.(MsgTransferOwnership)
+		return ok && EqualMsgTransferOwnership(v, w)
+	case *MsgTransferOwnership:
+		w, ok := y.(*MsgTransferOwnership)
+		return ok && (v == w || v != nil && w != nil && EqualMsgTransferOwnership(*v, *w))
+	case MsgUnForbidAddr:
+		w, ok := y.(MsgUnForbidAddr)
+		return ok && EqualMsgUnForbidAddr(v, w)
+	case *MsgUnForbidAddr:
+		w, ok := y.(*MsgUnForbidAddr)
+		return ok && (v == w || v != nil && w != nil && EqualMsgUnForbidAddr(*v, *w))
+	case MsgUnForbidToken:
+		w, ok := y.(MsgUnForbidToken)
+		return ok && EqualMsgUnForbidToken(v, w)
+	case *MsgUnForbidToken:
+		w, ok := y.(*MsgUnForbidToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgUnForbidToken(*v, *w))
+	case MsgUndelegate:
+		w, ok := y.(MsgUndelegate)
+		return ok && EqualMsgUndelegate(v, w)
+	case *MsgUndelegate:
+		w, ok := y.(*MsgUndelegate)
+		return ok && (v == w || v != nil && w != nil && EqualMsgUndelegate(*v, *w))
+	case MsgUnjail:
+		w, ok := y.(MsgUnjail)
+		return ok && EqualMsgUnjail(v, w)
+	case *MsgUnjail:
+		w, ok := y.(*MsgUnjail)
+		return ok && (v == w || v != nil && w != nil && EqualMsgUnjail(*v, *w))
+	case MsgVerifyInvariant:
+		w, ok := y.(MsgVerifyInvariant)
+		return ok && EqualMsgVerifyInvariant(v, w)
+	case *MsgVerifyInvariant:
+		w, ok := y.(*MsgVerifyInvariant)
+		return ok && (v == w || v != nil && w != nil && EqualMsgVerifyInvariant(*v, *w))
+	case MsgVote:
+		w, ok := y.(MsgVote)
+		return ok && EqualMsgVote(v, w)
+	case *MsgVote:
+		w, ok := y.(*MsgVote)
+		return ok && (v == w || v != nil && w != nil && EqualMsgVote(*v, *w))
+	case MsgWithdrawDelegatorReward:
+		w, ok := y.(MsgWithdrawDelegatorReward)
+		return ok && EqualMsgWithdrawDelegatorReward(v, w)
+	case *MsgWithdrawDelegatorReward:
+		w, ok := y.(*MsgWithdrawDelegatorReward)
+		return ok && (v == w || v != nil && w != nil && EqualMsgWithdrawDelegatorReward(*v, *w))
+	case MsgWithdrawValidatorCommission:
+		w, ok := y.(MsgWithdrawValidatorCommission)
+		return ok && EqualMsgWithdrawValidatorCommission(v, w)
+	case *MsgWithdrawValidatorCommission:
+		w, ok := y.(*MsgWithdrawValidatorCommission)
+		return ok && (v == w || v != nil && w != nil && EqualMsgWithdrawValidatorCommission(*v, *w))
 	default:
 		panic("Unknown type")
 	} // end of switch
-	return v, n, nil
-} // end of DecodeAccount
-func RandAccount(r RandSrc) Account {
-	switch r.GetUint() % 4 {
-	case 0:
-		return RandBaseVestingAccount(r)
-	case 1:
-		return RandContinuousVestingAccount(r)
-	case 2:
-		return RandDelayedVestingAccount(r)
-	case 3:
-		return RandModuleAccount(r)
-	default:
-		panic("Unknown Type.")
-	} // end of switch
-} // end of func
-// Interface
-func EncodeContent(w io.Writer, x interface{}) error {
+} //End of EqualMsg
+
+func DeepCopyPubKey(x PubKey) PubKey {
 	switch v := x.(type) {
-	case CommunityPoolSpendProposal:
-		w.Write(getMagicBytes("CommunityPoolSpendProposal"))
-		return EncodeCommunityPoolSpendProposal(w, v)
-	case *CommunityPoolSpendProposal:
-		w.Write(getMagicBytes("CommunityPoolSpendProposal"))
-		return EncodeCommunityPoolSpendProposal(w, *v)
-	case ParameterChangeProposal:
-		w.Write(getMagicBytes("ParameterChangeProposal"))
-		return EncodeParameterChangeProposal(w, v)
-	case *ParameterChangeProposal:
-		w.Write(getMagicBytes("ParameterChangeProposal"))
-		return EncodeParameterChangeProposal(w, *v)
-	case SoftwareUpgradeProposal:
-		w.Write(getMagicBytes("SoftwareUpgradeProposal"))
-		return EncodeSoftwareUpgradeProposal(w, v)
-	case *SoftwareUpgradeProposal:
-		w.Write(getMagicBytes("SoftwareUpgradeProposal"))
-		return EncodeSoftwareUpgradeProposal(w, *v)
-	case TextProposal:
-		w.Write(getMagicBytes("TextProposal"))
-		return EncodeTextProposal(w, v)
-	case *TextProposal:
-		w.Write(getMagicBytes("TextProposal"))
-		return EncodeTextProposal(w, *v)
-	default:
-		panic("Unknown Type.")
-	} // end of switch
-} // end of func
-func DecodeContent(bz []byte) (Content, int, error) {
-	var v Content
-	var magicBytes [4]byte
-	var n int
-	for i := 0; i < 4; i++ {
-		magicBytes[i] = bz[i]
-	}
-	switch magicBytes {
-	case [4]byte{37, 214, 119, 170}:
-		v, n, err := DecodeCommunityPoolSpendProposal(bz[4:])
-		return v, n + 4, err
-	case [4]byte{166, 63, 172, 210}:
-		v, n, err := DecodeParameterChangeProposal(bz[4:])
-		return v, n + 4, err
-	case [4]byte{37, 100, 208, 251}:
-		v, n, err := DecodeSoftwareUpgradeProposal(bz[4:])
-		return v, n + 4, err
-	case [4]byte{169, 32, 176, 245}:
-		v, n, err := DecodeTextProposal(bz[4:])
-		return v, n + 4, err
+	case nil:
+		return nil
+	case PubKeyEd25519:
+		return DeepCopyPubKeyEd25519(v)
+	case *PubKeyEd25519:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPubKeyEd25519(*v)
+		return &res
+	case PubKeyMultisigThreshold:
+		return DeepCopyPubKeyMultisigThreshold(v)
+	case *PubKeyMultisigThreshold:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPubKeyMultisigThreshold(*v)
+		return &res
+	case PubKeySecp256k1:
+		return DeepCopyPubKeySecp256k1(v)
+	case *PubKeySecp256k1:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPubKeySecp256k1(*v)
+		return &res
+	case StdSignature:
+		return DeepCopyStdSignature(v)
+	case *StdSignature:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyStdSignature(*v)
+		return &res
 	default:
 		panic("Unknown type")
 	} // end of switch
-	return v, n, nil
-} // end of DecodeContent
-func RandContent(r RandSrc) Content {
-	switch r.GetUint() % 4 {
-	case 0:
-		return RandCommunityPoolSpendProposal(r)
-	case 1:
-		return RandParameterChangeProposal(r)
-	case 2:
-		return RandSoftwareUpgradeProposal(r)
-	case 3:
-		return RandTextProposal(r)
+} //End of DeepCopyPubKey
+
+func EqualPubKey(x, y PubKey) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	switch v := x.(type) {
+	case PubKeyEd25519:
+		w, ok := y.(PubKeyEd25519)
+		return ok && EqualPubKeyEd25519(v, w)
+	case *PubKeyEd25519:
+		w, ok := y.(*PubKeyEd25519)
+		return ok && (v == w || v != nil && w != nil && EqualPubKeyEd25519(*v, *w))
+	case PubKeyMultisigThreshold:
+		w, ok := y.(PubKeyMultisigThreshold)
+		return ok && EqualPubKeyMultisigThreshold(v, w)
+	case *PubKeyMultisigThreshold:
+		w, ok := y.(*PubKeyMultisigThreshold)
+		return ok && (v == w || v != nil && w != nil && EqualPubKeyMultisigThreshold(*v, *w))
+	case PubKeySecp256k1:
+		w, ok := y.(PubKeySecp256k1)
+		return ok && EqualPubKeySecp256k1(v, w)
+	case *PubKeySecp256k1:
+		w, ok := y.(*PubKeySecp256k1)
+		return ok && (v == w || v != nil && w != nil && EqualPubKeySecp256k1(*v, *w))
+	case StdSignature:
+		w, ok := y.(StdSignature)
+		return ok && EqualStdSignature(v, w)
+	case *StdSignature:
+		w, ok := y.(*StdSignature)
+		return ok && (v == w || v != nil && w != nil && EqualStdSignature(*v, *w))
 	default:
-		panic("Unknown Type.")
+		panic("Unknown type")
 	} // end of switch
-} // end of func
-func getMagicBytes(name string) []byte {
-	switch name {
-	case "AccAddress":
-		return []byte{37, 50, 37, 208}
-	case "AccountX":
-		return []byte{52, 40, 250, 245}
-	case "BaseAccount":
-		return []byte{100, 94, 81, 72}
-	case "BaseToken":
-		return []byte{34, 178, 244, 51}
-	case "BaseVestingAccount":
-		return []byte{178, 47, 121, 129}
-	case "Coin":
-		return []byte{141, 140, 97, 80}
-	case "CommentRef":
-		return []byte{17, 162, 164, 235}
-	case "CommunityPoolSpendProposal":
-		return []byte{37, 214, 119, 170}
-	case "ContinuousVestingAccount":
-		return []byte{95, 96, 75, 0}
-	case "DelayedVestingAccount":
-		return []byte{187, 71, 224, 1}
-	case "DuplicateVoteEvidence":
-		return []byte{130, 76, 198, 17}
-	case "Input":
-		return []byte{165, 152, 189, 47}
-	case "LockedCoin":
-		return []byte{227, 236, 168, 93}
-	case "MarketInfo":
-		return []byte{174, 117, 167, 230}
-	case "ModuleAccount":
-		return []byte{190, 107, 1, 124}
-	case "MsgAddTokenWhitelist":
-		return []byte{147, 136, 220, 215}
-	case "MsgAliasUpdate":
-		return []byte{173, 181, 17, 162}
-	case "MsgBancorCancel":
-		return []byte{187, 190, 104, 91}
-	case "MsgBancorInit":
-		return []byte{177, 152, 232, 60}
-	case "MsgBancorTrade":
-		return []byte{225, 122, 18, 80}
-	case "MsgBeginRedelegate":
-		return []byte{247, 3, 0, 105}
-	case "MsgBurnToken":
-		return []byte{228, 0, 236, 212}
-	case "MsgCancelOrder":
-		return []byte{106, 229, 80, 141}
-	case "MsgCancelTradingPair":
-		return []byte{13, 177, 95, 127}
-	case "MsgCommentToken":
-		return []byte{79, 125, 235, 121}
-	case "MsgCreateOrder":
-		return []byte{246, 238, 7, 164}
-	case "MsgCreateTradingPair":
-		return []byte{130, 221, 55, 57}
-	case "MsgCreateValidator":
-		return []byte{58, 78, 252, 114}
-	case "MsgDelegate":
-		return []byte{1, 82, 140, 71}
-	case "MsgDeposit":
-		return []byte{205, 134, 140, 190}
-	case "MsgDonateToCommunityPool":
-		return []byte{20, 250, 115, 197}
-	case "MsgEditValidator":
-		return []byte{202, 62, 140, 8}
-	case "MsgForbidAddr":
-		return []byte{105, 235, 112, 10}
-	case "MsgForbidToken":
-		return []byte{36, 174, 203, 238}
-	case "MsgIssueToken":
-		return []byte{233, 180, 92, 129}
-	case "MsgMintToken":
-		return []byte{66, 148, 56, 203}
-	case "MsgModifyPricePrecision":
-		return []byte{76, 91, 156, 199}
-	case "MsgModifyTokenInfo":
-		return []byte{40, 158, 40, 18}
-	case "MsgMultiSend":
-		return []byte{207, 152, 156, 90}
-	case "MsgMultiSendX":
-		return []byte{61, 117, 88, 200}
-	case "MsgRemoveTokenWhitelist":
-		return []byte{44, 154, 68, 83}
-	case "MsgSend":
-		return []byte{100, 168, 39, 140}
-	case "MsgSendX":
-		return []byte{198, 76, 8, 81}
-	case "MsgSetMemoRequired":
-		return []byte{184, 238, 253, 154}
-	case "MsgSetWithdrawAddress":
-		return []byte{190, 178, 173, 144}
-	case "MsgSubmitProposal":
-		return []byte{115, 119, 137, 48}
-	case "MsgTransferOwnership":
-		return []byte{200, 224, 118, 175}
-	case "MsgUnForbidAddr":
-		return []byte{167, 165, 166, 227}
-	case "MsgUnForbidToken":
-		return []byte{78, 83, 156, 139}
-	case "MsgUndelegate":
-		return []byte{122, 66, 160, 76}
-	case "MsgUnjail":
-		return []byte{216, 247, 180, 46}
-	case "MsgVerifyInvariant":
-		return []byte{84, 44, 219, 65}
-	case "MsgVote":
-		return []byte{238, 246, 67, 141}
-	case "MsgWithdrawDelegatorReward":
-		return []byte{94, 251, 176, 152}
-	case "MsgWithdrawValidatorCommission":
-		return []byte{18, 172, 190, 152}
-	case "Order":
-		return []byte{57, 115, 63, 27}
+} //End of EqualPubKey
 
-	case "Output":
-		return []byte{251, 0, 54, 127}
-	case "ParamChange":
-		return []byte{234, 101, 49, 27}
-	case "ParameterChangeProposal":
-		return []byte{166, 63, 172, 210}
-	case "PrivKeyEd25519":
-		return []byte{93, 160, 108, 51}
-	case "PrivKeySecp256k1":
-		return []byte{209, 107, 141, 98}
-	case "PubKeyEd25519":
-		return []byte{108, 143, 2, 48}
-	case "PubKeyMultisigThreshold":
-		return []byte{131, 227, 102, 173}
-	case "PubKeySecp256k1":
-		return []byte{10, 126, 85, 105}
-	case "SignedMsgType":
-		return []byte{169, 174, 252, 87}
-	case "SoftwareUpgradeProposal":
-		return []byte{37, 100, 208, 251}
-	case "State":
-		return []byte{186, 223, 120, 4}
-	case "StdSignature":
-		return []byte{88, 244, 106, 18}
-	case "StdTx":
-		return []byte{71, 175, 179, 184}
-	case "Supply":
-		return []byte{233, 209, 209, 86}
-	case "TextProposal":
-		return []byte{169, 32, 176, 245}
-	case "Vote":
-		return []byte{113, 227, 24, 224}
-	case "VoteOption":
-		return []byte{56, 159, 20, 227}
+func DeepCopyAny(x interface{}) interface{} {
+	switch v := x.(type) {
+	case AccAddress:
+		return DeepCopyAccAddress(v)
+	case *AccAddress:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyAccAddress(*v)
+		return &res
+	case AccountX:
+		return DeepCopyAccountX(v)
+	case *AccountX:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyAccountX(*v)
+		return &res
+	case BaseAccount:
+		return DeepCopyBaseAccount(v)
+	case *BaseAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyBaseAccount(*v)
+		return &res
+	case BaseToken:
+		return DeepCopyBaseToken(v)
+	case *BaseToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyBaseToken(*v)
+		return &res
+	case BaseVestingAccount:
+		return DeepCopyBaseVestingAccount(v)
+	case *BaseVestingAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyBaseVestingAccount(*v)
+		return &res
+	case Coin:
+		return DeepCopyCoin(v)
+	case *Coin:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyCoin(*v)
+		return &res
+	case CommentRef:
+		return DeepCopyCommentRef(v)
+	case *CommentRef:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyCommentRef(*v)
+		return &res
+	case CommunityPoolSpendProposal:
+		return DeepCopyCommunityPoolSpendProposal(v)
+	case *CommunityPoolSpendProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyCommunityPoolSpendProposal(*v)
+		return &res
+	case ContinuousVestingAccount:
+		return DeepCopyContinuousVestingAccount(v)
+	case *ContinuousVestingAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyContinuousVestingAccount(*v)
+		return &res
+	case DelayedVestingAccount:
+		return DeepCopyDelayedVestingAccount(v)
+	case *DelayedVestingAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyDelayedVestingAccount(*v)
+		return &res
+	case DuplicateVoteEvidence:
+		return DeepCopyDuplicateVoteEvidence(v)
+	case *DuplicateVoteEvidence:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyDuplicateVoteEvidence(*v)
+		return &res
+	case Input:
+		return DeepCopyInput(v)
+	case *Input:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyInput(*v)
+		return &res
+	case LockedCoin:
+		return DeepCopyLockedCoin(v)
+	case *LockedCoin:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyLockedCoin(*v)
+		return &res
+	case MarketInfo:
+		return DeepCopyMarketInfo(v)
+	case *MarketInfo:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMarketInfo(*v)
+		return &res
+	case ModuleAccount:
+		return DeepCopyModuleAccount(v)
+	case *ModuleAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyModuleAccount(*v)
+		return &res
+	case MsgAddLiquidity:
+		return DeepCopyMsgAddLiquidity(v)
+	case *MsgAddLiquidity:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAddLiquidity(*v)
+		return &res
+	case MsgAddTokenWhitelist:
+		return DeepCopyMsgAddTokenWhitelist(v)
+	case *MsgAddTokenWhitelist:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAddTokenWhitelist(*v)
+		return &res
+	case MsgAliasUpdate:
+		return DeepCopyMsgAliasUpdate(v)
+	case *MsgAliasUpdate:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAliasUpdate(*v)
+		return &res
+	case MsgAutoSwapCancelOrder:
+		return DeepCopyMsgAutoSwapCancelOrder(v)
+	case *MsgAutoSwapCancelOrder:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAutoSwapCancelOrder(*v)
+		return &res
+	case MsgAutoSwapCreateOrder:
+		return DeepCopyMsgAutoSwapCreateOrder(v)
+	case *MsgAutoSwapCreateOrder:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgAutoSwapCreateOrder(*v)
+		return &res
+	case MsgBancorCancel:
+		return DeepCopyMsgBancorCancel(v)
+	case *MsgBancorCancel:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBancorCancel(*v)
+		return &res
+	case MsgBancorInit:
+		return DeepCopyMsgBancorInit(v)
+	case *MsgBancorInit:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBancorInit(*v)
+		return &res
+	case MsgBancorTrade:
+		return DeepCopyMsgBancorTrade(v)
+	case *MsgBancorTrade:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBancorTrade(*v)
+		return &res
+	case MsgBeginRedelegate:
+		return DeepCopyMsgBeginRedelegate(v)
+	case *MsgBeginRedelegate:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBeginRedelegate(*v)
+		return &res
+	case MsgBurnToken:
+		return DeepCopyMsgBurnToken(v)
+	case *MsgBurnToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgBurnToken(*v)
+		return &res
+	case MsgCancelOrder:
+		return DeepCopyMsgCancelOrder(v)
+	case *MsgCancelOrder:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCancelOrder(*v)
+		return &res
+	case MsgCancelTradingPair:
+		return DeepCopyMsgCancelTradingPair(v)
+	case *MsgCancelTradingPair:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCancelTradingPair(*v)
+		return &res
+	case MsgCommentToken:
+		return DeepCopyMsgCommentToken(v)
+	case *MsgCommentToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCommentToken(*v)
+		return &res
+	case MsgCreateOrder:
+		return DeepCopyMsgCreateOrder(v)
+	case *MsgCreateOrder:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCreateOrder(*v)
+		return &res
+	case MsgCreateTradingPair:
+		return DeepCopyMsgCreateTradingPair(v)
+	case *MsgCreateTradingPair:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCreateTradingPair(*v)
+		return &res
+	case MsgCreateValidator:
+		return DeepCopyMsgCreateValidator(v)
+	case *MsgCreateValidator:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgCreateValidator(*v)
+		return &res
+	case MsgDelegate:
+		return DeepCopyMsgDelegate(v)
+	case *MsgDelegate:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgDelegate(*v)
+		return &res
+	case MsgDeposit:
+		return DeepCopyMsgDeposit(v)
+	case *MsgDeposit:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgDeposit(*v)
+		return &res
+	case MsgDonateToCommunityPool:
+		return DeepCopyMsgDonateToCommunityPool(v)
+	case *MsgDonateToCommunityPool:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgDonateToCommunityPool(*v)
+		return &res
+	case MsgEditValidator:
+		return DeepCopyMsgEditValidator(v)
+	case *MsgEditValidator:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgEditValidator(*v)
+		return &res
+	case MsgForbidAddr:
+		return DeepCopyMsgForbidAddr(v)
+	case *MsgForbidAddr:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgForbidAddr(*v)
+		return &res
+	case MsgForbidToken:
+		return DeepCopyMsgForbidToken(v)
+	case *MsgForbidToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgForbidToken(*v)
+		return &res
+	case MsgIssueToken:
+		return DeepCopyMsgIssueToken(v)
+	case *MsgIssueToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgIssueToken(*v)
+		return &res
+	case MsgMintToken:
+		return DeepCopyMsgMintToken(v)
+	case *MsgMintToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgMintToken(*v)
+		return &res
+	case MsgModifyPricePrecision:
+		return DeepCopyMsgModifyPricePrecision(v)
+	case *MsgModifyPricePrecision:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgModifyPricePrecision(*v)
+		return &res
+	case MsgModifyTokenInfo:
+		return DeepCopyMsgModifyTokenInfo(v)
+	case *MsgModifyTokenInfo:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgModifyTokenInfo(*v)
+		return &res
+	case MsgMultiSend:
+		return DeepCopyMsgMultiSend(v)
+	case *MsgMultiSend:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgMultiSend(*v)
+		return &res
+	case MsgMultiSendX:
+		return DeepCopyMsgMultiSendX(v)
+	case *MsgMultiSendX:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgMultiSendX(*v)
+		return &res
+	case MsgRemoveLiquidity:
+		return DeepCopyMsgRemoveLiquidity(v)
+	case *MsgRemoveLiquidity:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgRemoveLiquidity(*v)
+		return &res
+	case MsgRemoveTokenWhitelist:
+		return DeepCopyMsgRemoveTokenWhitelist(v)
+	case *MsgRemoveTokenWhitelist:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgRemoveTokenWhitelist(*v)
+		return &res
+	case MsgSend:
+		return DeepCopyMsgSend(v)
+	case *MsgSend:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSend(*v)
+		return &res
+	case MsgSendX:
+		return DeepCopyMsgSendX(v)
+	case *MsgSendX:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSendX(*v)
+		return &res
+	case MsgSetMemoRequired:
+		return DeepCopyMsgSetMemoRequired(v)
+	case *MsgSetMemoRequired:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSetMemoRequired(*v)
+		return &res
+	case MsgSetReferee:
+		return DeepCopyMsgSetReferee(v)
+	case *MsgSetReferee:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSetReferee(*v)
+		return &res
+	case MsgSetWithdrawAddress:
+		return DeepCopyMsgSetWithdrawAddress(v)
+	case *MsgSetWithdrawAddress:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSetWithdrawAddress(*v)
+		return &res
+	case MsgSubmitProposal:
+		return DeepCopyMsgSubmitProposal(v)
+	case *MsgSubmitProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSubmitProposal(*v)
+		return &res
+	case MsgSupervisedSend:
+		return DeepCopyMsgSupervisedSend(v)
+	case *MsgSupervisedSend:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgSupervisedSend(*v)
+		return &res
+	case MsgTransferOwnership:
+		return DeepCopyMsgTransferOwnership(v)
+	case *MsgTransferOwnership:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgTransferOwnership(*v)
+		return &res
+	case MsgUnForbidAddr:
+		return DeepCopyMsgUnForbidAddr(v)
+	case *MsgUnForbidAddr:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgUnForbidAddr(*v)
+		return &res
+	case MsgUnForbidToken:
+		return DeepCopyMsgUnForbidToken(v)
+	case *MsgUnForbidToken:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgUnForbidToken(*v)
+		return &res
+	case MsgUndelegate:
+		return DeepCopyMsgUndelegate(v)
+	case *MsgUndelegate:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgUndelegate(*v)
+		return &res
+	case MsgUnjail:
+		return DeepCopyMsgUnjail(v)
+	case *MsgUnjail:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgUnjail(*v)
+		return &res
+	case MsgVerifyInvariant:
+		return DeepCopyMsgVerifyInvariant(v)
+	case *MsgVerifyInvariant:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgVerifyInvariant(*v)
+		return &res
+	case MsgVote:
+		return DeepCopyMsgVote(v)
+	case *MsgVote:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgVote(*v)
+		return &res
+	case MsgWithdrawDelegatorReward:
+		return DeepCopyMsgWithdrawDelegatorReward(v)
+	case *MsgWithdrawDelegatorReward:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgWithdrawDelegatorReward(*v)
+		return &res
+	case MsgWithdrawValidatorCommission:
+		return DeepCopyMsgWithdrawValidatorCommission(v)
+	case *MsgWithdrawValidatorCommission:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyMsgWithdrawValidatorCommission(*v)
+		return &res
+	case Order:
+		return DeepCopyOrder(v)
+	case *Order:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyOrder(*v)
+		return &res
+	case Output:
+		return DeepCopyOutput(v)
+	case *Output:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyOutput(*v)
+		return &res
+	case ParamChange:
+		return DeepCopyParamChange(v)
+	case *ParamChange:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyParamChange(*v)
+		return &res
+	case ParameterChangeProposal:
+		return DeepCopyParameterChangeProposal(v)
+	case *ParameterChangeProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyParameterChangeProposal(*v)
+		return &res
+	case Period:
+		return DeepCopyPeriod(v)
+	case *Period:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPeriod(*v)
+		return &res
+	case PeriodicVestingAccount:
+		return DeepCopyPeriodicVestingAccount(v)
+	case *PeriodicVestingAccount:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPeriodicVestingAccount(*v)
+		return &res
+	case PrivKeyEd25519:
+		return DeepCopyPrivKeyEd25519(v)
+	case *PrivKeyEd25519:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPrivKeyEd25519(*v)
+		return &res
+	case PrivKeySecp256k1:
+		return DeepCopyPrivKeySecp256k1(v)
+	case *PrivKeySecp256k1:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPrivKeySecp256k1(*v)
+		return &res
+	case PubKeyEd25519:
+		return DeepCopyPubKeyEd25519(v)
+	case *PubKeyEd25519:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPubKeyEd25519(*v)
+		return &res
+	case PubKeyMultisigThreshold:
+		return DeepCopyPubKeyMultisigThreshold(v)
+	case *PubKeyMultisigThreshold:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPubKeyMultisigThreshold(*v)
+		return &res
+	case PubKeySecp256k1:
+		return DeepCopyPubKeySecp256k1(v)
+	case *PubKeySecp256k1:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyPubKeySecp256k1(*v)
+		return &res
+	case SignedMsgType:
+		return DeepCopySignedMsgType(v)
+	case *SignedMsgType:
+		if v == nil {
+			return v
+		}
+		res := DeepCopySignedMsgType(*v)
+		return &res
+	case SoftwareUpgradeProposal:
+		return DeepCopySoftwareUpgradeProposal(v)
+	case *SoftwareUpgradeProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopySoftwareUpgradeProposal(*v)
+		return &res
+	case State:
+		return DeepCopyState(v)
+	case *State:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyState(*v)
+		return &res
+	case StdSignature:
+		return DeepCopyStdSignature(v)
+	case *StdSignature:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyStdSignature(*v)
+		return &res
+	case StdTx:
+		return DeepCopyStdTx(v)
+	case *StdTx:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyStdTx(*v)
+		return &res
+	case Supply:
+		return DeepCopySupply(v)
+	case *Supply:
+		if v == nil {
+			return v
+		}
+		res := DeepCopySupply(*v)
+		return &res
+	case TextProposal:
+		return DeepCopyTextProposal(v)
+	case *TextProposal:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyTextProposal(*v)
+		return &res
+	case Vote:
+		return DeepCopyVote(v)
+	case *Vote:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyVote(*v)
+		return &res
+	case VoteOption:
+		return DeepCopyVoteOption(v)
+	case *VoteOption:
+		if v == nil {
+			return v
+		}
+		res := DeepCopyVoteOption(*v)
+		return &res
+	default:
+		panic("Unknown type")
 	} // end of switch
-	panic("Should not reach here")
-	return []byte{}
-} // end of getMagicBytes
-func EncodeAny(w io.Writer, x interface{}) error {
+} //End of DeepCopyAny
+
+func EqualAny(x, y interface{}) bool {
 	switch v := x.(type) {
 	case AccAddress:
-		w.Write(getMagicBytes("AccAddress"))
-		return EncodeAccAddress(w, v)
+		w, ok := y.(AccAddress)
+		return ok && EqualAccAddress(v, w)
 	case *AccAddress:
-		w.Write(getMagicBytes("AccAddress"))
-		return EncodeAccAddress(w, *v)
+		w, ok := y.(*AccAddress)
+		return ok && (v == w || v != nil && w != nil && EqualAccAddress(*v, *w))
 	case AccountX:
-		w.Write(getMagicBytes("AccountX"))
-		return EncodeAccountX(w, v)
+		w, ok := y.(AccountX)
+		return ok && EqualAccountX(v, w)
 	case *AccountX:
-		w.Write(getMagicBytes("AccountX"))
-		return EncodeAccountX(w, *v)
+		w, ok := y.(*AccountX)
+		return ok && (v == w || v != nil && w != nil && EqualAccountX(*v, *w))
 	case BaseAccount:
-		w.Write(getMagicBytes("BaseAccount"))
-		return EncodeBaseAccount(w, v)
+		w, ok := y.(BaseAccount)
+		return ok && EqualBaseAccount(v, w)
 	case *BaseAccount:
-		w.Write(getMagicBytes("BaseAccount"))
-		return EncodeBaseAccount(w, *v)
+		w, ok := y.(*BaseAccount)
+		return ok && (v == w || v != nil && w != nil && EqualBaseAccount(*v, *w))
 	case BaseToken:
-		w.Write(getMagicBytes("BaseToken"))
-		return EncodeBaseToken(w, v)
+		w, ok := y.(BaseToken)
+		return ok && EqualBaseToken(v, w)
 	case *BaseToken:
-		w.Write(getMagicBytes("BaseToken"))
-		return EncodeBaseToken(w, *v)
+		w, ok := y.(*BaseToken)
+		return ok && (v == w || v != nil && w != nil && EqualBaseToken(*v, *w))
 	case BaseVestingAccount:
-		w.Write(getMagicBytes("BaseVestingAccount"))
-		return EncodeBaseVestingAccount(w, v)
+		w, ok := y.(BaseVestingAccount)
+		return ok && EqualBaseVestingAccount(v, w)
 	case *BaseVestingAccount:
-		w.Write(getMagicBytes("BaseVestingAccount"))
-		return EncodeBaseVestingAccount(w, *v)
+		w, ok := y.(*BaseVestingAccount)
+		return ok && (v == w || v != nil && w != nil && EqualBaseVestingAccount(*v, *w))
 	case Coin:
-		w.Write(getMagicBytes("Coin"))
-		return EncodeCoin(w, v)
+		w, ok := y.(Coin)
+		return ok && EqualCoin(v, w)
 	case *Coin:
-		w.Write(getMagicBytes("Coin"))
-		return EncodeCoin(w, *v)
+		w, ok := y.(*Coin)
+		return ok && (v == w || v != nil && w != nil && EqualCoin(*v, *w))
 	case CommentRef:
-		w.Write(getMagicBytes("CommentRef"))
-		return EncodeCommentRef(w, v)
+		w, ok := y.(CommentRef)
+		return ok && EqualCommentRef(v, w)
 	case *CommentRef:
-		w.Write(getMagicBytes("CommentRef"))
-		return EncodeCommentRef(w, *v)
+		w, ok := y.(*CommentRef)
+		return ok && (v == w || v != nil && w != nil && EqualCommentRef(*v, *w))
 	case CommunityPoolSpendProposal:
-		w.Write(getMagicBytes("CommunityPoolSpendProposal"))
-		return EncodeCommunityPoolSpendProposal(w, v)
+		w, ok := y.(CommunityPoolSpendProposal)
+		return ok && EqualCommunityPoolSpendProposal(v, w)
 	case *CommunityPoolSpendProposal:
-		w.Write(getMagicBytes("CommunityPoolSpendProposal"))
-		return EncodeCommunityPoolSpendProposal(w, *v)
+		w, ok := y.(*CommunityPoolSpendProposal)
+		return ok && (v == w || v != nil && w != nil && EqualCommunityPoolSpendProposal(*v, *w))
 	case ContinuousVestingAccount:
-		w.Write(getMagicBytes("ContinuousVestingAccount"))
-		return EncodeContinuousVestingAccount(w, v)
+		w, ok := y.(ContinuousVestingAccount)
+		return ok && EqualContinuousVestingAccount(v, w)
 	case *ContinuousVestingAccount:
-		w.Write(getMagicBytes("ContinuousVestingAccount"))
-		return EncodeContinuousVestingAccount(w, *v)
+		w, ok := y.(*ContinuousVestingAccount)
+		return ok && (v == w || v != nil && w != nil && EqualContinuousVestingAccount(*v, *w))
 	case DelayedVestingAccount:
-		w.Write(getMagicBytes("DelayedVestingAccount"))
-		return EncodeDelayedVestingAccount(w, v)
+		w, ok := y.(DelayedVestingAccount)
+		return ok && EqualDelayedVestingAccount(v, w)
 	case *DelayedVestingAccount:
-		w.Write(getMagicBytes("DelayedVestingAccount"))
-		return EncodeDelayedVestingAccount(w, *v)
+		w, ok := y.(*DelayedVestingAccount)
+		return ok && (v == w || v != nil && w != nil && EqualDelayedVestingAccount(*v, *w))
 	case DuplicateVoteEvidence:
-		w.Write(getMagicBytes("DuplicateVoteEvidence"))
-		return EncodeDuplicateVoteEvidence(w, v)
+		w, ok := y.(DuplicateVoteEvidence)
+		return ok && EqualDuplicateVoteEvidence(v, w)
 	case *DuplicateVoteEvidence:
-		w.Write(getMagicBytes("DuplicateVoteEvidence"))
-		return EncodeDuplicateVoteEvidence(w, *v)
+		w, ok := y.(*DuplicateVoteEvidence)
+		return ok && (v == w || v != nil && w != nil && EqualDuplicateVoteEvidence(*v, *w))
 	case Input:
-		w.Write(getMagicBytes("Input"))
-		return EncodeInput(w, v)
+		w, ok := y.(Input)
+		return ok && EqualInput(v, w)
 	case *Input:
-		w.Write(getMagicBytes("Input"))
-		return EncodeInput(w, *v)
+		w, ok := y.(*Input)
+		return ok && (v == w || v != nil && w != nil && EqualInput(*v, *w))
 	case LockedCoin:
-		w.Write(getMagicBytes("LockedCoin"))
-		return EncodeLockedCoin(w, v)
+		w, ok := y.(LockedCoin)
+		return ok && EqualLockedCoin(v, w)
 	case *LockedCoin:
-		w.Write(getMagicBytes("LockedCoin"))
-		return EncodeLockedCoin(w, *v)
+		w, ok := y.(*LockedCoin)
+		return ok && (v == w || v != nil && w != nil && EqualLockedCoin(*v, *w))
 	case MarketInfo:
-		w.Write(getMagicBytes("MarketInfo"))
-		return EncodeMarketInfo(w, v)
+		w, ok := y.(MarketInfo)
+		return ok && EqualMarketInfo(v, w)
 	case *MarketInfo:
-		w.Write(getMagicBytes("MarketInfo"))
-		return EncodeMarketInfo(w, *v)
+		w, ok := y.(*MarketInfo)
+		return ok && (v == w || v != nil && w != nil && EqualMarketInfo(*v, *w))
 	case ModuleAccount:
-		w.Write(getMagicBytes("ModuleAccount"))
-		return EncodeModuleAccount(w, v)
+		w, ok := y.(ModuleAccount)
+		return ok && EqualModuleAccount(v, w)
 	case *ModuleAccount:
-		w.Write(getMagicBytes("ModuleAccount"))
-		return EncodeModuleAccount(w, *v)
+		w, ok := y.(*ModuleAccount)
+		return ok && (v == w || v != nil && w != nil && EqualModuleAccount(*v, *w))
+	case MsgAddLiquidity:
+		w, ok := y.(MsgAddLiquidity)
+		return ok && EqualMsgAddLiquidity(v, w)
+	case *MsgAddLiquidity:
+		w, ok := y.(*MsgAddLiquidity)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAddLiquidity(*v, *w))
 	case MsgAddTokenWhitelist:
-		w.Write(getMagicBytes("MsgAddTokenWhitelist"))
-		return EncodeMsgAddTokenWhitelist(w, v)
+		w, ok := y.(MsgAddTokenWhitelist)
+		return ok && EqualMsgAddTokenWhitelist(v, w)
 	case *MsgAddTokenWhitelist:
-		w.Write(getMagicBytes("MsgAddTokenWhitelist"))
-		return EncodeMsgAddTokenWhitelist(w, *v)
+		w, ok := y.(*MsgAddTokenWhitelist)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAddTokenWhitelist(*v, *w))
 	case MsgAliasUpdate:
-		w.Write(getMagicBytes("MsgAliasUpdate"))
-		return EncodeMsgAliasUpdate(w, v)
+		w, ok := y.(MsgAliasUpdate)
+		return ok && EqualMsgAliasUpdate(v, w)
 	case *MsgAliasUpdate:
-		w.Write(getMagicBytes("MsgAliasUpdate"))
-		return EncodeMsgAliasUpdate(w, *v)
+		w, ok := y.(*MsgAliasUpdate)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAliasUpdate(*v, *w))
+	case MsgAutoSwapCancelOrder:
+		w, ok := y.(MsgAutoSwapCancelOrder)
+		return ok && EqualMsgAutoSwapCancelOrder(v, w)
+	case *MsgAutoSwapCancelOrder:
+		w, ok := y.(*MsgAutoSwapCancelOrder)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAutoSwapCancelOrder(*v, *w))
+	case MsgAutoSwapCreateOrder:
+		w, ok := y.(MsgAutoSwapCreateOrder)
+		return ok && EqualMsgAutoSwapCreateOrder(v, w)
+	case *MsgAutoSwapCreateOrder:
+		w, ok := y.(*MsgAutoSwapCreateOrder)
+		return ok && (v == w || v != nil && w != nil && EqualMsgAutoSwapCreateOrder(*v, *w))
 	case MsgBancorCancel:
-		w.Write(getMagicBytes("MsgBancorCancel"))
-		return EncodeMsgBancorCancel(w, v)
+		w, ok := y.(MsgBancorCancel)
+		return ok && EqualMsgBancorCancel(v, w)
 	case *MsgBancorCancel:
-		w.Write(getMagicBytes("MsgBancorCancel"))
-		return EncodeMsgBancorCancel(w, *v)
+		w, ok := y.(*MsgBancorCancel)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBancorCancel(*v, *w))
 	case MsgBancorInit:
-		w.Write(getMagicBytes("MsgBancorInit"))
-		return EncodeMsgBancorInit(w, v)
+		w, ok := y.(MsgBancorInit)
+		return ok && EqualMsgBancorInit(v, w)
 	case *MsgBancorInit:
-		w.Write(getMagicBytes("MsgBancorInit"))
-		return EncodeMsgBancorInit(w, *v)
+		w, ok := y.(*MsgBancorInit)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBancorInit(*v, *w))
 	case MsgBancorTrade:
-		w.Write(getMagicBytes("MsgBancorTrade"))
-		return EncodeMsgBancorTrade(w, v)
+		w, ok := y.(MsgBancorTrade)
+		return ok && EqualMsgBancorTrade(v, w)
 	case *MsgBancorTrade:
-		w.Write(getMagicBytes("MsgBancorTrade"))
-		return EncodeMsgBancorTrade(w, *v)
+		w, ok := y.(*MsgBancorTrade)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBancorTrade(*v, *w))
 	case MsgBeginRedelegate:
-		w.Write(getMagicBytes("MsgBeginRedelegate"))
-		return EncodeMsgBeginRedelegate(w, v)
+		w, ok := y.(MsgBeginRedelegate)
+		return ok && EqualMsgBeginRedelegate(v, w)
 	case *MsgBeginRedelegate:
-		w.Write(getMagicBytes("MsgBeginRedelegate"))
-		return EncodeMsgBeginRedelegate(w, *v)
+		w, ok := y.(*MsgBeginRedelegate)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBeginRedelegate(*v, *w))
 	case MsgBurnToken:
-		w.Write(getMagicBytes("MsgBurnToken"))
-		return EncodeMsgBurnToken(w, v)
+		w, ok := y.(MsgBurnToken)
+		return ok && EqualMsgBurnToken(v, w)
 	case *MsgBurnToken:
-		w.Write(getMagicBytes("MsgBurnToken"))
-		return EncodeMsgBurnToken(w, *v)
+		w, ok := y.(*MsgBurnToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgBurnToken(*v, *w))
 	case MsgCancelOrder:
-		w.Write(getMagicBytes("MsgCancelOrder"))
-		return EncodeMsgCancelOrder(w, v)
+		w, ok := y.(MsgCancelOrder)
+		return ok && EqualMsgCancelOrder(v, w)
 	case *MsgCancelOrder:
-		w.Write(getMagicBytes("MsgCancelOrder"))
-		return EncodeMsgCancelOrder(w, *v)
+		w, ok := y.(*MsgCancelOrder)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCancelOrder(*v, *w))
 	case MsgCancelTradingPair:
-		w.Write(getMagicBytes("MsgCancelTradingPair"))
-		return EncodeMsgCancelTradingPair(w, v)
+		w, ok := y.(MsgCancelTradingPair)
+		return ok && EqualMsgCancelTradingPair(v, w)
 	case *MsgCancelTradingPair:
-		w.Write(getMagicBytes("MsgCancelTradingPair"))
-		return EncodeMsgCancelTradingPair(w, *v)
+		w, ok := y.(*MsgCancelTradingPair)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCancelTradingPair(*v, *w))
 	case MsgCommentToken:
-		w.Write(getMagicBytes("MsgCommentToken"))
-		return EncodeMsgCommentToken(w, v)
+		w, ok := y.(MsgCommentToken)
+		return ok && EqualMsgCommentToken(v, w)
 	case *MsgCommentToken:
-		w.Write(getMagicBytes("MsgCommentToken"))
-		return EncodeMsgCommentToken(w, *v)
+		w, ok := y.(*MsgCommentToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCommentToken(*v, *w))
 	case MsgCreateOrder:
-		w.Write(getMagicBytes("MsgCreateOrder"))
-		return EncodeMsgCreateOrder(w, v)
+		w, ok := y.(MsgCreateOrder)
+		return ok && EqualMsgCreateOrder(v, w)
 	case *MsgCreateOrder:
-		w.Write(getMagicBytes("MsgCreateOrder"))
-		return EncodeMsgCreateOrder(w, *v)
+		w, ok := y.(*MsgCreateOrder)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCreateOrder(*v, *w))
 	case MsgCreateTradingPair:
-		w.Write(getMagicBytes("MsgCreateTradingPair"))
-		return EncodeMsgCreateTradingPair(w, v)
+		w, ok := y.(MsgCreateTradingPair)
+		return ok && EqualMsgCreateTradingPair(v, w)
 	case *MsgCreateTradingPair:
-		w.Write(getMagicBytes("MsgCreateTradingPair"))
-		return EncodeMsgCreateTradingPair(w, *v)
+		w, ok := y.(*MsgCreateTradingPair)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCreateTradingPair(*v, *w))
 	case MsgCreateValidator:
-		w.Write(getMagicBytes("MsgCreateValidator"))
-		return EncodeMsgCreateValidator(w, v)
+		w, ok := y.(MsgCreateValidator)
+		return ok && EqualMsgCreateValidator(v, w)
 	case *MsgCreateValidator:
-		w.Write(getMagicBytes("MsgCreateValidator"))
-		return EncodeMsgCreateValidator(w, *v)
+		w, ok := y.(*MsgCreateValidator)
+		return ok && (v == w || v != nil && w != nil && EqualMsgCreateValidator(*v, *w))
 	case MsgDelegate:
-		w.Write(getMagicBytes("MsgDelegate"))
-		return EncodeMsgDelegate(w, v)
+		w, ok := y.(MsgDelegate)
+		return ok && EqualMsgDelegate(v, w)
 	case *MsgDelegate:
-		w.Write(getMagicBytes("MsgDelegate"))
-		return EncodeMsgDelegate(w, *v)
+		w, ok := y.(*MsgDelegate)
+		return ok && (v == w || v != nil && w != nil && EqualMsgDelegate(*v, *w))
 	case MsgDeposit:
-		w.Write(getMagicBytes("MsgDeposit"))
-		return EncodeMsgDeposit(w, v)
+		w, ok := y.(MsgDeposit)
+		return ok && EqualMsgDeposit(v, w)
 	case *MsgDeposit:
-		w.Write(getMagicBytes("MsgDeposit"))
-		return EncodeMsgDeposit(w, *v)
+		w, ok := y.(*MsgDeposit)
+		return ok && (v == w || v != nil && w != nil && EqualMsgDeposit(*v, *w))
 	case MsgDonateToCommunityPool:
-		w.Write(getMagicBytes("MsgDonateToCommunityPool"))
-		return EncodeMsgDonateToCommunityPool(w, v)
+		w, ok := y.(MsgDonateToCommunityPool)
+		return ok && EqualMsgDonateToCommunityPool(v, w)
 	case *MsgDonateToCommunityPool:
-		w.Write(getMagicBytes("MsgDonateToCommunityPool"))
-		return EncodeMsgDonateToCommunityPool(w, *v)
+		w, ok := y.(*MsgDonateToCommunityPool)
+		return ok && (v == w || v != nil && w != nil && EqualMsgDonateToCommunityPool(*v, *w))
 	case MsgEditValidator:
-		w.Write(getMagicBytes("MsgEditValidator"))
-		return EncodeMsgEditValidator(w, v)
+		w, ok := y.(MsgEditValidator)
+		return ok && EqualMsgEditValidator(v, w)
 	case *MsgEditValidator:
-		w.Write(getMagicBytes("MsgEditValidator"))
-		return EncodeMsgEditValidator(w, *v)
+		w, ok := y.(*MsgEditValidator)
+		return ok && (v == w || v != nil && w != nil && EqualMsgEditValidator(*v, *w))
 	case MsgForbidAddr:
-		w.Write(getMagicBytes("MsgForbidAddr"))
-		return EncodeMsgForbidAddr(w, v)
+		w, ok := y.(MsgForbidAddr)
+		return ok && EqualMsgForbidAddr(v, w)
 	case *MsgForbidAddr:
-		w.Write(getMagicBytes("MsgForbidAddr"))
-		return EncodeMsgForbidAddr(w, *v)
+		w, ok := y.(*MsgForbidAddr)
+		return ok && (v == w || v != nil && w != nil && EqualMsgForbidAddr(*v, *w))
 	case MsgForbidToken:
-		w.Write(getMagicBytes("MsgForbidToken"))
-		return EncodeMsgForbidToken(w, v)
+		w, ok := y.(MsgForbidToken)
+		return ok && EqualMsgForbidToken(v, w)
 	case *MsgForbidToken:
-		w.Write(getMagicBytes("MsgForbidToken"))
-		return EncodeMsgForbidToken(w, *v)
+		w, ok := y.(*MsgForbidToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgForbidToken(*v, *w))
 	case MsgIssueToken:
-		w.Write(getMagicBytes("MsgIssueToken"))
-		return EncodeMsgIssueToken(w, v)
+		w, ok := y.(MsgIssueToken)
+		return ok && EqualMsgIssueToken(v, w)
 	case *MsgIssueToken:
-		w.Write(getMagicBytes("MsgIssueToken"))
-		return EncodeMsgIssueToken(w, *v)
+		w, ok := y.(*MsgIssueToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgIssueToken(*v, *w))
 	case MsgMintToken:
-		w.Write(getMagicBytes("MsgMintToken"))
-		return EncodeMsgMintToken(w, v)
+		w, ok := y.(MsgMintToken)
+		return ok && EqualMsgMintToken(v, w)
 	case *MsgMintToken:
-		w.Write(getMagicBytes("MsgMintToken"))
-		return EncodeMsgMintToken(w, *v)
+		w, ok := y.(*MsgMintToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgMintToken(*v, *w))
 	case MsgModifyPricePrecision:
-		w.Write(getMagicBytes("MsgModifyPricePrecision"))
-		return EncodeMsgModifyPricePrecision(w, v)
+		w, ok := y.(MsgModifyPricePrecision)
+		return ok && EqualMsgModifyPricePrecision(v, w)
 	case *MsgModifyPricePrecision:
-		w.Write(getMagicBytes("MsgModifyPricePrecision"))
-		return EncodeMsgModifyPricePrecision(w, *v)
+		w, ok := y.(*MsgModifyPricePrecision)
+		return ok && (v == w || v != nil && w != nil && EqualMsgModifyPricePrecision(*v, *w))
 	case MsgModifyTokenInfo:
-		w.Write(getMagicBytes("MsgModifyTokenInfo"))
-		return EncodeMsgModifyTokenInfo(w, v)
+		w, ok := y.(MsgModifyTokenInfo)
+		return ok && EqualMsgModifyTokenInfo(v, w)
 	case *MsgModifyTokenInfo:
-		w.Write(getMagicBytes("MsgModifyTokenInfo"))
-		return EncodeMsgModifyTokenInfo(w, *v)
+		w, ok := y.(*MsgModifyTokenInfo)
+		return ok && (v == w || v != nil && w != nil && EqualMsgModifyTokenInfo(*v, *w))
 	case MsgMultiSend:
-		w.Write(getMagicBytes("MsgMultiSend"))
-		return EncodeMsgMultiSend(w, v)
+		w, ok := y.(MsgMultiSend)
+		return ok && EqualMsgMultiSend(v, w)
 	case *MsgMultiSend:
-		w.Write(getMagicBytes("MsgMultiSend"))
-		return EncodeMsgMultiSend(w, *v)
+		w, ok := y.(*MsgMultiSend)
+		return ok && (v == w || v != nil && w != nil && EqualMsgMultiSend(*v, *w))
 	case MsgMultiSendX:
-		w.Write(getMagicBytes("MsgMultiSendX"))
-		return EncodeMsgMultiSendX(w, v)
+		w, ok := y.(MsgMultiSendX)
+		return ok && EqualMsgMultiSendX(v, w)
 	case *MsgMultiSendX:
-		w.Write(getMagicBytes("MsgMultiSendX"))
-		return EncodeMsgMultiSendX(w, *v)
+		w, ok := y.(*MsgMultiSendX)
+		return ok && (v == w || v != nil && w != nil && EqualMsgMultiSendX(*v, *w))
+	case MsgRemoveLiquidity:
+		w, ok := y.(MsgRemoveLiquidity)
+		return ok && EqualMsgRemoveLiquidity(v, w)
+	case *MsgRemoveLiquidity:
+		w, ok := y.(*MsgRemoveLiquidity)
+		return ok && (v == w || v != nil && w != nil && EqualMsgRemoveLiquidity(*v, *w))
 	case MsgRemoveTokenWhitelist:
-		w.Write(getMagicBytes("MsgRemoveTokenWhitelist"))
-		return EncodeMsgRemoveTokenWhitelist(w, v)
+		w, ok := y.(MsgRemoveTokenWhitelist)
+		return ok && EqualMsgRemoveTokenWhitelist(v, w)
 	case *MsgRemoveTokenWhitelist:
-		w.Write(getMagicBytes("MsgRemoveTokenWhitelist"))
-		return EncodeMsgRemoveTokenWhitelist(w, *v)
+		w, ok := y.(*MsgRemoveTokenWhitelist)
+		return ok && (v == w || v != nil && w != nil && EqualMsgRemoveTokenWhitelist(*v, *w))
 	case MsgSend:
-		w.Write(getMagicBytes("MsgSend"))
-		return EncodeMsgSend(w, v)
+		w, ok := y.(MsgSend)
+		return ok && EqualMsgSend(v, w)
 	case *MsgSend:
-		w.Write(getMagicBytes("MsgSend"))
-		return EncodeMsgSend(w, *v)
+		w, ok := y.(*MsgSend)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSend(*v, *w))
 	case MsgSendX:
-		w.Write(getMagicBytes("MsgSendX"))
-		return EncodeMsgSendX(w, v)
+		w, ok := y.(MsgSendX)
+		return ok && EqualMsgSendX(v, w)
 	case *MsgSendX:
-		w.Write(getMagicBytes("MsgSendX"))
-		return EncodeMsgSendX(w, *v)
+		w, ok := y.(*MsgSendX)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSendX(*v, *w))
 	case MsgSetMemoRequired:
-		w.Write(getMagicBytes("MsgSetMemoRequired"))
-		return EncodeMsgSetMemoRequired(w, v)
+		w, ok := y.(MsgSetMemoRequired)
+		return ok && EqualMsgSetMemoRequired(v, w)
 	case *MsgSetMemoRequired:
-		w.Write(getMagicBytes("MsgSetMemoRequired"))
-		return EncodeMsgSetMemoRequired(w, *v)
+		w, ok := y.(*MsgSetMemoRequired)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSetMemoRequired(*v, *w))
+	case MsgSetReferee:
+		w, ok := y.(MsgSetReferee)
+		return ok && EqualMsgSetReferee(v, w)
+	case *MsgSetReferee:
+		w, ok := y.(*MsgSetReferee)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSetReferee(*v, *w))
 	case MsgSetWithdrawAddress:
-		w.Write(getMagicBytes("MsgSetWithdrawAddress"))
-		return EncodeMsgSetWithdrawAddress(w, v)
+		w, ok := y.(MsgSetWithdrawAddress)
+		return ok && EqualMsgSetWithdrawAddress(v, w)
 	case *MsgSetWithdrawAddress:
-		w.Write(getMagicBytes("MsgSetWithdrawAddress"))
-		return EncodeMsgSetWithdrawAddress(w, *v)
+		w, ok := y.(*MsgSetWithdrawAddress)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSetWithdrawAddress(*v, *w))
 	case MsgSubmitProposal:
-		w.Write(getMagicBytes("MsgSubmitProposal"))
-		return EncodeMsgSubmitProposal(w, v)
+		w, ok := y.(MsgSubmitProposal)
+		return ok && EqualMsgSubmitProposal(v, w)
 	case *MsgSubmitProposal:
-		w.Write(getMagicBytes("MsgSubmitProposal"))
-		return EncodeMsgSubmitProposal(w, *v)
+		w, ok := y.(*MsgSubmitProposal)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSubmitProposal(*v, *w))
+	case MsgSupervisedSend:
+		w, ok := y.(MsgSupervisedSend)
+		return ok && EqualMsgSupervisedSend(v, w)
+	case *MsgSupervisedSend:
+		w, ok := y.(*MsgSupervisedSend)
+		return ok && (v == w || v != nil && w != nil && EqualMsgSupervisedSend(*v, *w))
 	case MsgTransferOwnership:
-		w.Write(getMagicBytes("MsgTransferOwnership"))
-		return EncodeMsgTransferOwnership(w, v)
+		w, ok := y.(MsgTransferOwnership)
+		return ok && EqualMsgTransferOwnership(v, w)
 	case *MsgTransferOwnership:
-		w.Write(getMagicBytes("MsgTransferOwnership"))
-		return EncodeMsgTransferOwnership(w, *v)
+		w, ok := y.(*MsgTransferOwnership)
+		return ok && (v == w || v != nil && w != nil && EqualMsgTransferOwnership(*v, *w))
 	case MsgUnForbidAddr:
-		w.Write(getMagicBytes("MsgUnForbidAddr"))
-		return EncodeMsgUnForbidAddr(w, v)
+		w, ok := y.(MsgUnForbidAddr)
+		return ok && EqualMsgUnForbidAddr(v, w)
 	case *MsgUnForbidAddr:
-		w.Write(getMagicBytes("MsgUnForbidAddr"))
-		return EncodeMsgUnForbidAddr(w, *v)
+		w, ok := y.(*MsgUnForbidAddr)
+		return ok && (v == w || v != nil && w != nil && EqualMsgUnForbidAddr(*v, *w))
 	case MsgUnForbidToken:
-		w.Write(getMagicBytes("MsgUnForbidToken"))
-		return EncodeMsgUnForbidToken(w, v)
+		w, ok := y.(MsgUnForbidToken)
+		return ok && EqualMsgUnForbidToken(v, w)
 	case *MsgUnForbidToken:
-		w.Write(getMagicBytes("MsgUnForbidToken"))
-		return EncodeMsgUnForbidToken(w, *v)
+		w, ok := y.(*MsgUnForbidToken)
+		return ok && (v == w || v != nil && w != nil && EqualMsgUnForbidToken(*v, *w))
 	case MsgUndelegate:
-		w.Write(getMagicBytes("MsgUndelegate"))
-		return EncodeMsgUndelegate(w, v)
+		w, ok := y.(MsgUndelegate)
+		return ok && EqualMsgUndelegate(v, w)
 	case *MsgUndelegate:
-		w.Write(getMagicBytes("MsgUndelegate"))
-		return EncodeMsgUndelegate(w, *v)
+		w, ok := y.(*MsgUndelegate)
+		return ok && (v == w || v != nil && w != nil && EqualMsgUndelegate(*v, *w))
 	case MsgUnjail:
-		w.Write(getMagicBytes("MsgUnjail"))
-		return EncodeMsgUnjail(w, v)
+		w, ok := y.(MsgUnjail)
+		return ok && EqualMsgUnjail(v, w)
 	case *MsgUnjail:
-		w.Write(getMagicBytes("MsgUnjail"))
-		return EncodeMsgUnjail(w, *v)
+		w, ok := y.(*MsgUnjail)
+		return ok && (v == w || v != nil && w != nil && EqualMsgUnjail(*v, *w))
 	case MsgVerifyInvariant:
-		w.Write(getMagicBytes("MsgVerifyInvariant"))
-		return EncodeMsgVerifyInvariant(w, v)
+		w, ok := y.(MsgVerifyInvariant)
+		return ok && EqualMsgVerifyInvariant(v, w)
 	case *MsgVerifyInvariant:
-		w.Write(getMagicBytes("MsgVerifyInvariant"))
-		return EncodeMsgVerifyInvariant(w, *v)
+		w, ok := y.(*MsgVerifyInvariant)
+		return ok && (v == w || v != nil && w != nil && EqualMsgVerifyInvariant(*v, *w))
 	case MsgVote:
-		w.Write(getMagicBytes("MsgVote"))
-		return EncodeMsgVote(w, v)
+		w, ok := y.(MsgVote)
+		return ok && EqualMsgVote(v, w)
 	case *MsgVote:
-		w.Write(getMagicBytes("MsgVote"))
-		return EncodeMsgVote(w, *v)
+		w, ok := y.(*MsgVote)
+		return ok && (v == w || v != nil && w != nil && EqualMsgVote(*v, *w))
 	case MsgWithdrawDelegatorReward:
-		w.Write(getMagicBytes("MsgWithdrawDelegatorReward"))
-		return EncodeMsgWithdrawDelegatorReward(w, v)
+		w, ok := y.(MsgWithdrawDelegatorReward)
+		return ok && EqualMsgWithdrawDelegatorReward(v, w)
 	case *MsgWithdrawDelegatorReward:
-		w.Write(getMagicBytes("MsgWithdrawDelegatorReward"))
-		return EncodeMsgWithdrawDelegatorReward(w, *v)
+		w, ok := y.(*MsgWithdrawDelegatorReward)
+		return ok && (v == w || v != nil && w != nil && EqualMsgWithdrawDelegatorReward(*v, *w))
 	case MsgWithdrawValidatorCommission:
-		w.Write(getMagicBytes("MsgWithdrawValidatorCommission"))
-		return EncodeMsgWithdrawValidatorCommission(w, v)
+		w, ok := y.(MsgWithdrawValidatorCommission)
+		return ok && EqualMsgWithdrawValidatorCommission(v, w)
 	case *MsgWithdrawValidatorCommission:
-		w.Write(getMagicBytes("MsgWithdrawValidatorCommission"))
-		return EncodeMsgWithdrawValidatorCommission(w, *v)
+		w, ok := y.(*MsgWithdrawValidatorCommission)
+		return ok && (v == w || v != nil && w != nil && EqualMsgWithdrawValidatorCommission(*v, *w))
 	case Order:
-		w.Write(getMagicBytes("Order"))
-		return EncodeOrder(w, v)
+		w, ok := y.(Order)
+		return ok && EqualOrder(v, w)
 	case *Order:
-		w.Write(getMagicBytes("Order"))
-		return EncodeOrder(w, *v)
+		w, ok := y.(*Order)
+		return ok && (v == w || v != nil && w != nil && EqualOrder(*v, *w))
 	case Output:
-		w.Write(getMagicBytes("Output"))
-		return EncodeOutput(w, v)
+		w, ok := y.(Output)
+		return ok && EqualOutput(v, w)
 	case *Output:
-		w.Write(getMagicBytes("Output"))
-		return EncodeOutput(w, *v)
+		w, ok := y.(*Output)
+		return ok && (v == w || v != nil && w != nil && EqualOutput(*v, *w))
 	case ParamChange:
-		w.Write(getMagicBytes("ParamChange"))
-		return EncodeParamChange(w, v)
+		w, ok := y.(ParamChange)
+		return ok && EqualParamChange(v, w)
 	case *ParamChange:
-		w.Write(getMagicBytes("ParamChange"))
-		return EncodeParamChange(w, *v)
+		w, ok := y.(*ParamChange)
+		return ok && (v == w || v != nil && w != nil && EqualParamChange(*v, *w))
 	case ParameterChangeProposal:
-		w.Write(getMagicBytes("ParameterChangeProposal"))
-		return EncodeParameterChangeProposal(w, v)
+		w, ok := y.(ParameterChangeProposal)
+		return ok && EqualParameterChangeProposal(v, w)
 	case *ParameterChangeProposal:
-		w.Write(getMagicBytes("ParameterChangeProposal"))
-		return EncodeParameterChangeProposal(w, *v)
+		w, ok := y.(*ParameterChangeProposal)
+		return ok && (v == w || v != nil && w != nil && EqualParameterChangeProposal(*v, *w))
+	case Period:
+		w, ok := y.(Period)
+		return ok && EqualPeriod(v, w)
+	case *Period:
+		w, ok := y.(*Period)
+		return ok && (v == w || v != nil && w != nil && EqualPeriod(*v, *w))
+	case PeriodicVestingAccount:
+		w, ok := y.(PeriodicVestingAccount)
+		return ok && EqualPeriodicVestingAccount(v, w)
+	case *PeriodicVestingAccount:
+		w, ok := y.(*PeriodicVestingAccount)
+		return ok && (v == w || v != nil && w != nil && EqualPeriodicVestingAccount(*v, *w))
 	case PrivKeyEd25519:
-		w.Write(getMagicBytes("PrivKeyEd25519"))
-		return EncodePrivKeyEd25519(w, v)
+		w, ok := y.(PrivKeyEd25519)
+		return ok && EqualPrivKeyEd25519(v, w)
 	case *PrivKeyEd25519:
-		w.Write(getMagicBytes("PrivKeyEd25519"))
-		return EncodePrivKeyEd25519(w, *v)
+		w, ok := y.(*PrivKeyEd25519)
+		return ok && (v == w || v != nil && w != nil && EqualPrivKeyEd25519(*v, *w))
 	case PrivKeySecp256k1:
-		w.Write(getMagicBytes("PrivKeySecp256k1"))
-		return EncodePrivKeySecp256k1(w, v)
+		w, ok := y.(PrivKeySecp256k1)
+		return ok && EqualPrivKeySecp256k1(v, w)
 	case *PrivKeySecp256k1:
-		w.Write(getMagicBytes("PrivKeySecp256k1"))
-		return EncodePrivKeySecp256k1(w, *v)
+		w, ok := y.(*PrivKeySecp256k1)
+		return ok && (v == w || v != nil && w != nil && EqualPrivKeySecp256k1(*v, *w))
 	case PubKeyEd25519:
-		w.Write(getMagicBytes("PubKeyEd25519"))
-		return EncodePubKeyEd25519(w, v)
+		w, ok := y.(PubKeyEd25519)
+		return ok && EqualPubKeyEd25519(v, w)
 	case *PubKeyEd25519:
-		w.Write(getMagicBytes("PubKeyEd25519"))
-		return EncodePubKeyEd25519(w, *v)
+		w, ok := y.(*PubKeyEd25519)
+		return ok && (v == w || v != nil && w != nil && EqualPubKeyEd25519(*v, *w))
 	case PubKeyMultisigThreshold:
-		w.Write(getMagicBytes("PubKeyMultisigThreshold"))
-		return EncodePubKeyMultisigThreshold(w, v)
+		w, ok := y.(PubKeyMultisigThreshold)
+		return ok && EqualPubKeyMultisigThreshold(v, w)
 	case *PubKeyMultisigThreshold:
-		w.Write(getMagicBytes("PubKeyMultisigThreshold"))
-		return EncodePubKeyMultisigThreshold(w, *v)
+		w, ok := y.(*PubKeyMultisigThreshold)
+		return ok && (v == w || v != nil && w != nil && EqualPubKeyMultisigThreshold(*v, *w))
 	case PubKeySecp256k1:
-		w.Write(getMagicBytes("PubKeySecp256k1"))
-		return EncodePubKeySecp256k1(w, v)
+		w, ok := y.(PubKeySecp256k1)
+		return ok && EqualPubKeySecp256k1(v, w)
 	case *PubKeySecp256k1:
-		w.Write(getMagicBytes("PubKeySecp256k1"))
-		return EncodePubKeySecp256k1(w, *v)
+		w, ok := y.(*PubKeySecp256k1)
+		return ok && (v == w || v != nil && w != nil && EqualPubKeySecp256k1(*v, *w))
 	case SignedMsgType:
-		w.Write(getMagicBytes("SignedMsgType"))
-		return EncodeSignedMsgType(w, v)
+		w, ok := y.(SignedMsgType)
+		return ok && EqualSignedMsgType(v, w)
 	case *SignedMsgType:
-		w.Write(getMagicBytes("SignedMsgType"))
-		return EncodeSignedMsgType(w, *v)
+		w, ok := y.(*SignedMsgType)
+		return ok && (v == w || v != nil && w != nil && EqualSignedMsgType(*v, *w))
 	case SoftwareUpgradeProposal:
-		w.Write(getMagicBytes("SoftwareUpgradeProposal"))
-		return EncodeSoftwareUpgradeProposal(w, v)
+		w, ok := y.(SoftwareUpgradeProposal)
+		return ok && EqualSoftwareUpgradeProposal(v, w)
 	case *SoftwareUpgradeProposal:
-		w.Write(getMagicBytes("SoftwareUpgradeProposal"))
-		return EncodeSoftwareUpgradeProposal(w, *v)
+		w, ok := y.(*SoftwareUpgradeProposal)
+		return ok && (v == w || v != nil && w != nil && EqualSoftwareUpgradeProposal(*v, *w))
 	case State:
-		w.Write(getMagicBytes("State"))
-		return EncodeState(w, v)
+		w, ok := y.(State)
+		return ok && EqualState(v, w)
 	case *State:
-		w.Write(getMagicBytes("State"))
-		return EncodeState(w, *v)
+		w, ok := y.(*State)
+		return ok && (v == w || v != nil && w != nil && EqualState(*v, *w))
 	case StdSignature:
-		w.Write(getMagicBytes("StdSignature"))
-		return EncodeStdSignature(w, v)
+		w, ok := y.(StdSignature)
+		return ok && EqualStdSignature(v, w)
 	case *StdSignature:
-		w.Write(getMagicBytes("StdSignature"))
-		return EncodeStdSignature(w, *v)
+		w, ok := y.(*StdSignature)
+		return ok && (v == w || v != nil && w != nil && EqualStdSignature(*v, *w))
 	case StdTx:
-		w.Write(getMagicBytes("StdTx"))
-		return EncodeStdTx(w, v)
+		w, ok := y.(StdTx)
+		return ok && EqualStdTx(v, w)
 	case *StdTx:
-		w.Write(getMagicBytes("StdTx"))
-		return EncodeStdTx(w, *v)
+		w, ok := y.(*StdTx)
+		return ok && (v == w || v != nil && w != nil && EqualStdTx(*v, *w))
 	case Supply:
-		w.Write(getMagicBytes("Supply"))
-		return EncodeSupply(w, v)
+		w, ok := y.(Supply)
+		return ok && EqualSupply(v, w)
 	case *Supply:
-		w.Write(getMagicBytes("Supply"))
-		return EncodeSupply(w, *v)
+		w, ok := y.(*Supply)
+		return ok && (v == w || v != nil && w != nil && EqualSupply(*v, *w))
 	case TextProposal:
-		w.Write(getMagicBytes("TextProposal"))
-		return EncodeTextProposal(w, v)
+		w, ok := y.(TextProposal)
+		return ok && EqualTextProposal(v, w)
 	case *TextProposal:
-		w.Write(getMagicBytes("TextProposal"))
-		return EncodeTextProposal(w, *v)
+		w, ok := y.(*TextProposal)
+		return ok && (v == w || v != nil && w != nil && EqualTextProposal(*v, *w))
 	case Vote:
-		w.Write(getMagicBytes("Vote"))
-		return EncodeVote(w, v)
+		w, ok := y.(Vote)
+		return ok && EqualVote(v, w)
 	case *Vote:
-		w.Write(getMagicBytes("Vote"))
-		return EncodeVote(w, *v)
+		w, ok := y.(*Vote)
+		return ok && (v == w || v != nil && w != nil && EqualVote(*v, *w))
 	case VoteOption:
-		w.Write(getMagicBytes("VoteOption"))
-		return EncodeVoteOption(w, v)
+		w, ok := y.(VoteOption)
+		return ok && EqualVoteOption(v, w)
 	case *VoteOption:
-		w.Write(getMagicBytes("VoteOption"))
-		return EncodeVoteOption(w, *v)
+		w, ok := y.(*VoteOption)
+		return ok && (v == w || v != nil && w != nil && EqualVoteOption(*v, *w))
 	default:
-		panic("Unknown Type.")
+		panic("Unknown type")
 	} // end of switch
-} // end of func
-func BareEncodeAny(w io.Writer, x interface{}) error {
+} //End of EqualAny
+
+func EncodeJSONAccAddress(w *bytes.Buffer, v AccAddress) error {
+	if err := codonWriteJSON(w, v); err != nil {
+		return err
+	}
+	return nil
+} //End of EncodeJSONAccAddress
+
+func EncodeJSONAccountX(w *bytes.Buffer, v AccountX) error {
+	w.WriteByte('{')
+	w.WriteString("\"address\":")
+	if err := codonWriteJSON(w, v.Address); err != nil {
+		return err
+	}
+	w.WriteString(",\"memo_required\":")
+	codonWriteJSONBool(w, bool(v.MemoRequired))
+	w.WriteString(",\"locked_coins\":")
+	if v.LockedCoins == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.LockedCoins); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONLockedCoin(w, v.LockedCoins[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteString(",\"frozen_coins\":")
+	if err := codonWriteJSON(w, v.FrozenCoins); err != nil {
+		return err
+	}
+	_comma1 := true
+	if len(v.Referee) != 0 {
+		if _comma1 {
+			w.WriteByte(',')
+		}
+		w.WriteString("\"referee\":")
+		if err := codonWriteJSON(w, v.Referee); err != nil {
+			return err
+		}
+		_comma1 = true
+	}
+	if v.RefereeChangeTime != 0 {
+		if _comma1 {
+			w.WriteByte(',')
+		}
+		w.WriteString("\"referee_change_time\":")
+		codonWriteJSONQuotedInt(w, int64(v.RefereeChangeTime))
+		_comma1 = true
+	}
+	_ = _comma1
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONAccountX
+
+func EncodeJSONBaseAccount(w *bytes.Buffer, v BaseAccount) error {
+	w.WriteByte('{')
+	w.WriteString("\"address\":")
+	if err := codonWriteJSON(w, v.Address); err != nil {
+		return err
+	}
+	w.WriteString(",\"coins\":")
+	if err := codonWriteJSON(w, v.Coins); err != nil {
+		return err
+	}
+	w.WriteString(",\"public_key\":")
+	if err := EncodeJSONPubKey(w, v.PubKey); err != nil {
+		return err
+	}
+	w.WriteString(",\"account_number\":")
+	codonWriteJSONQuotedUint(w, uint64(v.AccountNumber))
+	w.WriteString(",\"sequence\":")
+	codonWriteJSONQuotedUint(w, uint64(v.Sequence))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONBaseAccount
+
+func EncodeJSONBaseToken(w *bytes.Buffer, v BaseToken) error {
+	w.WriteByte('{')
+	w.WriteString("\"name\":")
+	codonWriteJSONString(w, string(v.Name))
+	w.WriteString(",\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"total_supply\":")
+	if err := codonWriteJSON(w, v.TotalSupply); err != nil {
+		return err
+	}
+	w.WriteString(",\"send_lock\":")
+	if err := codonWriteJSON(w, v.SendLock); err != nil {
+		return err
+	}
+	w.WriteString(",\"owner\":")
+	if err := codonWriteJSON(w, v.Owner); err != nil {
+		return err
+	}
+	w.WriteString(",\"mintable\":")
+	codonWriteJSONBool(w, bool(v.Mintable))
+	w.WriteString(",\"burnable\":")
+	codonWriteJSONBool(w, bool(v.Burnable))
+	w.WriteString(",\"addr_forbiddable\":")
+	codonWriteJSONBool(w, bool(v.AddrForbiddable))
+	w.WriteString(",\"token_forbiddable\":")
+	codonWriteJSONBool(w, bool(v.TokenForbiddable))
+	w.WriteString(",\"total_burn\":")
+	if err := codonWriteJSON(w, v.TotalBurn); err != nil {
+		return err
+	}
+	w.WriteString(",\"total_mint\":")
+	if err := codonWriteJSON(w, v.TotalMint); err != nil {
+		return err
+	}
+	w.WriteString(",\"is_forbidden\":")
+	codonWriteJSONBool(w, bool(v.IsForbidden))
+	w.WriteString(",\"url\":")
+	codonWriteJSONString(w, string(v.URL))
+	w.WriteString(",\"description\":")
+	codonWriteJSONString(w, string(v.Description))
+	w.WriteString(",\"identity\":")
+	codonWriteJSONString(w, string(v.Identity))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONBaseToken
+
+func EncodeJSONBaseVestingAccount(w *bytes.Buffer, v BaseVestingAccount) error {
+	w.WriteByte('{')
+	w.WriteString("\"BaseAccount\":")
+	if v.BaseAccount == nil {
+		w.WriteString("null")
+	} else {
+		if err := EncodeJSONBaseAccount(w, (*v.BaseAccount)); err != nil {
+			return err
+		}
+	}
+	w.WriteString(",\"original_vesting\":")
+	if err := codonWriteJSON(w, v.OriginalVesting); err != nil {
+		return err
+	}
+	w.WriteString(",\"delegated_free\":")
+	if err := codonWriteJSON(w, v.DelegatedFree); err != nil {
+		return err
+	}
+	w.WriteString(",\"delegated_vesting\":")
+	if err := codonWriteJSON(w, v.DelegatedVesting); err != nil {
+		return err
+	}
+	w.WriteString(",\"end_time\":")
+	codonWriteJSONQuotedInt(w, int64(v.EndTime))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONBaseVestingAccount
+
+func EncodeJSONCoin(w *bytes.Buffer, v Coin) error {
+	w.WriteByte('{')
+	w.WriteString("\"denom\":")
+	codonWriteJSONString(w, string(v.Denom))
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONCoin
+
+func EncodeJSONCommentRef(w *bytes.Buffer, v CommentRef) error {
+	w.WriteByte('{')
+	w.WriteString("\"id\":")
+	codonWriteJSONQuotedUint(w, uint64(v.ID))
+	w.WriteString(",\"reward_target\":")
+	if err := codonWriteJSON(w, v.RewardTarget); err != nil {
+		return err
+	}
+	w.WriteString(",\"reward_token\":")
+	codonWriteJSONString(w, string(v.RewardToken))
+	w.WriteString(",\"reward_amount\":")
+	codonWriteJSONQuotedInt(w, int64(v.RewardAmount))
+	w.WriteString(",\"attitudes\":")
+	if v.Attitudes == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Attitudes); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			codonWriteJSONInt(w, int64(v.Attitudes[_0]))
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONCommentRef
+
+func EncodeJSONCommunityPoolSpendProposal(w *bytes.Buffer, v CommunityPoolSpendProposal) error {
+	w.WriteByte('{')
+	w.WriteString("\"title\":")
+	codonWriteJSONString(w, string(v.Title))
+	w.WriteString(",\"description\":")
+	codonWriteJSONString(w, string(v.Description))
+	w.WriteString(",\"recipient\":")
+	if err := codonWriteJSON(w, v.Recipient); err != nil {
+		return err
+	}
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONCommunityPoolSpendProposal
+
+func EncodeJSONContinuousVestingAccount(w *bytes.Buffer, v ContinuousVestingAccount) error {
+	w.WriteByte('{')
+	w.WriteString("\"BaseVestingAccount\":")
+	if v.BaseVestingAccount == nil {
+		w.WriteString("null")
+	} else {
+		if err := EncodeJSONBaseVestingAccount(w, (*v.BaseVestingAccount)); err != nil {
+			return err
+		}
+	}
+	w.WriteString(",\"start_time\":")
+	codonWriteJSONQuotedInt(w, int64(v.StartTime))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONContinuousVestingAccount
+
+func EncodeJSONDelayedVestingAccount(w *bytes.Buffer, v DelayedVestingAccount) error {
+	w.WriteByte('{')
+	w.WriteString("\"BaseVestingAccount\":")
+	if v.BaseVestingAccount == nil {
+		w.WriteString("null")
+	} else {
+		if err := EncodeJSONBaseVestingAccount(w, (*v.BaseVestingAccount)); err != nil {
+			return err
+		}
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONDelayedVestingAccount
+
+func EncodeJSONDuplicateVoteEvidence(w *bytes.Buffer, v DuplicateVoteEvidence) error {
+	w.WriteByte('{')
+	w.WriteString("\"PubKey\":")
+	if err := EncodeJSONPubKey(w, v.PubKey); err != nil {
+		return err
+	}
+	w.WriteString(",\"VoteA\":")
+	if v.VoteA == nil {
+		w.WriteString("null")
+	} else {
+		if err := EncodeJSONVote(w, (*v.VoteA)); err != nil {
+			return err
+		}
+	}
+	w.WriteString(",\"VoteB\":")
+	if v.VoteB == nil {
+		w.WriteString("null")
+	} else {
+		if err := EncodeJSONVote(w, (*v.VoteB)); err != nil {
+			return err
+		}
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONDuplicateVoteEvidence
+
+func EncodeJSONInput(w *bytes.Buffer, v Input) error {
+	w.WriteByte('{')
+	w.WriteString("\"address\":")
+	if err := codonWriteJSON(w, v.Address); err != nil {
+		return err
+	}
+	w.WriteString(",\"coins\":")
+	if err := codonWriteJSON(w, v.Coins); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONInput
+
+func EncodeJSONLockedCoin(w *bytes.Buffer, v LockedCoin) error {
+	w.WriteByte('{')
+	w.WriteString("\"coin\":")
+	if err := EncodeJSONCoin(w, v.Coin); err != nil {
+		return err
+	}
+	w.WriteString(",\"unlock_time\":")
+	codonWriteJSONQuotedInt(w, int64(v.UnlockTime))
+	_comma1 := true
+	if len(v.FromAddress) != 0 {
+		if _comma1 {
+			w.WriteByte(',')
+		}
+		w.WriteString("\"from_address\":")
+		if err := codonWriteJSON(w, v.FromAddress); err != nil {
+			return err
+		}
+		_comma1 = true
+	}
+	if len(v.Supervisor) != 0 {
+		if _comma1 {
+			w.WriteByte(',')
+		}
+		w.WriteString("\"supervisor\":")
+		if err := codonWriteJSON(w, v.Supervisor); err != nil {
+			return err
+		}
+		_comma1 = true
+	}
+	if v.Reward != 0 {
+		if _comma1 {
+			w.WriteByte(',')
+		}
+		w.WriteString("\"reward\":")
+		codonWriteJSONQuotedInt(w, int64(v.Reward))
+		_comma1 = true
+	}
+	_ = _comma1
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONLockedCoin
+
+func EncodeJSONMarketInfo(w *bytes.Buffer, v MarketInfo) error {
+	w.WriteByte('{')
+	w.WriteString("\"stock\":")
+	codonWriteJSONString(w, string(v.Stock))
+	w.WriteString(",\"money\":")
+	codonWriteJSONString(w, string(v.Money))
+	w.WriteString(",\"price_precision\":")
+	codonWriteJSONUint(w, uint64(v.PricePrecision))
+	w.WriteString(",\"last_executed_price\":")
+	if err := codonWriteJSON(w, v.LastExecutedPrice); err != nil {
+		return err
+	}
+	w.WriteString(",\"order_precision\":")
+	codonWriteJSONUint(w, uint64(v.OrderPrecision))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMarketInfo
+
+func EncodeJSONModuleAccount(w *bytes.Buffer, v ModuleAccount) error {
+	w.WriteByte('{')
+	w.WriteString("\"BaseAccount\":")
+	if v.BaseAccount == nil {
+		w.WriteString("null")
+	} else {
+		if err := EncodeJSONBaseAccount(w, (*v.BaseAccount)); err != nil {
+			return err
+		}
+	}
+	w.WriteString(",\"name\":")
+	codonWriteJSONString(w, string(v.Name))
+	w.WriteString(",\"permissions\":")
+	if v.Permissions == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Permissions); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			codonWriteJSONString(w, string(v.Permissions[_0]))
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONModuleAccount
+
+func EncodeJSONMsgAddLiquidity(w *bytes.Buffer, v MsgAddLiquidity) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"stock\":")
+	codonWriteJSONString(w, string(v.Stock))
+	w.WriteString(",\"money\":")
+	codonWriteJSONString(w, string(v.Money))
+	w.WriteString(",\"stock_in\":")
+	if err := codonWriteJSON(w, v.StockIn); err != nil {
+		return err
+	}
+	w.WriteString(",\"money_in\":")
+	if err := codonWriteJSON(w, v.MoneyIn); err != nil {
+		return err
+	}
+	w.WriteString(",\"to\":")
+	if err := codonWriteJSON(w, v.To); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgAddLiquidity
+
+func EncodeJSONMsgAddTokenWhitelist(w *bytes.Buffer, v MsgAddTokenWhitelist) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"owner_address\":")
+	if err := codonWriteJSON(w, v.OwnerAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"whitelist\":")
+	if v.Whitelist == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Whitelist); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := codonWriteJSON(w, v.Whitelist[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgAddTokenWhitelist
+
+func EncodeJSONMsgAliasUpdate(w *bytes.Buffer, v MsgAliasUpdate) error {
+	w.WriteByte('{')
+	w.WriteString("\"owner\":")
+	if err := codonWriteJSON(w, v.Owner); err != nil {
+		return err
+	}
+	w.WriteString(",\"alias\":")
+	codonWriteJSONString(w, string(v.Alias))
+	w.WriteString(",\"is_add\":")
+	codonWriteJSONBool(w, bool(v.IsAdd))
+	w.WriteString(",\"as_default\":")
+	codonWriteJSONBool(w, bool(v.AsDefault))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgAliasUpdate
+
+func EncodeJSONMsgAutoSwapCancelOrder(w *bytes.Buffer, v MsgAutoSwapCancelOrder) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"order_id\":")
+	codonWriteJSONString(w, string(v.OrderID))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgAutoSwapCancelOrder
+
+func EncodeJSONMsgAutoSwapCreateOrder(w *bytes.Buffer, v MsgAutoSwapCreateOrder) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"identify\":")
+	codonWriteJSONUint(w, uint64(v.Identify))
+	w.WriteString(",\"trading_pair\":")
+	codonWriteJSONString(w, string(v.TradingPair))
+	w.WriteString(",\"price_precision\":")
+	codonWriteJSONUint(w, uint64(v.PricePrecision))
+	w.WriteString(",\"price\":")
+	codonWriteJSONQuotedInt(w, int64(v.Price))
+	w.WriteString(",\"quantity\":")
+	codonWriteJSONQuotedInt(w, int64(v.Quantity))
+	w.WriteString(",\"side\":")
+	codonWriteJSONUint(w, uint64(v.Side))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgAutoSwapCreateOrder
+
+func EncodeJSONMsgBancorCancel(w *bytes.Buffer, v MsgBancorCancel) error {
+	w.WriteByte('{')
+	w.WriteString("\"owner\":")
+	if err := codonWriteJSON(w, v.Owner); err != nil {
+		return err
+	}
+	w.WriteString(",\"stock\":")
+	codonWriteJSONString(w, string(v.Stock))
+	w.WriteString(",\"money\":")
+	codonWriteJSONString(w, string(v.Money))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgBancorCancel
+
+func EncodeJSONMsgBancorInit(w *bytes.Buffer, v MsgBancorInit) error {
+	w.WriteByte('{')
+	w.WriteString("\"owner\":")
+	if err := codonWriteJSON(w, v.Owner); err != nil {
+		return err
+	}
+	w.WriteString(",\"stock\":")
+	codonWriteJSONString(w, string(v.Stock))
+	w.WriteString(",\"money\":")
+	codonWriteJSONString(w, string(v.Money))
+	w.WriteString(",\"init_price\":")
+	codonWriteJSONString(w, string(v.InitPrice))
+	w.WriteString(",\"max_supply\":")
+	if err := codonWriteJSON(w, v.MaxSupply); err != nil {
+		return err
+	}
+	w.WriteString(",\"max_price\":")
+	codonWriteJSONString(w, string(v.MaxPrice))
+	w.WriteString(",\"max_money\":")
+	if err := codonWriteJSON(w, v.MaxMoney); err != nil {
+		return err
+	}
+	w.WriteString(",\"stock_precision\":")
+	codonWriteJSONUint(w, uint64(v.StockPrecision))
+	w.WriteString(",\"earliest_cancel_time\":")
+	codonWriteJSONQuotedInt(w, int64(v.EarliestCancelTime))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgBancorInit
+
+func EncodeJSONMsgBancorTrade(w *bytes.Buffer, v MsgBancorTrade) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"stock\":")
+	codonWriteJSONString(w, string(v.Stock))
+	w.WriteString(",\"money\":")
+	codonWriteJSONString(w, string(v.Money))
+	w.WriteString(",\"amount\":")
+	codonWriteJSONQuotedInt(w, int64(v.Amount))
+	w.WriteString(",\"is_buy\":")
+	codonWriteJSONBool(w, bool(v.IsBuy))
+	w.WriteString(",\"money_limit\":")
+	codonWriteJSONQuotedInt(w, int64(v.MoneyLimit))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgBancorTrade
+
+func EncodeJSONMsgBeginRedelegate(w *bytes.Buffer, v MsgBeginRedelegate) error {
+	w.WriteByte('{')
+	w.WriteString("\"delegator_address\":")
+	if err := codonWriteJSON(w, v.DelegatorAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"validator_src_address\":")
+	if err := codonWriteJSON(w, v.ValidatorSrcAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"validator_dst_address\":")
+	if err := codonWriteJSON(w, v.ValidatorDstAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"amount\":")
+	if err := EncodeJSONCoin(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgBeginRedelegate
+
+func EncodeJSONMsgBurnToken(w *bytes.Buffer, v MsgBurnToken) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteString(",\"owner_address\":")
+	if err := codonWriteJSON(w, v.OwnerAddress); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgBurnToken
+
+func EncodeJSONMsgCancelOrder(w *bytes.Buffer, v MsgCancelOrder) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"order_id\":")
+	codonWriteJSONString(w, string(v.OrderID))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgCancelOrder
+
+func EncodeJSONMsgCancelTradingPair(w *bytes.Buffer, v MsgCancelTradingPair) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"trading_pair\":")
+	codonWriteJSONString(w, string(v.TradingPair))
+	w.WriteString(",\"effective_time\":")
+	codonWriteJSONQuotedInt(w, int64(v.EffectiveTime))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgCancelTradingPair
+
+func EncodeJSONMsgCommentToken(w *bytes.Buffer, v MsgCommentToken) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"token\":")
+	codonWriteJSONString(w, string(v.Token))
+	w.WriteString(",\"donation\":")
+	codonWriteJSONQuotedInt(w, int64(v.Donation))
+	w.WriteString(",\"title\":")
+	codonWriteJSONString(w, string(v.Title))
+	w.WriteString(",\"content\":")
+	if v.Content == nil {
+		w.WriteString("null")
+	} else {
+		codonWriteJSONBytes(w, v.Content[:])
+	}
+	w.WriteString(",\"content_type\":")
+	codonWriteJSONInt(w, int64(v.ContentType))
+	w.WriteString(",\"references\":")
+	if v.References == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.References); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONCommentRef(w, v.References[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgCommentToken
+
+func EncodeJSONMsgCreateOrder(w *bytes.Buffer, v MsgCreateOrder) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"identify\":")
+	codonWriteJSONUint(w, uint64(v.Identify))
+	w.WriteString(",\"trading_pair\":")
+	codonWriteJSONString(w, string(v.TradingPair))
+	w.WriteString(",\"order_type\":")
+	codonWriteJSONUint(w, uint64(v.OrderType))
+	w.WriteString(",\"price_precision\":")
+	codonWriteJSONUint(w, uint64(v.PricePrecision))
+	w.WriteString(",\"price\":")
+	codonWriteJSONQuotedInt(w, int64(v.Price))
+	w.WriteString(",\"quantity\":")
+	codonWriteJSONQuotedInt(w, int64(v.Quantity))
+	w.WriteString(",\"side\":")
+	codonWriteJSONUint(w, uint64(v.Side))
+	w.WriteString(",\"time_in_force\":")
+	codonWriteJSONQuotedInt(w, int64(v.TimeInForce))
+	w.WriteString(",\"exist_blocks\":")
+	codonWriteJSONQuotedInt(w, int64(v.ExistBlocks))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgCreateOrder
+
+func EncodeJSONMsgCreateTradingPair(w *bytes.Buffer, v MsgCreateTradingPair) error {
+	w.WriteByte('{')
+	w.WriteString("\"stock\":")
+	codonWriteJSONString(w, string(v.Stock))
+	w.WriteString(",\"money\":")
+	codonWriteJSONString(w, string(v.Money))
+	w.WriteString(",\"creator\":")
+	if err := codonWriteJSON(w, v.Creator); err != nil {
+		return err
+	}
+	w.WriteString(",\"price_precision\":")
+	codonWriteJSONUint(w, uint64(v.PricePrecision))
+	w.WriteString(",\"order_precision\":")
+	codonWriteJSONUint(w, uint64(v.OrderPrecision))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgCreateTradingPair
+
+func EncodeJSONMsgCreateValidator(w *bytes.Buffer, v MsgCreateValidator) error {
+	if err := codonWriteJSON(w, v); err != nil {
+		return err
+	}
+	return nil
+} //End of EncodeJSONMsgCreateValidator
+
+func EncodeJSONMsgDelegate(w *bytes.Buffer, v MsgDelegate) error {
+	w.WriteByte('{')
+	w.WriteString("\"delegator_address\":")
+	if err := codonWriteJSON(w, v.DelegatorAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"validator_address\":")
+	if err := codonWriteJSON(w, v.ValidatorAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"amount\":")
+	if err := EncodeJSONCoin(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgDelegate
+
+func EncodeJSONMsgDeposit(w *bytes.Buffer, v MsgDeposit) error {
+	w.WriteByte('{')
+	w.WriteString("\"proposal_id\":")
+	codonWriteJSONQuotedUint(w, uint64(v.ProposalID))
+	w.WriteString(",\"depositor\":")
+	if err := codonWriteJSON(w, v.Depositor); err != nil {
+		return err
+	}
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgDeposit
+
+func EncodeJSONMsgDonateToCommunityPool(w *bytes.Buffer, v MsgDonateToCommunityPool) error {
+	w.WriteByte('{')
+	w.WriteString("\"from_addr\":")
+	if err := codonWriteJSON(w, v.FromAddr); err != nil {
+		return err
+	}
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgDonateToCommunityPool
+
+func EncodeJSONMsgEditValidator(w *bytes.Buffer, v MsgEditValidator) error {
+	w.WriteByte('{')
+	w.WriteString("\"Description\":")
+	w.WriteByte('{')
+	w.WriteString("\"moniker\":")
+	codonWriteJSONString(w, string(v.Description.Moniker))
+	w.WriteString(",\"identity\":")
+	codonWriteJSONString(w, string(v.Description.Identity))
+	w.WriteString(",\"website\":")
+	codonWriteJSONString(w, string(v.Description.Website))
+	w.WriteString(",\"details\":")
+	codonWriteJSONString(w, string(v.Description.Details))
+	w.WriteByte('}')
+	w.WriteString(",\"address\":")
+	if err := codonWriteJSON(w, v.ValidatorAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"commission_rate\":")
+	if v.CommissionRate == nil {
+		w.WriteString("null")
+	} else {
+		if err := codonWriteJSON(w, (*v.CommissionRate)); err != nil {
+			return err
+		}
+	}
+	w.WriteString(",\"min_self_delegation\":")
+	if v.MinSelfDelegation == nil {
+		w.WriteString("null")
+	} else {
+		if err := codonWriteJSON(w, (*v.MinSelfDelegation)); err != nil {
+			return err
+		}
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgEditValidator
+
+func EncodeJSONMsgForbidAddr(w *bytes.Buffer, v MsgForbidAddr) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"owner_address\":")
+	if err := codonWriteJSON(w, v.OwnerAddr); err != nil {
+		return err
+	}
+	w.WriteString(",\"addresses\":")
+	if v.Addresses == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Addresses); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := codonWriteJSON(w, v.Addresses[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgForbidAddr
+
+func EncodeJSONMsgForbidToken(w *bytes.Buffer, v MsgForbidToken) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"owner_address\":")
+	if err := codonWriteJSON(w, v.OwnerAddress); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgForbidToken
+
+func EncodeJSONMsgIssueToken(w *bytes.Buffer, v MsgIssueToken) error {
+	w.WriteByte('{')
+	w.WriteString("\"name\":")
+	codonWriteJSONString(w, string(v.Name))
+	w.WriteString(",\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"total_supply\":")
+	if err := codonWriteJSON(w, v.TotalSupply); err != nil {
+		return err
+	}
+	w.WriteString(",\"owner\":")
+	if err := codonWriteJSON(w, v.Owner); err != nil {
+		return err
+	}
+	w.WriteString(",\"mintable\":")
+	codonWriteJSONBool(w, bool(v.Mintable))
+	w.WriteString(",\"burnable\":")
+	codonWriteJSONBool(w, bool(v.Burnable))
+	w.WriteString(",\"addr_forbiddable\":")
+	codonWriteJSONBool(w, bool(v.AddrForbiddable))
+	w.WriteString(",\"token_forbiddable\":")
+	codonWriteJSONBool(w, bool(v.TokenForbiddable))
+	w.WriteString(",\"url\":")
+	codonWriteJSONString(w, string(v.URL))
+	w.WriteString(",\"description\":")
+	codonWriteJSONString(w, string(v.Description))
+	w.WriteString(",\"identity\":")
+	codonWriteJSONString(w, string(v.Identity))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgIssueToken
+
+func EncodeJSONMsgMintToken(w *bytes.Buffer, v MsgMintToken) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteString(",\"owner_address\":")
+	if err := codonWriteJSON(w, v.OwnerAddress); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgMintToken
+
+func EncodeJSONMsgModifyPricePrecision(w *bytes.Buffer, v MsgModifyPricePrecision) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"trading_pair\":")
+	codonWriteJSONString(w, string(v.TradingPair))
+	w.WriteString(",\"price_precision\":")
+	codonWriteJSONUint(w, uint64(v.PricePrecision))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgModifyPricePrecision
+
+func EncodeJSONMsgModifyTokenInfo(w *bytes.Buffer, v MsgModifyTokenInfo) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"owner_address\":")
+	if err := codonWriteJSON(w, v.OwnerAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"url\":")
+	codonWriteJSONString(w, string(v.URL))
+	w.WriteString(",\"description\":")
+	codonWriteJSONString(w, string(v.Description))
+	w.WriteString(",\"identity\":")
+	codonWriteJSONString(w, string(v.Identity))
+	w.WriteString(",\"name\":")
+	codonWriteJSONString(w, string(v.Name))
+	w.WriteString(",\"total_supply\":")
+	codonWriteJSONString(w, string(v.TotalSupply))
+	w.WriteString(",\"mintable\":")
+	codonWriteJSONString(w, string(v.Mintable))
+	w.WriteString(",\"burnable\":")
+	codonWriteJSONString(w, string(v.Burnable))
+	w.WriteString(",\"addr_forbiddable\":")
+	codonWriteJSONString(w, string(v.AddrForbiddable))
+	w.WriteString(",\"token_forbiddable\":")
+	codonWriteJSONString(w, string(v.TokenForbiddable))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgModifyTokenInfo
+
+func EncodeJSONMsgMultiSend(w *bytes.Buffer, v MsgMultiSend) error {
+	w.WriteByte('{')
+	w.WriteString("\"inputs\":")
+	if v.Inputs == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Inputs); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONInput(w, v.Inputs[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteString(",\"outputs\":")
+	if v.Outputs == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Outputs); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONOutput(w, v.Outputs[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgMultiSend
+
+func EncodeJSONMsgMultiSendX(w *bytes.Buffer, v MsgMultiSendX) error {
+	w.WriteByte('{')
+	w.WriteString("\"inputs\":")
+	if v.Inputs == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Inputs); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONInput(w, v.Inputs[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteString(",\"outputs\":")
+	if v.Outputs == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Outputs); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONOutput(w, v.Outputs[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgMultiSendX
+
+func EncodeJSONMsgRemoveLiquidity(w *bytes.Buffer, v MsgRemoveLiquidity) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"stock\":")
+	codonWriteJSONString(w, string(v.Stock))
+	w.WriteString(",\"money\":")
+	codonWriteJSONString(w, string(v.Money))
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteString(",\"to\":")
+	if err := codonWriteJSON(w, v.To); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgRemoveLiquidity
+
+func EncodeJSONMsgRemoveTokenWhitelist(w *bytes.Buffer, v MsgRemoveTokenWhitelist) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"owner_address\":")
+	if err := codonWriteJSON(w, v.OwnerAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"whitelist\":")
+	if v.Whitelist == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Whitelist); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := codonWriteJSON(w, v.Whitelist[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgRemoveTokenWhitelist
+
+func EncodeJSONMsgSend(w *bytes.Buffer, v MsgSend) error {
+	w.WriteByte('{')
+	w.WriteString("\"from_address\":")
+	if err := codonWriteJSON(w, v.FromAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"to_address\":")
+	if err := codonWriteJSON(w, v.ToAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgSend
+
+func EncodeJSONMsgSendX(w *bytes.Buffer, v MsgSendX) error {
+	w.WriteByte('{')
+	w.WriteString("\"from_address\":")
+	if err := codonWriteJSON(w, v.FromAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"to_address\":")
+	if err := codonWriteJSON(w, v.ToAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteString(",\"unlock_time\":")
+	codonWriteJSONQuotedInt(w, int64(v.UnlockTime))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgSendX
+
+func EncodeJSONMsgSetMemoRequired(w *bytes.Buffer, v MsgSetMemoRequired) error {
+	w.WriteByte('{')
+	w.WriteString("\"address\":")
+	if err := codonWriteJSON(w, v.Address); err != nil {
+		return err
+	}
+	w.WriteString(",\"required\":")
+	codonWriteJSONBool(w, bool(v.Required))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgSetMemoRequired
+
+func EncodeJSONMsgSetReferee(w *bytes.Buffer, v MsgSetReferee) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"referee\":")
+	if err := codonWriteJSON(w, v.Referee); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgSetReferee
+
+func EncodeJSONMsgSetWithdrawAddress(w *bytes.Buffer, v MsgSetWithdrawAddress) error {
+	w.WriteByte('{')
+	w.WriteString("\"delegator_address\":")
+	if err := codonWriteJSON(w, v.DelegatorAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"withdraw_address\":")
+	if err := codonWriteJSON(w, v.WithdrawAddress); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgSetWithdrawAddress
+
+func EncodeJSONMsgSubmitProposal(w *bytes.Buffer, v MsgSubmitProposal) error {
+	w.WriteByte('{')
+	w.WriteString("\"content\":")
+	if err := EncodeJSONContent(w, v.Content); err != nil {
+		return err
+	}
+	w.WriteString(",\"initial_deposit\":")
+	if err := codonWriteJSON(w, v.InitialDeposit); err != nil {
+		return err
+	}
+	w.WriteString(",\"proposer\":")
+	if err := codonWriteJSON(w, v.Proposer); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgSubmitProposal
+
+func EncodeJSONMsgSupervisedSend(w *bytes.Buffer, v MsgSupervisedSend) error {
+	w.WriteByte('{')
+	w.WriteString("\"from_address\":")
+	if err := codonWriteJSON(w, v.FromAddress); err != nil {
+		return err
+	}
+	_comma1 := true
+	if len(v.Supervisor) != 0 {
+		if _comma1 {
+			w.WriteByte(',')
+		}
+		w.WriteString("\"supervisor\":")
+		if err := codonWriteJSON(w, v.Supervisor); err != nil {
+			return err
+		}
+		_comma1 = true
+	}
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"to_address\":")
+	if err := codonWriteJSON(w, v.ToAddress); err != nil {
+		return err
+	}
+	_comma1 = true
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"amount\":")
+	if err := EncodeJSONCoin(w, v.Amount); err != nil {
+		return err
+	}
+	_comma1 = true
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"unlock_time\":")
+	codonWriteJSONQuotedInt(w, int64(v.UnlockTime))
+	_comma1 = true
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"reward\":")
+	codonWriteJSONQuotedInt(w, int64(v.Reward))
+	_comma1 = true
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"operation\":")
+	codonWriteJSONUint(w, uint64(v.Operation))
+	_comma1 = true
+	_ = _comma1
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgSupervisedSend
+
+func EncodeJSONMsgTransferOwnership(w *bytes.Buffer, v MsgTransferOwnership) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"original_owner\":")
+	if err := codonWriteJSON(w, v.OriginalOwner); err != nil {
+		return err
+	}
+	w.WriteString(",\"new_owner\":")
+	if err := codonWriteJSON(w, v.NewOwner); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgTransferOwnership
+
+func EncodeJSONMsgUnForbidAddr(w *bytes.Buffer, v MsgUnForbidAddr) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"owner_address\":")
+	if err := codonWriteJSON(w, v.OwnerAddr); err != nil {
+		return err
+	}
+	w.WriteString(",\"addresses\":")
+	if v.Addresses == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Addresses); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := codonWriteJSON(w, v.Addresses[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgUnForbidAddr
+
+func EncodeJSONMsgUnForbidToken(w *bytes.Buffer, v MsgUnForbidToken) error {
+	w.WriteByte('{')
+	w.WriteString("\"symbol\":")
+	codonWriteJSONString(w, string(v.Symbol))
+	w.WriteString(",\"owner_address\":")
+	if err := codonWriteJSON(w, v.OwnerAddress); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgUnForbidToken
+
+func EncodeJSONMsgUndelegate(w *bytes.Buffer, v MsgUndelegate) error {
+	w.WriteByte('{')
+	w.WriteString("\"delegator_address\":")
+	if err := codonWriteJSON(w, v.DelegatorAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"validator_address\":")
+	if err := codonWriteJSON(w, v.ValidatorAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"amount\":")
+	if err := EncodeJSONCoin(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgUndelegate
+
+func EncodeJSONMsgUnjail(w *bytes.Buffer, v MsgUnjail) error {
+	w.WriteByte('{')
+	w.WriteString("\"address\":")
+	if err := codonWriteJSON(w, v.ValidatorAddr); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgUnjail
+
+func EncodeJSONMsgVerifyInvariant(w *bytes.Buffer, v MsgVerifyInvariant) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"invariant_module_name\":")
+	codonWriteJSONString(w, string(v.InvariantModuleName))
+	w.WriteString(",\"invariant_route\":")
+	codonWriteJSONString(w, string(v.InvariantRoute))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgVerifyInvariant
+
+func EncodeJSONMsgVote(w *bytes.Buffer, v MsgVote) error {
+	w.WriteByte('{')
+	w.WriteString("\"proposal_id\":")
+	codonWriteJSONQuotedUint(w, uint64(v.ProposalID))
+	w.WriteString(",\"voter\":")
+	if err := codonWriteJSON(w, v.Voter); err != nil {
+		return err
+	}
+	w.WriteString(",\"option\":")
+	if err := codonWriteJSON(w, v.Option); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgVote
+
+func EncodeJSONMsgWithdrawDelegatorReward(w *bytes.Buffer, v MsgWithdrawDelegatorReward) error {
+	w.WriteByte('{')
+	w.WriteString("\"delegator_address\":")
+	if err := codonWriteJSON(w, v.DelegatorAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"validator_address\":")
+	if err := codonWriteJSON(w, v.ValidatorAddress); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgWithdrawDelegatorReward
+
+func EncodeJSONMsgWithdrawValidatorCommission(w *bytes.Buffer, v MsgWithdrawValidatorCommission) error {
+	w.WriteByte('{')
+	w.WriteString("\"validator_address\":")
+	if err := codonWriteJSON(w, v.ValidatorAddress); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONMsgWithdrawValidatorCommission
+
+func EncodeJSONOrder(w *bytes.Buffer, v Order) error {
+	w.WriteByte('{')
+	w.WriteString("\"sender\":")
+	if err := codonWriteJSON(w, v.Sender); err != nil {
+		return err
+	}
+	w.WriteString(",\"sequence\":")
+	codonWriteJSONQuotedUint(w, uint64(v.Sequence))
+	w.WriteString(",\"identify\":")
+	codonWriteJSONUint(w, uint64(v.Identify))
+	w.WriteString(",\"trading_pair\":")
+	codonWriteJSONString(w, string(v.TradingPair))
+	w.WriteString(",\"order_type\":")
+	codonWriteJSONUint(w, uint64(v.OrderType))
+	w.WriteString(",\"price\":")
+	if err := codonWriteJSON(w, v.Price); err != nil {
+		return err
+	}
+	w.WriteString(",\"quantity\":")
+	codonWriteJSONQuotedInt(w, int64(v.Quantity))
+	w.WriteString(",\"side\":")
+	codonWriteJSONUint(w, uint64(v.Side))
+	w.WriteString(",\"time_in_force\":")
+	codonWriteJSONQuotedInt(w, int64(v.TimeInForce))
+	w.WriteString(",\"height\":")
+	codonWriteJSONQuotedInt(w, int64(v.Height))
+	w.WriteString(",\"frozen_commission\":")
+	codonWriteJSONQuotedInt(w, int64(v.FrozenCommission))
+	w.WriteString(",\"exist_blocks\":")
+	codonWriteJSONQuotedInt(w, int64(v.ExistBlocks))
+	w.WriteString(",\"frozen_feature_fee\":")
+	codonWriteJSONQuotedInt(w, int64(v.FrozenFeatureFee))
+	_comma1 := true
+	if v.FrozenFee != 0 {
+		if _comma1 {
+			w.WriteByte(',')
+		}
+		w.WriteString("\"frozen_fee\":")
+		codonWriteJSONQuotedInt(w, int64(v.FrozenFee))
+		_comma1 = true
+	}
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"left_stock\":")
+	codonWriteJSONQuotedInt(w, int64(v.LeftStock))
+	_comma1 = true
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"freeze\":")
+	codonWriteJSONQuotedInt(w, int64(v.Freeze))
+	_comma1 = true
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"deal_stock\":")
+	codonWriteJSONQuotedInt(w, int64(v.DealStock))
+	_comma1 = true
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"deal_money\":")
+	codonWriteJSONQuotedInt(w, int64(v.DealMoney))
+	_comma1 = true
+	_ = _comma1
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONOrder
+
+func EncodeJSONOutput(w *bytes.Buffer, v Output) error {
+	w.WriteByte('{')
+	w.WriteString("\"address\":")
+	if err := codonWriteJSON(w, v.Address); err != nil {
+		return err
+	}
+	w.WriteString(",\"coins\":")
+	if err := codonWriteJSON(w, v.Coins); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONOutput
+
+func EncodeJSONParamChange(w *bytes.Buffer, v ParamChange) error {
+	w.WriteByte('{')
+	w.WriteString("\"subspace\":")
+	codonWriteJSONString(w, string(v.Subspace))
+	w.WriteString(",\"key\":")
+	codonWriteJSONString(w, string(v.Key))
+	_comma1 := true
+	if len(v.Subkey) != 0 {
+		if _comma1 {
+			w.WriteByte(',')
+		}
+		w.WriteString("\"subkey\":")
+		codonWriteJSONString(w, string(v.Subkey))
+		_comma1 = true
+	}
+	if _comma1 {
+		w.WriteByte(',')
+	}
+	w.WriteString("\"value\":")
+	codonWriteJSONString(w, string(v.Value))
+	_comma1 = true
+	_ = _comma1
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONParamChange
+
+func EncodeJSONParameterChangeProposal(w *bytes.Buffer, v ParameterChangeProposal) error {
+	w.WriteByte('{')
+	w.WriteString("\"title\":")
+	codonWriteJSONString(w, string(v.Title))
+	w.WriteString(",\"description\":")
+	codonWriteJSONString(w, string(v.Description))
+	w.WriteString(",\"changes\":")
+	if v.Changes == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Changes); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONParamChange(w, v.Changes[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONParameterChangeProposal
+
+func EncodeJSONPeriod(w *bytes.Buffer, v Period) error {
+	w.WriteByte('{')
+	w.WriteString("\"length\":")
+	codonWriteJSONQuotedInt(w, int64(v.Length))
+	w.WriteString(",\"amount\":")
+	if err := codonWriteJSON(w, v.Amount); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONPeriod
+
+func EncodeJSONPeriodicVestingAccount(w *bytes.Buffer, v PeriodicVestingAccount) error {
+	w.WriteByte('{')
+	w.WriteString("\"BaseVestingAccount\":")
+	if v.BaseVestingAccount == nil {
+		w.WriteString("null")
+	} else {
+		if err := EncodeJSONBaseVestingAccount(w, (*v.BaseVestingAccount)); err != nil {
+			return err
+		}
+	}
+	w.WriteString(",\"start_time\":")
+	codonWriteJSONQuotedInt(w, int64(v.StartTime))
+	w.WriteString(",\"vesting_periods\":")
+	if v.VestingPeriods == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.VestingPeriods); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONPeriod(w, v.VestingPeriods[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONPeriodicVestingAccount
+
+func EncodeJSONPrivKeyEd25519(w *bytes.Buffer, v PrivKeyEd25519) error {
+	codonWriteJSONBytes(w, v[:])
+	return nil
+} //End of EncodeJSONPrivKeyEd25519
+
+func EncodeJSONPrivKeySecp256k1(w *bytes.Buffer, v PrivKeySecp256k1) error {
+	codonWriteJSONBytes(w, v[:])
+	return nil
+} //End of EncodeJSONPrivKeySecp256k1
+
+func EncodeJSONPubKeyEd25519(w *bytes.Buffer, v PubKeyEd25519) error {
+	codonWriteJSONBytes(w, v[:])
+	return nil
+} //End of EncodeJSONPubKeyEd25519
+
+func EncodeJSONPubKeyMultisigThreshold(w *bytes.Buffer, v PubKeyMultisigThreshold) error {
+	w.WriteByte('{')
+	w.WriteString("\"threshold\":")
+	codonWriteJSONQuotedUint(w, uint64(v.K))
+	w.WriteString(",\"pubkeys\":")
+	if v.PubKeys == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.PubKeys); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONPubKey(w, v.PubKeys[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONPubKeyMultisigThreshold
+
+func EncodeJSONPubKeySecp256k1(w *bytes.Buffer, v PubKeySecp256k1) error {
+	codonWriteJSONBytes(w, v[:])
+	return nil
+} //End of EncodeJSONPubKeySecp256k1
+
+func EncodeJSONSignedMsgType(w *bytes.Buffer, v SignedMsgType) error {
+	codonWriteJSONUint(w, uint64(v))
+	return nil
+} //End of EncodeJSONSignedMsgType
+
+func EncodeJSONSoftwareUpgradeProposal(w *bytes.Buffer, v SoftwareUpgradeProposal) error {
+	w.WriteByte('{')
+	w.WriteString("\"title\":")
+	codonWriteJSONString(w, string(v.Title))
+	w.WriteString(",\"description\":")
+	codonWriteJSONString(w, string(v.Description))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONSoftwareUpgradeProposal
+
+func EncodeJSONState(w *bytes.Buffer, v State) error {
+	w.WriteByte('{')
+	w.WriteString("\"height_adjustment\":")
+	codonWriteJSONQuotedInt(w, int64(v.HeightAdjustment))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONState
+
+func EncodeJSONStdSignature(w *bytes.Buffer, v StdSignature) error {
+	w.WriteByte('{')
+	w.WriteString("\"pub_key\":")
+	if err := EncodeJSONPubKey(w, v.PubKey); err != nil {
+		return err
+	}
+	w.WriteString(",\"signature\":")
+	if v.Signature == nil {
+		w.WriteString("null")
+	} else {
+		codonWriteJSONBytes(w, v.Signature[:])
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONStdSignature
+
+func EncodeJSONStdTx(w *bytes.Buffer, v StdTx) error {
+	w.WriteByte('{')
+	w.WriteString("\"msg\":")
+	if v.Msgs == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Msgs); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONMsg(w, v.Msgs[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteString(",\"fee\":")
+	w.WriteByte('{')
+	w.WriteString("\"amount\":")
+	if err := codonWriteJSON(w, v.Fee.Amount); err != nil {
+		return err
+	}
+	w.WriteString(",\"gas\":")
+	codonWriteJSONQuotedUint(w, uint64(v.Fee.Gas))
+	w.WriteByte('}')
+	w.WriteString(",\"signatures\":")
+	if v.Signatures == nil {
+		w.WriteString("null")
+	} else {
+		w.WriteByte('[')
+		for _0 := 0; _0 < len(v.Signatures); _0++ {
+			if _0 != 0 {
+				w.WriteByte(',')
+			}
+			if err := EncodeJSONStdSignature(w, v.Signatures[_0]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	}
+	w.WriteString(",\"memo\":")
+	codonWriteJSONString(w, string(v.Memo))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONStdTx
+
+func EncodeJSONSupply(w *bytes.Buffer, v Supply) error {
+	w.WriteByte('{')
+	w.WriteString("\"total\":")
+	if err := codonWriteJSON(w, v.Total); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONSupply
+
+func EncodeJSONTextProposal(w *bytes.Buffer, v TextProposal) error {
+	w.WriteByte('{')
+	w.WriteString("\"title\":")
+	codonWriteJSONString(w, string(v.Title))
+	w.WriteString(",\"description\":")
+	codonWriteJSONString(w, string(v.Description))
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONTextProposal
+
+func EncodeJSONVote(w *bytes.Buffer, v Vote) error {
+	w.WriteByte('{')
+	w.WriteString("\"type\":")
+	codonWriteJSONUint(w, uint64(v.Type))
+	w.WriteString(",\"height\":")
+	codonWriteJSONQuotedInt(w, int64(v.Height))
+	w.WriteString(",\"round\":")
+	codonWriteJSONQuotedInt(w, int64(v.Round))
+	w.WriteString(",\"block_id\":")
+	w.WriteByte('{')
+	w.WriteString("\"hash\":")
+	if err := codonWriteJSON(w, v.BlockID.Hash); err != nil {
+		return err
+	}
+	w.WriteString(",\"parts\":")
+	w.WriteByte('{')
+	w.WriteString("\"total\":")
+	codonWriteJSONQuotedInt(w, int64(v.BlockID.PartsHeader.Total))
+	w.WriteString(",\"hash\":")
+	if err := codonWriteJSON(w, v.BlockID.PartsHeader.Hash); err != nil {
+		return err
+	}
+	w.WriteByte('}')
+	w.WriteByte('}')
+	w.WriteString(",\"timestamp\":")
+	if err := codonWriteJSON(w, v.Timestamp.Round(0).UTC()); err != nil {
+		return err
+	}
+	w.WriteString(",\"validator_address\":")
+	if err := codonWriteJSON(w, v.ValidatorAddress); err != nil {
+		return err
+	}
+	w.WriteString(",\"validator_index\":")
+	codonWriteJSONQuotedInt(w, int64(v.ValidatorIndex))
+	w.WriteString(",\"signature\":")
+	if v.Signature == nil {
+		w.WriteString("null")
+	} else {
+		codonWriteJSONBytes(w, v.Signature[:])
+	}
+	w.WriteByte('}')
+	return nil
+} //End of EncodeJSONVote
+
+func EncodeJSONVoteOption(w *bytes.Buffer, v VoteOption) error {
+	if err := codonWriteJSON(w, v); err != nil {
+		return err
+	}
+	return nil
+} //End of EncodeJSONVoteOption
+
+func EncodeJSONAccount(w *bytes.Buffer, x Account) error {
 	switch v := x.(type) {
-	case AccAddress:
-		return EncodeAccAddress(w, v)
-	case *AccAddress:
-		return EncodeAccAddress(w, *v)
-	case AccountX:
-		return EncodeAccountX(w, v)
-	case *AccountX:
-		return EncodeAccountX(w, *v)
-	case BaseAccount:
-		return EncodeBaseAccount(w, v)
-	case *BaseAccount:
-		return EncodeBaseAccount(w, *v)
-	case BaseToken:
-		return EncodeBaseToken(w, v)
-	case *BaseToken:
-		return EncodeBaseToken(w, *v)
+	case nil:
+		w.WriteString("null")
+		return nil
 	case BaseVestingAccount:
-		return EncodeBaseVestingAccount(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/BaseVestingAccount\",\"value\":")
+		if err := EncodeJSONBaseVestingAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *BaseVestingAccount:
-		return EncodeBaseVestingAccount(w, *v)
-	case Coin:
-		return EncodeCoin(w, v)
-	case *Coin:
-		return EncodeCoin(w, *v)
-	case CommentRef:
-		return EncodeCommentRef(w, v)
-	case *CommentRef:
-		return EncodeCommentRef(w, *v)
-	case CommunityPoolSpendProposal:
-		return EncodeCommunityPoolSpendProposal(w, v)
-	case *CommunityPoolSpendProposal:
-		return EncodeCommunityPoolSpendProposal(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/BaseVestingAccount\",\"value\":")
+		if err := EncodeJSONBaseVestingAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case ContinuousVestingAccount:
-		return EncodeContinuousVestingAccount(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/ContinuousVestingAccount\",\"value\":")
+		if err := EncodeJSONContinuousVestingAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *ContinuousVestingAccount:
-		return EncodeContinuousVestingAccount(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/ContinuousVestingAccount\",\"value\":")
+		if err := EncodeJSONContinuousVestingAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case DelayedVestingAccount:
-		return EncodeDelayedVestingAccount(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/DelayedVestingAccount\",\"value\":")
+		if err := EncodeJSONDelayedVestingAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *DelayedVestingAccount:
-		return EncodeDelayedVestingAccount(w, *v)
-	case DuplicateVoteEvidence:
-		return EncodeDuplicateVoteEvidence(w, v)
-	case *DuplicateVoteEvidence:
-		return EncodeDuplicateVoteEvidence(w, *v)
-	case Input:
-		return EncodeInput(w, v)
-	case *Input:
-		return EncodeInput(w, *v)
-	case LockedCoin:
-		return EncodeLockedCoin(w, v)
-	case *LockedCoin:
-		return EncodeLockedCoin(w, *v)
-	case MarketInfo:
-		return EncodeMarketInfo(w, v)
-	case *MarketInfo:
-		return EncodeMarketInfo(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/DelayedVestingAccount\",\"value\":")
+		if err := EncodeJSONDelayedVestingAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case ModuleAccount:
-		return EncodeModuleAccount(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/ModuleAccount\",\"value\":")
+		if err := EncodeJSONModuleAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *ModuleAccount:
-		return EncodeModuleAccount(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/ModuleAccount\",\"value\":")
+		if err := EncodeJSONModuleAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case PeriodicVestingAccount:
+		w.WriteString("{\"type\":\"cosmos-sdk/PeriodicVestingAccount\",\"value\":")
+		if err := EncodeJSONPeriodicVestingAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *PeriodicVestingAccount:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/PeriodicVestingAccount\",\"value\":")
+		if err := EncodeJSONPeriodicVestingAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	default:
+		return errors.New("Unknown type")
+	} // end of switch
+} //End of EncodeJSONAccount
+
+func EncodeJSONContent(w *bytes.Buffer, x Content) error {
+	switch v := x.(type) {
+	case nil:
+		w.WriteString("null")
+		return nil
+	case CommunityPoolSpendProposal:
+		w.WriteString("{\"type\":\"cosmos-sdk/CommunityPoolSpendProposal\",\"value\":")
+		if err := EncodeJSONCommunityPoolSpendProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *CommunityPoolSpendProposal:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/CommunityPoolSpendProposal\",\"value\":")
+		if err := EncodeJSONCommunityPoolSpendProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case ParameterChangeProposal:
+		w.WriteString("{\"type\":\"cosmos-sdk/ParameterChangeProposal\",\"value\":")
+		if err := EncodeJSONParameterChangeProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *ParameterChangeProposal:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/ParameterChangeProposal\",\"value\":")
+		if err := EncodeJSONParameterChangeProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case SoftwareUpgradeProposal:
+		w.WriteString("{\"type\":\"cosmos-sdk/SoftwareUpgradeProposal\",\"value\":")
+		if err := EncodeJSONSoftwareUpgradeProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *SoftwareUpgradeProposal:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/SoftwareUpgradeProposal\",\"value\":")
+		if err := EncodeJSONSoftwareUpgradeProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case TextProposal:
+		w.WriteString("{\"type\":\"cosmos-sdk/TextProposal\",\"value\":")
+		if err := EncodeJSONTextProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *TextProposal:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/TextProposal\",\"value\":")
+		if err := EncodeJSONTextProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	default:
+		return errors.New("Unknown type")
+	} // end of switch
+} //End of EncodeJSONContent
+
+func EncodeJSONMsg(w *bytes.Buffer, x Msg) error {
+	switch v := x.(type) {
+	case nil:
+		w.WriteString("null")
+		return nil
+	case MsgAddLiquidity:
+		w.WriteString("{\"type\":\"market/MsgAddLiquidity\",\"value\":")
+		if err := EncodeJSONMsgAddLiquidity(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgAddLiquidity:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"market/MsgAddLiquidity\",\"value\":")
+		if err := EncodeJSONMsgAddLiquidity(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgAddTokenWhitelist:
-		return EncodeMsgAddTokenWhitelist(w, v)
+		w.WriteString("{\"type\":\"asset/MsgAddTokenWhitelist\",\"value\":")
+		if err := EncodeJSONMsgAddTokenWhitelist(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgAddTokenWhitelist:
-		return EncodeMsgAddTokenWhitelist(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgAddTokenWhitelist\",\"value\":")
+		if err := EncodeJSONMsgAddTokenWhitelist(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgAliasUpdate:
-		return EncodeMsgAliasUpdate(w, v)
+		w.WriteString("{\"type\":\"alias/MsgAliasUpdate\",\"value\":")
+		if err := EncodeJSONMsgAliasUpdate(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgAliasUpdate:
-		return EncodeMsgAliasUpdate(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"alias/MsgAliasUpdate\",\"value\":")
+		if err := EncodeJSONMsgAliasUpdate(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgAutoSwapCancelOrder:
+		w.WriteString("{\"type\":\"market/MsgAutoSwapCancelOrder\",\"value\":")
+		if err := EncodeJSONMsgAutoSwapCancelOrder(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgAutoSwapCancelOrder:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"market/MsgAutoSwapCancelOrder\",\"value\":")
+		if err := EncodeJSONMsgAutoSwapCancelOrder(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgAutoSwapCreateOrder:
+		w.WriteString("{\"type\":\"market/MsgAutoSwapCreateOrder\",\"value\":")
+		if err := EncodeJSONMsgAutoSwapCreateOrder(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgAutoSwapCreateOrder:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"market/MsgAutoSwapCreateOrder\",\"value\":")
+		if err := EncodeJSONMsgAutoSwapCreateOrder(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgBancorCancel:
-		return EncodeMsgBancorCancel(w, v)
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorCancel\",\"value\":")
+		if err := EncodeJSONMsgBancorCancel(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBancorCancel:
-		return EncodeMsgBancorCancel(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorCancel\",\"value\":")
+		if err := EncodeJSONMsgBancorCancel(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgBancorInit:
-		return EncodeMsgBancorInit(w, v)
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorInit\",\"value\":")
+		if err := EncodeJSONMsgBancorInit(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBancorInit:
-		return EncodeMsgBancorInit(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorInit\",\"value\":")
+		if err := EncodeJSONMsgBancorInit(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgBancorTrade:
-		return EncodeMsgBancorTrade(w, v)
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorTrade\",\"value\":")
+		if err := EncodeJSONMsgBancorTrade(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBancorTrade:
-		return EncodeMsgBancorTrade(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorTrade\",\"value\":")
+		if err := EncodeJSONMsgBancorTrade(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgBeginRedelegate:
-		return EncodeMsgBeginRedelegate(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgBeginRedelegate\",\"value\":")
+		if err := EncodeJSONMsgBeginRedelegate(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBeginRedelegate:
-		return EncodeMsgBeginRedelegate(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgBeginRedelegate\",\"value\":")
+		if err := EncodeJSONMsgBeginRedelegate(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgBurnToken:
-		return EncodeMsgBurnToken(w, v)
+		w.WriteString("{\"type\":\"asset/MsgBurnToken\",\"value\":")
+		if err := EncodeJSONMsgBurnToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBurnToken:
-		return EncodeMsgBurnToken(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgBurnToken\",\"value\":")
+		if err := EncodeJSONMsgBurnToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgCancelOrder:
-		return EncodeMsgCancelOrder(w, v)
+		w.WriteString("{\"type\":\"market/MsgCancelOrder\",\"value\":")
+		if err := EncodeJSONMsgCancelOrder(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCancelOrder:
-		return EncodeMsgCancelOrder(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"market/MsgCancelOrder\",\"value\":")
+		if err := EncodeJSONMsgCancelOrder(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgCancelTradingPair:
-		return EncodeMsgCancelTradingPair(w, v)
+		w.WriteString("{\"type\":\"market/MsgCancelTradingPair\",\"value\":")
+		if err := EncodeJSONMsgCancelTradingPair(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCancelTradingPair:
-		return EncodeMsgCancelTradingPair(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"market/MsgCancelTradingPair\",\"value\":")
+		if err := EncodeJSONMsgCancelTradingPair(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgCommentToken:
-		return EncodeMsgCommentToken(w, v)
+		w.WriteString("{\"type\":\"comment/MsgCommentToken\",\"value\":")
+		if err := EncodeJSONMsgCommentToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCommentToken:
-		return EncodeMsgCommentToken(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"comment/MsgCommentToken\",\"value\":")
+		if err := EncodeJSONMsgCommentToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgCreateOrder:
-		return EncodeMsgCreateOrder(w, v)
+		w.WriteString("{\"type\":\"market/MsgCreateOrder\",\"value\":")
+		if err := EncodeJSONMsgCreateOrder(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCreateOrder:
-		return EncodeMsgCreateOrder(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"market/MsgCreateOrder\",\"value\":")
+		if err := EncodeJSONMsgCreateOrder(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgCreateTradingPair:
-		return EncodeMsgCreateTradingPair(w, v)
+		w.WriteString("{\"type\":\"market/MsgCreateTradingPair\",\"value\":")
+		if err := EncodeJSONMsgCreateTradingPair(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCreateTradingPair:
-		return EncodeMsgCreateTradingPair(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"market/MsgCreateTradingPair\",\"value\":")
+		if err := EncodeJSONMsgCreateTradingPair(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgCreateValidator:
-		return EncodeMsgCreateValidator(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgCreateValidator\",\"value\":")
+		if err := EncodeJSONMsgCreateValidator(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCreateValidator:
-		return EncodeMsgCreateValidator(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgCreateValidator\",\"value\":")
+		if err := EncodeJSONMsgCreateValidator(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgDelegate:
-		return EncodeMsgDelegate(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgDelegate\",\"value\":")
+		if err := EncodeJSONMsgDelegate(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgDelegate:
-		return EncodeMsgDelegate(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgDelegate\",\"value\":")
+		if err := EncodeJSONMsgDelegate(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgDeposit:
-		return EncodeMsgDeposit(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgDeposit\",\"value\":")
+		if err := EncodeJSONMsgDeposit(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgDeposit:
-		return EncodeMsgDeposit(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgDeposit\",\"value\":")
+		if err := EncodeJSONMsgDeposit(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgDonateToCommunityPool:
-		return EncodeMsgDonateToCommunityPool(w, v)
+		w.WriteString("{\"type\":\"distrx/MsgDonateToCommunityPool\",\"value\":")
+		if err := EncodeJSONMsgDonateToCommunityPool(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgDonateToCommunityPool:
-		return EncodeMsgDonateToCommunityPool(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"distrx/MsgDonateToCommunityPool\",\"value\":")
+		if err := EncodeJSONMsgDonateToCommunityPool(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgEditValidator:
-		return EncodeMsgEditValidator(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgEditValidator\",\"value\":")
+		if err := EncodeJSONMsgEditValidator(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgEditValidator:
-		return EncodeMsgEditValidator(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgEditValidator\",\"value\":")
+		if err := EncodeJSONMsgEditValidator(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgForbidAddr:
-		return EncodeMsgForbidAddr(w, v)
+		w.WriteString("{\"type\":\"asset/MsgForbidAddr\",\"value\":")
+		if err := EncodeJSONMsgForbidAddr(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgForbidAddr:
-		return EncodeMsgForbidAddr(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgForbidAddr\",\"value\":")
+		if err := EncodeJSONMsgForbidAddr(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgForbidToken:
-		return EncodeMsgForbidToken(w, v)
+		w.WriteString("{\"type\":\"asset/MsgForbidToken\",\"value\":")
+		if err := EncodeJSONMsgForbidToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgForbidToken:
-		return EncodeMsgForbidToken(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgForbidToken\",\"value\":")
+		if err := EncodeJSONMsgForbidToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgIssueToken:
-		return EncodeMsgIssueToken(w, v)
+		w.WriteString("{\"type\":\"asset/MsgIssueToken\",\"value\":")
+		if err := EncodeJSONMsgIssueToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgIssueToken:
-		return EncodeMsgIssueToken(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgIssueToken\",\"value\":")
+		if err := EncodeJSONMsgIssueToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgMintToken:
-		return EncodeMsgMintToken(w, v)
+		w.WriteString("{\"type\":\"asset/MsgMintToken\",\"value\":")
+		if err := EncodeJSONMsgMintToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgMintToken:
-		return EncodeMsgMintToken(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgMintToken\",\"value\":")
+		if err := EncodeJSONMsgMintToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgModifyPricePrecision:
-		return EncodeMsgModifyPricePrecision(w, v)
+		w.WriteString("{\"type\":\"market/MsgModifyPricePrecision\",\"value\":")
+		if err := EncodeJSONMsgModifyPricePrecision(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgModifyPricePrecision:
-		return EncodeMsgModifyPricePrecision(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"market/MsgModifyPricePrecision\",\"value\":")
+		if err := EncodeJSONMsgModifyPricePrecision(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgModifyTokenInfo:
-		return EncodeMsgModifyTokenInfo(w, v)
+		w.WriteString("{\"type\":\"asset/MsgModifyTokenInfo\",\"value\":")
+		if err := EncodeJSONMsgModifyTokenInfo(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgModifyTokenInfo:
-		return EncodeMsgModifyTokenInfo(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgModifyTokenInfo\",\"value\":")
+		if err := EncodeJSONMsgModifyTokenInfo(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgMultiSend:
-		return EncodeMsgMultiSend(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgMultiSend\",\"value\":")
+		if err := EncodeJSONMsgMultiSend(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgMultiSend:
-		return EncodeMsgMultiSend(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgMultiSend\",\"value\":")
+		if err := EncodeJSONMsgMultiSend(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgMultiSendX:
-		return EncodeMsgMultiSendX(w, v)
+		w.WriteString("{\"type\":\"bankx/MsgMultiSend\",\"value\":")
+		if err := EncodeJSONMsgMultiSendX(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgMultiSendX:
-		return EncodeMsgMultiSendX(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"bankx/MsgMultiSend\",\"value\":")
+		if err := EncodeJSONMsgMultiSendX(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgRemoveLiquidity:
+		w.WriteString("{\"type\":\"market/MsgRemoveLiquidity\",\"value\":")
+		if err := EncodeJSONMsgRemoveLiquidity(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgRemoveLiquidity:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"market/MsgRemoveLiquidity\",\"value\":")
+		if err := EncodeJSONMsgRemoveLiquidity(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgRemoveTokenWhitelist:
-		return EncodeMsgRemoveTokenWhitelist(w, v)
+		w.WriteString("{\"type\":\"asset/MsgRemoveTokenWhitelist\",\"value\":")
+		if err := EncodeJSONMsgRemoveTokenWhitelist(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgRemoveTokenWhitelist:
-		return EncodeMsgRemoveTokenWhitelist(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgRemoveTokenWhitelist\",\"value\":")
+		if err := EncodeJSONMsgRemoveTokenWhitelist(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgSend:
-		return EncodeMsgSend(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgSend\",\"value\":")
+		if err := EncodeJSONMsgSend(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSend:
-		return EncodeMsgSend(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgSend\",\"value\":")
+		if err := EncodeJSONMsgSend(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgSendX:
-		return EncodeMsgSendX(w, v)
+		w.WriteString("{\"type\":\"bankx/MsgSend\",\"value\":")
+		if err := EncodeJSONMsgSendX(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSendX:
-		return EncodeMsgSendX(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"bankx/MsgSend\",\"value\":")
+		if err := EncodeJSONMsgSendX(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgSetMemoRequired:
-		return EncodeMsgSetMemoRequired(w, v)
+		w.WriteString("{\"type\":\"bankx/MsgSetMemoRequired\",\"value\":")
+		if err := EncodeJSONMsgSetMemoRequired(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSetMemoRequired:
-		return EncodeMsgSetMemoRequired(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"bankx/MsgSetMemoRequired\",\"value\":")
+		if err := EncodeJSONMsgSetMemoRequired(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgSetReferee:
+		w.WriteString("{\"type\":\"authx/MsgSetReferee\",\"value\":")
+		if err := EncodeJSONMsgSetReferee(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgSetReferee:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"authx/MsgSetReferee\",\"value\":")
+		if err := EncodeJSONMsgSetReferee(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgSetWithdrawAddress:
-		return EncodeMsgSetWithdrawAddress(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgModifyWithdrawAddress\",\"value\":")
+		if err := EncodeJSONMsgSetWithdrawAddress(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSetWithdrawAddress:
-		return EncodeMsgSetWithdrawAddress(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgModifyWithdrawAddress\",\"value\":")
+		if err := EncodeJSONMsgSetWithdrawAddress(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgSubmitProposal:
-		return EncodeMsgSubmitProposal(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgSubmitProposal\",\"value\":")
+		if err := EncodeJSONMsgSubmitProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSubmitProposal:
-		return EncodeMsgSubmitProposal(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgSubmitProposal\",\"value\":")
+		if err := EncodeJSONMsgSubmitProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgSupervisedSend:
+		w.WriteString("{\"type\":\"bankx/MsgSupervisedSend\",\"value\":")
+		if err := EncodeJSONMsgSupervisedSend(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgSupervisedSend:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"bankx/MsgSupervisedSend\",\"value\":")
+		if err := EncodeJSONMsgSupervisedSend(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgTransferOwnership:
-		return EncodeMsgTransferOwnership(w, v)
+		w.WriteString("{\"type\":\"asset/MsgTransferOwnership\",\"value\":")
+		if err := EncodeJSONMsgTransferOwnership(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgTransferOwnership:
-		return EncodeMsgTransferOwnership(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgTransferOwnership\",\"value\":")
+		if err := EncodeJSONMsgTransferOwnership(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgUnForbidAddr:
-		return EncodeMsgUnForbidAddr(w, v)
+		w.WriteString("{\"type\":\"asset/MsgUnForbidAddr\",\"value\":")
+		if err := EncodeJSONMsgUnForbidAddr(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgUnForbidAddr:
-		return EncodeMsgUnForbidAddr(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgUnForbidAddr\",\"value\":")
+		if err := EncodeJSONMsgUnForbidAddr(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgUnForbidToken:
-		return EncodeMsgUnForbidToken(w, v)
+		w.WriteString("{\"type\":\"asset/MsgUnForbidToken\",\"value\":")
+		if err := EncodeJSONMsgUnForbidToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgUnForbidToken:
-		return EncodeMsgUnForbidToken(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"asset/MsgUnForbidToken\",\"value\":")
+		if err := EncodeJSONMsgUnForbidToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgUndelegate:
-		return EncodeMsgUndelegate(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgUndelegate\",\"value\":")
+		if err := EncodeJSONMsgUndelegate(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgUndelegate:
-		return EncodeMsgUndelegate(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgUndelegate\",\"value\":")
+		if err := EncodeJSONMsgUndelegate(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgUnjail:
-		return EncodeMsgUnjail(w, v)
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgUnjail\",\"value\":")
+		if err := EncodeJSONMsgUnjail(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgUnjail:
-		return EncodeMsgUnjail(w, *v)
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgUnjail\",\"value\":")
+		if err := EncodeJSONMsgUnjail(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case MsgVerifyInvariant:
-		return EncodeMsgVerifyInvariant(w, v)
-	case *MsgVerifyInvariant:
-		return EncodeMsgVerifyInvariant(w, *v)
-	case MsgVote:
-		return EncodeMsgVote(w, v)
-	case *MsgVote:
-		return EncodeMsgVote(w, *v)
-	case MsgWithdrawDelegatorReward:
-		return EncodeMsgWithdrawDelegatorReward(w, v)
-	case *MsgWithdrawDelegatorReward:
-		return EncodeMsgWithdrawDelegatorReward(w, *v)
-	case MsgWithdrawValidatorCommission:
-		return EncodeMsgWithdrawValidatorCommission(w, v)
-	case *MsgWithdrawValidatorCommission:
-		return EncodeMsgWithdrawValidatorCommission(w, *v)
-	case Order:
-		return EncodeOrder(w, v)
-	case *Order:
-		return EncodeOrder(w, *v)
-	case Output:
-		return EncodeOutput(w, v)
-	case *Output:
-		return EncodeOutput(w, *v)
-	case ParamChange:
-		return EncodeParamChange(w, v)
-	case *ParamChange:
-		return EncodeParamChange(w, *v)
-	case ParameterChangeProposal:
-		return EncodeParameterChangeProposal(w, v)
-	case *ParameterChangeProposal:
-		return EncodeParameterChangeProposal(w, *v)
-	case PrivKeyEd25519:
-		return EncodePrivKeyEd25519(w, v)
-	case *PrivKeyEd25519:
-		return EncodePrivKeyEd25519(w, *v)
-	case PrivKeySecp256k1:
-		return EncodePrivKeySecp256k1(w, v)
-	case *PrivKeySecp256k1:
-		return EncodePrivKeySecp256k1(w, *v)
-	case PubKeyEd25519:
-		return EncodePubKeyEd25519(w, v)
-	case *PubKeyEd25519:
-		return EncodePubKeyEd25519(w, *v)
-	case PubKeyMultisigThreshold:
-		return EncodePubKeyMultisigThreshold(w, v)
-	case *PubKeyMultisigThreshold:
-		return EncodePubKeyMultisigThreshold(w, *v)
-	case PubKeySecp256k1:
-		return EncodePubKeySecp256k1(w, v)
-	case *PubKeySecp256k1:
-		return EncodePubKeySecp256k1(w, *v)
-	case SignedMsgType:
-		return EncodeSignedMsgType(w, v)
-	case *SignedMsgType:
-		return EncodeSignedMsgType(w, *v)
-	case SoftwareUpgradeProposal:
-		return EncodeSoftwareUpgradeProposal(w, v)
-	case *SoftwareUpgradeProposal:
-		return EncodeSoftwareUpgradeProposal(w, *v)
-	case State:
-		return EncodeState(w, v)
-	case *State:
-		return EncodeState(w, *v)
-	case StdSignature:
-		return EncodeStdSignature(w, v)
-	case *StdSignature:
-		return EncodeStdSignature(w, *v)
-	case StdTx:
-		return EncodeStdTx(w, v)
-	case *StdTx:
-		return EncodeStdTx(w, *v)
-	case Supply:
-		return EncodeSupply(w, v)
-	case *Supply:
-		return EncodeSupply(w, *v)
-	case TextProposal:
-		return EncodeTextProposal(w, v)
-	case *TextProposal:
-		return EncodeTextProposal(w, *v)
-	case Vote:
-		return EncodeVote(w, v)
-	case *Vote:
-		return EncodeVote(w, *v)
-	case VoteOption:
-		return EncodeVoteOption(w, v)
-	case *VoteOption:
-		return EncodeVoteOption(w, *v)
-	default:
-		panic("Unknown Type.")
-	} // end of switch
-} // end of func
-func DecodeAny(bz []byte) (interface{}, int, error) {
-	var v interface{}
-	var magicBytes [4]byte
-	var n int
-	for i := 0; i < 4; i++ {
-		magicBytes[i] = bz[i]
-	}
-	switch magicBytes {
-	case [4]byte{37, 50, 37, 208}:
-		v, n, err := DecodeAccAddress(bz[4:])
-		return v, n + 4, err
-	case [4]byte{52, 40, 250, 245}:
-		v, n, err := DecodeAccountX(bz[4:])
-		return v, n + 4, err
-	case [4]byte{100, 94, 81, 72}:
-		v, n, err := DecodeBaseAccount(bz[4:])
-		return v, n + 4, err
-	case [4]byte{34, 178, 244, 51}:
-		v, n, err := DecodeBaseToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{178, 47, 121, 129}:
-		v, n, err := DecodeBaseVestingAccount(bz[4:])
-		return v, n + 4, err
-	case [4]byte{141, 140, 97, 80}:
-		v, n, err := DecodeCoin(bz[4:])
-		return v, n + 4, err
-	case [4]byte{17, 162, 164, 235}:
-		v, n, err := DecodeCommentRef(bz[4:])
-		return v, n + 4, err
-	case [4]byte{37, 214, 119, 170}:
-		v, n, err := DecodeCommunityPoolSpendProposal(bz[4:])
-		return v, n + 4, err
-	case [4]byte{95, 96, 75, 0}:
-		v, n, err := DecodeContinuousVestingAccount(bz[4:])
-		return v, n + 4, err
-	case [4]byte{187, 71, 224, 1}:
-		v, n, err := DecodeDelayedVestingAccount(bz[4:])
-		return v, n + 4, err
-	case [4]byte{130, 76, 198, 17}:
-		v, n, err := DecodeDuplicateVoteEvidence(bz[4:])
-		return v, n + 4, err
-	case [4]byte{165, 152, 189, 47}:
-		v, n, err := DecodeInput(bz[4:])
-		return v, n + 4, err
-	case [4]byte{227, 236, 168, 93}:
-		v, n, err := DecodeLockedCoin(bz[4:])
-		return v, n + 4, err
-	case [4]byte{174, 117, 167, 230}:
-		v, n, err := DecodeMarketInfo(bz[4:])
-		return v, n + 4, err
-	case [4]byte{190, 107, 1, 124}:
-		v, n, err := DecodeModuleAccount(bz[4:])
-		return v, n + 4, err
-	case [4]byte{147, 136, 220, 215}:
-		v, n, err := DecodeMsgAddTokenWhitelist(bz[4:])
-		return v, n + 4, err
-	case [4]byte{173, 181, 17, 162}:
-		v, n, err := DecodeMsgAliasUpdate(bz[4:])
-		return v, n + 4, err
-	case [4]byte{187, 190, 104, 91}:
-		v, n, err := DecodeMsgBancorCancel(bz[4:])
-		return v, n + 4, err
-	case [4]byte{177, 152, 232, 60}:
-		v, n, err := DecodeMsgBancorInit(bz[4:])
-		return v, n + 4, err
-	case [4]byte{225, 122, 18, 80}:
-		v, n, err := DecodeMsgBancorTrade(bz[4:])
-		return v, n + 4, err
-	case [4]byte{247, 3, 0, 105}:
-		v, n, err := DecodeMsgBeginRedelegate(bz[4:])
-		return v, n + 4, err
-	case [4]byte{228, 0, 236, 212}:
-		v, n, err := DecodeMsgBurnToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{106, 229, 80, 141}:
-		v, n, err := DecodeMsgCancelOrder(bz[4:])
-		return v, n + 4, err
-	case [4]byte{13, 177, 95, 127}:
-		v, n, err := DecodeMsgCancelTradingPair(bz[4:])
-		return v, n + 4, err
-	case [4]byte{79, 125, 235, 121}:
-		v, n, err := DecodeMsgCommentToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{246, 238, 7, 164}:
-		v, n, err := DecodeMsgCreateOrder(bz[4:])
-		return v, n + 4, err
-	case [4]byte{130, 221, 55, 57}:
-		v, n, err := DecodeMsgCreateTradingPair(bz[4:])
-		return v, n + 4, err
-	case [4]byte{58, 78, 252, 114}:
-		v, n, err := DecodeMsgCreateValidator(bz[4:])
-		return v, n + 4, err
-	case [4]byte{1, 82, 140, 71}:
-		v, n, err := DecodeMsgDelegate(bz[4:])
-		return v, n + 4, err
-	case [4]byte{205, 134, 140, 190}:
-		v, n, err := DecodeMsgDeposit(bz[4:])
-		return v, n + 4, err
-	case [4]byte{20, 250, 115, 197}:
-		v, n, err := DecodeMsgDonateToCommunityPool(bz[4:])
-		return v, n + 4, err
-	case [4]byte{202, 62, 140, 8}:
-		v, n, err := DecodeMsgEditValidator(bz[4:])
-		return v, n + 4, err
-	case [4]byte{105, 235, 112, 10}:
-		v, n, err := DecodeMsgForbidAddr(bz[4:])
-		return v, n + 4, err
-	case [4]byte{36, 174, 203, 238}:
-		v, n, err := DecodeMsgForbidToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{233, 180, 92, 129}:
-		v, n, err := DecodeMsgIssueToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{66, 148, 56, 203}:
-		v, n, err := DecodeMsgMintToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{76, 91, 156, 199}:
-		v, n, err := DecodeMsgModifyPricePrecision(bz[4:])
-		return v, n + 4, err
-	case [4]byte{40, 158, 40, 18}:
-		v, n, err := DecodeMsgModifyTokenInfo(bz[4:])
-		return v, n + 4, err
-	case [4]byte{207, 152, 156, 90}:
-		v, n, err := DecodeMsgMultiSend(bz[4:])
-		return v, n + 4, err
-	case [4]byte{61, 117, 88, 200}:
-		v, n, err := DecodeMsgMultiSendX(bz[4:])
-		return v, n + 4, err
-	case [4]byte{44, 154, 68, 83}:
-		v, n, err := DecodeMsgRemoveTokenWhitelist(bz[4:])
-		return v, n + 4, err
-	case [4]byte{100, 168, 39, 140}:
-		v, n, err := DecodeMsgSend(bz[4:])
-		return v, n + 4, err
-	case [4]byte{198, 76, 8, 81}:
-		v, n, err := DecodeMsgSendX(bz[4:])
-		return v, n + 4, err
-	case [4]byte{184, 238, 253, 154}:
-		v, n, err := DecodeMsgSetMemoRequired(bz[4:])
-		return v, n + 4, err
-	case [4]byte{190, 178, 173, 144}:
-		v, n, err := DecodeMsgSetWithdrawAddress(bz[4:])
-		return v, n + 4, err
-	case [4]byte{115, 119, 137, 48}:
-		v, n, err := DecodeMsgSubmitProposal(bz[4:])
-		return v, n + 4, err
-	case [4]byte{200, 224, 118, 175}:
-		v, n, err := DecodeMsgTransferOwnership(bz[4:])
-		return v, n + 4, err
-	case [4]byte{167, 165, 166, 227}:
-		v, n, err := DecodeMsgUnForbidAddr(bz[4:])
-		return v, n + 4, err
-	case [4]byte{78, 83, 156, 139}:
-		v, n, err := DecodeMsgUnForbidToken(bz[4:])
-		return v, n + 4, err
-	case [4]byte{122, 66, 160, 76}:
-		v, n, err := DecodeMsgUndelegate(bz[4:])
-		return v, n + 4, err
-	case [4]byte{216, 247, 180, 46}:
-		v, n, err := DecodeMsgUnjail(bz[4:])
-		return v, n + 4, err
-	case [4]byte{84, 44, 219, 65}:
-		v, n, err := DecodeMsgVerifyInvariant(bz[4:])
-		return v, n + 4, err
-	case [4]byte{238, 246, 67, 141}:
-		v, n, err := DecodeMsgVote(bz[4:])
-		return v, n + 4, err
-	case [4]byte{94, 251, 176, 152}:
-		v, n, err := DecodeMsgWithdrawDelegatorReward(bz[4:])
-		return v, n + 4, err
-	case [4]byte{18, 172, 190, 152}:
-		v, n, err := DecodeMsgWithdrawValidatorCommission(bz[4:])
-		return v, n + 4, err
-	case [4]byte{57, 115, 63, 27}:
-		v, n, err := DecodeOrder(bz[4:])
-		return v, n + 4, err
-	case [4]byte{251, 0, 54, 127}:
-		v, n, err := DecodeOutput(bz[4:])
-		return v, n + 4, err
-	case [4]byte{234, 101, 49, 27}:
-		v, n, err := DecodeParamChange(bz[4:])
-		return v, n + 4, err
-	case [4]byte{166, 63, 172, 210}:
-		v, n, err := DecodeParameterChangeProposal(bz[4:])
-		return v, n + 4, err
-	case [4]byte{93, 160, 108, 51}:
-		v, n, err := DecodePrivKeyEd25519(bz[4:])
-		return v, n + 4, err
-	case [4]byte{209, 107, 141, 98}:
-		v, n, err := DecodePrivKeySecp256k1(bz[4:])
-		return v, n + 4, err
-	case [4]byte{108, 143, 2, 48}:
-		v, n, err := DecodePubKeyEd25519(bz[4:])
-		return v, n + 4, err
-	case [4]byte{131, 227, 102, 173}:
-		v, n, err := DecodePubKeyMultisigThreshold(bz[4:])
-		return v, n + 4, err
-	case [4]byte{10, 126, 85, 105}:
-		v, n, err := DecodePubKeySecp256k1(bz[4:])
-		return v, n + 4, err
-	case [4]byte{169, 174, 252, 87}:
-		v, n, err := DecodeSignedMsgType(bz[4:])
-		return v, n + 4, err
-	case [4]byte{37, 100, 208, 251}:
-		v, n, err := DecodeSoftwareUpgradeProposal(bz[4:])
-		return v, n + 4, err
-	case [4]byte{186, 223, 120, 4}:
-		v, n, err := DecodeState(bz[4:])
-		return v, n + 4, err
-	case [4]byte{88, 244, 106, 18}:
-		v, n, err := DecodeStdSignature(bz[4:])
-		return v, n + 4, err
-	case [4]byte{71, 175, 179, 184}:
-		v, n, err := DecodeStdTx(bz[4:])
-		return v, n + 4, err
-	case [4]byte{233, 209, 209, 86}:
-		v, n, err := DecodeSupply(bz[4:])
-		return v, n + 4, err
-	case [4]byte{169, 32, 176, 245}:
-		v, n, err := DecodeTextProposal(bz[4:])
-		return v, n + 4, err
-	case [4]byte{113, 227, 24, 224}:
-		v, n, err := DecodeVote(bz[4:])
-		return v, n + 4, err
-	case [4]byte{56, 159, 20, 227}:
-		v, n, err := DecodeVoteOption(bz[4:])
-		return v, n + 4, err
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgVerifyInvariant\",\"value\":")
+		if err := EncodeJSONMsgVerifyInvariant(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgVerifyInvariant:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgVerifyInvariant\",\"value\":")
+		if err := EncodeJSONMsgVerifyInvariant(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgVote:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgVote\",\"value\":")
+		if err := EncodeJSONMsgVote(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgVote:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgVote\",\"value\":")
+		if err := EncodeJSONMsgVote(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgWithdrawDelegatorReward:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawDelegationReward\",\"value\":")
+		if err := EncodeJSONMsgWithdrawDelegatorReward(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgWithdrawDelegatorReward:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawDelegationReward\",\"value\":")
+		if err := EncodeJSONMsgWithdrawDelegatorReward(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgWithdrawValidatorCommission:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawValidatorCommission\",\"value\":")
+		if err := EncodeJSONMsgWithdrawValidatorCommission(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgWithdrawValidatorCommission:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawValidatorCommission\",\"value\":")
+		if err := EncodeJSONMsgWithdrawValidatorCommission(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	default:
-		panic("Unknown type")
+		return errors.New("Unknown type")
 	} // end of switch
-	return v, n, nil
-} // end of DecodeAny
-func BareDecodeAny(bz []byte, x interface{}) (n int, err error) {
+} //End of EncodeJSONMsg
+
+func EncodeJSONPubKey(w *bytes.Buffer, x PubKey) error {
+	switch v := x.(type) {
+	case nil:
+		w.WriteString("null")
+		return nil
+	case PubKeyEd25519:
+		w.WriteString("{\"type\":\"tendermint/PubKeyEd25519\",\"value\":")
+		if err := EncodeJSONPubKeyEd25519(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *PubKeyEd25519:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"tendermint/PubKeyEd25519\",\"value\":")
+		if err := EncodeJSONPubKeyEd25519(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case PubKeyMultisigThreshold:
+		w.WriteString("{\"type\":\"tendermint/PubKeyMultisigThreshold\",\"value\":")
+		if err := EncodeJSONPubKeyMultisigThreshold(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *PubKeyMultisigThreshold:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"tendermint/PubKeyMultisigThreshold\",\"value\":")
+		if err := EncodeJSONPubKeyMultisigThreshold(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case PubKeySecp256k1:
+		w.WriteString("{\"type\":\"tendermint/PubKeySecp256k1\",\"value\":")
+		if err := EncodeJSONPubKeySecp256k1(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *PubKeySecp256k1:
+		if v == nil {
+			return errors.New("nil pointer in an interface")
+		}
+		w.WriteString("{\"type\":\"tendermint/PubKeySecp256k1\",\"value\":")
+		if err := EncodeJSONPubKeySecp256k1(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	default:
+		return errors.New("Unknown type")
+	} // end of switch
+} //End of EncodeJSONPubKey
+
+// EncodeJSONAny writes the amino JSON of x, as MarshalJSON of the amino codec
+func EncodeJSONAny(w *bytes.Buffer, x interface{}) error {
 	switch v := x.(type) {
+	case nil:
+		w.WriteString("null")
+		return nil
+	case AccAddress:
+		if err := EncodeJSONAccAddress(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *AccAddress:
-		*v, n, err = DecodeAccAddress(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONAccAddress(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case AccountX:
+		w.WriteString("{\"type\":\"authx/AccountX\",\"value\":")
+		if err := EncodeJSONAccountX(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *AccountX:
-		*v, n, err = DecodeAccountX(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"authx/AccountX\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"authx/AccountX\",\"value\":")
+		if err := EncodeJSONAccountX(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case BaseAccount:
+		w.WriteString("{\"type\":\"cosmos-sdk/Account\",\"value\":")
+		if err := EncodeJSONBaseAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *BaseAccount:
-		*v, n, err = DecodeBaseAccount(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/Account\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/Account\",\"value\":")
+		if err := EncodeJSONBaseAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case BaseToken:
+		w.WriteString("{\"type\":\"asset/BaseToken\",\"value\":")
+		if err := EncodeJSONBaseToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *BaseToken:
-		*v, n, err = DecodeBaseToken(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/BaseToken\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/BaseToken\",\"value\":")
+		if err := EncodeJSONBaseToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case BaseVestingAccount:
+		w.WriteString("{\"type\":\"cosmos-sdk/BaseVestingAccount\",\"value\":")
+		if err := EncodeJSONBaseVestingAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *BaseVestingAccount:
-		*v, n, err = DecodeBaseVestingAccount(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/BaseVestingAccount\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/BaseVestingAccount\",\"value\":")
+		if err := EncodeJSONBaseVestingAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case Coin:
+		if err := EncodeJSONCoin(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *Coin:
-		*v, n, err = DecodeCoin(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONCoin(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case CommentRef:
+		if err := EncodeJSONCommentRef(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *CommentRef:
-		*v, n, err = DecodeCommentRef(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONCommentRef(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case CommunityPoolSpendProposal:
+		w.WriteString("{\"type\":\"cosmos-sdk/CommunityPoolSpendProposal\",\"value\":")
+		if err := EncodeJSONCommunityPoolSpendProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *CommunityPoolSpendProposal:
-		*v, n, err = DecodeCommunityPoolSpendProposal(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/CommunityPoolSpendProposal\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/CommunityPoolSpendProposal\",\"value\":")
+		if err := EncodeJSONCommunityPoolSpendProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case ContinuousVestingAccount:
+		w.WriteString("{\"type\":\"cosmos-sdk/ContinuousVestingAccount\",\"value\":")
+		if err := EncodeJSONContinuousVestingAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *ContinuousVestingAccount:
-		*v, n, err = DecodeContinuousVestingAccount(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/ContinuousVestingAccount\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/ContinuousVestingAccount\",\"value\":")
+		if err := EncodeJSONContinuousVestingAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case DelayedVestingAccount:
+		w.WriteString("{\"type\":\"cosmos-sdk/DelayedVestingAccount\",\"value\":")
+		if err := EncodeJSONDelayedVestingAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *DelayedVestingAccount:
-		*v, n, err = DecodeDelayedVestingAccount(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/DelayedVestingAccount\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/DelayedVestingAccount\",\"value\":")
+		if err := EncodeJSONDelayedVestingAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case DuplicateVoteEvidence:
+		w.WriteString("{\"type\":\"tendermint/DuplicateVoteEvidence\",\"value\":")
+		if err := EncodeJSONDuplicateVoteEvidence(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *DuplicateVoteEvidence:
-		*v, n, err = DecodeDuplicateVoteEvidence(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"tendermint/DuplicateVoteEvidence\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"tendermint/DuplicateVoteEvidence\",\"value\":")
+		if err := EncodeJSONDuplicateVoteEvidence(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case Input:
+		if err := EncodeJSONInput(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *Input:
-		*v, n, err = DecodeInput(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONInput(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case LockedCoin:
+		if err := EncodeJSONLockedCoin(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *LockedCoin:
-		*v, n, err = DecodeLockedCoin(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONLockedCoin(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case MarketInfo:
+		w.WriteString("{\"type\":\"market/TradingPair\",\"value\":")
+		if err := EncodeJSONMarketInfo(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MarketInfo:
-		*v, n, err = DecodeMarketInfo(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"market/TradingPair\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/TradingPair\",\"value\":")
+		if err := EncodeJSONMarketInfo(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case ModuleAccount:
+		w.WriteString("{\"type\":\"cosmos-sdk/ModuleAccount\",\"value\":")
+		if err := EncodeJSONModuleAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *ModuleAccount:
-		*v, n, err = DecodeModuleAccount(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/ModuleAccount\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/ModuleAccount\",\"value\":")
+		if err := EncodeJSONModuleAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgAddLiquidity:
+		w.WriteString("{\"type\":\"market/MsgAddLiquidity\",\"value\":")
+		if err := EncodeJSONMsgAddLiquidity(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgAddLiquidity:
+		if v == nil {
+			w.WriteString("{\"type\":\"market/MsgAddLiquidity\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/MsgAddLiquidity\",\"value\":")
+		if err := EncodeJSONMsgAddLiquidity(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgAddTokenWhitelist:
+		w.WriteString("{\"type\":\"asset/MsgAddTokenWhitelist\",\"value\":")
+		if err := EncodeJSONMsgAddTokenWhitelist(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgAddTokenWhitelist:
-		*v, n, err = DecodeMsgAddTokenWhitelist(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgAddTokenWhitelist\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgAddTokenWhitelist\",\"value\":")
+		if err := EncodeJSONMsgAddTokenWhitelist(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgAliasUpdate:
+		w.WriteString("{\"type\":\"alias/MsgAliasUpdate\",\"value\":")
+		if err := EncodeJSONMsgAliasUpdate(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgAliasUpdate:
-		*v, n, err = DecodeMsgAliasUpdate(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"alias/MsgAliasUpdate\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"alias/MsgAliasUpdate\",\"value\":")
+		if err := EncodeJSONMsgAliasUpdate(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgAutoSwapCancelOrder:
+		w.WriteString("{\"type\":\"market/MsgAutoSwapCancelOrder\",\"value\":")
+		if err := EncodeJSONMsgAutoSwapCancelOrder(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgAutoSwapCancelOrder:
+		if v == nil {
+			w.WriteString("{\"type\":\"market/MsgAutoSwapCancelOrder\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/MsgAutoSwapCancelOrder\",\"value\":")
+		if err := EncodeJSONMsgAutoSwapCancelOrder(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgAutoSwapCreateOrder:
+		w.WriteString("{\"type\":\"market/MsgAutoSwapCreateOrder\",\"value\":")
+		if err := EncodeJSONMsgAutoSwapCreateOrder(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgAutoSwapCreateOrder:
+		if v == nil {
+			w.WriteString("{\"type\":\"market/MsgAutoSwapCreateOrder\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/MsgAutoSwapCreateOrder\",\"value\":")
+		if err := EncodeJSONMsgAutoSwapCreateOrder(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgBancorCancel:
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorCancel\",\"value\":")
+		if err := EncodeJSONMsgBancorCancel(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBancorCancel:
-		*v, n, err = DecodeMsgBancorCancel(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"bancorlite/MsgBancorCancel\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorCancel\",\"value\":")
+		if err := EncodeJSONMsgBancorCancel(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgBancorInit:
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorInit\",\"value\":")
+		if err := EncodeJSONMsgBancorInit(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBancorInit:
-		*v, n, err = DecodeMsgBancorInit(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"bancorlite/MsgBancorInit\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorInit\",\"value\":")
+		if err := EncodeJSONMsgBancorInit(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgBancorTrade:
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorTrade\",\"value\":")
+		if err := EncodeJSONMsgBancorTrade(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBancorTrade:
-		*v, n, err = DecodeMsgBancorTrade(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"bancorlite/MsgBancorTrade\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"bancorlite/MsgBancorTrade\",\"value\":")
+		if err := EncodeJSONMsgBancorTrade(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgBeginRedelegate:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgBeginRedelegate\",\"value\":")
+		if err := EncodeJSONMsgBeginRedelegate(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBeginRedelegate:
-		*v, n, err = DecodeMsgBeginRedelegate(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgBeginRedelegate\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgBeginRedelegate\",\"value\":")
+		if err := EncodeJSONMsgBeginRedelegate(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgBurnToken:
+		w.WriteString("{\"type\":\"asset/MsgBurnToken\",\"value\":")
+		if err := EncodeJSONMsgBurnToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgBurnToken:
-		*v, n, err = DecodeMsgBurnToken(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgBurnToken\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgBurnToken\",\"value\":")
+		if err := EncodeJSONMsgBurnToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgCancelOrder:
+		w.WriteString("{\"type\":\"market/MsgCancelOrder\",\"value\":")
+		if err := EncodeJSONMsgCancelOrder(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCancelOrder:
-		*v, n, err = DecodeMsgCancelOrder(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"market/MsgCancelOrder\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/MsgCancelOrder\",\"value\":")
+		if err := EncodeJSONMsgCancelOrder(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgCancelTradingPair:
+		w.WriteString("{\"type\":\"market/MsgCancelTradingPair\",\"value\":")
+		if err := EncodeJSONMsgCancelTradingPair(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCancelTradingPair:
-		*v, n, err = DecodeMsgCancelTradingPair(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"market/MsgCancelTradingPair\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/MsgCancelTradingPair\",\"value\":")
+		if err := EncodeJSONMsgCancelTradingPair(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgCommentToken:
+		w.WriteString("{\"type\":\"comment/MsgCommentToken\",\"value\":")
+		if err := EncodeJSONMsgCommentToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCommentToken:
-		*v, n, err = DecodeMsgCommentToken(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"comment/MsgCommentToken\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"comment/MsgCommentToken\",\"value\":")
+		if err := EncodeJSONMsgCommentToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgCreateOrder:
+		w.WriteString("{\"type\":\"market/MsgCreateOrder\",\"value\":")
+		if err := EncodeJSONMsgCreateOrder(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCreateOrder:
-		*v, n, err = DecodeMsgCreateOrder(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"market/MsgCreateOrder\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/MsgCreateOrder\",\"value\":")
+		if err := EncodeJSONMsgCreateOrder(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgCreateTradingPair:
+		w.WriteString("{\"type\":\"market/MsgCreateTradingPair\",\"value\":")
+		if err := EncodeJSONMsgCreateTradingPair(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCreateTradingPair:
-		*v, n, err = DecodeMsgCreateTradingPair(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"market/MsgCreateTradingPair\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/MsgCreateTradingPair\",\"value\":")
+		if err := EncodeJSONMsgCreateTradingPair(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgCreateValidator:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgCreateValidator\",\"value\":")
+		if err := EncodeJSONMsgCreateValidator(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgCreateValidator:
-		*v, n, err = DecodeMsgCreateValidator(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgCreateValidator\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgCreateValidator\",\"value\":")
+		if err := EncodeJSONMsgCreateValidator(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgDelegate:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgDelegate\",\"value\":")
+		if err := EncodeJSONMsgDelegate(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgDelegate:
-		*v, n, err = DecodeMsgDelegate(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgDelegate\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgDelegate\",\"value\":")
+		if err := EncodeJSONMsgDelegate(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgDeposit:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgDeposit\",\"value\":")
+		if err := EncodeJSONMsgDeposit(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgDeposit:
-		*v, n, err = DecodeMsgDeposit(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgDeposit\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgDeposit\",\"value\":")
+		if err := EncodeJSONMsgDeposit(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgDonateToCommunityPool:
+		w.WriteString("{\"type\":\"distrx/MsgDonateToCommunityPool\",\"value\":")
+		if err := EncodeJSONMsgDonateToCommunityPool(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgDonateToCommunityPool:
-		*v, n, err = DecodeMsgDonateToCommunityPool(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"distrx/MsgDonateToCommunityPool\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"distrx/MsgDonateToCommunityPool\",\"value\":")
+		if err := EncodeJSONMsgDonateToCommunityPool(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgEditValidator:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgEditValidator\",\"value\":")
+		if err := EncodeJSONMsgEditValidator(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgEditValidator:
-		*v, n, err = DecodeMsgEditValidator(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgEditValidator\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgEditValidator\",\"value\":")
+		if err := EncodeJSONMsgEditValidator(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgForbidAddr:
+		w.WriteString("{\"type\":\"asset/MsgForbidAddr\",\"value\":")
+		if err := EncodeJSONMsgForbidAddr(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgForbidAddr:
-		*v, n, err = DecodeMsgForbidAddr(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgForbidAddr\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgForbidAddr\",\"value\":")
+		if err := EncodeJSONMsgForbidAddr(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgForbidToken:
+		w.WriteString("{\"type\":\"asset/MsgForbidToken\",\"value\":")
+		if err := EncodeJSONMsgForbidToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgForbidToken:
-		*v, n, err = DecodeMsgForbidToken(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgForbidToken\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgForbidToken\",\"value\":")
+		if err := EncodeJSONMsgForbidToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgIssueToken:
+		w.WriteString("{\"type\":\"asset/MsgIssueToken\",\"value\":")
+		if err := EncodeJSONMsgIssueToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgIssueToken:
-		*v, n, err = DecodeMsgIssueToken(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgIssueToken\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgIssueToken\",\"value\":")
+		if err := EncodeJSONMsgIssueToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgMintToken:
+		w.WriteString("{\"type\":\"asset/MsgMintToken\",\"value\":")
+		if err := EncodeJSONMsgMintToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgMintToken:
-		*v, n, err = DecodeMsgMintToken(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgMintToken\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgMintToken\",\"value\":")
+		if err := EncodeJSONMsgMintToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgModifyPricePrecision:
+		w.WriteString("{\"type\":\"market/MsgModifyPricePrecision\",\"value\":")
+		if err := EncodeJSONMsgModifyPricePrecision(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgModifyPricePrecision:
-		*v, n, err = DecodeMsgModifyPricePrecision(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"market/MsgModifyPricePrecision\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/MsgModifyPricePrecision\",\"value\":")
+		if err := EncodeJSONMsgModifyPricePrecision(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgModifyTokenInfo:
+		w.WriteString("{\"type\":\"asset/MsgModifyTokenInfo\",\"value\":")
+		if err := EncodeJSONMsgModifyTokenInfo(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgModifyTokenInfo:
-		*v, n, err = DecodeMsgModifyTokenInfo(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgModifyTokenInfo\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgModifyTokenInfo\",\"value\":")
+		if err := EncodeJSONMsgModifyTokenInfo(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgMultiSend:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgMultiSend\",\"value\":")
+		if err := EncodeJSONMsgMultiSend(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgMultiSend:
-		*v, n, err = DecodeMsgMultiSend(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgMultiSend\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgMultiSend\",\"value\":")
+		if err := EncodeJSONMsgMultiSend(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgMultiSendX:
+		w.WriteString("{\"type\":\"bankx/MsgMultiSend\",\"value\":")
+		if err := EncodeJSONMsgMultiSendX(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgMultiSendX:
-		*v, n, err = DecodeMsgMultiSendX(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"bankx/MsgMultiSend\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"bankx/MsgMultiSend\",\"value\":")
+		if err := EncodeJSONMsgMultiSendX(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgRemoveLiquidity:
+		w.WriteString("{\"type\":\"market/MsgRemoveLiquidity\",\"value\":")
+		if err := EncodeJSONMsgRemoveLiquidity(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgRemoveLiquidity:
+		if v == nil {
+			w.WriteString("{\"type\":\"market/MsgRemoveLiquidity\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/MsgRemoveLiquidity\",\"value\":")
+		if err := EncodeJSONMsgRemoveLiquidity(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgRemoveTokenWhitelist:
+		w.WriteString("{\"type\":\"asset/MsgRemoveTokenWhitelist\",\"value\":")
+		if err := EncodeJSONMsgRemoveTokenWhitelist(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgRemoveTokenWhitelist:
-		*v, n, err = DecodeMsgRemoveTokenWhitelist(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgRemoveTokenWhitelist\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgRemoveTokenWhitelist\",\"value\":")
+		if err := EncodeJSONMsgRemoveTokenWhitelist(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgSend:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgSend\",\"value\":")
+		if err := EncodeJSONMsgSend(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSend:
-		*v, n, err = DecodeMsgSend(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgSend\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgSend\",\"value\":")
+		if err := EncodeJSONMsgSend(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgSendX:
+		w.WriteString("{\"type\":\"bankx/MsgSend\",\"value\":")
+		if err := EncodeJSONMsgSendX(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSendX:
-		*v, n, err = DecodeMsgSendX(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"bankx/MsgSend\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"bankx/MsgSend\",\"value\":")
+		if err := EncodeJSONMsgSendX(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgSetMemoRequired:
+		w.WriteString("{\"type\":\"bankx/MsgSetMemoRequired\",\"value\":")
+		if err := EncodeJSONMsgSetMemoRequired(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSetMemoRequired:
-		*v, n, err = DecodeMsgSetMemoRequired(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"bankx/MsgSetMemoRequired\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"bankx/MsgSetMemoRequired\",\"value\":")
+		if err := EncodeJSONMsgSetMemoRequired(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgSetReferee:
+		w.WriteString("{\"type\":\"authx/MsgSetReferee\",\"value\":")
+		if err := EncodeJSONMsgSetReferee(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgSetReferee:
+		if v == nil {
+			w.WriteString("{\"type\":\"authx/MsgSetReferee\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"authx/MsgSetReferee\",\"value\":")
+		if err := EncodeJSONMsgSetReferee(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgSetWithdrawAddress:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgModifyWithdrawAddress\",\"value\":")
+		if err := EncodeJSONMsgSetWithdrawAddress(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSetWithdrawAddress:
-		*v, n, err = DecodeMsgSetWithdrawAddress(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgModifyWithdrawAddress\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgModifyWithdrawAddress\",\"value\":")
+		if err := EncodeJSONMsgSetWithdrawAddress(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgSubmitProposal:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgSubmitProposal\",\"value\":")
+		if err := EncodeJSONMsgSubmitProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgSubmitProposal:
-		*v, n, err = DecodeMsgSubmitProposal(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgSubmitProposal\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgSubmitProposal\",\"value\":")
+		if err := EncodeJSONMsgSubmitProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgSupervisedSend:
+		w.WriteString("{\"type\":\"bankx/MsgSupervisedSend\",\"value\":")
+		if err := EncodeJSONMsgSupervisedSend(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *MsgSupervisedSend:
+		if v == nil {
+			w.WriteString("{\"type\":\"bankx/MsgSupervisedSend\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"bankx/MsgSupervisedSend\",\"value\":")
+		if err := EncodeJSONMsgSupervisedSend(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgTransferOwnership:
+		w.WriteString("{\"type\":\"asset/MsgTransferOwnership\",\"value\":")
+		if err := EncodeJSONMsgTransferOwnership(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgTransferOwnership:
-		*v, n, err = DecodeMsgTransferOwnership(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgTransferOwnership\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgTransferOwnership\",\"value\":")
+		if err := EncodeJSONMsgTransferOwnership(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgUnForbidAddr:
+		w.WriteString("{\"type\":\"asset/MsgUnForbidAddr\",\"value\":")
+		if err := EncodeJSONMsgUnForbidAddr(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgUnForbidAddr:
-		*v, n, err = DecodeMsgUnForbidAddr(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgUnForbidAddr\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgUnForbidAddr\",\"value\":")
+		if err := EncodeJSONMsgUnForbidAddr(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgUnForbidToken:
+		w.WriteString("{\"type\":\"asset/MsgUnForbidToken\",\"value\":")
+		if err := EncodeJSONMsgUnForbidToken(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgUnForbidToken:
-		*v, n, err = DecodeMsgUnForbidToken(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"asset/MsgUnForbidToken\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"asset/MsgUnForbidToken\",\"value\":")
+		if err := EncodeJSONMsgUnForbidToken(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgUndelegate:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgUndelegate\",\"value\":")
+		if err := EncodeJSONMsgUndelegate(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgUndelegate:
-		*v, n, err = DecodeMsgUndelegate(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgUndelegate\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgUndelegate\",\"value\":")
+		if err := EncodeJSONMsgUndelegate(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgUnjail:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgUnjail\",\"value\":")
+		if err := EncodeJSONMsgUnjail(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgUnjail:
-		*v, n, err = DecodeMsgUnjail(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgUnjail\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgUnjail\",\"value\":")
+		if err := EncodeJSONMsgUnjail(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgVerifyInvariant:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgVerifyInvariant\",\"value\":")
+		if err := EncodeJSONMsgVerifyInvariant(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgVerifyInvariant:
-		*v, n, err = DecodeMsgVerifyInvariant(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgVerifyInvariant\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgVerifyInvariant\",\"value\":")
+		if err := EncodeJSONMsgVerifyInvariant(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgVote:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgVote\",\"value\":")
+		if err := EncodeJSONMsgVote(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgVote:
-		*v, n, err = DecodeMsgVote(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgVote\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgVote\",\"value\":")
+		if err := EncodeJSONMsgVote(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgWithdrawDelegatorReward:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawDelegationReward\",\"value\":")
+		if err := EncodeJSONMsgWithdrawDelegatorReward(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgWithdrawDelegatorReward:
-		*v, n, err = DecodeMsgWithdrawDelegatorReward(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawDelegationReward\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawDelegationReward\",\"value\":")
+		if err := EncodeJSONMsgWithdrawDelegatorReward(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case MsgWithdrawValidatorCommission:
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawValidatorCommission\",\"value\":")
+		if err := EncodeJSONMsgWithdrawValidatorCommission(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *MsgWithdrawValidatorCommission:
-		*v, n, err = DecodeMsgWithdrawValidatorCommission(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawValidatorCommission\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/MsgWithdrawValidatorCommission\",\"value\":")
+		if err := EncodeJSONMsgWithdrawValidatorCommission(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case Order:
+		w.WriteString("{\"type\":\"market/Order\",\"value\":")
+		if err := EncodeJSONOrder(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *Order:
-		*v, n, err = DecodeOrder(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"market/Order\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"market/Order\",\"value\":")
+		if err := EncodeJSONOrder(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case Output:
+		if err := EncodeJSONOutput(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *Output:
-		*v, n, err = DecodeOutput(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONOutput(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case ParamChange:
+		if err := EncodeJSONParamChange(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *ParamChange:
-		*v, n, err = DecodeParamChange(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONParamChange(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case ParameterChangeProposal:
+		w.WriteString("{\"type\":\"cosmos-sdk/ParameterChangeProposal\",\"value\":")
+		if err := EncodeJSONParameterChangeProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *ParameterChangeProposal:
-		*v, n, err = DecodeParameterChangeProposal(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/ParameterChangeProposal\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/ParameterChangeProposal\",\"value\":")
+		if err := EncodeJSONParameterChangeProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case Period:
+		if err := EncodeJSONPeriod(w, v); err != nil {
+			return err
+		}
+		return nil
+	case *Period:
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONPeriod(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case PeriodicVestingAccount:
+		w.WriteString("{\"type\":\"cosmos-sdk/PeriodicVestingAccount\",\"value\":")
+		if err := EncodeJSONPeriodicVestingAccount(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case *PeriodicVestingAccount:
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/PeriodicVestingAccount\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/PeriodicVestingAccount\",\"value\":")
+		if err := EncodeJSONPeriodicVestingAccount(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case PrivKeyEd25519:
+		w.WriteString("{\"type\":\"tendermint/PrivKeyEd25519\",\"value\":")
+		if err := EncodeJSONPrivKeyEd25519(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *PrivKeyEd25519:
-		*v, n, err = DecodePrivKeyEd25519(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"tendermint/PrivKeyEd25519\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"tendermint/PrivKeyEd25519\",\"value\":")
+		if err := EncodeJSONPrivKeyEd25519(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case PrivKeySecp256k1:
+		w.WriteString("{\"type\":\"tendermint/PrivKeySecp256k1\",\"value\":")
+		if err := EncodeJSONPrivKeySecp256k1(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *PrivKeySecp256k1:
-		*v, n, err = DecodePrivKeySecp256k1(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"tendermint/PrivKeySecp256k1\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"tendermint/PrivKeySecp256k1\",\"value\":")
+		if err := EncodeJSONPrivKeySecp256k1(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case PubKeyEd25519:
+		w.WriteString("{\"type\":\"tendermint/PubKeyEd25519\",\"value\":")
+		if err := EncodeJSONPubKeyEd25519(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *PubKeyEd25519:
-		*v, n, err = DecodePubKeyEd25519(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"tendermint/PubKeyEd25519\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"tendermint/PubKeyEd25519\",\"value\":")
+		if err := EncodeJSONPubKeyEd25519(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case PubKeyMultisigThreshold:
+		w.WriteString("{\"type\":\"tendermint/PubKeyMultisigThreshold\",\"value\":")
+		if err := EncodeJSONPubKeyMultisigThreshold(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *PubKeyMultisigThreshold:
-		*v, n, err = DecodePubKeyMultisigThreshold(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"tendermint/PubKeyMultisigThreshold\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"tendermint/PubKeyMultisigThreshold\",\"value\":")
+		if err := EncodeJSONPubKeyMultisigThreshold(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case PubKeySecp256k1:
+		w.WriteString("{\"type\":\"tendermint/PubKeySecp256k1\",\"value\":")
+		if err := EncodeJSONPubKeySecp256k1(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *PubKeySecp256k1:
-		*v, n, err = DecodePubKeySecp256k1(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"tendermint/PubKeySecp256k1\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"tendermint/PubKeySecp256k1\",\"value\":")
+		if err := EncodeJSONPubKeySecp256k1(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case SignedMsgType:
+		if err := EncodeJSONSignedMsgType(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *SignedMsgType:
-		*v, n, err = DecodeSignedMsgType(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONSignedMsgType(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case SoftwareUpgradeProposal:
+		w.WriteString("{\"type\":\"cosmos-sdk/SoftwareUpgradeProposal\",\"value\":")
+		if err := EncodeJSONSoftwareUpgradeProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *SoftwareUpgradeProposal:
-		*v, n, err = DecodeSoftwareUpgradeProposal(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/SoftwareUpgradeProposal\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/SoftwareUpgradeProposal\",\"value\":")
+		if err := EncodeJSONSoftwareUpgradeProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case State:
+		w.WriteString("{\"type\":\"incentive/state\",\"value\":")
+		if err := EncodeJSONState(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *State:
-		*v, n, err = DecodeState(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"incentive/state\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"incentive/state\",\"value\":")
+		if err := EncodeJSONState(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case StdSignature:
+		if err := EncodeJSONStdSignature(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *StdSignature:
-		*v, n, err = DecodeStdSignature(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONStdSignature(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case StdTx:
+		w.WriteString("{\"type\":\"cosmos-sdk/StdTx\",\"value\":")
+		if err := EncodeJSONStdTx(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *StdTx:
-		*v, n, err = DecodeStdTx(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/StdTx\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/StdTx\",\"value\":")
+		if err := EncodeJSONStdTx(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case Supply:
+		w.WriteString("{\"type\":\"cosmos-sdk/Supply\",\"value\":")
+		if err := EncodeJSONSupply(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *Supply:
-		*v, n, err = DecodeSupply(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/Supply\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/Supply\",\"value\":")
+		if err := EncodeJSONSupply(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case TextProposal:
+		w.WriteString("{\"type\":\"cosmos-sdk/TextProposal\",\"value\":")
+		if err := EncodeJSONTextProposal(w, v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
 	case *TextProposal:
-		*v, n, err = DecodeTextProposal(bz)
+		if v == nil {
+			w.WriteString("{\"type\":\"cosmos-sdk/TextProposal\",\"value\":")
+			w.WriteString("null")
+			w.WriteByte('}')
+			return nil
+		}
+		w.WriteString("{\"type\":\"cosmos-sdk/TextProposal\",\"value\":")
+		if err := EncodeJSONTextProposal(w, *v); err != nil {
+			return err
+		}
+		w.WriteByte('}')
+		return nil
+	case Vote:
+		if err := EncodeJSONVote(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *Vote:
-		*v, n, err = DecodeVote(bz)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONVote(w, *v); err != nil {
+			return err
+		}
+		return nil
+	case VoteOption:
+		if err := EncodeJSONVoteOption(w, v); err != nil {
+			return err
+		}
+		return nil
 	case *VoteOption:
-		*v, n, err = DecodeVoteOption(bz)
-	default:
-		panic("Unknown type")
-	} // end of switch
-	return
-} // end of DecodeVar
-func RandAny(r RandSrc) interface{} {
-	switch r.GetUint() % 73 {
-	case 0:
-		return RandAccAddress(r)
-	case 1:
-		return RandAccountX(r)
-	case 2:
-		return RandBaseAccount(r)
-	case 3:
-		return RandBaseToken(r)
-	case 4:
-		return RandBaseVestingAccount(r)
-	case 5:
-		return RandCoin(r)
-	case 6:
-		return RandCommentRef(r)
-	case 7:
-		return RandCommunityPoolSpendProposal(r)
-	case 8:
-		return RandContinuousVestingAccount(r)
-	case 9:
-		return RandDelayedVestingAccount(r)
-	case 10:
-		return RandDuplicateVoteEvidence(r)
-	case 11:
-		return RandInput(r)
-	case 12:
-		return RandLockedCoin(r)
-	case 13:
-		return RandMarketInfo(r)
-	case 14:
-		return RandModuleAccount(r)
-	case 15:
-		return RandMsgAddTokenWhitelist(r)
-	case 16:
-		return RandMsgAliasUpdate(r)
-	case 17:
-		return RandMsgBancorCancel(r)
-	case 18:
-		return RandMsgBancorInit(r)
-	case 19:
-		return RandMsgBancorTrade(r)
-	case 20:
-		return RandMsgBeginRedelegate(r)
-	case 21:
-		return RandMsgBurnToken(r)
-	case 22:
-		return RandMsgCancelOrder(r)
-	case 23:
-		return RandMsgCancelTradingPair(r)
-	case 24:
-		return RandMsgCommentToken(r)
-	case 25:
-		return RandMsgCreateOrder(r)
-	case 26:
-		return RandMsgCreateTradingPair(r)
-	case 27:
-		return RandMsgCreateValidator(r)
-	case 28:
-		return RandMsgDelegate(r)
-	case 29:
-		return RandMsgDeposit(r)
-	case 30:
-		return RandMsgDonateToCommunityPool(r)
-	case 31:
-		return RandMsgEditValidator(r)
-	case 32:
-		return RandMsgForbidAddr(r)
-	case 33:
-		return RandMsgForbidToken(r)
-	case 34:
-		return RandMsgIssueToken(r)
-	case 35:
-		return RandMsgMintToken(r)
-	case 36:
-		return RandMsgModifyPricePrecision(r)
-	case 37:
-		return RandMsgModifyTokenInfo(r)
-	case 38:
-		return RandMsgMultiSend(r)
-	case 39:
-		return RandMsgMultiSendX(r)
-	case 40:
-		return RandMsgRemoveTokenWhitelist(r)
-	case 41:
-		return RandMsgSend(r)
-	case 42:
-		return RandMsgSendX(r)
-	case 43:
-		return RandMsgSetMemoRequired(r)
-	case 44:
-		return RandMsgSetWithdrawAddress(r)
-	case 45:
-		return RandMsgSubmitProposal(r)
-	case 46:
-		return RandMsgTransferOwnership(r)
-	case 47:
-		return RandMsgUnForbidAddr(r)
-	case 48:
-		return RandMsgUnForbidToken(r)
-	case 49:
-		return RandMsgUndelegate(r)
-	case 50:
-		return RandMsgUnjail(r)
-	case 51:
-		return RandMsgVerifyInvariant(r)
-	case 52:
-		return RandMsgVote(r)
-	case 53:
-		return RandMsgWithdrawDelegatorReward(r)
-	case 54:
-		return RandMsgWithdrawValidatorCommission(r)
-	case 55:
-		return RandOrder(r)
-	case 56:
-		return RandOutput(r)
-	case 57:
-		return RandParamChange(r)
-	case 58:
-		return RandParameterChangeProposal(r)
-	case 59:
-		return RandPrivKeyEd25519(r)
-	case 60:
-		return RandPrivKeySecp256k1(r)
-	case 61:
-		return RandPubKeyEd25519(r)
-	case 62:
-		return RandPubKeyMultisigThreshold(r)
-	case 63:
-		return RandPubKeySecp256k1(r)
-	case 64:
-		return RandSignedMsgType(r)
-	case 65:
-		return RandSoftwareUpgradeProposal(r)
-	case 66:
-		return RandState(r)
-	case 67:
-		return RandStdSignature(r)
-	case 68:
-		return RandStdTx(r)
-	case 69:
-		return RandSupply(r)
-	case 70:
-		return RandTextProposal(r)
-	case 71:
-		return RandVote(r)
-	case 72:
-		return RandVoteOption(r)
+		if v == nil {
+			w.WriteString("null")
+			return nil
+		}
+		if err := EncodeJSONVoteOption(w, *v); err != nil {
+			return err
+		}
+		return nil
 	default:
-		panic("Unknown Type.")
+		return errors.New("Unknown type")
 	} // end of switch
-} // end of func
-func GetSupportList() []string {
-	return []string{
-		"github.com/coinexchain/cet-sdk/modules/alias/internal/types.MsgAliasUpdate",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.BaseToken",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgAddTokenWhitelist",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgBurnToken",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgForbidAddr",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgForbidToken",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgIssueToken",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgMintToken",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgModifyTokenInfo",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgRemoveTokenWhitelist",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgTransferOwnership",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgUnForbidAddr",
-		"github.com/coinexchain/cet-sdk/modules/asset/internal/types.MsgUnForbidToken",
-		"github.com/coinexchain/cet-sdk/modules/authx/internal/types.AccountX",
-		"github.com/coinexchain/cet-sdk/modules/authx/internal/types.LockedCoin",
-		"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types.MsgBancorCancel",
-		"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types.MsgBancorInit",
-		"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types.MsgBancorTrade",
-		"github.com/coinexchain/cet-sdk/modules/bankx/internal/types.MsgMultiSend",
-		"github.com/coinexchain/cet-sdk/modules/bankx/internal/types.MsgSend",
-		"github.com/coinexchain/cet-sdk/modules/bankx/internal/types.MsgSetMemoRequired",
-		"github.com/coinexchain/cet-sdk/modules/comment/internal/types.CommentRef",
-		"github.com/coinexchain/cet-sdk/modules/comment/internal/types.MsgCommentToken",
-		"github.com/coinexchain/cet-sdk/modules/distributionx/types.MsgDonateToCommunityPool",
-		"github.com/coinexchain/cet-sdk/modules/incentive/internal/types.State",
-		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MarketInfo",
-		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgCancelOrder",
-		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgCancelTradingPair",
-		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgCreateOrder",
-		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgCreateTradingPair",
-		"github.com/coinexchain/cet-sdk/modules/market/internal/types.MsgModifyPricePrecision",
-		"github.com/coinexchain/cet-sdk/modules/market/internal/types.Order",
-		"github.com/cosmos/cosmos-sdk/types.AccAddress",
-		"github.com/cosmos/cosmos-sdk/types.Coin",
-		"github.com/cosmos/cosmos-sdk/types.Msg",
-		"github.com/cosmos/cosmos-sdk/x/auth/exported.Account",
-		"github.com/cosmos/cosmos-sdk/x/auth/types.BaseAccount",
-		"github.com/cosmos/cosmos-sdk/x/auth/types.BaseVestingAccount",
-		"github.com/cosmos/cosmos-sdk/x/auth/types.ContinuousVestingAccount",
-		"github.com/cosmos/cosmos-sdk/x/auth/types.DelayedVestingAccount",
-		"github.com/cosmos/cosmos-sdk/x/auth/types.StdSignature",
-		"github.com/cosmos/cosmos-sdk/x/auth/types.StdTx",
-		"github.com/cosmos/cosmos-sdk/x/bank/internal/types.Input",
-		"github.com/cosmos/cosmos-sdk/x/bank/internal/types.MsgMultiSend",
-		"github.com/cosmos/cosmos-sdk/x/bank/internal/types.MsgSend",
-		"github.com/cosmos/cosmos-sdk/x/bank/internal/types.Output",
-		"github.com/cosmos/cosmos-sdk/x/crisis/internal/types.MsgVerifyInvariant",
-		"github.com/cosmos/cosmos-sdk/x/distribution/types.CommunityPoolSpendProposal",
-		"github.com/cosmos/cosmos-sdk/x/distribution/types.MsgSetWithdrawAddress",
-		"github.com/cosmos/cosmos-sdk/x/distribution/types.MsgWithdrawDelegatorReward",
-		"github.com/cosmos/cosmos-sdk/x/distribution/types.MsgWithdrawValidatorCommission",
-		"github.com/cosmos/cosmos-sdk/x/gov/types.Content",
-		"github.com/cosmos/cosmos-sdk/x/gov/types.MsgDeposit",
-		"github.com/cosmos/cosmos-sdk/x/gov/types.MsgSubmitProposal",
-		"github.com/cosmos/cosmos-sdk/x/gov/types.MsgVote",
-		"github.com/cosmos/cosmos-sdk/x/gov/types.SoftwareUpgradeProposal",
-		"github.com/cosmos/cosmos-sdk/x/gov/types.TextProposal",
-		"github.com/cosmos/cosmos-sdk/x/gov/types.VoteOption",
-		"github.com/cosmos/cosmos-sdk/x/params/types.ParamChange",
-		"github.com/cosmos/cosmos-sdk/x/params/types.ParameterChangeProposal",
-		"github.com/cosmos/cosmos-sdk/x/slashing/types.MsgUnjail",
-		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgBeginRedelegate",
-		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgCreateValidator",
-		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgDelegate",
-		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgEditValidator",
-		"github.com/cosmos/cosmos-sdk/x/staking/types.MsgUndelegate",
-		"github.com/cosmos/cosmos-sdk/x/supply/internal/types.ModuleAccount",
-		"github.com/cosmos/cosmos-sdk/x/supply/internal/types.Supply",
-		"github.com/tendermint/tendermint/crypto.PubKey",
-		"github.com/tendermint/tendermint/crypto/ed25519.PrivKeyEd25519",
-		"github.com/tendermint/tendermint/crypto/ed25519.PubKeyEd25519",
-		"github.com/tendermint/tendermint/crypto/multisig.PubKeyMultisigThreshold",
-		"github.com/tendermint/tendermint/crypto/secp256k1.PrivKeySecp256k1",
-		"github.com/tendermint/tendermint/crypto/secp256k1.PubKeySecp256k1",
-		"github.com/tendermint/tendermint/types.DuplicateVoteEvidence",
-		"github.com/tendermint/tendermint/types.SignedMsgType",
-		"github.com/tendermint/tendermint/types.Vote",
-	}
-} // end of GetSupportList
+} //End of EncodeJSONAny
@@ -0,0 +1,102 @@
+package codec_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	amino "github.com/tendermint/go-amino"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/codec"
+)
+
+// unsupportedTypes are registered in the amino codec of the app, but are
+// declared in internal packages of cet-sdk, so codec.go can not refer to them
+var unsupportedTypes = map[string]bool{
+	"market/MsgAutoSwapCreateTradingPair": true, // autoswap/internal/types
+}
+
+// registeredTypes returns the names of the concrete types registered in the
+// amino codec of the app, by their prefixes
+func registeredTypes(t *testing.T, cdc *amino.Codec) map[string]string {
+	var buf bytes.Buffer
+	require.Nil(t, cdc.PrintTypes(&buf))
+	types := make(map[string]string)
+	for _, line := range strings.Split(buf.String(), "\n")[2:] {
+		if cols := strings.Split(line, "|"); len(cols) > 3 {
+			types[strings.TrimSpace(cols[3])] = strings.TrimSpace(cols[2])
+		}
+	}
+	return types
+}
+
+func TestTypeListCoversAminoCodec(t *testing.T) {
+	cdc := app.MakeCodec()
+	types := registeredTypes(t, cdc)
+	covered := make(map[string]bool)
+	for _, av := range codec.GetTypeList() {
+		// the interfaces are listed as nil pointers
+		if reflect.TypeOf(av.Value).Kind() == reflect.Ptr {
+			continue
+		}
+		// the binary encoding of a registered type starts with its prefix
+		bz, err := cdc.MarshalBinaryBare(av.Value)
+		if err != nil || len(bz) < 4 {
+			continue
+		}
+		if name, ok := types[fmt.Sprintf("0x%X", bz[:4])]; ok {
+			covered[name] = true
+		}
+	}
+
+	var missing []string
+	for _, name := range types {
+		if !covered[name] && !unsupportedTypes[name] {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	require.Empty(t, missing, "add these types to types.go and GetTypeList, then run: go run ./codec/run > codec/codec.go")
+
+	for name := range unsupportedTypes {
+		require.False(t, covered[name], "%s is supported now", name)
+	}
+}
+
+func TestCodecFileIsGenerated(t *testing.T) {
+	src, err := codec.GenerateCodecSource(app.MakeCodec())
+	require.Nil(t, err)
+	file, err := ioutil.ReadFile("codec.go")
+	require.Nil(t, err)
+	require.True(t, bytes.Equal(src, file), "codec.go is stale, run: go run ./codec/run > codec/codec.go")
+}
+
+func TestEncodeAnyNewMsgs(t *testing.T) {
+	addr := []byte("addr")
+	msgs := []codec.Msg{
+		codec.MsgSetReferee{Sender: addr, Referee: addr},
+		codec.MsgSupervisedSend{FromAddress: addr, ToAddress: addr, Amount: sdk.NewInt64Coin("cet", 10)},
+		codec.MsgAddLiquidity{Sender: addr, Stock: "abc", Money: "cet", StockIn: sdk.NewInt(1), MoneyIn: sdk.NewInt(2), To: addr},
+		codec.MsgRemoveLiquidity{Sender: addr, Stock: "abc", Money: "cet", Amount: sdk.NewInt(1), To: addr},
+		codec.MsgAutoSwapCreateOrder{Sender: addr, TradingPair: "abc/cet", Price: 10, Quantity: 100},
+		codec.MsgAutoSwapCancelOrder{Sender: addr, OrderID: "id"},
+	}
+	cdc := app.MakeCodec()
+	for _, msg := range msgs {
+		var buf bytes.Buffer
+		require.Nil(t, codec.EncodeAny(&buf, msg))
+		v, n, err := codec.DecodeAny(buf.Bytes())
+		require.Nil(t, err)
+		require.Equal(t, buf.Len(), n)
+		// the empty slices are decoded as nil ones
+		require.Equal(t, cdc.MustMarshalJSON(msg), cdc.MustMarshalJSON(v))
+	}
+}
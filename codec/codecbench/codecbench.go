@@ -0,0 +1,184 @@
+// Package codecbench benchmarks the codon codec against amino, on a random
+// value of each type codon supports
+package codecbench
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"testing"
+
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/coinexchain/dex/codec"
+)
+
+const (
+	OpEncode = "encode"
+	OpDecode = "decode"
+
+	CodecCodon = "codon"
+	CodecAmino = "amino"
+)
+
+// BenchCase is a random value of one of the types in GetSupportList, which the
+// benchmarks encode and decode with codon and amino
+type BenchCase struct {
+	Alias string
+	Value interface{}
+	// AminoOK is false if amino can not encode and decode the value, as its
+	// interfaces are not registered in the amino codec
+	AminoOK bool
+}
+
+// BenchResult is the result of a benchmark of a BenchCase
+type BenchResult struct {
+	Alias       string
+	Codec       string
+	Op          string
+	Size        int
+	NsPerOp     int64
+	AllocsPerOp int64
+	BytesPerOp  int64
+}
+
+// typePath returns the type path of v, as in GetSupportList
+func typePath(v interface{}) string {
+	t := reflect.TypeOf(v)
+	return t.PkgPath() + "." + t.Name()
+}
+
+// GetBenchCases returns a random value of each concrete type in GetSupportList,
+// ordered by the aliases of the types. The values are generated by RandAny, and
+// are the ones amino can encode if any.
+func GetBenchCases(cdc *amino.Codec, seed int64) []BenchCase {
+	supported := make(map[string]bool)
+	for _, path := range codec.GetSupportList() {
+		supported[path] = true
+	}
+	cases := make(map[string]*BenchCase)
+	for _, av := range codec.GetTypeList() {
+		if reflect.TypeOf(av.Value).Kind() != reflect.Ptr && supported[typePath(av.Value)] {
+			cases[typePath(av.Value)] = &BenchCase{Alias: av.Alias}
+		}
+	}
+
+	r := codec.NewRandSrc(seed)
+	for i, found := 0, 0; i < 100000 && found < len(cases); i++ {
+		v := codec.RandAny(r)
+		c, ok := cases[typePath(v)]
+		if !ok || c.AminoOK {
+			continue
+		}
+		if aminoRoundTrips(cdc, v) {
+			c.Value, c.AminoOK = v, true
+			found++
+		} else if c.Value == nil {
+			c.Value = v
+		}
+	}
+
+	res := make([]BenchCase, 0, len(cases))
+	for _, c := range cases {
+		if c.Value != nil {
+			res = append(res, *c)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Alias < res[j].Alias })
+	return res
+}
+
+func aminoRoundTrips(cdc *amino.Codec, v interface{}) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	bz, err := cdc.MarshalBinaryBare(v)
+	if err != nil {
+		return false
+	}
+	return cdc.UnmarshalBinaryBare(bz, reflect.New(reflect.TypeOf(v)).Interface()) == nil
+}
+
+// Benchmark returns the benchmark of the operation op on the value of c by the codec name
+func (c BenchCase) Benchmark(cdc *amino.Codec, name, op string) (size int, bench func(b *testing.B)) {
+	var codonBuf bytes.Buffer
+	if err := codec.EncodeAny(&codonBuf, c.Value); err != nil {
+		panic(err)
+	}
+	codonBz := codonBuf.Bytes()
+
+	switch {
+	case name == CodecCodon && op == OpEncode:
+		return len(codonBz), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var buf bytes.Buffer
+				if err := codec.EncodeAny(&buf, c.Value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	case name == CodecCodon && op == OpDecode:
+		return len(codonBz), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := codec.DecodeAny(codonBz); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+
+	aminoBz := cdc.MustMarshalBinaryBare(c.Value)
+	switch {
+	case name == CodecAmino && op == OpEncode:
+		return len(aminoBz), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := cdc.MarshalBinaryBare(c.Value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	case name == CodecAmino && op == OpDecode:
+		t := reflect.TypeOf(c.Value)
+		return len(aminoBz), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := cdc.UnmarshalBinaryBare(aminoBz, reflect.New(t).Interface()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+	panic("unknown benchmark " + name + " " + op)
+}
+
+// RunBenchmarks runs the benchmarks of encoding and decoding the cases with
+// codon, and with amino if it can encode them
+func RunBenchmarks(cdc *amino.Codec, cases []BenchCase) []BenchResult {
+	var results []BenchResult
+	for _, c := range cases {
+		for _, name := range []string{CodecCodon, CodecAmino} {
+			if name == CodecAmino && !c.AminoOK {
+				continue
+			}
+			for _, op := range []string{OpEncode, OpDecode} {
+				size, bench := c.Benchmark(cdc, name, op)
+				res := testing.Benchmark(bench)
+				results = append(results, BenchResult{
+					Alias:       c.Alias,
+					Codec:       name,
+					Op:          op,
+					Size:        size,
+					NsPerOp:     res.NsPerOp(),
+					AllocsPerOp: res.AllocsPerOp(),
+					BytesPerOp:  res.AllocedBytesPerOp(),
+				})
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,42 @@
+package codecbench_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/codec"
+	"github.com/coinexchain/dex/codec/codecbench"
+)
+
+func TestBenchCases(t *testing.T) {
+	cdc := app.MakeCodec()
+	cases := codecbench.GetBenchCases(cdc, 0)
+	// all the concrete types of GetSupportList, which has 4 interfaces too
+	require.Equal(t, len(codec.GetSupportList())-4, len(cases))
+	aminoOK := 0
+	for _, c := range cases {
+		if c.AminoOK {
+			aminoOK++
+		}
+	}
+	require.True(t, aminoOK > len(cases)*9/10, "amino can encode only %d of %d cases", aminoOK, len(cases))
+}
+
+// BenchmarkCodecs encodes and decodes a value of each type supported by codon,
+// with codon and amino, as Type/codec/op
+func BenchmarkCodecs(b *testing.B) {
+	cdc := app.MakeCodec()
+	for _, c := range codecbench.GetBenchCases(cdc, 0) {
+		for _, name := range []string{codecbench.CodecCodon, codecbench.CodecAmino} {
+			if name == codecbench.CodecAmino && !c.AminoOK {
+				continue
+			}
+			for _, op := range []string{codecbench.OpEncode, codecbench.OpDecode} {
+				_, bench := c.Benchmark(cdc, name, op)
+				b.Run(c.Alias+"/"+name+"/"+op, bench)
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+const minReadSize = 4096
+
+var (
+	ErrTooManyBytes   = errors.New("the stream is longer than the max total bytes")
+	ErrNotEnoughBytes = errors.New("Not enough bytes to read")
+	ErrSliceTooLong   = errors.New("the slice is longer than the max slice length")
+)
+
+// DecodeError reports the corrupt input of a stream, at the offset of the value
+// which can not be decoded
+type DecodeError struct {
+	Offset int64
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("codon: can not decode the value at offset %d: %s", e.Offset, e.Err.Error())
+}
+
+// Decoder decodes the values concatenated in a stream, as written by EncodeAny.
+// As a value can only be decoded from all its bytes, the Decoder reads more bytes
+// until the value at the head of the stream is decoded. The max total bytes bound
+// the memory a corrupt or malicious stream can make it use, and the values with
+// slices longer than the max slice length are rejected before the slices are
+// allocated.
+// Only the values cut short make the Decoder read more bytes. A corrupt value is
+// reported as soon as its bytes are found corrupt.
+type Decoder struct {
+	r              io.Reader
+	maxSliceLength int
+	maxTotalBytes  int64
+
+	buf     []byte // the bytes read but not decoded yet
+	offset  int64  // the offset of buf in the stream
+	total   int64  // the bytes read from r
+	eof     bool
+	tooMany bool // more than the max total bytes are in the stream
+	err     error
+}
+
+func NewDecoder(r io.Reader, maxSliceLength int, maxTotalBytes int64) *Decoder {
+	return &Decoder{
+		r:              r,
+		maxSliceLength: maxSliceLength,
+		maxTotalBytes:  maxTotalBytes,
+	}
+}
+
+// Offset returns the offset in the stream of the next value to decode
+func (d *Decoder) Offset() int64 {
+	return d.offset
+}
+
+// Decode returns the next value of the stream, or io.EOF at the end of the stream.
+// After an error, Decode returns the same error.
+func (d *Decoder) Decode() (interface{}, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	for {
+		if len(d.buf) != 0 {
+			v, n, err := decodeAny(d.buf, d.maxSliceLength)
+			if err == nil {
+				d.buf = d.buf[n:]
+				d.offset += int64(n)
+				return v, nil
+			}
+			if err != ErrNotEnoughBytes || d.eof {
+				return nil, d.fail(err)
+			}
+		} else if d.eof {
+			d.err = io.EOF
+			return nil, d.err
+		}
+		if err := d.fill(); err != nil {
+			return nil, d.fail(err)
+		}
+	}
+}
+
+func (d *Decoder) fail(err error) error {
+	d.err = &DecodeError{Offset: d.offset, Err: err}
+	return d.err
+}
+
+// fill reads more bytes to buf, asking for at least as many as buffered, so that
+// a long value is not decoded again for each few bytes. The decoded values refer
+// to the bytes of buf, so they are never overwritten: the bytes are read after
+// buf, or to a new buffer.
+func (d *Decoder) fill() error {
+	if d.tooMany {
+		return ErrTooManyBytes
+	}
+	size := len(d.buf)
+	if size < minReadSize {
+		size = minReadSize
+	}
+	// one more byte than allowed is read, to tell the streams of the max total
+	// bytes from the longer ones
+	if left := d.maxTotalBytes + 1 - d.total; int64(size) > left {
+		size = int(left)
+	}
+	if cap(d.buf)-len(d.buf) < size {
+		buf := make([]byte, len(d.buf), len(d.buf)+size)
+		copy(buf, d.buf)
+		d.buf = buf
+	}
+	n, err := d.r.Read(d.buf[len(d.buf) : len(d.buf)+size])
+	d.buf = d.buf[:len(d.buf)+n]
+	d.total += int64(n)
+	if d.total > d.maxTotalBytes {
+		// the values before the limit are still decoded
+		d.buf = d.buf[:len(d.buf)-1]
+		d.tooMany = true
+		return nil
+	}
+	if err == io.EOF {
+		d.eof = true
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,144 @@
+package codec_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/dex/codec"
+)
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// randStream returns the codon encodings of random values, concatenated, and the
+// values decoded from their own encodings
+func randStream(t *testing.T, count int) ([]byte, []interface{}, []int64) {
+	var stream []byte
+	var values []interface{}
+	var offsets []int64
+	for i := 0; i < count; i++ {
+		bz := mustEncodeAny(t, codec.RandAny(newRandSrc(int64(i))))
+		v, _, err := codec.DecodeAny(bz)
+		require.Nil(t, err)
+		offsets = append(offsets, int64(len(stream)))
+		values = append(values, v)
+		stream = append(stream, bz...)
+	}
+	return stream, values, offsets
+}
+
+// A value cut short is never decoded, or the Decoder could decode a value from
+// the bytes read so far when the value has more, and it fails with
+// ErrNotEnoughBytes, or the Decoder would not read the rest of it
+func TestDecodeAnyPrefix(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		bz := mustEncodeAny(t, codec.RandAny(newRandSrc(int64(i))))
+		for n := 0; n < len(bz); n++ {
+			_, _, err := codec.DecodeAny(bz[:n])
+			require.Equal(t, codec.ErrNotEnoughBytes, err, "%d bytes of %d", n, len(bz))
+		}
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	stream, values, offsets := randStream(t, 500)
+	readers := map[string]io.Reader{
+		"all":     bytes.NewReader(stream),
+		"onebyte": iotest.OneByteReader(bytes.NewReader(stream)),
+		"half":    iotest.HalfReader(bytes.NewReader(stream)),
+		"dataerr": iotest.DataErrReader(bytes.NewReader(stream)),
+	}
+	for name, r := range readers {
+		d := codec.NewDecoder(r, 1000, int64(len(stream)))
+		for i, expected := range values {
+			require.Equal(t, offsets[i], d.Offset(), name)
+			v, err := d.Decode()
+			require.Nil(t, err, name)
+			require.True(t, codec.EqualAny(expected, v), "%s: %T", name, expected)
+		}
+		_, err := d.Decode()
+		require.Equal(t, io.EOF, err, name)
+		_, err = d.Decode()
+		require.Equal(t, io.EOF, err, name)
+	}
+}
+
+func TestDecoderCorruptInput(t *testing.T) {
+	stream, values, offsets := randStream(t, 10)
+	// the magic bytes of the 6th value are not of any type
+	corrupt := append([]byte{}, stream...)
+	copy(corrupt[offsets[5]:], []byte{0, 0, 0, 0})
+
+	// the corrupt value is reported without reading the endless stream after it
+	r := io.MultiReader(bytes.NewReader(corrupt), zeroReader{})
+	d := codec.NewDecoder(iotest.HalfReader(r), 1000, 1<<40)
+	for i := 0; i < 5; i++ {
+		v, err := d.Decode()
+		require.Nil(t, err)
+		require.True(t, codec.EqualAny(values[i], v))
+	}
+	_, err := d.Decode()
+	decodeErr, ok := err.(*codec.DecodeError)
+	require.True(t, ok, "%v", err)
+	require.Equal(t, offsets[5], decodeErr.Offset)
+	require.Equal(t, "Unknown type", decodeErr.Err.Error())
+	_, err2 := d.Decode()
+	require.Equal(t, err, err2)
+
+	// the last value is cut short
+	d = codec.NewDecoder(bytes.NewReader(stream[:len(stream)-1]), 1000, 1<<20)
+	for i := 0; i < 9; i++ {
+		_, err := d.Decode()
+		require.Nil(t, err)
+	}
+	_, err = d.Decode()
+	decodeErr, ok = err.(*codec.DecodeError)
+	require.True(t, ok, "%v", err)
+	require.Equal(t, offsets[9], decodeErr.Offset)
+}
+
+func TestDecoderLimits(t *testing.T) {
+	stream, _, offsets := randStream(t, 10)
+	d := codec.NewDecoder(bytes.NewReader(stream), 1000, offsets[5]+1)
+	for i := 0; i < 5; i++ {
+		_, err := d.Decode()
+		require.Nil(t, err)
+	}
+	_, err := d.Decode()
+	require.Equal(t, &codec.DecodeError{Offset: offsets[5], Err: codec.ErrTooManyBytes}, err)
+
+	// a malicious length prefix is rejected before the slice is read, or makes the
+	// Decoder read until the max total bytes if not more than the max slice length
+	prefix := mustEncodeAny(t, codec.AccAddress{})[:4]
+	var buf [binary.MaxVarintLen64]byte
+	prefix = append(prefix, buf[:binary.PutVarint(buf[:], 1<<40)]...)
+	d = codec.NewDecoder(io.MultiReader(bytes.NewReader(prefix), zeroReader{}), 1000, 1<<20)
+	_, err = d.Decode()
+	require.Equal(t, &codec.DecodeError{Offset: 0, Err: codec.ErrSliceTooLong}, err)
+	d = codec.NewDecoder(io.MultiReader(bytes.NewReader(prefix), zeroReader{}), 1<<50, 1<<20)
+	_, err = d.Decode()
+	require.Equal(t, &codec.DecodeError{Offset: 0, Err: codec.ErrTooManyBytes}, err)
+
+	acc := codec.AccountX{LockedCoins: make([]codec.LockedCoin, 3)}
+	for i := range acc.LockedCoins {
+		acc.LockedCoins[i].Coin.Amount = codec.RandInt(newRandSrc(int64(i)))
+	}
+	bz := mustEncodeAny(t, acc)
+	d = codec.NewDecoder(bytes.NewReader(bz), 3, 1<<20)
+	_, err = d.Decode()
+	require.Nil(t, err)
+	d = codec.NewDecoder(bytes.NewReader(bz), 2, 1<<20)
+	_, err = d.Decode()
+	require.Equal(t, &codec.DecodeError{Offset: 0, Err: codec.ErrSliceTooLong}, err)
+}
@@ -0,0 +1,264 @@
+package codec
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/coinexchain/codon"
+)
+
+// typeIndex indexes the types of the list which codon generates the code for
+type typeIndex struct {
+	aliases   map[reflect.Type]string
+	concretes []string // the aliases of the types not interfaces, sorted
+	ifcs      []string // the aliases of the interfaces, sorted
+	types     map[string]reflect.Type
+}
+
+func newTypeIndex(list []codon.AliasAndValue) typeIndex {
+	idx := typeIndex{
+		aliases: make(map[reflect.Type]string, len(list)),
+		types:   make(map[string]reflect.Type, len(list)),
+	}
+	for _, av := range list {
+		t := reflect.TypeOf(av.Value)
+		if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Interface {
+			t = t.Elem()
+			idx.ifcs = append(idx.ifcs, av.Alias)
+		} else {
+			idx.concretes = append(idx.concretes, av.Alias)
+		}
+		idx.aliases[t] = av.Alias
+		idx.types[av.Alias] = t
+	}
+	sort.Strings(idx.concretes)
+	sort.Strings(idx.ifcs)
+	return idx
+}
+
+// implementations returns the aliases of the registered types implementing the
+// interface as values, as in codon
+func (idx typeIndex) implementations(ifcType reflect.Type) []string {
+	var impls []string
+	for _, alias := range idx.concretes {
+		if idx.types[alias].Implements(ifcType) {
+			impls = append(impls, alias)
+		}
+	}
+	return impls
+}
+
+// deepCopyGen generates the DeepCopy and Equal functions of the types which
+// codon generates the Encode, Decode and Rand functions for. It follows codon:
+// the registered structs and interfaces are copied and compared by their own
+// functions, the other structs are inlined, and the interfaces dispatch on the
+// registered structs implementing them.
+type deepCopyGen struct {
+	typeIndex
+	leafTypes map[string]string
+}
+
+func newDeepCopyGen(leafTypes map[string]string, list []codon.AliasAndValue) *deepCopyGen {
+	return &deepCopyGen{
+		typeIndex: newTypeIndex(list),
+		leafTypes: leafTypes,
+	}
+}
+
+// GenerateDeepCopyFile writes the DeepCopy and Equal functions of the types in
+// the list, which are appended to the code generated by codon
+func GenerateDeepCopyFile(w io.Writer, leafTypes map[string]string, list []codon.AliasAndValue) {
+	g := newDeepCopyGen(leafTypes, list)
+	for _, alias := range g.concretes {
+		g.writeConcrete(w, alias)
+	}
+	for _, alias := range g.ifcs {
+		g.writeIfc(w, alias)
+	}
+	g.writeAny(w)
+}
+
+func (g *deepCopyGen) writeConcrete(w io.Writer, alias string) {
+	t := g.types[alias]
+	var lines []string
+	g.copyLines(&lines, "out", "v", t, 0, true)
+	fmt.Fprintf(w, "func DeepCopy%s(v %s) %s {\nvar out %s\n%s\nreturn out\n} //End of DeepCopy%s\n\n",
+		alias, alias, alias, alias, strings.Join(lines, "\n"), alias)
+
+	lines = lines[:0]
+	g.equalLines(&lines, "a", "b", t, 0, true)
+	fmt.Fprintf(w, "func Equal%s(a, b %s) bool {\n%s\nreturn true\n} //End of Equal%s\n\n",
+		alias, alias, strings.Join(lines, "\n"), alias)
+}
+
+func (g *deepCopyGen) writeIfc(w io.Writer, alias string) {
+	impls := g.implementations(g.types[alias])
+	fmt.Fprintf(w, "func DeepCopy%s(x %s) %s {\nswitch v := x.(type) {\ncase nil:\nreturn nil\n", alias, alias, alias)
+	writeDeepCopyCases(w, impls)
+	fmt.Fprintf(w, "default:\npanic(\"Unknown type\")\n} // end of switch\n} //End of DeepCopy%s\n\n", alias)
+
+	fmt.Fprintf(w, "func Equal%s(x, y %s) bool {\nif x == nil || y == nil {\nreturn x == nil && y == nil\n}\nswitch v := x.(type) {\n", alias, alias)
+	writeEqualCases(w, impls)
+	fmt.Fprintf(w, "default:\npanic(\"Unknown type\")\n} // end of switch\n} //End of Equal%s\n\n", alias)
+}
+
+func (g *deepCopyGen) writeAny(w io.Writer) {
+	fmt.Fprintf(w, "func DeepCopyAny(x interface{}) interface{} {\nswitch v := x.(type) {\n")
+	writeDeepCopyCases(w, g.concretes)
+	fmt.Fprintf(w, "default:\npanic(\"Unknown type\")\n} // end of switch\n} //End of DeepCopyAny\n\n")
+
+	fmt.Fprintf(w, "func EqualAny(x, y interface{}) bool {\nswitch v := x.(type) {\n")
+	writeEqualCases(w, g.concretes)
+	fmt.Fprintf(w, "default:\npanic(\"Unknown type\")\n} // end of switch\n} //End of EqualAny\n\n")
+}
+
+// writeDeepCopyCases writes the cases of a type switch on v copying the types,
+// both as values and as pointers
+func writeDeepCopyCases(w io.Writer, aliases []string) {
+	for _, alias := range aliases {
+		fmt.Fprintf(w, "case %s:\nreturn DeepCopy%s(v)\n", alias, alias)
+		fmt.Fprintf(w, "case *%s:\nif v == nil {\nreturn v\n}\nres := DeepCopy%s(*v)\nreturn &res\n", alias, alias)
+	}
+}
+
+// writeEqualCases writes the cases of a type switch on v comparing it with y,
+// which must have the same type to be equal
+func writeEqualCases(w io.Writer, aliases []string) {
+	for _, alias := range aliases {
+		fmt.Fprintf(w, "case %s:\nw, ok := y.(%s)\nreturn ok && Equal%s(v, w)\n", alias, alias, alias)
+		fmt.Fprintf(w, "case *%s:\nw, ok := y.(*%s)\nreturn ok && (v == w || v != nil && w != nil && Equal%s(*v, *w))\n",
+			alias, alias, alias)
+	}
+}
+
+func (g *deepCopyGen) leafName(t reflect.Type) (string, bool) {
+	if _, ok := g.leafTypes[t.PkgPath()+"."+t.Name()]; ok {
+		return t.Name(), true
+	}
+	return "", false
+}
+
+// typeName returns how the generated code refers to t
+func (g *deepCopyGen) typeName(t reflect.Type) string {
+	if alias, ok := g.aliases[t]; ok {
+		return alias
+	}
+	if leaf, ok := g.leafTypes[t.PkgPath()+"."+t.Name()]; ok {
+		return leaf
+	}
+	if len(t.PkgPath()) == 0 && len(t.Name()) != 0 {
+		return t.Name() //basic type
+	}
+	switch t.Kind() {
+	case reflect.Slice:
+		return "[]" + g.typeName(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), g.typeName(t.Elem()))
+	case reflect.Ptr:
+		return "*" + g.typeName(t.Elem())
+	}
+	panic(t.PkgPath() + "." + t.Name() + " is not registered")
+}
+
+func isBasicKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// copyLines generates the lines copying src to dst, which holds the zero value
+func (g *deepCopyGen) copyLines(lines *[]string, dst, src string, t reflect.Type, iterLevel int, top bool) {
+	if leaf, ok := g.leafName(t); ok {
+		*lines = append(*lines, fmt.Sprintf("%s = DeepCopy%s(%s)", dst, leaf, src))
+		return
+	}
+	if alias, ok := g.aliases[t]; ok && (!top || t.Kind() == reflect.Interface) {
+		if !isBasicKind(t.Kind()) {
+			*lines = append(*lines, fmt.Sprintf("%s = DeepCopy%s(%s)", dst, alias, src))
+			return
+		}
+	}
+	iterVar := fmt.Sprintf("_%d", iterLevel)
+	switch k := t.Kind(); {
+	case isBasicKind(k):
+		*lines = append(*lines, fmt.Sprintf("%s = %s", dst, src))
+	case k == reflect.Array && isBasicKind(t.Elem().Kind()):
+		*lines = append(*lines, fmt.Sprintf("%s = %s", dst, src))
+	case k == reflect.Array:
+		*lines = append(*lines, fmt.Sprintf("for %s := 0; %s < len(%s); %s++ {", iterVar, iterVar, src, iterVar))
+		g.copyLines(lines, dst+"["+iterVar+"]", src+"["+iterVar+"]", t.Elem(), iterLevel+1, false)
+		*lines = append(*lines, "}")
+	case k == reflect.Slice && isBasicKind(t.Elem().Kind()):
+		*lines = append(*lines, fmt.Sprintf("if %s != nil {\n%s = make([]%s, len(%s))\ncopy(%s, %s)\n}",
+			src, dst, g.typeName(t.Elem()), src, dst, src))
+	case k == reflect.Slice:
+		*lines = append(*lines, fmt.Sprintf("if %s != nil {\n%s = make([]%s, len(%s))", src, dst, g.typeName(t.Elem()), src))
+		*lines = append(*lines, fmt.Sprintf("for %s := 0; %s < len(%s); %s++ {", iterVar, iterVar, src, iterVar))
+		g.copyLines(lines, dst+"["+iterVar+"]", src+"["+iterVar+"]", t.Elem(), iterLevel+1, false)
+		*lines = append(*lines, "}\n}")
+	case k == reflect.Ptr:
+		*lines = append(*lines, fmt.Sprintf("if %s != nil {\n%s = new(%s)", src, dst, g.typeName(t.Elem())))
+		g.copyLines(lines, "(*"+dst+")", "(*"+src+")", t.Elem(), iterLevel, false)
+		*lines = append(*lines, "}")
+	case k == reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			name := t.Field(i).Name
+			g.copyLines(lines, dst+"."+name, src+"."+name, t.Field(i).Type, iterLevel, false)
+		}
+	default:
+		panic(fmt.Sprintf("%s.%s of kind %s is not supported", t.PkgPath(), t.Name(), k))
+	}
+}
+
+// equalLines generates the lines returning false if a and b are not equal.
+// The nil slices are equal to the empty ones, as they are encoded the same.
+func (g *deepCopyGen) equalLines(lines *[]string, a, b string, t reflect.Type, iterLevel int, top bool) {
+	if leaf, ok := g.leafName(t); ok {
+		*lines = append(*lines, fmt.Sprintf("if !Equal%s(%s, %s) {\nreturn false\n}", leaf, a, b))
+		return
+	}
+	if alias, ok := g.aliases[t]; ok && (!top || t.Kind() == reflect.Interface) {
+		if !isBasicKind(t.Kind()) {
+			*lines = append(*lines, fmt.Sprintf("if !Equal%s(%s, %s) {\nreturn false\n}", alias, a, b))
+			return
+		}
+	}
+	iterVar := fmt.Sprintf("_%d", iterLevel)
+	switch k := t.Kind(); {
+	case isBasicKind(k):
+		*lines = append(*lines, fmt.Sprintf("if %s != %s {\nreturn false\n}", a, b))
+	case k == reflect.Array && isBasicKind(t.Elem().Kind()):
+		*lines = append(*lines, fmt.Sprintf("if %s != %s {\nreturn false\n}", a, b))
+	case k == reflect.Array:
+		*lines = append(*lines, fmt.Sprintf("for %s := 0; %s < len(%s); %s++ {", iterVar, iterVar, a, iterVar))
+		g.equalLines(lines, a+"["+iterVar+"]", b+"["+iterVar+"]", t.Elem(), iterLevel+1, false)
+		*lines = append(*lines, "}")
+	case k == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		*lines = append(*lines, fmt.Sprintf("if !bytes.Equal(%s, %s) {\nreturn false\n}", a, b))
+	case k == reflect.Slice:
+		*lines = append(*lines, fmt.Sprintf("if len(%s) != len(%s) {\nreturn false\n}", a, b))
+		*lines = append(*lines, fmt.Sprintf("for %s := 0; %s < len(%s); %s++ {", iterVar, iterVar, a, iterVar))
+		g.equalLines(lines, a+"["+iterVar+"]", b+"["+iterVar+"]", t.Elem(), iterLevel+1, false)
+		*lines = append(*lines, "}")
+	case k == reflect.Ptr:
+		*lines = append(*lines, fmt.Sprintf("if (%s == nil) != (%s == nil) {\nreturn false\n}", a, b))
+		*lines = append(*lines, fmt.Sprintf("if %s != nil {", a))
+		g.equalLines(lines, "(*"+a+")", "(*"+b+")", t.Elem(), iterLevel, false)
+		*lines = append(*lines, "}")
+	case k == reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			name := t.Field(i).Name
+			g.equalLines(lines, a+"."+name, b+"."+name, t.Field(i).Type, iterLevel, false)
+		}
+	default:
+		panic(fmt.Sprintf("%s.%s of kind %s is not supported", t.PkgPath(), t.Name(), k))
+	}
+}
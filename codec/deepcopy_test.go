@@ -0,0 +1,138 @@
+package codec_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/dex/codec"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// requireNoSharedMemory fails if the mutable memory reachable from a is also
+// reachable from b at the same place
+func requireNoSharedMemory(t *testing.T, a, b reflect.Value, path string) {
+	switch a.Kind() {
+	case reflect.Slice:
+		if a.Len() != 0 {
+			require.NotEqual(t, a.Pointer(), b.Pointer(), path)
+		}
+		for i := 0; i < a.Len(); i++ {
+			requireNoSharedMemory(t, a.Index(i), b.Index(i), path+"[]")
+		}
+	case reflect.Ptr:
+		if !a.IsNil() {
+			require.NotEqual(t, a.Pointer(), b.Pointer(), path)
+			requireNoSharedMemory(t, a.Elem(), b.Elem(), path)
+		}
+	case reflect.Interface:
+		if !a.IsNil() {
+			requireNoSharedMemory(t, a.Elem(), b.Elem(), path)
+		}
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			requireNoSharedMemory(t, a.Index(i), b.Index(i), path+"[]")
+		}
+	case reflect.Struct:
+		// the locations of the times are shared, but never changed
+		if a.Type() == timeType {
+			return
+		}
+		for i := 0; i < a.NumField(); i++ {
+			requireNoSharedMemory(t, a.Field(i), b.Field(i), path+"."+a.Type().Field(i).Name)
+		}
+	}
+}
+
+// jsonEqual reports whether a and b have the same JSON encoding, if they both
+// have one, as the random times can be out of the range of JSON
+func jsonEqual(a, b interface{}) (equal bool, ok bool) {
+	bzA, errA := json.Marshal(a)
+	bzB, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return false, false
+	}
+	return bytes.Equal(bzA, bzB), true
+}
+
+func mustEncodeAny(t *testing.T, v interface{}) []byte {
+	var buf bytes.Buffer
+	require.Nil(t, codec.EncodeAny(&buf, v))
+	return buf.Bytes()
+}
+
+func TestDeepCopyAny(t *testing.T) {
+	for i := 0; i < 2000; i++ {
+		v := codec.RandAny(newRandSrc(int64(i)))
+		c := codec.DeepCopyAny(v)
+		require.Equal(t, reflect.TypeOf(v), reflect.TypeOf(c))
+		if equal, ok := jsonEqual(v, c); ok {
+			require.True(t, equal, "%T", v)
+		}
+		require.True(t, codec.EqualAny(v, c), "%T", v)
+		requireNoSharedMemory(t, reflect.ValueOf(v), reflect.ValueOf(c), reflect.TypeOf(v).Name())
+
+		ptr := reflect.New(reflect.TypeOf(v))
+		ptr.Elem().Set(reflect.ValueOf(v))
+		p := codec.DeepCopyAny(ptr.Interface())
+		require.True(t, codec.EqualAny(ptr.Interface(), p))
+		require.False(t, codec.EqualAny(v, p))
+		requireNoSharedMemory(t, ptr, reflect.ValueOf(p), "*"+reflect.TypeOf(v).Name())
+	}
+}
+
+// The values are compared with the values decoded from their codon encoding
+// with a byte changed, which are close to them, and equal only if the changed
+// byte does not matter. The decoded values are compared, as the random ones can
+// have empty slices, which are decoded as nil ones with other JSON encodings.
+// The values equal have the same JSON encoding, but not always the other way
+// round, as JSON replaces the invalid UTF-8 of the random strings, so they are
+// compared by their codon encodings too.
+func TestEqualAnyMatchesJSON(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	compared, notEqual := 0, 0
+	for i := 0; i < 2000; i++ {
+		bz := mustEncodeAny(t, codec.RandAny(newRandSrc(int64(i))))
+		v, _, err := codec.DecodeAny(bz)
+		require.Nil(t, err)
+
+		bz[4+r.Intn(len(bz)-4)] ^= byte(1 + r.Intn(255))
+		w, _, err := codec.DecodeAny(bz)
+		if err != nil {
+			continue
+		}
+		equal := codec.EqualAny(v, w)
+		require.Equal(t, bytes.Equal(mustEncodeAny(t, v), mustEncodeAny(t, w)), equal, "%T", v)
+		if jsonEq, ok := jsonEqual(v, w); ok {
+			compared++
+			if equal {
+				require.True(t, jsonEq, "%T", v)
+			}
+		}
+		if !equal {
+			notEqual++
+		}
+	}
+	require.True(t, compared > 1000, "only %d values compared by JSON", compared)
+	require.True(t, notEqual > 1000, "only %d values not equal", notEqual)
+}
+
+func TestEqualNilAndEmpty(t *testing.T) {
+	require.True(t, codec.EqualStdTx(codec.StdTx{}, codec.StdTx{Msgs: []codec.Msg{}, Signatures: []codec.StdSignature{}}))
+	require.False(t, codec.EqualStdTx(codec.StdTx{}, codec.StdTx{Msgs: []codec.Msg{nil}}))
+	require.True(t, codec.EqualMsg(nil, nil))
+	require.False(t, codec.EqualMsg(nil, codec.MsgSend{}))
+	require.True(t, codec.EqualBaseAccount(codec.BaseAccount{}, codec.DeepCopyBaseAccount(codec.BaseAccount{})))
+
+	acc := codec.AccountX{Address: []byte("addr")}
+	c := codec.DeepCopyAccountX(acc)
+	c.Address[0] = 'A'
+	require.Equal(t, "addr", string(acc.Address))
+	require.False(t, codec.EqualAccountX(acc, c))
+}
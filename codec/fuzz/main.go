@@ -28,7 +28,6 @@ func main() {
 		}
 
 		ifc := dexcodec.RandAny(r)
-		origS, _ := json.Marshal(ifc)
 		var buf bytes.Buffer
 		err := dexcodec.EncodeAny(&buf, ifc)
 		if err != nil {
@@ -42,8 +41,9 @@ func main() {
 			codon.ShowInfoForVar(leafTypes, ifc)
 			panic(err)
 		}
-		decS, _ := json.Marshal(ifcDec)
-		if !bytes.Equal(origS, decS) {
+		if !dexcodec.EqualAny(ifc, ifcDec) {
+			origS, _ := json.Marshal(ifc)
+			decS, _ := json.Marshal(ifcDec)
 			fmt.Printf("Now: %d\n%s\n%s\n", i, string(origS), string(decS))
 			codon.ShowInfoForVar(leafTypes, ifc)
 			panic("Mismatch!")
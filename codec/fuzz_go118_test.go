@@ -0,0 +1,106 @@
+//go:build go1.18
+// +build go1.18
+
+package codec_test
+
+import (
+	"bytes"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/codec"
+)
+
+// The fuzz targets need the fuzzing of go 1.18, the helpers they share with the
+// other tests are in fuzz_test.go.
+
+// maxAllocPerByte bounds the memory the decoders allocate for each byte they
+// decode, as a slice can not have more elements than the bytes left
+const (
+	maxAllocPerByte = 1024
+	maxAllocBase    = 1 << 20
+)
+
+// checkAlloc fails if decode allocates too much memory for the size of bz
+func checkAlloc(t *testing.T, bz []byte, decode func()) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	decode()
+	runtime.ReadMemStats(&after)
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > maxAllocBase+maxAllocPerByte*uint64(len(bz)) {
+		t.Fatalf("%d bytes allocated to decode %d bytes", alloc, len(bz))
+	}
+}
+
+// bareDecodeTargets are pointers to the types which BareDecodeAny decodes
+func bareDecodeTargets() []interface{} {
+	var targets []interface{}
+	for _, av := range codec.GetTypeList() {
+		if reflect.TypeOf(av.Value).Kind() != reflect.Ptr {
+			targets = append(targets, reflect.New(reflect.TypeOf(av.Value)).Interface())
+		}
+	}
+	return targets
+}
+
+func addSeeds(f *testing.F) {
+	r := newRandSrc(0)
+	for i := 0; i < 20; i++ {
+		var buf bytes.Buffer
+		if codec.EncodeAny(&buf, codec.RandAny(r)) == nil {
+			f.Add(buf.Bytes())
+		}
+	}
+}
+
+func FuzzDecodeAny(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		var v interface{}
+		var err error
+		checkAlloc(t, bz, func() {
+			v, _, err = codec.DecodeAny(bz)
+		})
+		if err != nil {
+			return
+		}
+		// what is decoded can be encoded, and decoded to the same value
+		var buf bytes.Buffer
+		require.Nil(t, codec.EncodeAny(&buf, v))
+		v2, n, err := codec.DecodeAny(buf.Bytes())
+		require.Nil(t, err)
+		require.Equal(t, buf.Len(), n)
+		var buf2 bytes.Buffer
+		require.Nil(t, codec.EncodeAny(&buf2, v2))
+		require.Equal(t, buf.Bytes(), buf2.Bytes())
+	})
+}
+
+func FuzzBareDecodeAny(f *testing.F) {
+	targets := bareDecodeTargets()
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		if len(bz) == 0 {
+			return
+		}
+		// the first byte selects the type to decode the others to
+		target := targets[int(bz[0])%len(targets)]
+		checkAlloc(t, bz[1:], func() {
+			_, _ = codec.BareDecodeAny(bz[1:], target)
+		})
+	})
+}
+
+func FuzzRandAnyMatchesAmino(f *testing.F) {
+	cdc := app.MakeCodec()
+	for i := 0; i < 10; i++ {
+		f.Add(newRandSrc(int64(i)).bz[:512])
+	}
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		checkRandAny(t, cdc, &bytesRandSrc{bz: bz})
+	})
+}
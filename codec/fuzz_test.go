@@ -0,0 +1,97 @@
+package codec_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/codec"
+)
+
+// bytesRandSrc is a RandSrc taking the random values from the fuzz input, and
+// zeros after the input is used up
+type bytesRandSrc struct {
+	bz []byte
+}
+
+func (r *bytesRandSrc) next(n int) []byte {
+	res := make([]byte, n)
+	copy(res, r.bz)
+	if n > len(r.bz) {
+		n = len(r.bz)
+	}
+	r.bz = r.bz[n:]
+	return res
+}
+
+func (r *bytesRandSrc) GetBool() bool          { return r.GetUint8()%2 == 1 }
+func (r *bytesRandSrc) GetInt() int            { return int(r.GetUint64()) }
+func (r *bytesRandSrc) GetInt8() int8          { return int8(r.GetUint8()) }
+func (r *bytesRandSrc) GetInt16() int16        { return int16(r.GetUint16()) }
+func (r *bytesRandSrc) GetInt32() int32        { return int32(r.GetUint32()) }
+func (r *bytesRandSrc) GetInt64() int64        { return int64(r.GetUint64()) }
+func (r *bytesRandSrc) GetUint() uint          { return uint(r.GetUint64()) }
+func (r *bytesRandSrc) GetUint8() uint8        { return r.next(1)[0] }
+func (r *bytesRandSrc) GetUint16() uint16      { return binary.LittleEndian.Uint16(r.next(2)) }
+func (r *bytesRandSrc) GetUint32() uint32      { return binary.LittleEndian.Uint32(r.next(4)) }
+func (r *bytesRandSrc) GetUint64() uint64      { return binary.LittleEndian.Uint64(r.next(8)) }
+func (r *bytesRandSrc) GetFloat32() float32    { return float32(r.GetUint32()) }
+func (r *bytesRandSrc) GetFloat64() float64    { return float64(r.GetUint64()) }
+func (r *bytesRandSrc) GetString(n int) string { return string(r.GetBytes(n)) }
+func (r *bytesRandSrc) GetBytes(n int) []byte  { return r.next(n) }
+
+func newRandSrc(seed int64) *bytesRandSrc {
+	bz := make([]byte, 1<<16)
+	rand.New(rand.NewSource(seed)).Read(bz)
+	return &bytesRandSrc{bz: bz}
+}
+
+// The codon encoding is not the amino one: there are no field tags, and the
+// lengths and the signed integers are zigzag-encoded. So the values are compared
+// through amino: the value decoded by codon must have the same amino encoding as
+// the value encoded, and so must the value decoded by amino from it.
+func checkRandAny(t *testing.T, cdc *amino.Codec, r codec.RandSrc) bool {
+	v := codec.RandAny(r)
+	var buf bytes.Buffer
+	require.Nil(t, codec.EncodeAny(&buf, v))
+	decoded, n, err := codec.DecodeAny(buf.Bytes())
+	require.Nil(t, err)
+	require.Equal(t, buf.Len(), n)
+	var buf2 bytes.Buffer
+	require.Nil(t, codec.EncodeAny(&buf2, decoded))
+	require.Equal(t, buf.Bytes(), buf2.Bytes())
+
+	aminoBz, err := cdc.MarshalBinaryBare(v)
+	if err != nil {
+		// as the random times out of the range of amino
+		return false
+	}
+	require.Equal(t, aminoBz, cdc.MustMarshalBinaryBare(decoded), "%T", v)
+
+	aminoDecoded := reflect.New(reflect.TypeOf(v))
+	if cdc.UnmarshalBinaryBare(aminoBz, aminoDecoded.Interface()) != nil {
+		// as the interfaces amino can not decode to a value
+		return true
+	}
+	var buf3 bytes.Buffer
+	require.Nil(t, codec.EncodeAny(&buf3, aminoDecoded.Elem().Interface()))
+	require.Equal(t, buf.Bytes(), buf3.Bytes(), "%T", v)
+	return true
+}
+
+func TestRandAnyMatchesAmino(t *testing.T) {
+	cdc := app.MakeCodec()
+	compared := 0
+	for i := 0; i < 2000; i++ {
+		if checkRandAny(t, cdc, newRandSrc(int64(i))) {
+			compared++
+		}
+	}
+	require.True(t, compared > 1000, "only %d values compared with amino", compared)
+}
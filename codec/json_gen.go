@@ -0,0 +1,252 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/coinexchain/codon"
+	amino "github.com/tendermint/go-amino"
+)
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// jsonGen generates the EncodeJSON functions, which write the amino JSON of the
+// types without reflection. It follows encodeReflectJSON of amino: the types with
+// a MarshalJSON method are written by it, the registered types are wrapped as
+// {"type":...,"value":...} in the interfaces, the 64-bit integers are quoted and
+// the byte slices are in base64.
+type jsonGen struct {
+	typeIndex
+	names   map[string]string // the names registered in amino, by alias
+	counter int
+}
+
+func newJSONGen(cdc *amino.Codec, list []codon.AliasAndValue) *jsonGen {
+	idx := newTypeIndex(list)
+	return &jsonGen{
+		typeIndex: idx,
+		names:     aminoNames(cdc, idx),
+	}
+}
+
+// aminoNames returns the names of the types registered in the amino codec, found
+// by the prefixes of their binary encodings
+func aminoNames(cdc *amino.Codec, idx typeIndex) map[string]string {
+	var buf bytes.Buffer
+	if err := cdc.PrintTypes(&buf); err != nil {
+		panic(err)
+	}
+	prefix2name := make(map[string]string)
+	for _, line := range strings.Split(buf.String(), "\n")[2:] {
+		if cols := strings.Split(line, "|"); len(cols) > 3 {
+			prefix2name[strings.TrimSpace(cols[3])] = strings.TrimSpace(cols[2])
+		}
+	}
+	names := make(map[string]string)
+	for _, alias := range idx.concretes {
+		bz, err := cdc.MarshalBinaryBare(reflect.Zero(idx.types[alias]).Interface())
+		if err != nil || len(bz) < 4 {
+			continue
+		}
+		if name, ok := prefix2name[fmt.Sprintf("0x%X", bz[:4])]; ok {
+			names[alias] = name
+		}
+	}
+	return names
+}
+
+// GenerateJSONFile writes the EncodeJSON functions of the types in the list,
+// which are appended to the code generated by codon
+func GenerateJSONFile(w io.Writer, cdc *amino.Codec, list []codon.AliasAndValue) {
+	g := newJSONGen(cdc, list)
+	for _, alias := range g.concretes {
+		var lines []string
+		g.counter = 0
+		g.jsonLines(&lines, "v", g.types[alias], 0, true)
+		fmt.Fprintf(w, "func EncodeJSON%s(w *bytes.Buffer, v %s) error {\n%s\nreturn nil\n} //End of EncodeJSON%s\n\n",
+			alias, alias, strings.Join(lines, "\n"), alias)
+	}
+	for _, alias := range g.ifcs {
+		fmt.Fprintf(w, "func EncodeJSON%s(w *bytes.Buffer, x %s) error {\nswitch v := x.(type) {\ncase nil:\nw.WriteString(\"null\")\nreturn nil\n", alias, alias)
+		g.writeWrappedCases(w, g.implementations(g.types[alias]), false)
+		fmt.Fprintf(w, "default:\nreturn errors.New(\"Unknown type\")\n} // end of switch\n} //End of EncodeJSON%s\n\n", alias)
+	}
+	fmt.Fprintf(w, "// EncodeJSONAny writes the amino JSON of x, as MarshalJSON of the amino codec\n")
+	fmt.Fprintf(w, "func EncodeJSONAny(w *bytes.Buffer, x interface{}) error {\nswitch v := x.(type) {\ncase nil:\nw.WriteString(\"null\")\nreturn nil\n")
+	g.writeWrappedCases(w, g.concretes, true)
+	fmt.Fprintf(w, "default:\nreturn errors.New(\"Unknown type\")\n} // end of switch\n} //End of EncodeJSONAny\n\n")
+}
+
+// writeWrappedCases writes the cases of a type switch on v writing the registered
+// types wrapped with their names. The types not registered are only written by
+// EncodeJSONAny, as amino can not write them in the interfaces.
+func (g *jsonGen) writeWrappedCases(w io.Writer, aliases []string, any bool) {
+	for _, alias := range aliases {
+		name, registered := g.names[alias]
+		if !registered && !any {
+			continue
+		}
+		open, end := "", ""
+		if registered {
+			open = fmt.Sprintf("w.WriteString(%s)\n", strconv.Quote(`{"type":"`+name+`","value":`))
+			end = "w.WriteByte('}')\n"
+		}
+		fmt.Fprintf(w, "case %s:\n%sif err := EncodeJSON%s(w, v); err != nil {\nreturn err\n}\n%sreturn nil\n",
+			alias, open, alias, end)
+		// amino writes the nil pointers as null, but does not allow them in the interfaces
+		nilPtr := "return errors.New(\"nil pointer in an interface\")"
+		if any {
+			nilPtr = open + "w.WriteString(\"null\")\n" + end + "return nil"
+		}
+		fmt.Fprintf(w, "case *%s:\nif v == nil {\n%s\n}\n%sif err := EncodeJSON%s(w, *v); err != nil {\nreturn err\n}\n%sreturn nil\n",
+			alias, nilPtr, open, alias, end)
+	}
+}
+
+func (g *jsonGen) writeStr(lines *[]string, s string) {
+	*lines = append(*lines, fmt.Sprintf("w.WriteString(%s)", strconv.Quote(s)))
+}
+
+// jsonLines generates the lines writing the amino JSON of the value of expr
+func (g *jsonGen) jsonLines(lines *[]string, expr string, t reflect.Type, iterLevel int, top bool) {
+	if t.Kind() == reflect.Ptr {
+		*lines = append(*lines, fmt.Sprintf("if %s == nil {\nw.WriteString(\"null\")\n} else {", expr))
+		g.jsonLines(lines, "(*"+expr+")", t.Elem(), iterLevel, false)
+		*lines = append(*lines, "}")
+		return
+	}
+	if t.PkgPath() == "time" && t.Name() == "Time" {
+		// amino writes the times in UTC
+		*lines = append(*lines, fmt.Sprintf("if err := codonWriteJSON(w, %s.Round(0).UTC()); err != nil {\nreturn err\n}", expr))
+		return
+	}
+	if t.Implements(jsonMarshalerType) {
+		*lines = append(*lines, fmt.Sprintf("if err := codonWriteJSON(w, %s); err != nil {\nreturn err\n}", expr))
+		return
+	}
+	if reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		panic(fmt.Sprintf("%s.%s implements json.Marshaler by pointer, which is not supported", t.PkgPath(), t.Name()))
+	}
+	if alias, ok := g.aliases[t]; ok && !top {
+		if !isBasicKind(t.Kind()) {
+			*lines = append(*lines, fmt.Sprintf("if err := EncodeJSON%s(w, %s); err != nil {\nreturn err\n}", alias, expr))
+			return
+		}
+	}
+	iterVar := fmt.Sprintf("_%d", iterLevel)
+	switch t.Kind() {
+	case reflect.Bool:
+		*lines = append(*lines, fmt.Sprintf("codonWriteJSONBool(w, bool(%s))", expr))
+	case reflect.Int, reflect.Int64:
+		*lines = append(*lines, fmt.Sprintf("codonWriteJSONQuotedInt(w, int64(%s))", expr))
+	case reflect.Uint, reflect.Uint64:
+		*lines = append(*lines, fmt.Sprintf("codonWriteJSONQuotedUint(w, uint64(%s))", expr))
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		*lines = append(*lines, fmt.Sprintf("codonWriteJSONInt(w, int64(%s))", expr))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		*lines = append(*lines, fmt.Sprintf("codonWriteJSONUint(w, uint64(%s))", expr))
+	case reflect.String:
+		*lines = append(*lines, fmt.Sprintf("codonWriteJSONString(w, string(%s))", expr))
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice {
+			*lines = append(*lines, fmt.Sprintf("if %s == nil {\nw.WriteString(\"null\")\n} else {", expr))
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			*lines = append(*lines, fmt.Sprintf("codonWriteJSONBytes(w, %s[:])", expr))
+		} else {
+			*lines = append(*lines, "w.WriteByte('[')")
+			*lines = append(*lines, fmt.Sprintf("for %s := 0; %s < len(%s); %s++ {\nif %s != 0 {\nw.WriteByte(',')\n}",
+				iterVar, iterVar, expr, iterVar, iterVar))
+			g.jsonLines(lines, expr+"["+iterVar+"]", t.Elem(), iterLevel+1, false)
+			*lines = append(*lines, "}\nw.WriteByte(']')")
+		}
+		if t.Kind() == reflect.Slice {
+			*lines = append(*lines, "}")
+		}
+	case reflect.Struct:
+		g.structLines(lines, expr, t, iterLevel)
+	default:
+		panic(fmt.Sprintf("%s.%s of kind %s is not supported", t.PkgPath(), t.Name(), t.Kind()))
+	}
+}
+
+// structLines generates the lines writing the fields of a struct, as amino does:
+// the embedded structs are fields named after their types, and the fields with
+// omitempty are skipped if empty. A comma is written before a field if a field
+// was written before it, which is only known when the program runs if the
+// fields before it have omitempty.
+func (g *jsonGen) structLines(lines *[]string, expr string, t reflect.Type, iterLevel int) {
+	*lines = append(*lines, "w.WriteByte('{')")
+	commaVar := ""
+	written := false
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonTag := field.Tag.Get("json")
+		if len(field.PkgPath) != 0 || jsonTag == "-" {
+			continue
+		}
+		tagParts := strings.Split(jsonTag, ",")
+		name := field.Name
+		if tagParts[0] != "" {
+			name = tagParts[0]
+		}
+		// amino only reads omitempty right after the name
+		omitEmpty := len(tagParts) > 1 && tagParts[1] == "omitempty"
+		nameJSON, err := json.Marshal(name)
+		if err != nil {
+			panic(err)
+		}
+		fieldExpr := expr + "." + field.Name
+
+		if omitEmpty {
+			if commaVar == "" {
+				g.counter++
+				commaVar = fmt.Sprintf("_comma%d", g.counter)
+				*lines = append(*lines, fmt.Sprintf("%s := %v", commaVar, written))
+			}
+			*lines = append(*lines, fmt.Sprintf("if %s {", nonEmptyCond(fieldExpr, field.Type)))
+		}
+		switch {
+		case commaVar != "":
+			*lines = append(*lines, fmt.Sprintf("if %s {\nw.WriteByte(',')\n}", commaVar))
+			g.writeStr(lines, string(nameJSON)+":")
+		case written:
+			g.writeStr(lines, ","+string(nameJSON)+":")
+		default:
+			g.writeStr(lines, string(nameJSON)+":")
+		}
+		g.jsonLines(lines, fieldExpr, field.Type, iterLevel, false)
+		if commaVar != "" {
+			*lines = append(*lines, fmt.Sprintf("%s = true", commaVar))
+		}
+		if omitEmpty {
+			*lines = append(*lines, "}")
+		} else {
+			written = true
+		}
+	}
+	if commaVar != "" {
+		*lines = append(*lines, fmt.Sprintf("_ = %s", commaVar))
+	}
+	*lines = append(*lines, "w.WriteByte('}')")
+}
+
+// nonEmptyCond returns the condition of the value of expr being written with
+// omitempty, which skips the zero values and the empty slices and strings
+func nonEmptyCond(expr string, t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String, reflect.Slice:
+		return fmt.Sprintf("len(%s) != 0", expr)
+	case reflect.Bool:
+		return expr
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("%s != 0", expr)
+	}
+	panic(fmt.Sprintf("omitempty of %s.%s of kind %s is not supported", t.PkgPath(), t.Name(), t.Kind()))
+}
@@ -0,0 +1,74 @@
+package codec_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/dex/app"
+	"github.com/coinexchain/dex/codec"
+)
+
+// aminoJSON returns the amino JSON of v, or an error if amino fails or panics
+func aminoJSON(v interface{}) (bz []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("amino panics: %v", r)
+		}
+	}()
+	return app.MakeCodec().MarshalJSON(v)
+}
+
+func requireSameJSON(t *testing.T, v interface{}) bool {
+	expected, aminoErr := aminoJSON(v)
+	var buf bytes.Buffer
+	err := codec.EncodeJSONAny(&buf, v)
+	if aminoErr != nil {
+		require.NotNil(t, err, "%T: %v", v, aminoErr)
+		return false
+	}
+	require.Nil(t, err, "%T", v)
+	require.Equal(t, string(expected), buf.String(), "%T", v)
+	return true
+}
+
+func TestEncodeJSONAnyMatchesAmino(t *testing.T) {
+	same := 0
+	for i := 0; i < 2000; i++ {
+		v := codec.RandAny(newRandSrc(int64(i)))
+		if requireSameJSON(t, v) {
+			same++
+		}
+		ptr := reflect.New(reflect.TypeOf(v))
+		ptr.Elem().Set(reflect.ValueOf(v))
+		requireSameJSON(t, ptr.Interface())
+		requireSameJSON(t, reflect.Zero(ptr.Type()).Interface())
+	}
+	// the random times out of the range of MarshalJSON fail in both
+	require.True(t, same > 1000, "%d", same)
+	requireSameJSON(t, nil)
+}
+
+func TestEncodeJSONSpecialValues(t *testing.T) {
+	addr := []byte("addr")
+	values := []interface{}{
+		codec.StdTx{},
+		codec.StdTx{Msgs: []codec.Msg{}, Memo: "<a&b>  \x00\x7f\xff\t\"\\é"},
+		codec.StdTx{Msgs: []codec.Msg{codec.MsgSetReferee{Sender: addr, Referee: addr}}},
+		codec.AccountX{Address: addr},
+		codec.AccountX{Address: addr, RefereeChangeTime: 1},
+		codec.MsgEditValidator{},
+		codec.BaseVestingAccount{},
+		codec.PubKeyEd25519{},
+	}
+	for _, v := range values {
+		require.True(t, requireSameJSON(t, v), "%T", v)
+	}
+
+	// amino does not allow the nil pointers in the interfaces
+	var buf bytes.Buffer
+	require.NotNil(t, codec.EncodeJSONAny(&buf, codec.StdTx{Msgs: []codec.Msg{(*codec.MsgSetReferee)(nil)}}))
+}
@@ -1,9 +1,14 @@
 package codec
 
 import (
+	"bytes"
+	"go/format"
 	"io"
+	"regexp"
+	"strings"
 
 	"github.com/coinexchain/codon"
+	amino "github.com/tendermint/go-amino"
 )
 
 func ShowInfo() {
@@ -21,6 +26,7 @@ func ShowInfo() {
 	codon.ShowInfoForVar(leafTypes, BaseVestingAccount{})
 	codon.ShowInfoForVar(leafTypes, ContinuousVestingAccount{})
 	codon.ShowInfoForVar(leafTypes, DelayedVestingAccount{})
+	codon.ShowInfoForVar(leafTypes, PeriodicVestingAccount{})
 	codon.ShowInfoForVar(leafTypes, ModuleAccount{})
 	codon.ShowInfoForVar(leafTypes, StdTx{})
 	codon.ShowInfoForVar(leafTypes, MsgBeginRedelegate{})
@@ -45,9 +51,11 @@ func ShowInfo() {
 	codon.ShowInfoForVar(leafTypes, Supply{})
 
 	codon.ShowInfoForVar(leafTypes, AccountX{})
+	codon.ShowInfoForVar(leafTypes, MsgSetReferee{})
 	codon.ShowInfoForVar(leafTypes, MsgMultiSendX{})
 	codon.ShowInfoForVar(leafTypes, MsgSendX{})
 	codon.ShowInfoForVar(leafTypes, MsgSetMemoRequired{})
+	codon.ShowInfoForVar(leafTypes, MsgSupervisedSend{})
 	codon.ShowInfoForVar(leafTypes, BaseToken{})
 	codon.ShowInfoForVar(leafTypes, MsgAddTokenWhitelist{})
 	codon.ShowInfoForVar(leafTypes, MsgBurnToken{})
@@ -70,14 +78,19 @@ func ShowInfo() {
 	codon.ShowInfoForVar(leafTypes, MsgModifyPricePrecision{})
 	codon.ShowInfoForVar(leafTypes, Order{})
 	codon.ShowInfoForVar(leafTypes, MarketInfo{})
+	codon.ShowInfoForVar(leafTypes, MsgAddLiquidity{})
+	codon.ShowInfoForVar(leafTypes, MsgRemoveLiquidity{})
+	codon.ShowInfoForVar(leafTypes, MsgAutoSwapCreateOrder{})
+	codon.ShowInfoForVar(leafTypes, MsgAutoSwapCancelOrder{})
 	codon.ShowInfoForVar(leafTypes, &MsgDonateToCommunityPool{})
 	codon.ShowInfoForVar(leafTypes, &MsgCommentToken{})
 	codon.ShowInfoForVar(leafTypes, &State{})
 	codon.ShowInfoForVar(leafTypes, &MsgAliasUpdate{})
 }
 
-func GenerateCodecFile(w io.Writer) {
-	list := []codon.AliasAndValue{
+// GetTypeList returns the types which the codec is generated for, with their aliases in types.go
+func GetTypeList() []codon.AliasAndValue {
+	return []codon.AliasAndValue{
 		{Alias: "PubKey", Value: (*PubKey)(nil)},
 		{Alias: "Msg", Value: (*Msg)(nil)},
 		{Alias: "Account", Value: (*Account)(nil)},
@@ -101,11 +114,13 @@ func GenerateCodecFile(w io.Writer) {
 		{Alias: "Output", Value: Output{}},
 		{Alias: "AccAddress", Value: AccAddress{}},
 		{Alias: "CommentRef", Value: CommentRef{}},
+		{Alias: "Period", Value: Period{}},
 
 		{Alias: "BaseAccount", Value: BaseAccount{}},
 		{Alias: "BaseVestingAccount", Value: BaseVestingAccount{}},
 		{Alias: "ContinuousVestingAccount", Value: ContinuousVestingAccount{}},
 		{Alias: "DelayedVestingAccount", Value: DelayedVestingAccount{}},
+		{Alias: "PeriodicVestingAccount", Value: PeriodicVestingAccount{}},
 		{Alias: "ModuleAccount", Value: ModuleAccount{}},
 		{Alias: "StdTx", Value: StdTx{}},
 		{Alias: "MsgBeginRedelegate", Value: MsgBeginRedelegate{}},
@@ -130,9 +145,11 @@ func GenerateCodecFile(w io.Writer) {
 		{Alias: "Supply", Value: Supply{}},
 
 		{Alias: "AccountX", Value: AccountX{}},
+		{Alias: "MsgSetReferee", Value: MsgSetReferee{}},
 		{Alias: "MsgMultiSendX", Value: MsgMultiSendX{}},
 		{Alias: "MsgSendX", Value: MsgSendX{}},
 		{Alias: "MsgSetMemoRequired", Value: MsgSetMemoRequired{}},
+		{Alias: "MsgSupervisedSend", Value: MsgSupervisedSend{}},
 		{Alias: "BaseToken", Value: BaseToken{}},
 		{Alias: "MsgAddTokenWhitelist", Value: MsgAddTokenWhitelist{}},
 		{Alias: "MsgBurnToken", Value: MsgBurnToken{}},
@@ -155,17 +172,152 @@ func GenerateCodecFile(w io.Writer) {
 		{Alias: "MsgModifyPricePrecision", Value: MsgModifyPricePrecision{}},
 		{Alias: "Order", Value: Order{}},
 		{Alias: "MarketInfo", Value: MarketInfo{}},
+		{Alias: "MsgAddLiquidity", Value: MsgAddLiquidity{}},
+		{Alias: "MsgRemoveLiquidity", Value: MsgRemoveLiquidity{}},
+		{Alias: "MsgAutoSwapCreateOrder", Value: MsgAutoSwapCreateOrder{}},
+		{Alias: "MsgAutoSwapCancelOrder", Value: MsgAutoSwapCancelOrder{}},
 		{Alias: "MsgDonateToCommunityPool", Value: MsgDonateToCommunityPool{}},
 		{Alias: "MsgCommentToken", Value: MsgCommentToken{}},
 		{Alias: "State", Value: State{}},
 		{Alias: "MsgAliasUpdate", Value: MsgAliasUpdate{}},
 	}
+}
 
-	extraImports := []string{`"time"`, `sdk "github.com/cosmos/cosmos-sdk/types"`}
+// GenerateCodecFile writes the code of codec.go, whose JSON encoders use the
+// names of the types registered in cdc
+func GenerateCodecFile(w io.Writer, cdc *amino.Codec) {
+	extraImports := []string{`"bytes"`, `"encoding/base64"`, `"encoding/json"`, `"math/big"`, `"strconv"`,
+		`"time"`, `"unicode/utf8"`, `sdk "github.com/cosmos/cosmos-sdk/types"`}
 	ignoreImpl := make(map[string]string)
 	ignoreImpl["StdSignature"] = "PubKey"
 	ignoreImpl["PubKeyMultisigThreshold"] = "PubKey"
-	codon.GenerateCodecFile(w, GetLeafTypes(), ignoreImpl, list, extraLogics, extraImports)
+	codon.GenerateCodecFile(w, GetLeafTypes(), ignoreImpl, GetTypeList(), extraLogics, extraImports)
+	GenerateDeepCopyFile(w, GetLeafTypes(), GetTypeList())
+	GenerateJSONFile(w, cdc, GetTypeList())
+}
+
+// GenerateCodecSource returns the gofmt-ed code of codec.go, with the decoders
+// hardened against malformed bytes
+func GenerateCodecSource(cdc *amino.Codec) ([]byte, error) {
+	var buf bytes.Buffer
+	GenerateCodecFile(&buf, cdc)
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return format.Source([]byte(hardenDecoders(string(src))))
+}
+
+// decoderFixes are applied to the code of codon, whose decoders trust the bytes
+// they decode: they read the magic bytes without checking the length, panic on
+// unknown magic bytes, allocate the slices of any length found in the bytes and
+// fill the arrays up to any length.
+// The decoders must never panic or allocate much more than the size of the bytes,
+// so that the streaming Decoder can read the corrupt streams, and the fuzz targets
+// hold for any bytes. The errors of the bytes cut short are ErrNotEnoughBytes, so
+// that the streaming Decoder can tell them from the corrupt bytes.
+var decoderFixes = []struct{ old, new string }{
+	{
+		old: "\tfor i := 0; i < 4; i++ {\n\t\tmagicBytes[i] = bz[i]\n\t}\n",
+		new: "\tif len(bz) < 4 {\n\t\treturn v, 0, errors.New(\"Not enough bytes to read\")\n\t}\n" +
+			"\tfor i := 0; i < 4; i++ {\n\t\tmagicBytes[i] = bz[i]\n\t}\n",
+	},
+	{
+		old: "\tdefault:\n\t\tpanic(\"Unknown type\")\n\t} // end of switch\n\treturn v, n, nil\n",
+		new: "\tdefault:\n\t\treturn v, n, errors.New(\"Unknown type\")\n\t} // end of switch\n",
+	},
+	{
+		old: "\tdefault:\n\t\tpanic(\"Unknown type\")\n\t} // end of switch\n\treturn\n",
+		new: "\tdefault:\n\t\terr = errors.New(\"Unknown type\")\n\t} // end of switch\n\treturn\n",
+	},
+	{
+		old: "length = codonDecodeInt(bz, &n, &err)",
+		new: "length = codonDecodeLength(bz, &n, &err, limit)",
+	},
+	{
+		old: "\tif len(bz) < length {\n\t\treturn nil, 0, errors.New(\"Not enough bytes to read\")\n",
+		new: "\tif length < 0 {\n\t\treturn nil, 0, errors.New(\"Invalid length\")\n\t}\n" +
+			"\tif len(bz) < length {\n\t\treturn nil, 0, errors.New(\"Not enough bytes to read\")\n",
+	},
+	{
+		// the arrays have their own lengths, which are not limited
+		old: "length = codonDecodeLength(bz, &n, &err, limit)\n\tif err != nil {\n\t\treturn v, total, err\n\t}\n" +
+			"\tbz = bz[n:]\n\ttotal += n\n\tfor _0, length_0 := 0, length; _0 < length_0; _0++ { //array of uint8\n",
+		new: "length = codonDecodeLength(bz, &n, &err, -1)\n\tif err != nil {\n\t\treturn v, total, err\n\t}\n" +
+			"\tbz = bz[n:]\n\ttotal += n\n\tif length != len(v) {\n\t\treturn v, total, errors.New(\"Invalid length\")\n\t}\n" +
+			"\tfor _0, length_0 := 0, length; _0 < length_0; _0++ { //array of uint8\n",
+	},
+	{
+		// the varints cut short must not be decoded as zeros, or a value cut short
+		// would be decoded as another one by the streaming Decoder
+		old: "\t*m = n\n\t*err = nil\n\treturn int64(i)\n",
+		new: "\t*m = n\n\treturn int64(i)\n",
+	},
+	{
+		old: "\t*m = n\n\t*err = nil\n\treturn uint64(i)\n",
+		new: "\t*m = n\n\treturn uint64(i)\n",
+	},
+	{
+		// not about decoding, but go vet complains about the unreachable code
+		old: "\tpanic(\"Should not reach here\")\n\treturn []byte{}\n",
+		new: "\tpanic(\"Should not reach here\")\n",
+	},
+	{
+		old: "errors.New(\"Not enough bytes to read\")",
+		new: "ErrNotEnoughBytes",
+	},
+	{
+		old: "errors.New(\"buffer too small\")",
+		new: "ErrNotEnoughBytes",
+	},
+}
+
+var (
+	decodeFuncRegexp = regexp.MustCompile(`(?m)^func Decode(\w+)\(bz \[\]byte\) \(([^)]*)\) \{$`)
+	decodeCallRegexp = regexp.MustCompile(`\bDecode(\w+)\((bz(?:\[\d*:\d*\])?)\)`)
+)
+
+func hardenDecoders(src string) string {
+	for _, fix := range decoderFixes {
+		if !strings.Contains(src, fix.old) {
+			panic("the code of codon has changed, can not find: " + fix.old)
+		}
+		src = strings.Replace(src, fix.old, fix.new, -1)
+	}
+	return limitSliceLength(src)
+}
+
+// limitSliceLength makes each DecodeX function a wrapper of decodeX, which takes
+// the max length of the slices and passes it down to the decoders it calls, so
+// that a slice too long is rejected before it is allocated. The decoders of the
+// leaf types are written by hand and have no slices to limit.
+func limitSliceLength(src string) string {
+	leaves := make(map[string]bool)
+	for _, alias := range GetLeafTypes() {
+		leaves[alias[strings.Index(alias, ".")+1:]] = true
+	}
+	src = decodeCallRegexp.ReplaceAllStringFunc(src, func(call string) string {
+		m := decodeCallRegexp.FindStringSubmatch(call)
+		if leaves[m[1]] {
+			return call
+		}
+		return "decode" + m[1] + "(" + m[2] + ", limit)"
+	})
+	src = decodeFuncRegexp.ReplaceAllStringFunc(src, func(decl string) string {
+		m := decodeFuncRegexp.FindStringSubmatch(decl)
+		if leaves[m[1]] {
+			return decl
+		}
+		return "func Decode" + m[1] + "(bz []byte) (" + m[2] + ") {\n\treturn decode" + m[1] + "(bz, -1)\n}\n\n" +
+			"func decode" + m[1] + "(bz []byte, limit int) (" + m[2] + ") {"
+	})
+	const bareDecl = "func BareDecodeAny(bz []byte, x interface{}) (n int, err error) {"
+	if !strings.Contains(src, bareDecl) {
+		panic("the code of codon has changed, can not find: " + bareDecl)
+	}
+	return strings.Replace(src, bareDecl, "func BareDecodeAny(bz []byte, x interface{}) (n int, err error) {\n"+
+		"\treturn bareDecodeAny(bz, x, -1)\n}\n\n"+
+		"func bareDecodeAny(bz []byte, x interface{}, limit int) (n int, err error) {", 1)
 }
 
 func GetLeafTypes() map[string]string {
@@ -198,6 +350,24 @@ const MaxSliceLength = 10
 const MaxStringLength = 100
 
 var extraLogics = `
+// codonDecodeLength decodes the length of a slice, which can not be more than
+// the limit, if not negative. As each element takes at least one byte, a length
+// more than the bytes left means the bytes are cut short.
+func codonDecodeLength(bz []byte, n *int, err *error, limit int) int {
+	length := codonDecodeInt(bz, n, err)
+	if *err != nil {
+		return length
+	}
+	if length < 0 {
+		*err = errors.New("Invalid length")
+	} else if limit >= 0 && length > limit {
+		*err = ErrSliceTooLong
+	} else if length > len(bz)-*n {
+		*err = ErrNotEnoughBytes
+	}
+	return length
+}
+
 func EncodeTime(w io.Writer, t time.Time) error {
 	t = t.UTC()
 	sec := t.Unix()
@@ -319,9 +489,145 @@ func RandDec(r RandSrc) sdk.Dec {
 	for i:=0; i<count; i++ {
 		res = res.MulInt64(r.GetInt64())
 	}
-	res = res.QuoInt64(r.GetInt64()&0xFFFFFFFF)
+	res = res.QuoInt64(r.GetInt64()&0xFFFFFFFF + 1)
 	return res
 }
+
+func DeepCopyTime(t time.Time) time.Time {
+	return t
+}
+
+// EqualTime compares the instants, as the times are encoded in UTC
+func EqualTime(a, b time.Time) bool {
+	return a.Equal(b)
+}
+
+func DeepCopyInt(v sdk.Int) sdk.Int {
+	if v == (sdk.Int{}) {
+		return v
+	}
+	return sdk.NewIntFromBigInt(v.BigInt())
+}
+
+func EqualInt(a, b sdk.Int) bool {
+	if a == (sdk.Int{}) || b == (sdk.Int{}) {
+		return a == b
+	}
+	return a.Equal(b)
+}
+
+func DeepCopyDec(v sdk.Dec) sdk.Dec {
+	if v.IsNil() {
+		return v
+	}
+	return sdk.Dec{Int: new(big.Int).Set(v.Int)}
+}
+
+func EqualDec(a, b sdk.Dec) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() && b.IsNil()
+	}
+	return a.Equal(b)
+}
+
+// codonJSONEscapes holds the escapes of the ASCII bytes written by encoding/json,
+// which escapes the control bytes and <, > and & for HTML
+var codonJSONEscapes [utf8.RuneSelf]string
+
+func init() {
+	for c := 0; c < utf8.RuneSelf; c++ {
+		bz, _ := json.Marshal(string(rune(c)))
+		if s := string(bz[1 : len(bz)-1]); s != string(rune(c)) {
+			codonJSONEscapes[c] = s
+		}
+	}
+}
+
+// codonWriteJSONString writes s as json.Marshal does, with the invalid UTF-8
+// replaced by U+FFFD and U+2028 and U+2029 escaped
+func codonWriteJSONString(w *bytes.Buffer, s string) {
+	w.WriteByte('"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if codonJSONEscapes[c] != "" {
+				w.WriteString(s[start:i])
+				w.WriteString(codonJSONEscapes[c])
+				start = i + 1
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			w.WriteString(s[start:i])
+			w.WriteString("\ufffd")
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			w.WriteString(s[start:i])
+			w.WriteString("\\u202")
+			w.WriteByte(byte('8' + r - '\u2028'))
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	w.WriteString(s[start:])
+	w.WriteByte('"')
+}
+
+func codonWriteJSONBytes(w *bytes.Buffer, bz []byte) {
+	w.WriteByte('"')
+	buf := make([]byte, base64.StdEncoding.EncodedLen(len(bz)))
+	base64.StdEncoding.Encode(buf, bz)
+	w.Write(buf)
+	w.WriteByte('"')
+}
+
+func codonWriteJSONBool(w *bytes.Buffer, v bool) {
+	if v {
+		w.WriteString("true")
+	} else {
+		w.WriteString("false")
+	}
+}
+
+func codonWriteJSONInt(w *bytes.Buffer, v int64) {
+	var buf [20]byte
+	w.Write(strconv.AppendInt(buf[:0], v, 10))
+}
+
+func codonWriteJSONUint(w *bytes.Buffer, v uint64) {
+	var buf [20]byte
+	w.Write(strconv.AppendUint(buf[:0], v, 10))
+}
+
+// amino quotes the 64-bit integers, which JavaScript can not handle
+func codonWriteJSONQuotedInt(w *bytes.Buffer, v int64) {
+	w.WriteByte('"')
+	codonWriteJSONInt(w, v)
+	w.WriteByte('"')
+}
+
+func codonWriteJSONQuotedUint(w *bytes.Buffer, v uint64) {
+	w.WriteByte('"')
+	codonWriteJSONUint(w, v)
+	w.WriteByte('"')
+}
+
+// codonWriteJSON writes the output of MarshalJSON as it is, as amino does
+func codonWriteJSON(w *bytes.Buffer, v json.Marshaler) error {
+	bz, err := v.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	w.Write(bz)
+	return nil
+}
 `
 
 /*
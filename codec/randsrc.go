@@ -0,0 +1,37 @@
+package codec
+
+import (
+	"math/rand"
+)
+
+// mathRandSrc is a RandSrc of math/rand
+type mathRandSrc struct {
+	*rand.Rand
+}
+
+var _ RandSrc = mathRandSrc{}
+
+// NewRandSrc returns a RandSrc of math/rand, seeded with seed
+func NewRandSrc(seed int64) RandSrc {
+	return mathRandSrc{rand.New(rand.NewSource(seed))}
+}
+
+func (r mathRandSrc) GetBool() bool          { return r.Intn(2) == 1 }
+func (r mathRandSrc) GetInt() int            { return int(r.Uint64()) }
+func (r mathRandSrc) GetInt8() int8          { return int8(r.Uint32()) }
+func (r mathRandSrc) GetInt16() int16        { return int16(r.Uint32()) }
+func (r mathRandSrc) GetInt32() int32        { return int32(r.Uint32()) }
+func (r mathRandSrc) GetInt64() int64        { return int64(r.Uint64()) }
+func (r mathRandSrc) GetUint() uint          { return uint(r.Uint64()) }
+func (r mathRandSrc) GetUint8() uint8        { return uint8(r.Uint32()) }
+func (r mathRandSrc) GetUint16() uint16      { return uint16(r.Uint32()) }
+func (r mathRandSrc) GetUint32() uint32      { return r.Uint32() }
+func (r mathRandSrc) GetUint64() uint64      { return r.Uint64() }
+func (r mathRandSrc) GetFloat32() float32    { return r.Float32() }
+func (r mathRandSrc) GetFloat64() float64    { return r.Float64() }
+func (r mathRandSrc) GetString(n int) string { return string(r.GetBytes(n)) }
+func (r mathRandSrc) GetBytes(n int) []byte {
+	bz := make([]byte, n)
+	r.Read(bz)
+	return bz
+}
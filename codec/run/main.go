@@ -1,16 +1,44 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"os"
 
+	"github.com/coinexchain/dex/app"
 	"github.com/coinexchain/dex/codec"
 )
 
+var schema = flag.Bool("schema", false, "print the schema of the types instead")
+
 func main() {
+	flag.Parse()
 	//codec.ShowInfo()
-	genCode()
+	if *schema {
+		genSchema()
+	} else {
+		genCode()
+	}
 }
 
+// genCode prints the code of codec.go, run as: go run ./codec/run > codec/codec.go
 func genCode() {
-	codec.GenerateCodecFile(os.Stdout)
+	src, err := codec.GenerateCodecSource(app.MakeCodec())
+	exitOnErr(err)
+	os.Stdout.Write(src)
+}
+
+// genSchema prints the schema of the types in JSON, run as:
+// go run ./codec/run -schema > codec/schema.json
+func genSchema() {
+	bz, err := codec.GenerateSchemaJSON()
+	exitOnErr(err)
+	os.Stdout.Write(bz)
+}
+
+func exitOnErr(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
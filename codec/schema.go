@@ -0,0 +1,191 @@
+package codec
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+const SchemaVersion = 1
+
+// The kinds of the layouts in the schema, named after their encodings
+const (
+	KindBool      = "bool"
+	KindInt8      = "int8"
+	KindUint8     = "uint8"
+	KindInt16     = "int16"
+	KindUint16    = "uint16"
+	KindVarint    = "varint"
+	KindUvarint   = "uvarint"
+	KindFloat32   = "float32"
+	KindFloat64   = "float64"
+	KindString    = "string"
+	KindBytes     = "bytes"
+	KindList      = "list"
+	KindStruct    = "struct"
+	KindRef       = "ref"
+	KindInterface = "interface"
+	KindTime      = "time"
+	KindInt       = "int"
+	KindDec       = "dec"
+)
+
+// kindEncodings describes how the values of each kind are encoded
+var kindEncodings = map[string]string{
+	KindBool:      "1 byte, 0 or 1",
+	KindInt8:      "1 byte",
+	KindUint8:     "1 byte",
+	KindInt16:     "2 bytes, little endian",
+	KindUint16:    "2 bytes, little endian",
+	KindVarint:    "zigzag varint of 64 bits, as PutVarint of encoding/binary in Go",
+	KindUvarint:   "varint of 64 bits, as PutUvarint of encoding/binary in Go",
+	KindFloat32:   "4 bytes of IEEE 754, little endian",
+	KindFloat64:   "8 bytes of IEEE 754, little endian",
+	KindString:    "the length in bytes as a varint, then the UTF-8 bytes",
+	KindBytes:     "the count of the bytes as a varint, then the bytes; the count of an array is its length",
+	KindList:      "the count of the elements as a varint, then the elements; the count of an array is its length",
+	KindStruct:    "the fields in order, without tags",
+	KindRef:       "the layout of the type named by type, without its magic bytes",
+	KindInterface: "the 4 magic bytes of the implementation, then its layout",
+	KindTime:      "the seconds since 1970-01-01 UTC as a varint, then the nanoseconds as a varint",
+	KindInt:       "the integer in decimal as a string",
+	KindDec:       "the decimal times 10^18 as an integer in decimal, as a string",
+}
+
+// Schema describes the codon encoding of the types, for the decoders written
+// in other languages. A value encoded by EncodeAny starts with the 4 magic bytes
+// of its type, followed by its layout.
+type Schema struct {
+	Version   int               `json:"version"`
+	Encodings map[string]string `json:"encodings"`
+	Types     []TypeSchema      `json:"types"`
+}
+
+type TypeSchema struct {
+	Name string `json:"name"`
+	// the magic bytes in hex, not for the interfaces
+	MagicBytes string `json:"magic_bytes,omitempty"`
+	// the types implementing an interface
+	Implementations []string `json:"implementations,omitempty"`
+	Layout
+}
+
+// Layout describes the encoding of a type, or of a field
+type Layout struct {
+	Kind   string        `json:"kind"`
+	Length int           `json:"length,omitempty"` // of the arrays, fixed
+	Elem   *Layout       `json:"elem,omitempty"`   // of the lists
+	Fields []FieldLayout `json:"fields,omitempty"` // of the structs
+	Type   string        `json:"type,omitempty"`   // of the refs and the interfaces
+}
+
+type FieldLayout struct {
+	Name string `json:"name"`
+	Layout
+}
+
+type schemaGen struct {
+	typeIndex
+	leafTypes map[string]string
+}
+
+// GetSchema returns the schema of the types in GetTypeList
+func GetSchema() Schema {
+	g := schemaGen{
+		typeIndex: newTypeIndex(GetTypeList()),
+		leafTypes: GetLeafTypes(),
+	}
+	schema := Schema{
+		Version:   SchemaVersion,
+		Encodings: kindEncodings,
+	}
+	for _, alias := range g.ifcs {
+		schema.Types = append(schema.Types, TypeSchema{
+			Name:            alias,
+			Implementations: g.implementations(g.types[alias]),
+			Layout:          Layout{Kind: KindInterface},
+		})
+	}
+	for _, alias := range g.concretes {
+		schema.Types = append(schema.Types, TypeSchema{
+			Name:       alias,
+			MagicBytes: hex.EncodeToString(getMagicBytes(alias)),
+			Layout:     g.layout(g.types[alias], true),
+		})
+	}
+	return schema
+}
+
+// GenerateSchemaJSON returns the schema in JSON
+func GenerateSchemaJSON() ([]byte, error) {
+	bz, err := json.MarshalIndent(GetSchema(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(bz, '\n'), nil
+}
+
+// layout follows the encoding functions of codon, which write the pointers as
+// the values they point to
+func (g schemaGen) layout(t reflect.Type, top bool) Layout {
+	if _, ok := g.leafTypes[t.PkgPath()+"."+t.Name()]; ok {
+		switch t.Name() {
+		case "Time":
+			return Layout{Kind: KindTime}
+		case "Int":
+			return Layout{Kind: KindInt}
+		case "Dec":
+			return Layout{Kind: KindDec}
+		}
+	}
+	if alias, ok := g.aliases[t]; ok && !top {
+		if t.Kind() == reflect.Interface {
+			return Layout{Kind: KindInterface, Type: alias}
+		}
+		return Layout{Kind: KindRef, Type: alias}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return Layout{Kind: KindBool}
+	case reflect.Int8:
+		return Layout{Kind: KindInt8}
+	case reflect.Uint8:
+		return Layout{Kind: KindUint8}
+	case reflect.Int16:
+		return Layout{Kind: KindInt16}
+	case reflect.Uint16:
+		return Layout{Kind: KindUint16}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return Layout{Kind: KindVarint}
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return Layout{Kind: KindUvarint}
+	case reflect.Float32:
+		return Layout{Kind: KindFloat32}
+	case reflect.Float64:
+		return Layout{Kind: KindFloat64}
+	case reflect.String:
+		return Layout{Kind: KindString}
+	case reflect.Array, reflect.Slice:
+		var layout Layout
+		if t.Elem().Kind() == reflect.Uint8 {
+			layout = Layout{Kind: KindBytes}
+		} else {
+			elem := g.layout(t.Elem(), false)
+			layout = Layout{Kind: KindList, Elem: &elem}
+		}
+		if t.Kind() == reflect.Array {
+			layout.Length = t.Len()
+		}
+		return layout
+	case reflect.Ptr:
+		return g.layout(t.Elem(), top)
+	case reflect.Struct:
+		layout := Layout{Kind: KindStruct, Fields: make([]FieldLayout, t.NumField())}
+		for i := 0; i < t.NumField(); i++ {
+			layout.Fields[i] = FieldLayout{Name: t.Field(i).Name, Layout: g.layout(t.Field(i).Type, false)}
+		}
+		return layout
+	}
+	panic(fmt.Sprintf("%s.%s of kind %s is not supported", t.PkgPath(), t.Name(), t.Kind()))
+}
@@ -0,0 +1,178 @@
+package codec_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/dex/codec"
+)
+
+func TestSchemaFileIsGenerated(t *testing.T) {
+	bz, err := codec.GenerateSchemaJSON()
+	require.Nil(t, err)
+	file, err := ioutil.ReadFile("schema.json")
+	require.Nil(t, err)
+	require.True(t, bytes.Equal(bz, file), "schema.json is stale, run: go run ./codec/run -schema > codec/schema.json")
+}
+
+// schemaWalker skips the values as a decoder written from the schema alone would
+type schemaWalker struct {
+	types   map[string]codec.TypeSchema
+	byMagic map[string]string
+}
+
+func newSchemaWalker(t *testing.T) *schemaWalker {
+	bz, err := ioutil.ReadFile("schema.json")
+	require.Nil(t, err)
+	var schema codec.Schema
+	require.Nil(t, json.Unmarshal(bz, &schema))
+	w := &schemaWalker{
+		types:   make(map[string]codec.TypeSchema),
+		byMagic: make(map[string]string),
+	}
+	for _, ts := range schema.Types {
+		w.types[ts.Name] = ts
+		if ts.MagicBytes != "" {
+			w.byMagic[ts.MagicBytes] = ts.Name
+		}
+		for _, kind := range kindsOf(ts.Layout) {
+			require.Contains(t, schema.Encodings, kind)
+		}
+	}
+	return w
+}
+
+func kindsOf(l codec.Layout) []string {
+	kinds := []string{l.Kind}
+	if l.Elem != nil {
+		kinds = append(kinds, kindsOf(*l.Elem)...)
+	}
+	for _, f := range l.Fields {
+		kinds = append(kinds, kindsOf(f.Layout)...)
+	}
+	return kinds
+}
+
+// walkAny returns the count of the bytes of the value encoded by EncodeAny
+func (w *schemaWalker) walkAny(bz []byte) (int, error) {
+	if len(bz) < 4 {
+		return 0, fmt.Errorf("no magic bytes")
+	}
+	name, ok := w.byMagic[hex.EncodeToString(bz[:4])]
+	if !ok {
+		return 0, fmt.Errorf("unknown magic bytes %x", bz[:4])
+	}
+	n, err := w.walk(w.types[name].Layout, bz[4:])
+	return 4 + n, err
+}
+
+func (w *schemaWalker) walkCount(bz []byte) (int, int, error) {
+	count, n := binary.Varint(bz)
+	if n <= 0 || count < 0 || count > int64(len(bz)) {
+		return 0, 0, fmt.Errorf("invalid count")
+	}
+	return int(count), n, nil
+}
+
+func (w *schemaWalker) walk(l codec.Layout, bz []byte) (int, error) {
+	fixed := map[string]int{
+		codec.KindBool: 1, codec.KindInt8: 1, codec.KindUint8: 1,
+		codec.KindInt16: 2, codec.KindUint16: 2, codec.KindFloat32: 4, codec.KindFloat64: 8,
+	}
+	if size, ok := fixed[l.Kind]; ok {
+		if len(bz) < size {
+			return 0, fmt.Errorf("not enough bytes")
+		}
+		return size, nil
+	}
+	switch l.Kind {
+	case codec.KindVarint:
+		if _, n := binary.Varint(bz); n > 0 {
+			return n, nil
+		}
+		return 0, fmt.Errorf("invalid varint")
+	case codec.KindUvarint:
+		if _, n := binary.Uvarint(bz); n > 0 {
+			return n, nil
+		}
+		return 0, fmt.Errorf("invalid uvarint")
+	case codec.KindTime:
+		return w.walkFields(bz, codec.Layout{Kind: codec.KindVarint}, codec.Layout{Kind: codec.KindVarint})
+	case codec.KindString, codec.KindBytes, codec.KindInt, codec.KindDec:
+		count, n, err := w.walkCount(bz)
+		if err != nil || len(bz)-n < count {
+			return 0, fmt.Errorf("invalid bytes")
+		}
+		if l.Length != 0 && count != l.Length {
+			return 0, fmt.Errorf("%d bytes in an array of %d", count, l.Length)
+		}
+		return n + count, nil
+	case codec.KindList:
+		count, n, err := w.walkCount(bz)
+		if err != nil {
+			return 0, err
+		}
+		if l.Length != 0 && count != l.Length {
+			return 0, fmt.Errorf("%d elements in an array of %d", count, l.Length)
+		}
+		elems := make([]codec.Layout, count)
+		for i := range elems {
+			elems[i] = *l.Elem
+		}
+		m, err := w.walkFields(bz[n:], elems...)
+		return n + m, err
+	case codec.KindStruct:
+		fields := make([]codec.Layout, len(l.Fields))
+		for i, f := range l.Fields {
+			fields[i] = f.Layout
+		}
+		return w.walkFields(bz, fields...)
+	case codec.KindRef:
+		return w.walk(w.types[l.Type].Layout, bz)
+	case codec.KindInterface:
+		if len(bz) < 4 {
+			return 0, fmt.Errorf("no magic bytes")
+		}
+		name := w.byMagic[hex.EncodeToString(bz[:4])]
+		for _, impl := range w.types[l.Type].Implementations {
+			if impl == name {
+				n, err := w.walk(w.types[name].Layout, bz[4:])
+				return 4 + n, err
+			}
+		}
+		return 0, fmt.Errorf("%x is not of an implementation of %s", bz[:4], l.Type)
+	}
+	return 0, fmt.Errorf("unknown kind %s", l.Kind)
+}
+
+func (w *schemaWalker) walkFields(bz []byte, fields ...codec.Layout) (int, error) {
+	total := 0
+	for _, f := range fields {
+		n, err := w.walk(f, bz[total:])
+		if err != nil {
+			return 0, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func TestSchemaMatchesEncoding(t *testing.T) {
+	w := newSchemaWalker(t)
+	require.Len(t, w.types, len(codec.GetTypeList()))
+	stream, _, offsets := randStream(t, 2000)
+	offsets = append(offsets, int64(len(stream)))
+	for i := 0; i+1 < len(offsets); i++ {
+		bz := stream[offsets[i]:offsets[i+1]]
+		n, err := w.walkAny(bz)
+		require.Nil(t, err, "value %d", i)
+		require.Equal(t, len(bz), n, "value %d", i)
+	}
+}
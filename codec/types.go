@@ -22,12 +22,15 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/alias"
 	"github.com/coinexchain/cet-sdk/modules/asset"
 	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/autoswap"
 	"github.com/coinexchain/cet-sdk/modules/bancorlite"
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/modules/comment"
 	distrx "github.com/coinexchain/cet-sdk/modules/distributionx"
 	"github.com/coinexchain/cet-sdk/modules/incentive"
 	"github.com/coinexchain/cet-sdk/modules/market"
+
+	"github.com/coinexchain/dex/app/vesting"
 )
 
 type (
@@ -62,6 +65,8 @@ type (
 	BaseVestingAccount             = auth.BaseVestingAccount
 	ContinuousVestingAccount       = auth.ContinuousVestingAccount
 	DelayedVestingAccount          = auth.DelayedVestingAccount
+	PeriodicVestingAccount         = vesting.PeriodicVestingAccount
+	Period                         = vesting.Period
 	StdTx                          = auth.StdTx
 	MsgBeginRedelegate             = staking.MsgBeginRedelegate
 	MsgCreateValidator             = staking.MsgCreateValidator
@@ -86,9 +91,11 @@ type (
 	ModuleAccount                  = supply.ModuleAccount
 
 	AccountX                 = authx.AccountX
+	MsgSetReferee            = authx.MsgSetReferee
 	MsgMultiSendX            = bankx.MsgMultiSend
 	MsgSendX                 = bankx.MsgSend
 	MsgSetMemoRequired       = bankx.MsgSetMemoRequired
+	MsgSupervisedSend        = bankx.MsgSupervisedSend
 	BaseToken                = asset.BaseToken
 	MsgAddTokenWhitelist     = asset.MsgAddTokenWhitelist
 	MsgBurnToken             = asset.MsgBurnToken
@@ -111,6 +118,10 @@ type (
 	MsgModifyPricePrecision  = market.MsgModifyPricePrecision
 	Order                    = market.Order
 	MarketInfo               = market.MarketInfo
+	MsgAddLiquidity          = autoswap.MsgAddLiquidity
+	MsgRemoveLiquidity       = autoswap.MsgRemoveLiquidity
+	MsgAutoSwapCreateOrder   = autoswap.MsgCreateOrder
+	MsgAutoSwapCancelOrder   = autoswap.MsgCancelOrder
 	MsgDonateToCommunityPool = distrx.MsgDonateToCommunityPool
 	MsgCommentToken          = comment.MsgCommentToken
 	State                    = incentive.State